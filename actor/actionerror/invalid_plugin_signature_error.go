@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidPluginSignatureError is returned when a plugin binary's signature
+// does not match any of its repository's trusted keys.
+type InvalidPluginSignatureError struct {
+	RepositoryName string
+}
+
+func (e InvalidPluginSignatureError) Error() string {
+	return fmt.Sprintf("plugin binary signature does not match any trusted key of repository %s", e.RepositoryName)
+}
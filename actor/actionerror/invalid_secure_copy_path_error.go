@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// InvalidSecureCopyPathError is returned when a path to copy to or from an app
+// instance does not name a file or directory that can be copied.
+type InvalidSecureCopyPathError struct {
+	Path string
+}
+
+func (e InvalidSecureCopyPathError) Error() string {
+	return fmt.Sprintf("cannot copy '%s': path must name a file or directory", e.Path)
+}
@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginRepositoryHasNoTrustedKeysError is returned when a plugin's signature
+// cannot be verified because its repository has no trusted keys.
+type PluginRepositoryHasNoTrustedKeysError struct {
+	RepositoryName string
+}
+
+func (e PluginRepositoryHasNoTrustedKeysError) Error() string {
+	return fmt.Sprintf("repository %s has no trusted keys", e.RepositoryName)
+}
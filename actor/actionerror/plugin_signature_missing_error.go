@@ -0,0 +1,13 @@
+package actionerror
+
+import "fmt"
+
+// PluginSignatureMissingError is returned when a repository does not list a
+// signature for a plugin binary.
+type PluginSignatureMissingError struct {
+	RepositoryName string
+}
+
+func (e PluginSignatureMissingError) Error() string {
+	return fmt.Sprintf("repository %s has no signature for the plugin binary", e.RepositoryName)
+}
@@ -0,0 +1,14 @@
+package actionerror
+
+import "fmt"
+
+// RouteDestinationNotFoundError is returned when a route is not mapped to an
+// application.
+type RouteDestinationNotFoundError struct {
+	AppGUID   string
+	RouteGUID string
+}
+
+func (e RouteDestinationNotFoundError) Error() string {
+	return fmt.Sprintf("Destination for app guid %s not found on route guid %s", e.AppGUID, e.RouteGUID)
+}
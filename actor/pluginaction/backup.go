@@ -0,0 +1,35 @@
+package pluginaction
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	"code.cloudfoundry.org/gofileutils/fileutils"
+)
+
+// BackupPluginBinary copies the binary of an installed plugin to
+// config.PluginHome() + /backups so that it can be reinstalled later, and
+// returns the path of the copy.
+func (actor Actor) BackupPluginBinary(plugin configv3.Plugin) (string, error) {
+	backupDir := filepath.Join(actor.config.PluginHome(), "backups")
+	err := os.MkdirAll(backupDir, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	backupPath := generic.ExecutableFilename(filepath.Join(backupDir, fmt.Sprintf("%s-%s", plugin.Name, plugin.Version)))
+	err = fileutils.CopyPathToPath(plugin.Location, backupPath)
+	if err != nil {
+		return "", err
+	}
+
+	err = os.Chmod(backupPath, 0700)
+	if err != nil {
+		return "", err
+	}
+
+	return backupPath, nil
+}
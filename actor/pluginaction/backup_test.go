@@ -0,0 +1,78 @@
+package pluginaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("backup actions", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+		pluginHome string
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(pluginHome)).To(Succeed())
+	})
+
+	Describe("BackupPluginBinary", func() {
+		var (
+			plugin     configv3.Plugin
+			backupPath string
+			err        error
+		)
+
+		BeforeEach(func() {
+			plugin = configv3.Plugin{
+				Name:     "some-plugin",
+				Version:  configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+				Location: filepath.Join(pluginHome, "some-plugin"),
+			}
+		})
+
+		JustBeforeEach(func() {
+			backupPath, err = actor.BackupPluginBinary(plugin)
+		})
+
+		When("the plugin binary exists", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(plugin.Location, []byte("some-binary"), 0755)).To(Succeed())
+			})
+
+			It("copies the binary to the backups directory in plugin home", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(backupPath).To(Equal(generic.ExecutableFilename(filepath.Join(pluginHome, "backups", "some-plugin-1.2.3"))))
+
+				contents, readErr := ioutil.ReadFile(backupPath)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(contents).To(BeEquivalentTo("some-binary"))
+			})
+		})
+
+		When("the plugin binary does not exist", func() {
+			It("returns the error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -47,7 +47,7 @@ var _ = Describe("plugin info actions", func() {
 								Name:    "some-plugin",
 								Version: "1.2.3",
 								Binaries: []plugin.PluginBinary{
-									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum"},
+									{Platform: "osx", URL: "http://some-darwin-url", Checksum: "somechecksum", Signature: "some-signature"},
 									{Platform: "win64", URL: "http://some-windows-url", Checksum: "anotherchecksum"},
 									{Platform: "linux64", URL: "http://some-linux-url", Checksum: "lastchecksum"},
 								},
@@ -97,6 +97,8 @@ var _ = Describe("plugin info actions", func() {
 						Expect(pluginInfo.Name).To(Equal("some-plugin"))
 						Expect(pluginInfo.Version).To(Equal("1.2.3"))
 						Expect(pluginInfo.URL).To(Equal("http://some-darwin-url"))
+						Expect(pluginInfo.Checksum).To(Equal("somechecksum"))
+						Expect(pluginInfo.Signature).To(Equal("some-signature"))
 						Expect(repos).To(ConsistOf("some-repo"))
 					})
 				})
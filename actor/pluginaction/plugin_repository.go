@@ -8,7 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 )
 
-func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
+func (actor Actor) AddPluginRepository(repoName string, repoURL string, trustedKeys []string) error {
 	normalizedURL, err := normalizeURLPath(repoURL)
 	if err != nil {
 		return actionerror.AddPluginRepositoryError{
@@ -18,6 +18,17 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
+	for _, trustedKey := range trustedKeys {
+		_, err = decodeTrustedKey(trustedKey)
+		if err != nil {
+			return actionerror.AddPluginRepositoryError{
+				Name:    repoName,
+				URL:     repoURL,
+				Message: err.Error(),
+			}
+		}
+	}
+
 	repoNameLowerCased := strings.ToLower(repoName)
 	for _, repository := range actor.config.PluginRepositories() {
 		existingRepoNameLowerCased := strings.ToLower(repository.Name)
@@ -40,7 +51,31 @@ func (actor Actor) AddPluginRepository(repoName string, repoURL string) error {
 		}
 	}
 
-	actor.config.AddPluginRepository(repoName, normalizedURL)
+	actor.config.AddPluginRepository(repoName, normalizedURL, trustedKeys)
+	return nil
+}
+
+// AddPluginRepositoryTrustedKeys adds trusted keys to an already registered
+// repository, so that the signatures of the plugins installed from it are
+// verified from then on.
+func (actor Actor) AddPluginRepositoryTrustedKeys(repoName string, trustedKeys []string) error {
+	repository, err := actor.GetPluginRepository(repoName)
+	if err != nil {
+		return err
+	}
+
+	for _, trustedKey := range trustedKeys {
+		_, err = decodeTrustedKey(trustedKey)
+		if err != nil {
+			return actionerror.AddPluginRepositoryError{
+				Name:    repository.Name,
+				URL:     repository.URL,
+				Message: err.Error(),
+			}
+		}
+	}
+
+	actor.config.AddPluginRepositoryTrustedKeys(repository.Name, trustedKeys)
 	return nil
 }
 
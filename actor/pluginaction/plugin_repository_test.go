@@ -27,15 +27,22 @@ var _ = Describe("Plugin Repository Actions", func() {
 	})
 
 	Describe("AddPluginRepository", func() {
-		var err error
+		var (
+			trustedKeys []string
+			err         error
+		)
+
+		BeforeEach(func() {
+			trustedKeys = []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}
+		})
 
 		JustBeforeEach(func() {
-			err = actor.AddPluginRepository("some-repo", "some-URL")
+			err = actor.AddPluginRepository("some-repo", "some-URL", trustedKeys)
 		})
 
 		When("passed a url without a scheme", func() {
 			It("prepends https://", func() {
-				_ = actor.AddPluginRepository("some-repo2", "some-URL")
+				_ = actor.AddPluginRepository("some-repo2", "some-URL", nil)
 				url := fakePluginClient.GetPluginRepositoryArgsForCall(1)
 				Expect(strings.HasPrefix(url, "https://")).To(BeTrue())
 			})
@@ -43,12 +50,29 @@ var _ = Describe("Plugin Repository Actions", func() {
 
 		When("passed a schemeless IP address with a port", func() {
 			It("prepends https://", func() {
-				_ = actor.AddPluginRepository("some-repo2", "127.0.0.1:5000")
+				_ = actor.AddPluginRepository("some-repo2", "127.0.0.1:5000", nil)
 				url := fakePluginClient.GetPluginRepositoryArgsForCall(1)
 				Expect(strings.HasPrefix(url, "https://")).To(BeTrue())
 			})
 		})
 
+		When("a trusted key is not an ed25519 public key", func() {
+			BeforeEach(func() {
+				trustedKeys = []string{"c29tZS1rZXk="}
+			})
+
+			It("returns an AddPluginRepositoryError", func() {
+				Expect(err).To(MatchError(actionerror.AddPluginRepositoryError{
+					Name:    "some-repo",
+					URL:     "some-URL",
+					Message: "trusted key c29tZS1rZXk= is not an ed25519 public key",
+				}))
+
+				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
+				Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(0))
+			})
+		})
+
 		When("the repository name is taken", func() {
 			BeforeEach(func() {
 				fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
@@ -115,7 +139,7 @@ var _ = Describe("Plugin Repository Actions", func() {
 			})
 
 			It("returns a RepositoryAlreadyExistsError", func() {
-				err = actor.AddPluginRepository("some-repo", "some-URL/")
+				err = actor.AddPluginRepository("some-repo", "some-URL/", nil)
 				Expect(err).To(MatchError(actionerror.RepositoryAlreadyExistsError{Name: "some-repo", URL: "https://some-URL"}))
 
 				Expect(fakePluginClient.GetPluginRepositoryCallCount()).To(Equal(0))
@@ -144,9 +168,10 @@ var _ = Describe("Plugin Repository Actions", func() {
 				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-URL"))
 
 				Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(1))
-				repoName, repoURL := fakeConfig.AddPluginRepositoryArgsForCall(0)
+				repoName, repoURL, repoTrustedKeys := fakeConfig.AddPluginRepositoryArgsForCall(0)
 				Expect(repoName).To(Equal("some-repo"))
 				Expect(repoURL).To(Equal("https://some-URL"))
+				Expect(repoTrustedKeys).To(Equal(trustedKeys))
 			})
 		})
 
@@ -176,9 +201,65 @@ var _ = Describe("Plugin Repository Actions", func() {
 				Expect(fakePluginClient.GetPluginRepositoryArgsForCall(0)).To(Equal("https://some-URL"))
 
 				Expect(fakeConfig.AddPluginRepositoryCallCount()).To(Equal(1))
-				repoName, repoURL := fakeConfig.AddPluginRepositoryArgsForCall(0)
+				repoName, repoURL, repoTrustedKeys := fakeConfig.AddPluginRepositoryArgsForCall(0)
 				Expect(repoName).To(Equal("some-repo"))
 				Expect(repoURL).To(Equal("https://some-URL"))
+				Expect(repoTrustedKeys).To(Equal(trustedKeys))
+			})
+		})
+	})
+
+	Describe("AddPluginRepositoryTrustedKeys", func() {
+		var (
+			trustedKeys []string
+			err         error
+		)
+
+		BeforeEach(func() {
+			trustedKeys = []string{"AAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA="}
+			fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{
+				{Name: "Some-Repo", URL: "https://some-URL"},
+			})
+		})
+
+		JustBeforeEach(func() {
+			err = actor.AddPluginRepositoryTrustedKeys("some-repo", trustedKeys)
+		})
+
+		When("the repository is registered", func() {
+			It("adds the keys to the repository", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.AddPluginRepositoryTrustedKeysCallCount()).To(Equal(1))
+				repoName, repoTrustedKeys := fakeConfig.AddPluginRepositoryTrustedKeysArgsForCall(0)
+				Expect(repoName).To(Equal("Some-Repo"))
+				Expect(repoTrustedKeys).To(Equal(trustedKeys))
+			})
+		})
+
+		When("a trusted key is not an ed25519 public key", func() {
+			BeforeEach(func() {
+				trustedKeys = []string{"c29tZS1rZXk="}
+			})
+
+			It("returns an AddPluginRepositoryError", func() {
+				Expect(err).To(MatchError(actionerror.AddPluginRepositoryError{
+					Name:    "Some-Repo",
+					URL:     "https://some-URL",
+					Message: "trusted key c29tZS1rZXk= is not an ed25519 public key",
+				}))
+				Expect(fakeConfig.AddPluginRepositoryTrustedKeysCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the repository is not registered", func() {
+			BeforeEach(func() {
+				fakeConfig.PluginRepositoriesReturns(nil)
+			})
+
+			It("returns a RepositoryNotRegisteredError", func() {
+				Expect(err).To(MatchError(actionerror.RepositoryNotRegisteredError{Name: "some-repo"}))
+				Expect(fakeConfig.AddPluginRepositoryTrustedKeysCallCount()).To(Equal(0))
 			})
 		})
 	})
@@ -14,11 +14,18 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
-	AddPluginRepositoryStub        func(string, string)
+	AddPluginRepositoryStub        func(string, string, []string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 []string
+	}
+	AddPluginRepositoryTrustedKeysStub        func(string, []string)
+	addPluginRepositoryTrustedKeysMutex       sync.RWMutex
+	addPluginRepositoryTrustedKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
 	}
 	GetPluginStub        func(string) (configv3.Plugin, bool)
 	getPluginMutex       sync.RWMutex
@@ -113,16 +120,22 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return argsForCall.arg1
 }
 
-func (fake *FakeConfig) AddPluginRepository(arg1 string, arg2 string) {
+func (fake *FakeConfig) AddPluginRepository(arg1 string, arg2 string, arg3 []string) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2})
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2, arg3Copy})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		fake.AddPluginRepositoryStub(arg1, arg2)
+		fake.AddPluginRepositoryStub(arg1, arg2, arg3)
 	}
 }
 
@@ -132,16 +145,53 @@ func (fake *FakeConfig) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeConfig) AddPluginRepositoryCalls(stub func(string, string)) {
+func (fake *FakeConfig) AddPluginRepositoryCalls(stub func(string, string, []string)) {
 	fake.addPluginRepositoryMutex.Lock()
 	defer fake.addPluginRepositoryMutex.Unlock()
 	fake.AddPluginRepositoryStub = stub
 }
 
-func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string, []string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	argsForCall := fake.addPluginRepositoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeys(arg1 string, arg2 []string) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	fake.addPluginRepositoryTrustedKeysArgsForCall = append(fake.addPluginRepositoryTrustedKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryTrustedKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	if fake.AddPluginRepositoryTrustedKeysStub != nil {
+		fake.AddPluginRepositoryTrustedKeysStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysCallCount() int {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysCalls(stub func(string, []string)) {
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	fake.AddPluginRepositoryTrustedKeysStub = stub
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryTrustedKeysArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
@@ -454,6 +504,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
 	fake.getPluginMutex.RLock()
 	defer fake.getPluginMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
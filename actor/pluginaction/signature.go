@@ -0,0 +1,57 @@
+package pluginaction
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"golang.org/x/crypto/ed25519"
+)
+
+// ValidateFileSignature verifies that signature is a valid ed25519 signature
+// of the file at path by one of the repository's trusted keys.
+func (actor Actor) ValidateFileSignature(path string, signature string, repository configv3.PluginRepository) error {
+	if len(repository.TrustedKeys) == 0 {
+		return actionerror.PluginRepositoryHasNoTrustedKeysError{RepositoryName: repository.Name}
+	}
+
+	if signature == "" {
+		return actionerror.PluginSignatureMissingError{RepositoryName: repository.Name}
+	}
+
+	decodedSignature, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil || len(decodedSignature) != ed25519.SignatureSize {
+		return actionerror.InvalidPluginSignatureError{RepositoryName: repository.Name}
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	for _, trustedKey := range repository.TrustedKeys {
+		publicKey, err := decodeTrustedKey(trustedKey)
+		if err != nil {
+			continue
+		}
+		if ed25519.Verify(publicKey, contents, decodedSignature) {
+			return nil
+		}
+	}
+
+	return actionerror.InvalidPluginSignatureError{RepositoryName: repository.Name}
+}
+
+// decodeTrustedKey decodes a base64 encoded ed25519 public key.
+func decodeTrustedKey(trustedKey string) (ed25519.PublicKey, error) {
+	decodedKey, err := base64.StdEncoding.DecodeString(trustedKey)
+	if err != nil {
+		return nil, fmt.Errorf("trusted key %s is not base64 encoded", trustedKey)
+	}
+	if len(decodedKey) != ed25519.PublicKeySize {
+		return nil, fmt.Errorf("trusted key %s is not an ed25519 public key", trustedKey)
+	}
+	return ed25519.PublicKey(decodedKey), nil
+}
@@ -0,0 +1,115 @@
+package pluginaction_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/actor/pluginaction/pluginactionfakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/ed25519"
+)
+
+var _ = Describe("Signatures", func() {
+	var (
+		actor      *Actor
+		fakeConfig *pluginactionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(pluginactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig, nil)
+	})
+
+	Describe("ValidateFileSignature", func() {
+		var (
+			file       *os.File
+			publicKey  ed25519.PublicKey
+			privateKey ed25519.PrivateKey
+			signature  string
+			repository configv3.PluginRepository
+			err        error
+		)
+
+		BeforeEach(func() {
+			file, err = ioutil.TempFile("", "")
+			Expect(err).NotTo(HaveOccurred())
+			defer file.Close()
+
+			err = ioutil.WriteFile(file.Name(), []byte("foo"), 0600)
+			Expect(err).NotTo(HaveOccurred())
+
+			publicKey, privateKey, err = ed25519.GenerateKey(nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			signature = base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, []byte("foo")))
+			repository = configv3.PluginRepository{
+				Name: "some-repo",
+				TrustedKeys: []string{
+					"not-a-key",
+					base64.StdEncoding.EncodeToString(publicKey),
+				},
+			}
+		})
+
+		AfterEach(func() {
+			Expect(os.Remove(file.Name())).To(Succeed())
+		})
+
+		JustBeforeEach(func() {
+			err = actor.ValidateFileSignature(file.Name(), signature, repository)
+		})
+
+		When("the file is signed by one of the trusted keys", func() {
+			It("returns no error", func() {
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the file is signed by another key", func() {
+			BeforeEach(func() {
+				_, otherPrivateKey, keyErr := ed25519.GenerateKey(nil)
+				Expect(keyErr).NotTo(HaveOccurred())
+				signature = base64.StdEncoding.EncodeToString(ed25519.Sign(otherPrivateKey, []byte("foo")))
+			})
+
+			It("returns an InvalidPluginSignatureError", func() {
+				Expect(err).To(MatchError(actionerror.InvalidPluginSignatureError{RepositoryName: "some-repo"}))
+			})
+		})
+
+		When("the signature is not base64 encoded", func() {
+			BeforeEach(func() {
+				signature = "some-signature"
+			})
+
+			It("returns an InvalidPluginSignatureError", func() {
+				Expect(err).To(MatchError(actionerror.InvalidPluginSignatureError{RepositoryName: "some-repo"}))
+			})
+		})
+
+		When("the signature is missing", func() {
+			BeforeEach(func() {
+				signature = ""
+			})
+
+			It("returns a PluginSignatureMissingError", func() {
+				Expect(err).To(MatchError(actionerror.PluginSignatureMissingError{RepositoryName: "some-repo"}))
+			})
+		})
+
+		When("the repository has no trusted keys", func() {
+			BeforeEach(func() {
+				repository.TrustedKeys = nil
+			})
+
+			It("returns a PluginRepositoryHasNoTrustedKeysError", func() {
+				Expect(err).To(MatchError(actionerror.PluginRepositoryHasNoTrustedKeysError{RepositoryName: "some-repo"}))
+			})
+		})
+	})
+})
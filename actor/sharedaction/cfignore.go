@@ -0,0 +1,212 @@
+package sharedaction
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	ignore "github.com/sabhiram/go-gitignore"
+)
+
+// IgnoreRule is a single pattern from an ignore file.
+type IgnoreRule struct {
+	// Source is the slash separated path, relative to the app directory, of
+	// the file the rule was read from. It is empty for the built-in rules.
+	Source string
+	// Line is the line number of the rule in Source.
+	Line int
+	// Pattern is the rule as written, including any leading "!".
+	Pattern string
+
+	dir     string
+	dirOnly bool
+	negate  bool
+	matcher *ignore.GitIgnore
+}
+
+// IgnoreDecision records whether a file in an app directory is excluded from
+// the app bits and which rule made that decision.
+type IgnoreDecision struct {
+	Filename string
+	IsDir    bool
+	Ignored  bool
+	// Rule is the last rule that matched the file, if any.
+	Rule    IgnoreRule
+	Matched bool
+}
+
+// cfIgnoreMatcher applies the built-in rules followed by the rules in every
+// .gitignore (when honored) and .cfignore file found while walking an app
+// directory. As with git, rules in a subdirectory only apply to paths below
+// it, later rules take precedence over earlier ones, and a "!" rule
+// re-includes a previously excluded path.
+type cfIgnoreMatcher struct {
+	rootDir        string
+	honorGitIgnore bool
+	rules          []IgnoreRule
+}
+
+func (actor Actor) newCFIgnoreMatcher(sourceDir string) (*cfIgnoreMatcher, error) {
+	matcher := &cfIgnoreMatcher{
+		rootDir:        sourceDir,
+		honorGitIgnore: actor.Config.HonorGitIgnore(),
+	}
+
+	for _, line := range DefaultIgnoreLines {
+		matcher.addRule("", 0, line, "")
+	}
+
+	// If verbose logging has files in the current dir, ignore them
+	_, traceFiles := actor.Config.Verbose()
+	for _, traceFilePath := range traceFiles {
+		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
+			matcher.addRule("", 0, filepath.ToSlash(relPath), "")
+		}
+	}
+
+	err := matcher.loadDirectory(".")
+	if err != nil {
+		return nil, err
+	}
+	return matcher, nil
+}
+
+// loadDirectory reads the ignore files in relDir, which is relative to the
+// root of the app directory. The .gitignore is read first so that the
+// .cfignore in the same directory can override it.
+func (matcher *cfIgnoreMatcher) loadDirectory(relDir string) error {
+	var ignoreFiles []string
+	if matcher.honorGitIgnore {
+		ignoreFiles = append(ignoreFiles, ".gitignore")
+	}
+	ignoreFiles = append(ignoreFiles, ".cfignore")
+
+	dir := filepath.ToSlash(relDir)
+	for _, ignoreFile := range ignoreFiles {
+		raw, err := ioutil.ReadFile(filepath.Join(matcher.rootDir, relDir, ignoreFile))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		source := path.Join(dir, ignoreFile)
+		for i, line := range strings.Split(string(raw), "\n") {
+			matcher.addRule(source, i+1, line, dir)
+		}
+	}
+	return nil
+}
+
+func (matcher *cfIgnoreMatcher) addRule(source string, lineNumber int, line string, dir string) {
+	pattern := strings.TrimSpace(strings.TrimRight(line, "\r"))
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return
+	}
+
+	negate := strings.HasPrefix(pattern, "!")
+	compiled, _ := ignore.CompileIgnoreLines(strings.TrimPrefix(pattern, "!"))
+
+	if dir == "." {
+		dir = ""
+	}
+
+	matcher.rules = append(matcher.rules, IgnoreRule{
+		Source:  source,
+		Line:    lineNumber,
+		Pattern: pattern,
+		dir:     dir,
+		dirOnly: strings.HasSuffix(pattern, "/"),
+		negate:  negate,
+		matcher: compiled,
+	})
+}
+
+// match returns the decision for relPath, a path relative to the root of the
+// app directory.
+func (matcher *cfIgnoreMatcher) match(relPath string, isDir bool) IgnoreDecision {
+	filename := filepath.ToSlash(relPath)
+	decision := IgnoreDecision{
+		Filename: filename,
+		IsDir:    isDir,
+	}
+
+	for _, rule := range matcher.rules {
+		rulePath := filename
+		if rule.dir != "" {
+			if !strings.HasPrefix(filename, rule.dir+"/") {
+				continue
+			}
+			rulePath = strings.TrimPrefix(filename, rule.dir+"/")
+		}
+
+		// Patterns ending in "/" only match directories, so the directory
+		// itself needs a trailing slash to match them.
+		if rule.matcher.MatchesPath(rulePath) || (isDir && rule.dirOnly && rule.matcher.MatchesPath(rulePath+"/")) {
+			decision.Ignored = !rule.negate
+			decision.Rule = rule
+			decision.Matched = true
+		}
+	}
+
+	return decision
+}
+
+// walk walks evalDir and calls walkFn with the ignore decision for every path
+// below it. Ignored directories are not descended into, so, as with git,
+// files under them cannot be re-included.
+func (matcher *cfIgnoreMatcher) walk(evalDir string, walkFn func(fullPath string, info os.FileInfo, decision IgnoreDecision) error) error {
+	return filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(evalDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		if relPath == "." {
+			return nil
+		}
+
+		decision := matcher.match(relPath, info.IsDir())
+		err = walkFn(fullPath, info, decision)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if decision.Ignored {
+				return filepath.SkipDir
+			}
+			return matcher.loadDirectory(relPath)
+		}
+		return nil
+	})
+}
+
+// CheckIgnoredFiles returns the include or exclude decision for every file
+// and directory in sourceDir, in the order they would be walked when
+// gathering resources.
+func (actor Actor) CheckIgnoredFiles(sourceDir string) ([]IgnoreDecision, error) {
+	matcher, err := actor.newCFIgnoreMatcher(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	evalDir, err := filepath.EvalSymlinks(sourceDir)
+	if err != nil {
+		return nil, err
+	}
+
+	var decisions []IgnoreDecision
+	err = matcher.walk(evalDir, func(_ string, _ os.FileInfo, decision IgnoreDecision) error {
+		decisions = append(decisions, decision)
+		return nil
+	})
+	return decisions, err
+}
@@ -0,0 +1,227 @@
+package sharedaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignore Actions", func() {
+	var (
+		fakeConfig *sharedactionfakes.FakeConfig
+		actor      *Actor
+		srcDir     string
+	)
+
+	writeFile := func(relPath string, contents string) {
+		fullPath := filepath.Join(srcDir, filepath.FromSlash(relPath))
+		Expect(os.MkdirAll(filepath.Dir(fullPath), 0777)).To(Succeed())
+		Expect(ioutil.WriteFile(fullPath, []byte(contents), 0600)).To(Succeed())
+	}
+
+	gatheredFilenames := func() []string {
+		resources, err := actor.GatherDirectoryResources(srcDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		var filenames []string
+		for _, resource := range resources {
+			filenames = append(filenames, resource.Filename)
+		}
+		return filenames
+	}
+
+	BeforeEach(func() {
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		actor = NewActor(fakeConfig)
+
+		var err error
+		srcDir, err = ioutil.TempDir("", "cfignore-actions-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile("app.js", "app")
+		writeFile("debug.log", "log")
+		writeFile("keep.log", "log")
+		writeFile("services/api/debug.log", "log")
+		writeFile("services/api/index.js", "api")
+		writeFile("services/api/tmp/cache", "cache")
+		writeFile("vendor/lib.js", "lib")
+		writeFile("vendor/keep.js", "keep")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+	})
+
+	Describe("GatherDirectoryResources", func() {
+		When("there are .cfignore files in subdirectories", func() {
+			BeforeEach(func() {
+				writeFile(".cfignore", "*.log\n")
+				writeFile("services/api/.cfignore", "/tmp\n!debug.log\n")
+			})
+
+			It("applies each file's rules to the paths below it", func() {
+				Expect(gatheredFilenames()).To(Equal([]string{
+					"app.js",
+					"services",
+					"services/api",
+					"services/api/debug.log",
+					"services/api/index.js",
+					"vendor",
+					"vendor/keep.js",
+					"vendor/lib.js",
+				}))
+			})
+		})
+
+		When("a rule re-includes a file with '!'", func() {
+			BeforeEach(func() {
+				writeFile(".cfignore", "*.log\n!keep.log\n")
+			})
+
+			It("includes the file again", func() {
+				filenames := gatheredFilenames()
+				Expect(filenames).To(ContainElement("keep.log"))
+				Expect(filenames).ToNot(ContainElement("debug.log"))
+				Expect(filenames).ToNot(ContainElement("services/api/debug.log"))
+			})
+		})
+
+		When("a rule re-includes a file in an excluded directory", func() {
+			BeforeEach(func() {
+				writeFile(".cfignore", "vendor/\n!vendor/keep.js\n")
+			})
+
+			It("keeps the whole directory excluded", func() {
+				filenames := gatheredFilenames()
+				Expect(filenames).ToNot(ContainElement("vendor"))
+				Expect(filenames).ToNot(ContainElement("vendor/keep.js"))
+				Expect(filenames).ToNot(ContainElement("vendor/lib.js"))
+			})
+		})
+
+		When("the directory contents are excluded but a file is re-included", func() {
+			BeforeEach(func() {
+				writeFile(".cfignore", "vendor/*\n!vendor/keep.js\n")
+			})
+
+			It("includes only the re-included file", func() {
+				filenames := gatheredFilenames()
+				Expect(filenames).To(ContainElement("vendor"))
+				Expect(filenames).To(ContainElement("vendor/keep.js"))
+				Expect(filenames).ToNot(ContainElement("vendor/lib.js"))
+			})
+		})
+
+		When("there are .gitignore files", func() {
+			BeforeEach(func() {
+				writeFile(".gitignore", "vendor\n")
+				writeFile("services/api/.gitignore", "tmp\n")
+			})
+
+			When("honoring .gitignore is enabled", func() {
+				BeforeEach(func() {
+					fakeConfig.HonorGitIgnoreReturns(true)
+				})
+
+				It("excludes the files matched by them", func() {
+					filenames := gatheredFilenames()
+					Expect(filenames).ToNot(ContainElement("vendor/lib.js"))
+					Expect(filenames).ToNot(ContainElement("services/api/tmp/cache"))
+					Expect(filenames).To(ContainElement("services/api/index.js"))
+				})
+
+				When("the .cfignore in the same directory re-includes a file", func() {
+					BeforeEach(func() {
+						writeFile(".cfignore", "!vendor\n")
+					})
+
+					It("lets the .cfignore take precedence", func() {
+						filenames := gatheredFilenames()
+						Expect(filenames).To(ContainElement("vendor/lib.js"))
+					})
+				})
+			})
+
+			When("honoring .gitignore is disabled", func() {
+				It("ignores them", func() {
+					filenames := gatheredFilenames()
+					Expect(filenames).To(ContainElement("vendor/lib.js"))
+					Expect(filenames).To(ContainElement("services/api/tmp/cache"))
+					Expect(filenames).ToNot(ContainElement(".gitignore"))
+				})
+			})
+		})
+	})
+
+	Describe("CheckIgnoredFiles", func() {
+		var (
+			decisions  []IgnoreDecision
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			writeFile(".cfignore", "# logs\n*.log\nvendor/\n")
+			writeFile("services/api/.cfignore", "!debug.log\n")
+		})
+
+		JustBeforeEach(func() {
+			decisions, executeErr = actor.CheckIgnoredFiles(srcDir)
+		})
+
+		It("returns every decision along with the rule that made it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			type result struct {
+				Filename string
+				IsDir    bool
+				Ignored  bool
+				Matched  bool
+				Source   string
+				Line     int
+				Pattern  string
+			}
+			var results []result
+			for _, decision := range decisions {
+				results = append(results, result{
+					Filename: decision.Filename,
+					IsDir:    decision.IsDir,
+					Ignored:  decision.Ignored,
+					Matched:  decision.Matched,
+					Source:   decision.Rule.Source,
+					Line:     decision.Rule.Line,
+					Pattern:  decision.Rule.Pattern,
+				})
+			}
+
+			Expect(results).To(Equal([]result{
+				{Filename: ".cfignore", Ignored: true, Matched: true, Pattern: ".cfignore"},
+				{Filename: "app.js"},
+				{Filename: "debug.log", Ignored: true, Matched: true, Source: ".cfignore", Line: 2, Pattern: "*.log"},
+				{Filename: "keep.log", Ignored: true, Matched: true, Source: ".cfignore", Line: 2, Pattern: "*.log"},
+				{Filename: "services", IsDir: true},
+				{Filename: "services/api", IsDir: true},
+				{Filename: "services/api/.cfignore", Ignored: true, Matched: true, Pattern: ".cfignore"},
+				{Filename: "services/api/debug.log", Matched: true, Source: "services/api/.cfignore", Line: 1, Pattern: "!debug.log"},
+				{Filename: "services/api/index.js"},
+				{Filename: "services/api/tmp", IsDir: true},
+				{Filename: "services/api/tmp/cache"},
+				{Filename: "vendor", IsDir: true, Ignored: true, Matched: true, Source: ".cfignore", Line: 3, Pattern: "vendor/"},
+			}))
+		})
+
+		When("the directory does not exist", func() {
+			BeforeEach(func() {
+				Expect(os.RemoveAll(srcDir)).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(HaveOccurred())
+			})
+		})
+	})
+})
@@ -9,7 +9,9 @@ type Config interface {
 	CurrentUserName() (string, error)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HonorGitIgnore() bool
 	RefreshToken() string
+	ResourceCacheFilePath() string
 	TargetedOrganizationName() string
 	Verbose() (bool, []string)
 }
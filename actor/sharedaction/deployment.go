@@ -0,0 +1,36 @@
+package sharedaction
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// PollDeployment calls getState until the deployment it reports on is
+// deployed or canceled, sleeping for pollingInterval after every other state.
+// The warnings of every call are passed to handleWarnings. A
+// StartupTimeoutError is returned if the deployment has not finished within
+// timeout.
+func PollDeployment(getState func() (constant.DeploymentState, []string, error), handleWarnings func([]string), pollingInterval time.Duration, timeout time.Duration) error {
+	deadline := time.Now().Add(timeout)
+	for time.Now().Before(deadline) {
+		state, warnings, err := getState()
+		handleWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		switch state {
+		case constant.DeploymentDeployed:
+			return nil
+		case constant.DeploymentCanceled:
+			return errors.New("Deployment has been canceled")
+		}
+
+		time.Sleep(pollingInterval)
+	}
+
+	return actionerror.StartupTimeoutError{}
+}
@@ -0,0 +1,99 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PollDeployment", func() {
+	var (
+		states          []constant.DeploymentState
+		getStateErr     error
+		getStateCalls   int
+		allWarnings     []string
+		pollingInterval time.Duration
+		timeout         time.Duration
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		states = nil
+		getStateErr = nil
+		getStateCalls = 0
+		allWarnings = nil
+		pollingInterval = 0
+		timeout = time.Second
+	})
+
+	JustBeforeEach(func() {
+		executeErr = PollDeployment(
+			func() (constant.DeploymentState, []string, error) {
+				state := states[len(states)-1]
+				if getStateCalls < len(states) {
+					state = states[getStateCalls]
+				}
+				getStateCalls++
+				return state, []string{"warning"}, getStateErr
+			},
+			func(warnings []string) {
+				allWarnings = append(allWarnings, warnings...)
+			},
+			pollingInterval,
+			timeout,
+		)
+	})
+
+	When("the deployment eventually deploys", func() {
+		BeforeEach(func() {
+			states = []constant.DeploymentState{constant.DeploymentDeploying, constant.DeploymentDeploying, constant.DeploymentDeployed}
+		})
+
+		It("returns nil and passes on the warnings of every call", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(getStateCalls).To(Equal(3))
+			Expect(allWarnings).To(Equal([]string{"warning", "warning", "warning"}))
+		})
+	})
+
+	When("the deployment is canceled", func() {
+		BeforeEach(func() {
+			states = []constant.DeploymentState{constant.DeploymentDeploying, constant.DeploymentCanceled}
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("Deployment has been canceled"))
+			Expect(getStateCalls).To(Equal(2))
+		})
+	})
+
+	When("getting the state fails", func() {
+		BeforeEach(func() {
+			states = []constant.DeploymentState{""}
+			getStateErr = errors.New("some-error")
+		})
+
+		It("returns the error and passes on the warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(allWarnings).To(Equal([]string{"warning"}))
+		})
+	})
+
+	When("the deployment stays in a state other than deploying", func() {
+		BeforeEach(func() {
+			states = []constant.DeploymentState{"CANCELING"}
+			pollingInterval = 20 * time.Millisecond
+			timeout = 50 * time.Millisecond
+		})
+
+		It("sleeps between polls and times out", func() {
+			Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+			Expect(getStateCalls).To(BeNumerically("<=", 3))
+		})
+	})
+})
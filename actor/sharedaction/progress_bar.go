@@ -0,0 +1,9 @@
+package sharedaction
+
+import "io"
+
+//go:generate counterfeiter . ProgressBar
+
+type ProgressBar interface {
+	NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader
+}
@@ -100,14 +100,13 @@ func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error
 	return resources, nil
 }
 
-// GatherDirectoryResources returns a list of resources for a directory.
+// GatherDirectoryResources returns a list of resources for a directory. The
+// SHA1s of regular files are cached on disk between calls and only
+// recalculated when a file's size or modification time changes.
 func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error) {
-	var (
-		resources []Resource
-		gitIgnore *ignore.GitIgnore
-	)
+	var resources []Resource
 
-	gitIgnore, err := actor.generateDirectoryCFIgnoreMatcher(sourceDir)
+	matcher, err := actor.newCFIgnoreMatcher(sourceDir)
 	if err != nil {
 		log.Errorln("reading .cfignore file:", err)
 		return nil, err
@@ -119,27 +118,21 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 		return nil, err
 	}
 
-	walkErr := filepath.Walk(evalDir, func(fullPath string, info os.FileInfo, err error) error {
-		if err != nil {
-			return err
-		}
+	absDir, err := filepath.Abs(evalDir)
+	if err != nil {
+		return nil, err
+	}
 
-		relPath, err := filepath.Rel(evalDir, fullPath)
-		if err != nil {
-			return err
-		}
+	cache := actor.loadResourceCache()
 
+	walkErr := matcher.walk(evalDir, func(fullPath string, info os.FileInfo, decision IgnoreDecision) error {
 		// if file ignored contine to the next file
-		if gitIgnore.MatchesPath(relPath) {
-			return nil
-		}
-
-		if relPath == "." {
+		if decision.Ignored {
 			return nil
 		}
 
 		resource := Resource{
-			Filename: filepath.ToSlash(relPath),
+			Filename: decision.Filename,
 		}
 
 		switch {
@@ -153,7 +146,17 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 			resource.Mode = fixMode(info.Mode())
 		default:
 			// If the file is regular we want to open
-			// and calculate the sha of the file
+			// and calculate the sha of the file, unless it is unchanged
+			// since it was last cached
+			resource.Mode = fixMode(info.Mode())
+			resource.Size = info.Size()
+
+			cachePath := filepath.Join(absDir, filepath.FromSlash(decision.Filename))
+			if entry, ok := cache.lookup(cachePath, info); ok {
+				resource.SHA1 = entry.SHA1
+				break
+			}
+
 			file, err := os.Open(fullPath)
 			if err != nil {
 				return err
@@ -166,15 +169,18 @@ func (actor Actor) GatherDirectoryResources(sourceDir string) ([]Resource, error
 				return err
 			}
 
-			resource.Mode = fixMode(info.Mode())
 			resource.SHA1 = fmt.Sprintf("%x", sum.Sum(nil))
-			resource.Size = info.Size()
+			cache.store(cachePath, info, resource.SHA1)
 		}
 
 		resources = append(resources, resource)
 		return nil
 	})
 
+	if walkErr == nil {
+		cache.save(absDir)
+	}
+
 	if len(resources) == 0 {
 		return nil, actionerror.EmptyDirectoryError{Path: sourceDir}
 	}
@@ -415,26 +421,6 @@ func (Actor) generateArchiveCFIgnoreMatcher(files []*zip.File) (*ignore.GitIgnor
 	return ignore.CompileIgnoreLines(DefaultIgnoreLines...)
 }
 
-func (actor Actor) generateDirectoryCFIgnoreMatcher(sourceDir string) (*ignore.GitIgnore, error) {
-	pathToCFIgnore := filepath.Join(sourceDir, ".cfignore")
-
-	additionalIgnoreLines := DefaultIgnoreLines
-
-	// If verbose logging has files in the current dir, ignore them
-	_, traceFiles := actor.Config.Verbose()
-	for _, traceFilePath := range traceFiles {
-		if relPath, err := filepath.Rel(sourceDir, traceFilePath); err == nil {
-			additionalIgnoreLines = append(additionalIgnoreLines, relPath)
-		}
-	}
-
-	if _, err := os.Stat(pathToCFIgnore); !os.IsNotExist(err) {
-		return ignore.CompileIgnoreFileAndLines(pathToCFIgnore, additionalIgnoreLines...)
-	} else {
-		return ignore.CompileIgnoreLines(additionalIgnoreLines...)
-	}
-}
-
 func (Actor) findInResources(path string, filesToInclude []Resource) (Resource, bool) {
 	for _, resource := range filesToInclude {
 		if resource.Filename == filepath.ToSlash(path) {
@@ -0,0 +1,154 @@
+package sharedaction
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// racyModTimeWindow is how recently a file can have been modified and still
+// be trusted by the resource cache. Files written within this window could
+// change again without their modification time changing, so they are always
+// rehashed.
+const racyModTimeWindow = 2 * time.Second
+
+type resourceCacheEntry struct {
+	Size    int64  `json:"size"`
+	ModTime int64  `json:"mtime"`
+	SHA1    string `json:"sha1"`
+}
+
+// resourceCache is an on-disk store of file SHA1s, keyed by absolute path.
+// An entry is only used when the file's size and modification time still
+// match the ones recorded alongside it.
+type resourceCache struct {
+	path    string
+	entries map[string]resourceCacheEntry
+	seen    map[string]bool
+	dirty   bool
+}
+
+// loadResourceCache reads the resource cache from the location provided by
+// the config. A missing or unreadable cache is treated as empty.
+func (actor Actor) loadResourceCache() *resourceCache {
+	cache := &resourceCache{
+		entries: map[string]resourceCacheEntry{},
+		seen:    map[string]bool{},
+	}
+
+	if actor.Config == nil {
+		return cache
+	}
+
+	cache.path = actor.Config.ResourceCacheFilePath()
+	if cache.path == "" {
+		return cache
+	}
+
+	raw, err := ioutil.ReadFile(cache.path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", cache.path).Warnln("reading resource cache:", err)
+		}
+		return cache
+	}
+
+	err = json.Unmarshal(raw, &cache.entries)
+	if err != nil {
+		log.WithField("path", cache.path).Warnln("parsing resource cache:", err)
+		cache.entries = map[string]resourceCacheEntry{}
+	}
+
+	return cache
+}
+
+// lookup returns the cached entry for fullPath if the file has not changed
+// since it was recorded.
+func (cache *resourceCache) lookup(fullPath string, info os.FileInfo) (resourceCacheEntry, bool) {
+	cache.seen[fullPath] = true
+
+	entry, ok := cache.entries[fullPath]
+	if !ok || entry.Size != info.Size() || entry.ModTime != info.ModTime().UnixNano() {
+		return resourceCacheEntry{}, false
+	}
+	return entry, true
+}
+
+// store records the SHA1 of fullPath.
+func (cache *resourceCache) store(fullPath string, info os.FileInfo, sha1 string) {
+	cache.seen[fullPath] = true
+
+	if time.Since(info.ModTime()) < racyModTimeWindow {
+		return
+	}
+
+	cache.entries[fullPath] = resourceCacheEntry{
+		Size:    info.Size(),
+		ModTime: info.ModTime().UnixNano(),
+		SHA1:    sha1,
+	}
+	cache.dirty = true
+}
+
+// save drops the entries under rootDir that were not visited and writes the
+// cache back to disk. Failures are logged and otherwise ignored, since the
+// cache is only an optimization.
+func (cache *resourceCache) save(rootDir string) {
+	if cache.path == "" {
+		return
+	}
+
+	prefix := rootDir + string(filepath.Separator)
+	for fullPath := range cache.entries {
+		if strings.HasPrefix(fullPath, prefix) && !cache.seen[fullPath] {
+			delete(cache.entries, fullPath)
+			cache.dirty = true
+		}
+	}
+
+	if !cache.dirty {
+		return
+	}
+
+	raw, err := json.Marshal(cache.entries)
+	if err != nil {
+		log.Warnln("marshalling resource cache:", err)
+		return
+	}
+
+	dir := filepath.Dir(cache.path)
+	err = os.MkdirAll(dir, 0700)
+	if err != nil {
+		log.WithField("path", dir).Warnln("creating resource cache directory:", err)
+		return
+	}
+
+	// Write to a temporary file and rename it so that concurrent pushes never
+	// read a partially written cache.
+	tempFile, err := ioutil.TempFile(dir, "temp-resource-cache")
+	if err != nil {
+		log.WithField("path", dir).Warnln("creating temporary resource cache:", err)
+		return
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		log.WithField("path", tempFile.Name()).Warnln("writing resource cache:", err)
+		return
+	}
+
+	err = os.Rename(tempFile.Name(), cache.path)
+	if err != nil {
+		log.WithField("path", cache.path).Warnln("saving resource cache:", err)
+	}
+}
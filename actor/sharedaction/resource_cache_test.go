@@ -0,0 +1,223 @@
+package sharedaction_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Resource Cache", func() {
+	var (
+		fakeConfig *sharedactionfakes.FakeConfig
+		actor      *Actor
+		srcDir     string
+		cacheDir   string
+		cachePath  string
+		filePath   string
+		anHourAgo  time.Time
+
+		resources  []Resource
+		executeErr error
+	)
+
+	readCache := func() map[string]map[string]interface{} {
+		raw, err := ioutil.ReadFile(cachePath)
+		Expect(err).ToNot(HaveOccurred())
+
+		entries := map[string]map[string]interface{}{}
+		Expect(json.Unmarshal(raw, &entries)).To(Succeed())
+		return entries
+	}
+
+	writeCache := func(entries map[string]map[string]interface{}) {
+		raw, err := json.Marshal(entries)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(cachePath, raw, 0600)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		srcDir, err = ioutil.TempDir("", "resource-cache-test")
+		Expect(err).ToNot(HaveOccurred())
+		srcDir, err = filepath.EvalSymlinks(srcDir)
+		Expect(err).ToNot(HaveOccurred())
+
+		cacheDir, err = ioutil.TempDir("", "resource-cache-dir")
+		Expect(err).ToNot(HaveOccurred())
+		cachePath = filepath.Join(cacheDir, ".cf", "resource-cache.json")
+
+		fakeConfig = new(sharedactionfakes.FakeConfig)
+		fakeConfig.ResourceCacheFilePathReturns(cachePath)
+		actor = NewActor(fakeConfig)
+
+		filePath = filepath.Join(srcDir, "tmpFile1")
+		Expect(ioutil.WriteFile(filePath, []byte("why hello"), 0600)).To(Succeed())
+
+		anHourAgo = time.Now().Add(-time.Hour)
+		Expect(os.Chtimes(filePath, anHourAgo, anHourAgo)).To(Succeed())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		resources, executeErr = actor.GatherDirectoryResources(srcDir)
+	})
+
+	When("the cache does not exist", func() {
+		It("hashes the files and writes them to the cache", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+
+			entries := readCache()
+			Expect(entries).To(HaveKey(filePath))
+			Expect(entries[filePath]["sha1"]).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+			Expect(entries[filePath]["size"]).To(BeNumerically("==", 9))
+			Expect(entries[filePath]["mtime"]).To(BeNumerically("==", anHourAgo.UnixNano()))
+		})
+	})
+
+	When("the file is unchanged since it was cached", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			writeCache(map[string]map[string]interface{}{
+				filePath: {
+					"size":  9,
+					"mtime": anHourAgo.UnixNano(),
+					"sha1":  "some-cached-sha",
+				},
+			})
+		})
+
+		It("uses the cached SHA1 without rehashing", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(ConsistOf(Resource{
+				Filename: "tmpFile1",
+				Mode:     0600,
+				SHA1:     "some-cached-sha",
+				Size:     9,
+			}))
+		})
+	})
+
+	When("only the mode of the file has changed since it was cached", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			writeCache(map[string]map[string]interface{}{
+				filePath: {
+					"size":  9,
+					"mtime": anHourAgo.UnixNano(),
+					"sha1":  "some-cached-sha",
+				},
+			})
+			Expect(os.Chmod(filePath, 0700)).To(Succeed())
+		})
+
+		It("uses the cached SHA1 and the current mode", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(ConsistOf(Resource{
+				Filename: "tmpFile1",
+				Mode:     0700,
+				SHA1:     "some-cached-sha",
+				Size:     9,
+			}))
+		})
+	})
+
+	When("the file has been modified since it was cached", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			writeCache(map[string]map[string]interface{}{
+				filePath: {
+					"size":  9,
+					"mtime": anHourAgo.Add(-time.Hour).UnixNano(),
+					"sha1":  "some-stale-sha",
+				},
+			})
+		})
+
+		It("rehashes the file and updates the cache", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+
+			Expect(readCache()[filePath]["sha1"]).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+		})
+	})
+
+	When("the file was modified very recently", func() {
+		BeforeEach(func() {
+			now := time.Now()
+			Expect(os.Chtimes(filePath, now, now)).To(Succeed())
+		})
+
+		It("does not cache the file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(cachePath).ToNot(BeAnExistingFile())
+		})
+	})
+
+	When("the cache has entries for files that no longer exist", func() {
+		var (
+			deletedPath string
+			otherPath   string
+		)
+
+		BeforeEach(func() {
+			deletedPath = filepath.Join(srcDir, "deleted-file")
+			otherPath = filepath.Join(cacheDir, "some-other-app", "some-file")
+
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			writeCache(map[string]map[string]interface{}{
+				deletedPath: {"size": 1, "mtime": 1, "sha1": "some-sha"},
+				otherPath:   {"size": 1, "mtime": 1, "sha1": "some-sha"},
+			})
+		})
+
+		It("removes the entries under the gathered directory only", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			entries := readCache()
+			Expect(entries).ToNot(HaveKey(deletedPath))
+			Expect(entries).To(HaveKey(otherPath))
+			Expect(entries).To(HaveKey(filePath))
+		})
+	})
+
+	When("the cache file is corrupt", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Dir(cachePath), 0700)).To(Succeed())
+			Expect(ioutil.WriteFile(cachePath, []byte("not json"), 0600)).To(Succeed())
+		})
+
+		It("ignores it and rewrites the cache", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(readCache()).To(HaveKey(filePath))
+		})
+	})
+
+	When("no cache location is configured", func() {
+		BeforeEach(func() {
+			fakeConfig.ResourceCacheFilePathReturns("")
+		})
+
+		It("still gathers the resources", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(resources).To(HaveLen(1))
+			Expect(resources[0].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+			Expect(cachePath).ToNot(BeAnExistingFile())
+		})
+	})
+})
@@ -0,0 +1,293 @@
+package sharedaction
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+)
+
+// DownloadFromSecureShell copies the file or directory at remotePath on the
+// app instance to localPath. As with scp, if localPath is an existing
+// directory the copy is placed inside it. Only regular files and directories
+// are copied.
+func (actor Actor) DownloadFromSecureShell(sshClient SecureShellClient, sshOptions SSHOptions, remotePath string, localPath string, progressBar ProgressBar) error {
+	remoteDir, remoteBase, err := splitSecureCopyPath(remotePath)
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	totalSize := remoteSecureCopySize(sshClient, remotePath)
+
+	destination := localPath
+	if info, statErr := os.Stat(localPath); statErr == nil && info.IsDir() {
+		destination = filepath.Join(localPath, remoteBase)
+	}
+
+	reader, writer := io.Pipe()
+	runErrs := make(chan error, 1)
+	go func() {
+		runErr := sshClient.RunCommand(fmt.Sprintf("tar -cf - -C %s %s", shellQuote(remoteDir), shellQuote(remoteBase)), nil, writer, nil)
+		_ = writer.CloseWithError(runErr)
+		runErrs <- runErr
+	}()
+
+	extractErr := extractSecureCopyArchive(reader, remoteBase, destination, progressBar, totalSize)
+	if extractErr == nil {
+		// tar pads the archive after its end marker, so read the rest of the
+		// stream to let the command finish.
+		_, extractErr = io.Copy(ioutil.Discard, reader)
+	}
+	_ = reader.CloseWithError(extractErr)
+
+	runErr := <-runErrs
+	if extractErr != nil {
+		return extractErr
+	}
+	return runErr
+}
+
+// remoteSecureCopySize returns the total size of the files at remotePath on
+// the app instance, or 0 when it cannot be determined, for example because the
+// instance's find does not support -printf. The copy itself reports a missing
+// remote path.
+func remoteSecureCopySize(sshClient SecureShellClient, remotePath string) int64 {
+	var sizes bytes.Buffer
+	err := sshClient.RunCommand(fmt.Sprintf("find %s -type f -printf '%%s\\n'", shellQuote(remotePath)), nil, &sizes, nil)
+	if err != nil {
+		return 0
+	}
+
+	var totalSize int64
+	for _, line := range strings.Fields(sizes.String()) {
+		size, parseErr := strconv.ParseInt(line, 10, 64)
+		if parseErr == nil {
+			totalSize += size
+		}
+	}
+	return totalSize
+}
+
+// UploadToSecureShell copies the file or directory at localPath to remotePath
+// on the app instance. As with scp, if remotePath is an existing directory the
+// copy is placed inside it. Only regular files and directories are copied.
+func (actor Actor) UploadToSecureShell(sshClient SecureShellClient, sshOptions SSHOptions, localPath string, remotePath string, progressBar ProgressBar) error {
+	absPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+
+	evalPath, err := filepath.EvalSymlinks(absPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return actionerror.NonexistentAppPathError{Path: localPath}
+		}
+		return err
+	}
+
+	// The base of the resolved path names the copy on the app instance, so
+	// that "." and paths ending in ".." are named after the directory they
+	// refer to.
+	localBase := filepath.Base(evalPath)
+	if localBase == "." || localBase == ".." || localBase == string(filepath.Separator) {
+		return actionerror.InvalidSecureCopyPathError{Path: localPath}
+	}
+
+	var totalSize int64
+	err = filepath.Walk(evalPath, func(_ string, info os.FileInfo, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		if info.Mode().IsRegular() {
+			totalSize += info.Size()
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	reader, writer := io.Pipe()
+	archiveErrs := make(chan error, 1)
+	go func() {
+		archiveErr := writeSecureCopyArchive(writer, evalPath, localBase, progressBar, totalSize)
+		_ = writer.CloseWithError(archiveErr)
+		archiveErrs <- archiveErr
+	}()
+
+	runErr := sshClient.RunCommand(secureCopyExtractCommand(remotePath, localBase), reader, ioutil.Discard, nil)
+	_ = reader.CloseWithError(runErr)
+
+	// An archive that could not be written is cut short, which makes the
+	// remote tar fail as well; the local error explains why.
+	archiveErr := <-archiveErrs
+	if runErr != nil && archiveErr != nil {
+		return archiveErr
+	}
+	return runErr
+}
+
+// secureCopyExtractCommand returns the shell command that extracts an archive
+// containing base into remotePath. When remotePath is not a directory, base is
+// extracted next to it and renamed.
+func secureCopyExtractCommand(remotePath string, base string) string {
+	return fmt.Sprintf(
+		`if [ -d %[1]s ]; then tar -xf - -C %[1]s; else tmp=$(mktemp -d "$(dirname %[1]s)/.cf-scp.XXXXXX") && tar -xf - -C "$tmp" && mv -f "$tmp"/%[2]s %[1]s; status=$?; rm -rf "$tmp"; exit $status; fi`,
+		shellQuote(remotePath),
+		shellQuote(base),
+	)
+}
+
+func splitSecureCopyPath(remotePath string) (string, string, error) {
+	cleanPath := path.Clean(remotePath)
+	base := path.Base(cleanPath)
+	if base == "." || base == ".." || base == "/" {
+		return "", "", actionerror.InvalidSecureCopyPathError{Path: remotePath}
+	}
+	return path.Dir(cleanPath), base, nil
+}
+
+func extractSecureCopyArchive(reader io.Reader, base string, destination string, progressBar ProgressBar, totalSize int64) error {
+	archive := tar.NewReader(reader)
+	progress := progressBar.NewProgressBarWrapper(archive, totalSize)
+
+	for {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		target, err := secureCopyTargetPath(header.Name, base, destination)
+		if err != nil {
+			return err
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, header.FileInfo().Mode().Perm()|0700)
+		case tar.TypeReg, tar.TypeRegA:
+			err = extractSecureCopyFile(target, header.FileInfo().Mode().Perm(), progress)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// secureCopyTargetPath maps name, a path in an archive rooted at base, to
+// where it is written below destination. Names that would end up outside of
+// destination are rejected.
+func secureCopyTargetPath(name string, base string, destination string) (string, error) {
+	cleanName := path.Clean(name)
+	if cleanName == base {
+		return destination, nil
+	}
+	if !strings.HasPrefix(cleanName, base+"/") {
+		return "", fmt.Errorf("unexpected path '%s' in archive", name)
+	}
+	return filepath.Join(destination, filepath.FromSlash(strings.TrimPrefix(cleanName, base+"/"))), nil
+}
+
+func extractSecureCopyFile(target string, mode os.FileMode, content io.Reader) error {
+	err := os.MkdirAll(filepath.Dir(target), 0755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, content)
+	if err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// currentFileReader reads from whichever file is being archived, so that a
+// single progress bar can track the content of every file.
+type currentFileReader struct {
+	io.Reader
+}
+
+func writeSecureCopyArchive(writer io.Writer, sourcePath string, base string, progressBar ProgressBar, totalSize int64) error {
+	archive := tar.NewWriter(writer)
+	current := &currentFileReader{Reader: bytes.NewReader(nil)}
+	progress := progressBar.NewProgressBarWrapper(current, totalSize)
+
+	err := filepath.Walk(sourcePath, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !info.IsDir() && !info.Mode().IsRegular() {
+			return nil
+		}
+
+		relPath, err := filepath.Rel(sourcePath, fullPath)
+		if err != nil {
+			return err
+		}
+
+		header, err := tar.FileInfoHeader(info, "")
+		if err != nil {
+			return err
+		}
+		header.Name = path.Join(base, filepath.ToSlash(relPath))
+		if info.IsDir() {
+			header.Name += "/"
+		}
+
+		err = archive.WriteHeader(header)
+		if err != nil || info.IsDir() {
+			return err
+		}
+
+		file, err := os.Open(fullPath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+
+		current.Reader = file
+		_, err = io.CopyN(archive, progress, header.Size)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+// shellQuote quotes value so that the remote shell passes it through as a
+// single argument.
+func shellQuote(value string) string {
+	return "'" + strings.Replace(value, "'", `'\''`, -1) + "'"
+}
@@ -0,0 +1,401 @@
+package sharedaction_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Secure Copy Actions", func() {
+	var (
+		actor           *Actor
+		fakeSSHClient   *sharedactionfakes.FakeSecureShellClient
+		fakeProgressBar *sharedactionfakes.FakeProgressBar
+		sshOptions      SSHOptions
+		localDir        string
+	)
+
+	type archiveEntry struct {
+		Name    string
+		Content string
+	}
+
+	buildArchive := func(entries ...archiveEntry) []byte {
+		buffer := new(bytes.Buffer)
+		archive := tar.NewWriter(buffer)
+		for _, entry := range entries {
+			header := &tar.Header{Name: entry.Name, Mode: 0644, Size: int64(len(entry.Content)), Typeflag: tar.TypeReg}
+			if strings.HasSuffix(entry.Name, "/") {
+				header = &tar.Header{Name: entry.Name, Mode: 0755, Typeflag: tar.TypeDir}
+			}
+			Expect(archive.WriteHeader(header)).To(Succeed())
+			_, err := archive.Write([]byte(entry.Content))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(archive.Close()).To(Succeed())
+		// Pad the archive the way tar does.
+		buffer.Write(make([]byte, 10240-buffer.Len()%10240))
+		return buffer.Bytes()
+	}
+
+	readArchive := func(raw []byte) []archiveEntry {
+		var entries []archiveEntry
+		archive := tar.NewReader(bytes.NewReader(raw))
+		for {
+			header, err := archive.Next()
+			if err == io.EOF {
+				return entries
+			}
+			Expect(err).ToNot(HaveOccurred())
+			content, err := ioutil.ReadAll(archive)
+			Expect(err).ToNot(HaveOccurred())
+			entries = append(entries, archiveEntry{Name: header.Name, Content: string(content)})
+		}
+	}
+
+	BeforeEach(func() {
+		actor = NewActor(nil)
+		fakeSSHClient = new(sharedactionfakes.FakeSecureShellClient)
+		fakeProgressBar = new(sharedactionfakes.FakeProgressBar)
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.Reader, _ int64) io.Reader {
+			return reader
+		}
+
+		sshOptions = SSHOptions{
+			Username:           "some-user",
+			Passcode:           "some-passcode",
+			Endpoint:           "some-endpoint",
+			HostKeyFingerprint: "some-fingerprint",
+			SkipHostValidation: true,
+		}
+
+		var err error
+		localDir, err = ioutil.TempDir("", "secure-copy-test")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+	})
+
+	Describe("DownloadFromSecureShell", func() {
+		var (
+			remotePath  string
+			localPath   string
+			archive     []byte
+			executeErr  error
+			tarErr      error
+			findCommand string
+			tarCommand  string
+		)
+
+		BeforeEach(func() {
+			remotePath = "/home/vcap/app/config"
+			localPath = localDir
+			archive = buildArchive(
+				archiveEntry{Name: "config/"},
+				archiveEntry{Name: "config/app.yml", Content: "some-config"},
+				archiveEntry{Name: "config/nested/"},
+				archiveEntry{Name: "config/nested/db.yml", Content: "some-db-config"},
+			)
+			tarErr = nil
+
+			fakeSSHClient.RunCommandStub = func(command string, stdin io.Reader, stdout io.Writer, _ io.Writer) error {
+				if strings.HasPrefix(command, "find ") {
+					findCommand = command
+					_, err := io.WriteString(stdout, "11\n14\n")
+					return err
+				}
+
+				tarCommand = command
+				_, err := stdout.Write(archive)
+				if err != nil {
+					return err
+				}
+				return tarErr
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.DownloadFromSecureShell(fakeSSHClient, sshOptions, remotePath, localPath, fakeProgressBar)
+		})
+
+		It("connects to the app instance and closes the connection", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSSHClient.ConnectCallCount()).To(Equal(1))
+			username, passcode, endpoint, fingerprint, skipHostValidation := fakeSSHClient.ConnectArgsForCall(0)
+			Expect(username).To(Equal("some-user"))
+			Expect(passcode).To(Equal("some-passcode"))
+			Expect(endpoint).To(Equal("some-endpoint"))
+			Expect(fingerprint).To(Equal("some-fingerprint"))
+			Expect(skipHostValidation).To(BeTrue())
+
+			Expect(fakeSSHClient.CloseCallCount()).To(Equal(1))
+		})
+
+		It("streams the remote path as a tar archive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(2))
+			Expect(findCommand).To(Equal(`find '/home/vcap/app/config' -type f -printf '%s\n'`))
+			Expect(tarCommand).To(Equal(`tar -cf - -C '/home/vcap/app' 'config'`))
+		})
+
+		It("tracks the progress against the total size of the remote files", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(25))
+		})
+
+		When("the local path is an existing directory", func() {
+			It("copies the remote path into it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				content, err := ioutil.ReadFile(filepath.Join(localDir, "config", "app.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("some-config"))
+
+				content, err = ioutil.ReadFile(filepath.Join(localDir, "config", "nested", "db.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("some-db-config"))
+			})
+		})
+
+		When("the local path does not exist", func() {
+			BeforeEach(func() {
+				remotePath = "/tmp/heap.hprof"
+				localPath = filepath.Join(localDir, "copy.hprof")
+				archive = buildArchive(archiveEntry{Name: "heap.hprof", Content: "some-heap"})
+			})
+
+			It("copies the remote path to it", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				content, err := ioutil.ReadFile(localPath)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("some-heap"))
+			})
+		})
+
+		When("the archive contains a path outside of the remote path", func() {
+			BeforeEach(func() {
+				archive = buildArchive(archiveEntry{Name: "config/../../escape", Content: "some-content"})
+			})
+
+			It("returns an error without writing it", func() {
+				Expect(executeErr).To(MatchError("unexpected path 'config/../../escape' in archive"))
+				Expect(filepath.Join(filepath.Dir(localDir), "escape")).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the remote path is not a file or directory", func() {
+			BeforeEach(func() {
+				remotePath = "/"
+			})
+
+			It("returns an InvalidSecureCopyPathError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecureCopyPathError{Path: "/"}))
+				Expect(fakeSSHClient.ConnectCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the size of the remote files cannot be determined", func() {
+			BeforeEach(func() {
+				fakeSSHClient.RunCommandStub = func(command string, stdin io.Reader, stdout io.Writer, _ io.Writer) error {
+					if strings.HasPrefix(command, "find ") {
+						return errors.New("find: unrecognized: -printf")
+					}
+					_, err := stdout.Write(archive)
+					return err
+				}
+			})
+
+			It("copies the remote path without a total size", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				content, err := ioutil.ReadFile(filepath.Join(localDir, "config", "app.yml"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(content)).To(Equal("some-config"))
+
+				_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+				Expect(size).To(BeZero())
+			})
+		})
+
+		When("the remote path does not exist", func() {
+			BeforeEach(func() {
+				fakeSSHClient.RunCommandReturns(errors.New("tar: /home/vcap/app/config: No such file or directory"))
+				fakeSSHClient.RunCommandStub = nil
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("tar: /home/vcap/app/config: No such file or directory"))
+				Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(2))
+			})
+		})
+
+		When("the remote tar fails", func() {
+			BeforeEach(func() {
+				tarErr = errors.New("tar: config/app.yml: file changed as we read it")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("tar: config/app.yml: file changed as we read it"))
+			})
+		})
+
+		When("connecting fails", func() {
+			BeforeEach(func() {
+				fakeSSHClient.ConnectReturns(errors.New("some-connect-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-connect-error"))
+				Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UploadToSecureShell", func() {
+		var (
+			localPath  string
+			remotePath string
+			uploaded   []byte
+			runErr     error
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(localDir, "config", "nested"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "config", "app.yml"), []byte("some-config"), 0644)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "config", "nested", "db.yml"), []byte("some-db-config"), 0600)).To(Succeed())
+
+			localPath = filepath.Join(localDir, "config")
+			remotePath = "/home/vcap/app/it's here"
+			runErr = nil
+
+			fakeSSHClient.RunCommandStub = func(command string, stdin io.Reader, stdout io.Writer, _ io.Writer) error {
+				var err error
+				uploaded, err = ioutil.ReadAll(stdin)
+				Expect(err).ToNot(HaveOccurred())
+				return runErr
+			}
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.UploadToSecureShell(fakeSSHClient, sshOptions, localPath, remotePath, fakeProgressBar)
+		})
+
+		It("streams the local path to a remote tar as an archive", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSSHClient.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSSHClient.CloseCallCount()).To(Equal(1))
+
+			Expect(fakeSSHClient.RunCommandCallCount()).To(Equal(1))
+			command, _, _, _ := fakeSSHClient.RunCommandArgsForCall(0)
+			Expect(command).To(ContainSubstring(`if [ -d '/home/vcap/app/it'\''s here' ]; then tar -xf - -C '/home/vcap/app/it'\''s here';`))
+			Expect(command).To(ContainSubstring(`mv -f "$tmp"/'config' '/home/vcap/app/it'\''s here'`))
+
+			Expect(readArchive(uploaded)).To(Equal([]archiveEntry{
+				{Name: "config/"},
+				{Name: "config/app.yml", Content: "some-config"},
+				{Name: "config/nested/"},
+				{Name: "config/nested/db.yml", Content: "some-db-config"},
+			}))
+		})
+
+		It("tracks the progress against the total size of the local files", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+			_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+			Expect(size).To(BeEquivalentTo(25))
+		})
+
+		When("the local path is a file", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "config", "app.yml")
+			})
+
+			It("uploads only the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(readArchive(uploaded)).To(Equal([]archiveEntry{
+					{Name: "app.yml", Content: "some-config"},
+				}))
+			})
+		})
+
+		When("the local path is the current directory", func() {
+			var previousDir string
+
+			BeforeEach(func() {
+				var err error
+				previousDir, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Chdir(filepath.Join(localDir, "config"))).To(Succeed())
+
+				localPath = "."
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(previousDir)).To(Succeed())
+			})
+
+			It("names the copy after the directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				command, _, _, _ := fakeSSHClient.RunCommandArgsForCall(0)
+				Expect(command).To(ContainSubstring(`mv -f "$tmp"/'config' '/home/vcap/app/it'\''s here'`))
+
+				Expect(readArchive(uploaded)).To(ContainElement(archiveEntry{Name: "config/app.yml", Content: "some-config"}))
+			})
+		})
+
+		When("the local path is the root directory", func() {
+			BeforeEach(func() {
+				localPath = string(filepath.Separator)
+			})
+
+			It("returns an InvalidSecureCopyPathError", func() {
+				Expect(executeErr).To(MatchError(actionerror.InvalidSecureCopyPathError{Path: localPath}))
+				Expect(fakeSSHClient.ConnectCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the local path does not exist", func() {
+			BeforeEach(func() {
+				localPath = filepath.Join(localDir, "missing")
+			})
+
+			It("returns a NonexistentAppPathError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NonexistentAppPathError{Path: localPath}))
+				Expect(fakeSSHClient.ConnectCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the remote tar fails", func() {
+			BeforeEach(func() {
+				runErr = errors.New("tar: Cannot open: Permission denied")
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("tar: Cannot open: Permission denied"))
+			})
+		})
+	})
+})
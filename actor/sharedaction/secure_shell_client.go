@@ -1,6 +1,10 @@
 package sharedaction
 
-import "code.cloudfoundry.org/cli/util/clissh"
+import (
+	"io"
+
+	"code.cloudfoundry.org/cli/util/clissh"
+)
 
 //go:generate counterfeiter . SecureShellClient
 
@@ -9,5 +13,8 @@ type SecureShellClient interface {
 	Close() error
 	InteractiveSession(commands []string, terminalRequest clissh.TTYRequest) error
 	LocalPortForward(localPortForwardSpecs []clissh.LocalPortForward) error
+	RemotePortForward(remotePortForwardSpecs []clissh.RemotePortForward) error
+	DynamicPortForward(localAddresses []string) error
+	RunCommand(command string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error
 	Wait() error
 }
@@ -60,6 +60,16 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HonorGitIgnoreStub        func() bool
+	honorGitIgnoreMutex       sync.RWMutex
+	honorGitIgnoreArgsForCall []struct {
+	}
+	honorGitIgnoreReturns struct {
+		result1 bool
+	}
+	honorGitIgnoreReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	RefreshTokenStub        func() string
 	refreshTokenMutex       sync.RWMutex
 	refreshTokenArgsForCall []struct {
@@ -70,6 +80,16 @@ type FakeConfig struct {
 	refreshTokenReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct {
+	}
+	resourceCacheFilePathReturns struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	TargetedOrganizationNameStub        func() string
 	targetedOrganizationNameMutex       sync.RWMutex
 	targetedOrganizationNameArgsForCall []struct {
@@ -359,6 +379,58 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HonorGitIgnore() bool {
+	fake.honorGitIgnoreMutex.Lock()
+	ret, specificReturn := fake.honorGitIgnoreReturnsOnCall[len(fake.honorGitIgnoreArgsForCall)]
+	fake.honorGitIgnoreArgsForCall = append(fake.honorGitIgnoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HonorGitIgnore", []interface{}{})
+	fake.honorGitIgnoreMutex.Unlock()
+	if fake.HonorGitIgnoreStub != nil {
+		return fake.HonorGitIgnoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.honorGitIgnoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HonorGitIgnoreCallCount() int {
+	fake.honorGitIgnoreMutex.RLock()
+	defer fake.honorGitIgnoreMutex.RUnlock()
+	return len(fake.honorGitIgnoreArgsForCall)
+}
+
+func (fake *FakeConfig) HonorGitIgnoreCalls(stub func() bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = stub
+}
+
+func (fake *FakeConfig) HonorGitIgnoreReturns(result1 bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = nil
+	fake.honorGitIgnoreReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) HonorGitIgnoreReturnsOnCall(i int, result1 bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = nil
+	if fake.honorGitIgnoreReturnsOnCall == nil {
+		fake.honorGitIgnoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.honorGitIgnoreReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) RefreshToken() string {
 	fake.refreshTokenMutex.Lock()
 	ret, specificReturn := fake.refreshTokenReturnsOnCall[len(fake.refreshTokenArgsForCall)]
@@ -411,6 +483,58 @@ func (fake *FakeConfig) RefreshTokenReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceCacheFilePathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCalls(stub func() string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = stub
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) TargetedOrganizationName() string {
 	fake.targetedOrganizationNameMutex.Lock()
 	ret, specificReturn := fake.targetedOrganizationNameReturnsOnCall[len(fake.targetedOrganizationNameArgsForCall)]
@@ -531,8 +655,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.honorGitIgnoreMutex.RLock()
+	defer fake.honorGitIgnoreMutex.RUnlock()
 	fake.refreshTokenMutex.RLock()
 	defer fake.refreshTokenMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
 	fake.targetedOrganizationNameMutex.RLock()
 	defer fake.targetedOrganizationNameMutex.RUnlock()
 	fake.verboseMutex.RLock()
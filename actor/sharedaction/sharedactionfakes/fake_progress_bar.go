@@ -0,0 +1,113 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package sharedactionfakes
+
+import (
+	io "io"
+	sync "sync"
+
+	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
+)
+
+type FakeProgressBar struct {
+	NewProgressBarWrapperStub        func(io.Reader, int64) io.Reader
+	newProgressBarWrapperMutex       sync.RWMutex
+	newProgressBarWrapperArgsForCall []struct {
+		arg1 io.Reader
+		arg2 int64
+	}
+	newProgressBarWrapperReturns struct {
+		result1 io.Reader
+	}
+	newProgressBarWrapperReturnsOnCall map[int]struct {
+		result1 io.Reader
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapper(arg1 io.Reader, arg2 int64) io.Reader {
+	fake.newProgressBarWrapperMutex.Lock()
+	ret, specificReturn := fake.newProgressBarWrapperReturnsOnCall[len(fake.newProgressBarWrapperArgsForCall)]
+	fake.newProgressBarWrapperArgsForCall = append(fake.newProgressBarWrapperArgsForCall, struct {
+		arg1 io.Reader
+		arg2 int64
+	}{arg1, arg2})
+	fake.recordInvocation("NewProgressBarWrapper", []interface{}{arg1, arg2})
+	fake.newProgressBarWrapperMutex.Unlock()
+	if fake.NewProgressBarWrapperStub != nil {
+		return fake.NewProgressBarWrapperStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.newProgressBarWrapperReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperCallCount() int {
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	return len(fake.newProgressBarWrapperArgsForCall)
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperCalls(stub func(io.Reader, int64) io.Reader) {
+	fake.newProgressBarWrapperMutex.Lock()
+	defer fake.newProgressBarWrapperMutex.Unlock()
+	fake.NewProgressBarWrapperStub = stub
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperArgsForCall(i int) (io.Reader, int64) {
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	argsForCall := fake.newProgressBarWrapperArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperReturns(result1 io.Reader) {
+	fake.newProgressBarWrapperMutex.Lock()
+	defer fake.newProgressBarWrapperMutex.Unlock()
+	fake.NewProgressBarWrapperStub = nil
+	fake.newProgressBarWrapperReturns = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperReturnsOnCall(i int, result1 io.Reader) {
+	fake.newProgressBarWrapperMutex.Lock()
+	defer fake.newProgressBarWrapperMutex.Unlock()
+	fake.NewProgressBarWrapperStub = nil
+	if fake.newProgressBarWrapperReturnsOnCall == nil {
+		fake.newProgressBarWrapperReturnsOnCall = make(map[int]struct {
+			result1 io.Reader
+		})
+	}
+	fake.newProgressBarWrapperReturnsOnCall[i] = struct {
+		result1 io.Reader
+	}{result1}
+}
+
+func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeProgressBar) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ sharedaction.ProgressBar = new(FakeProgressBar)
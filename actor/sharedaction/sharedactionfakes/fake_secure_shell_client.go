@@ -2,6 +2,7 @@
 package sharedactionfakes
 
 import (
+	io "io"
 	sync "sync"
 
 	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
@@ -34,6 +35,17 @@ type FakeSecureShellClient struct {
 	connectReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DynamicPortForwardStub        func([]string) error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+		arg1 []string
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func([]string, clissh.TTYRequest) error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct {
@@ -57,6 +69,31 @@ type FakeSecureShellClient struct {
 	localPortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RemotePortForwardStub        func([]clissh.RemotePortForward) error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+		arg1 []clissh.RemotePortForward
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RunCommandStub        func(string, io.Reader, io.Writer, io.Writer) error
+	runCommandMutex       sync.RWMutex
+	runCommandArgsForCall []struct {
+		arg1 string
+		arg2 io.Reader
+		arg3 io.Writer
+		arg4 io.Writer
+	}
+	runCommandReturns struct {
+		result1 error
+	}
+	runCommandReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct {
@@ -187,6 +224,71 @@ func (fake *FakeSecureShellClient) ConnectReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) DynamicPortForward(arg1 []string) error {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("DynamicPortForward", []interface{}{arg1Copy})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.dynamicPortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardCalls(stub func([]string) error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardArgsForCall(i int) []string {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	argsForCall := fake.dynamicPortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturns(result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) InteractiveSession(arg1 []string, arg2 clissh.TTYRequest) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -318,6 +420,134 @@ func (fake *FakeSecureShellClient) LocalPortForwardReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeSecureShellClient) RemotePortForward(arg1 []clissh.RemotePortForward) error {
+	var arg1Copy []clissh.RemotePortForward
+	if arg1 != nil {
+		arg1Copy = make([]clissh.RemotePortForward, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+		arg1 []clissh.RemotePortForward
+	}{arg1Copy})
+	fake.recordInvocation("RemotePortForward", []interface{}{arg1Copy})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.remotePortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardCalls(stub func([]clissh.RemotePortForward) error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = stub
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardArgsForCall(i int) []clissh.RemotePortForward {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	argsForCall := fake.remotePortForwardArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturns(result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RunCommand(arg1 string, arg2 io.Reader, arg3 io.Writer, arg4 io.Writer) error {
+	fake.runCommandMutex.Lock()
+	ret, specificReturn := fake.runCommandReturnsOnCall[len(fake.runCommandArgsForCall)]
+	fake.runCommandArgsForCall = append(fake.runCommandArgsForCall, struct {
+		arg1 string
+		arg2 io.Reader
+		arg3 io.Writer
+		arg4 io.Writer
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RunCommand", []interface{}{arg1, arg2, arg3, arg4})
+	fake.runCommandMutex.Unlock()
+	if fake.RunCommandStub != nil {
+		return fake.RunCommandStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.runCommandReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShellClient) RunCommandCallCount() int {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	return len(fake.runCommandArgsForCall)
+}
+
+func (fake *FakeSecureShellClient) RunCommandCalls(stub func(string, io.Reader, io.Writer, io.Writer) error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = stub
+}
+
+func (fake *FakeSecureShellClient) RunCommandArgsForCall(i int) (string, io.Reader, io.Writer, io.Writer) {
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
+	argsForCall := fake.runCommandArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturns(result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	fake.runCommandReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShellClient) RunCommandReturnsOnCall(i int, result1 error) {
+	fake.runCommandMutex.Lock()
+	defer fake.runCommandMutex.Unlock()
+	fake.RunCommandStub = nil
+	if fake.runCommandReturnsOnCall == nil {
+		fake.runCommandReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.runCommandReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShellClient) Wait() error {
 	fake.waitMutex.Lock()
 	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
@@ -377,10 +607,16 @@ func (fake *FakeSecureShellClient) Invocations() map[string][][]interface{} {
 	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.runCommandMutex.RLock()
+	defer fake.runCommandMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -13,6 +13,8 @@ const (
 
 type LocalPortForward clissh.LocalPortForward
 
+type RemotePortForward clissh.RemotePortForward
+
 type SSHOptions struct {
 	Commands              []string
 	Username              string
@@ -23,6 +25,9 @@ type SSHOptions struct {
 	SkipRemoteExecution   bool
 	TTYOption             TTYOption
 	LocalPortForwardSpecs []LocalPortForward
+
+	RemotePortForwardSpecs      []RemotePortForward
+	DynamicPortForwardAddresses []string
 }
 
 func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SSHOptions) error {
@@ -37,6 +42,16 @@ func (actor Actor) ExecuteSecureShell(sshClient SecureShellClient, sshOptions SS
 		return err
 	}
 
+	err = sshClient.RemotePortForward(convertActorToSSHPackageRemoteForwardingSpecs(sshOptions.RemotePortForwardSpecs))
+	if err != nil {
+		return err
+	}
+
+	err = sshClient.DynamicPortForward(sshOptions.DynamicPortForwardAddresses)
+	if err != nil {
+		return err
+	}
+
 	if sshOptions.SkipRemoteExecution {
 		err = sshClient.Wait()
 	} else {
@@ -54,3 +69,13 @@ func convertActorToSSHPackageForwardingSpecs(actorSpecs []LocalPortForward) []cl
 
 	return sshPackageSpecs
 }
+
+func convertActorToSSHPackageRemoteForwardingSpecs(actorSpecs []RemotePortForward) []clissh.RemotePortForward {
+	sshPackageSpecs := []clissh.RemotePortForward{}
+
+	for _, spec := range actorSpecs {
+		sshPackageSpecs = append(sshPackageSpecs, clissh.RemotePortForward(spec))
+	}
+
+	return sshPackageSpecs
+}
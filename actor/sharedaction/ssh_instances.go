@@ -0,0 +1,126 @@
+package sharedaction
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// InstanceSSHOptions are the SSH options for one instance of a process.
+type InstanceSSHOptions struct {
+	Index uint
+	SSHOptions
+}
+
+// InstanceSecureShellError is the reason a command failed on an instance.
+type InstanceSecureShellError struct {
+	Index uint
+	Err   error
+}
+
+// ExecuteSecureShellOnInstances runs the commands in each instance's options
+// on all instances in parallel, using a new client from newSSHClient for each
+// one. Every line of output is written to stdout or stderr prefixed with the
+// index of the instance it came from. The instances the command failed on are
+// returned in index order.
+func (actor Actor) ExecuteSecureShellOnInstances(newSSHClient func() SecureShellClient, instances []InstanceSSHOptions, stdout io.Writer, stderr io.Writer) []InstanceSecureShellError {
+	var (
+		outputLock sync.Mutex
+		failedLock sync.Mutex
+		failed     []InstanceSecureShellError
+		wg         sync.WaitGroup
+	)
+
+	for _, instance := range instances {
+		wg.Add(1)
+		go func(instance InstanceSSHOptions) {
+			defer wg.Done()
+
+			prefix := fmt.Sprintf("[instance %d] ", instance.Index)
+			instanceStdout := &linePrefixWriter{writer: stdout, prefix: prefix, lock: &outputLock}
+			instanceStderr := &linePrefixWriter{writer: stderr, prefix: prefix, lock: &outputLock}
+
+			err := executeSecureShellCommand(newSSHClient(), instance.SSHOptions, instanceStdout, instanceStderr)
+			instanceStdout.Flush()
+			instanceStderr.Flush()
+
+			if err != nil {
+				failedLock.Lock()
+				failed = append(failed, InstanceSecureShellError{Index: instance.Index, Err: err})
+				failedLock.Unlock()
+			}
+		}(instance)
+	}
+	wg.Wait()
+
+	sort.Slice(failed, func(i int, j int) bool {
+		return failed[i].Index < failed[j].Index
+	})
+	return failed
+}
+
+func executeSecureShellCommand(sshClient SecureShellClient, sshOptions SSHOptions, stdout io.Writer, stderr io.Writer) error {
+	err := sshClient.Connect(sshOptions.Username, sshOptions.Passcode, sshOptions.Endpoint, sshOptions.HostKeyFingerprint, sshOptions.SkipHostValidation)
+	if err != nil {
+		return err
+	}
+	defer sshClient.Close()
+
+	return sshClient.RunCommand(strings.Join(sshOptions.Commands, " "), nil, stdout, stderr)
+}
+
+// linePrefixWriter writes whole lines to writer with prefix in front of each
+// one. Partial lines are held back until they are completed or flushed, so
+// that lines from writers sharing the same lock are never interleaved.
+type linePrefixWriter struct {
+	writer  io.Writer
+	prefix  string
+	lock    *sync.Mutex
+	partial []byte
+}
+
+func (w *linePrefixWriter) Write(p []byte) (int, error) {
+	w.partial = append(w.partial, p...)
+
+	end := bytes.LastIndexByte(w.partial, '\n')
+	if end == -1 {
+		return len(p), nil
+	}
+
+	lines := w.partial[:end+1]
+	w.partial = append([]byte(nil), w.partial[end+1:]...)
+
+	err := w.writeLines(lines)
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// Flush writes out any partial line that is still held back.
+func (w *linePrefixWriter) Flush() {
+	if len(w.partial) == 0 {
+		return
+	}
+	_ = w.writeLines(append(w.partial, '\n'))
+	w.partial = nil
+}
+
+func (w *linePrefixWriter) writeLines(lines []byte) error {
+	var prefixed bytes.Buffer
+	for _, line := range bytes.SplitAfter(lines, []byte("\n")) {
+		if len(line) == 0 {
+			continue
+		}
+		prefixed.WriteString(w.prefix)
+		prefixed.Write(line)
+	}
+
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	_, err := w.writer.Write(prefixed.Bytes())
+	return err
+}
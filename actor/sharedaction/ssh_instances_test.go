@@ -0,0 +1,128 @@
+package sharedaction_test
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	. "code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ExecuteSecureShellOnInstances", func() {
+	var (
+		actor       *Actor
+		clients     map[string]*sharedactionfakes.FakeSecureShellClient
+		connectErrs map[string]error
+		commandErrs map[string]error
+		instances   []InstanceSSHOptions
+		stdout      *Buffer
+		stderr      *Buffer
+		failed      []InstanceSecureShellError
+	)
+
+	BeforeEach(func() {
+		actor = NewActor(new(sharedactionfakes.FakeConfig))
+		stdout = NewBuffer()
+		stderr = NewBuffer()
+		clients = map[string]*sharedactionfakes.FakeSecureShellClient{}
+		connectErrs = map[string]error{}
+		commandErrs = map[string]error{"cf:some-process-guid/3": errors.New("some-command-error")}
+
+		instances = []InstanceSSHOptions{
+			{
+				Index: 3,
+				SSHOptions: SSHOptions{
+					Commands:           []string{"echo", "hello"},
+					Username:           "cf:some-process-guid/3",
+					Passcode:           "some-passcode-3",
+					Endpoint:           "some-endpoint",
+					HostKeyFingerprint: "some-fingerprint",
+					SkipHostValidation: true,
+				},
+			},
+			{
+				Index: 1,
+				SSHOptions: SSHOptions{
+					Commands: []string{"echo", "hello"},
+					Username: "cf:some-process-guid/1",
+					Passcode: "some-passcode-1",
+				},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var clientsLock sync.Mutex
+		newSSHClient := func() SecureShellClient {
+			client := new(sharedactionfakes.FakeSecureShellClient)
+			var username string
+			client.ConnectStub = func(connectUsername string, _ string, _ string, _ string, _ bool) error {
+				username = connectUsername
+				clientsLock.Lock()
+				clients[username] = client
+				clientsLock.Unlock()
+				return connectErrs[username]
+			}
+			client.RunCommandStub = func(_ string, _ io.Reader, stdout io.Writer, stderr io.Writer) error {
+				_, _ = stdout.Write([]byte("hello\nfrom "))
+				_, _ = stdout.Write([]byte("the instance\nno newline"))
+				_, _ = stderr.Write([]byte("some warning\n"))
+				return commandErrs[username]
+			}
+			return client
+		}
+
+		failed = actor.ExecuteSecureShellOnInstances(newSSHClient, instances, stdout, stderr)
+	})
+
+	It("runs the command on every instance with its own client", func() {
+		Expect(clients).To(HaveLen(2))
+
+		_, passcode, endpoint, fingerprint, skipHostValidation := clients["cf:some-process-guid/3"].ConnectArgsForCall(0)
+		Expect(passcode).To(Equal("some-passcode-3"))
+		Expect(endpoint).To(Equal("some-endpoint"))
+		Expect(fingerprint).To(Equal("some-fingerprint"))
+		Expect(skipHostValidation).To(BeTrue())
+
+		for _, client := range clients {
+			Expect(client.RunCommandCallCount()).To(Equal(1))
+			command, stdin, _, _ := client.RunCommandArgsForCall(0)
+			Expect(command).To(Equal("echo hello"))
+			Expect(stdin).To(BeNil())
+			Expect(client.CloseCallCount()).To(Equal(1))
+		}
+	})
+
+	It("prefixes each line of output with the instance index", func() {
+		for _, index := range []string{"1", "3"} {
+			Expect(string(stdout.Contents())).To(ContainSubstring("[instance " + index + "] hello\n[instance " + index + "] from the instance\n"))
+			Expect(string(stdout.Contents())).To(ContainSubstring("[instance " + index + "] no newline\n"))
+			Expect(string(stderr.Contents())).To(ContainSubstring("[instance " + index + "] some warning\n"))
+		}
+	})
+
+	It("returns the instances the command failed on", func() {
+		Expect(failed).To(Equal([]InstanceSecureShellError{
+			{Index: 3, Err: errors.New("some-command-error")},
+		}))
+	})
+
+	When("connecting to an instance fails", func() {
+		BeforeEach(func() {
+			connectErrs["cf:some-process-guid/1"] = errors.New("some-connect-error")
+		})
+
+		It("returns the failures in index order", func() {
+			Expect(failed).To(Equal([]InstanceSecureShellError{
+				{Index: 1, Err: errors.New("some-connect-error")},
+				{Index: 3, Err: errors.New("some-command-error")},
+			}))
+			Expect(clients["cf:some-process-guid/1"].RunCommandCallCount()).To(Equal(0))
+			Expect(clients["cf:some-process-guid/1"].CloseCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -99,6 +99,49 @@ var _ = Describe("SSH Actions", func() {
 				})
 			})
 
+			When("remote and dynamic port forwarding are requested", func() {
+				BeforeEach(func() {
+					sshOptions.RemotePortForwardSpecs = []RemotePortForward{
+						{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+					}
+					sshOptions.DynamicPortForwardAddresses = []string{"local-socks-address"}
+				})
+
+				It("forwards the remote ports and starts the SOCKS proxies", func() {
+					Expect(fakeSecureShellClient.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.RemotePortForwardArgsForCall(0)).To(Equal(
+						[]clissh.RemotePortForward{
+							{RemoteAddress: "remote-address-1", LocalAddress: "local-address-1"},
+						},
+					))
+
+					Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShellClient.DynamicPortForwardArgsForCall(0)).To(ConsistOf("local-socks-address"))
+				})
+
+				When("remote port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.RemotePortForwardReturns(errors.New("some-remote-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-remote-forwarding-error"))
+						Expect(fakeSecureShellClient.DynamicPortForwardCallCount()).To(Equal(0))
+					})
+				})
+
+				When("dynamic port forwarding fails", func() {
+					BeforeEach(func() {
+						fakeSecureShellClient.DynamicPortForwardReturns(errors.New("some-dynamic-forwarding-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-dynamic-forwarding-error"))
+						Expect(fakeSecureShellClient.InteractiveSessionCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			When("local port forwarding succeeds", func() {
 				When("skipping remote execution", func() {
 					BeforeEach(func() {
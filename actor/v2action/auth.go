@@ -2,11 +2,30 @@ package v2action
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
+// slowDownIncrement is added to the device code polling interval every time
+// UAA asks the client to slow down (RFC 8628, section 3.5).
+const slowDownIncrement = 5 * time.Second
+
+// DeviceAuthorization contains the codes and URIs needed to complete a device
+// code login.
+type DeviceAuthorization uaa.DeviceAuthorization
+
+// BrowserLogin is an in progress authorization code login. The user must open
+// AuthorizationURL in a browser to complete it.
+type BrowserLogin struct {
+	AuthorizationURL string
+
+	listener  *uaa.RedirectListener
+	challenge uaa.PKCEChallenge
+}
+
 // Authenticate authenticates the user in UAA and sets the returned tokens in
 // the config.
 //
@@ -35,3 +54,124 @@ func (actor Actor) Authenticate(ID string, secret string, origin string, grantTy
 
 	return nil
 }
+
+// GetDeviceAuthorization starts a device code login.
+func (actor Actor) GetDeviceAuthorization() (DeviceAuthorization, error) {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return DeviceAuthorization{}, actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	deviceAuthorization, err := actor.UAAClient.RequestDeviceAuthorization()
+	return DeviceAuthorization(deviceAuthorization), err
+}
+
+// AuthenticateWithDeviceCode polls UAA until the user approves the device
+// code login, then sets the returned tokens in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithDeviceCode(deviceAuthorization DeviceAuthorization) error {
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	interval := time.Duration(deviceAuthorization.Interval) * time.Second
+	if interval == 0 {
+		interval = actor.Config.PollingInterval()
+	}
+
+	var deadline time.Time
+	if deviceAuthorization.ExpiresIn > 0 {
+		deadline = time.Now().Add(time.Duration(deviceAuthorization.ExpiresIn) * time.Second)
+	}
+
+	for {
+		accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithDeviceCode(deviceAuthorization.DeviceCode)
+		switch err.(type) {
+		case nil:
+			actor.saveUserTokens(accessToken, refreshToken)
+			return nil
+		case uaa.AuthorizationPendingError:
+		case uaa.SlowDownError:
+			interval += slowDownIncrement
+		default:
+			actor.Config.SetTokenInformation("", "", "")
+			return err
+		}
+
+		if !deadline.IsZero() && time.Now().Add(interval).After(deadline) {
+			actor.Config.SetTokenInformation("", "", "")
+			return uaa.ExpiredDeviceCodeError{}
+		}
+
+		time.Sleep(interval)
+	}
+}
+
+// StartBrowserLogin starts an authorization code login with PKCE. A loopback
+// listener is started to receive the authorization code; it is closed by
+// AuthenticateWithBrowserLogin.
+func (actor Actor) StartBrowserLogin() (BrowserLogin, error) {
+	if actor.Config.UAAGrantType() == string(constant.GrantTypeClientCredentials) {
+		return BrowserLogin{}, actionerror.PasswordGrantTypeLogoutRequiredError{}
+	}
+
+	challenge, err := uaa.NewPKCEChallenge()
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	state, err := uaa.NewAuthorizationState()
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	listener, err := uaa.NewRedirectListener(state)
+	if err != nil {
+		return BrowserLogin{}, err
+	}
+
+	authorizationURL, err := actor.UAAClient.AuthorizationCodeURL(listener.RedirectURI(), challenge, state)
+	if err != nil {
+		_ = listener.Close()
+		return BrowserLogin{}, err
+	}
+
+	return BrowserLogin{
+		AuthorizationURL: authorizationURL,
+		listener:         listener,
+		challenge:        challenge,
+	}, nil
+}
+
+// AuthenticateWithBrowserLogin waits up to timeout for the browser to be
+// redirected back with an authorization code, exchanges it for tokens and
+// sets them in the config.
+//
+// It unsets the currently targeted org and space whether authentication
+// succeeds or not.
+func (actor Actor) AuthenticateWithBrowserLogin(login BrowserLogin, timeout time.Duration) error {
+	defer login.listener.Close()
+
+	actor.Config.UnsetOrganizationAndSpaceInformation()
+
+	code, err := login.listener.WaitForCode(timeout)
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	accessToken, refreshToken, err := actor.UAAClient.AuthenticateWithAuthorizationCode(code, login.challenge.Verifier, login.listener.RedirectURI())
+	if err != nil {
+		actor.Config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	actor.saveUserTokens(accessToken, refreshToken)
+	return nil
+}
+
+// saveUserTokens stores the tokens of an interactive user login. The grant
+// type is cleared so that the tokens are refreshed with the refresh token.
+func (actor Actor) saveUserTokens(accessToken string, refreshToken string) {
+	actor.Config.SetTokenInformation(fmt.Sprintf("bearer %s", accessToken), refreshToken, "")
+	actor.Config.SetUAAGrantType("")
+}
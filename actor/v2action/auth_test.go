@@ -2,10 +2,13 @@ package v2action_test
 
 import (
 	"errors"
+	"net/http"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -120,4 +123,161 @@ var _ = Describe("Auth Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetDeviceAuthorization", func() {
+		var (
+			deviceAuthorization DeviceAuthorization
+			actualErr           error
+		)
+
+		JustBeforeEach(func() {
+			deviceAuthorization, actualErr = actor.GetDeviceAuthorization()
+		})
+
+		When("UAA returns a device authorization", func() {
+			BeforeEach(func() {
+				fakeUAAClient.RequestDeviceAuthorizationReturns(uaa.DeviceAuthorization{
+					DeviceCode: "some-device-code",
+					UserCode:   "ABCD-EFGH",
+				}, nil)
+			})
+
+			It("returns the device authorization", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+				Expect(deviceAuthorization).To(Equal(DeviceAuthorization{
+					DeviceCode: "some-device-code",
+					UserCode:   "ABCD-EFGH",
+				}))
+			})
+		})
+
+		When("a previous user authenticated with a client grant type", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(actualErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.RequestDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("AuthenticateWithDeviceCode", func() {
+		var actualErr error
+
+		JustBeforeEach(func() {
+			actualErr = actor.AuthenticateWithDeviceCode(DeviceAuthorization{DeviceCode: "some-device-code"})
+		})
+
+		When("the user approves the login after polling", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(0, "", "", uaa.AuthorizationPendingError{})
+				fakeUAAClient.AuthenticateWithDeviceCodeReturnsOnCall(1, "some-access-token", "some-refresh-token", nil)
+			})
+
+			It("polls until the tokens are returned and stores them", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeCallCount()).To(Equal(2))
+				Expect(fakeUAAClient.AuthenticateWithDeviceCodeArgsForCall(1)).To(Equal("some-device-code"))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+			})
+		})
+
+		When("the device code expires", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithDeviceCodeReturns("", "", uaa.ExpiredDeviceCodeError{})
+			})
+
+			It("returns the error and clears the tokens", func() {
+				Expect(actualErr).To(MatchError(uaa.ExpiredDeviceCodeError{}))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("browser login", func() {
+		var (
+			login     BrowserLogin
+			startErr  error
+			actualErr error
+		)
+
+		BeforeEach(func() {
+			fakeUAAClient.AuthorizationCodeURLReturns("https://login.example.com/oauth/authorize?some-query", nil)
+		})
+
+		JustBeforeEach(func() {
+			login, startErr = actor.StartBrowserLogin()
+		})
+
+		It("returns the authorization URL for a loopback redirect with a PKCE challenge", func() {
+			Expect(startErr).NotTo(HaveOccurred())
+			Expect(login.AuthorizationURL).To(Equal("https://login.example.com/oauth/authorize?some-query"))
+
+			Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(1))
+			redirectURI, challenge, state := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+			Expect(redirectURI).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+			Expect(challenge.Challenge).NotTo(BeEmpty())
+			Expect(state).NotTo(BeEmpty())
+
+			Expect(actor.AuthenticateWithBrowserLogin(login, time.Millisecond)).To(MatchError(uaa.AuthorizationCodeTimeoutError{}))
+		})
+
+		When("the browser is redirected back with a code", func() {
+			BeforeEach(func() {
+				fakeUAAClient.AuthenticateWithAuthorizationCodeReturns("some-access-token", "some-refresh-token", nil)
+			})
+
+			JustBeforeEach(func() {
+				Expect(startErr).NotTo(HaveOccurred())
+				redirectURI, _, state := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+
+				response, err := http.Get(redirectURI + "?code=some-code&state=" + state)
+				Expect(err).NotTo(HaveOccurred())
+				response.Body.Close()
+
+				actualErr = actor.AuthenticateWithBrowserLogin(login, time.Second)
+			})
+
+			It("exchanges the code and stores the tokens", func() {
+				Expect(actualErr).NotTo(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateWithAuthorizationCodeCallCount()).To(Equal(1))
+				redirectURI, challenge, _ := fakeUAAClient.AuthorizationCodeURLArgsForCall(0)
+				code, verifier, passedRedirectURI := fakeUAAClient.AuthenticateWithAuthorizationCodeArgsForCall(0)
+				Expect(code).To(Equal("some-code"))
+				Expect(verifier).To(Equal(challenge.Verifier))
+				Expect(passedRedirectURI).To(Equal(redirectURI))
+
+				Expect(fakeConfig.UnsetOrganizationAndSpaceInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, _ := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+			})
+		})
+
+		When("a previous user authenticated with a client grant type", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(startErr).To(MatchError(actionerror.PasswordGrantTypeLogoutRequiredError{}))
+				Expect(fakeUAAClient.AuthorizationCodeURLCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,94 @@
+package v2action
+
+import (
+	"code.cloudfoundry.org/cli/api/logcache"
+	"github.com/cloudfoundry/sonde-go/events"
+)
+
+// DefaultRecentLogLines is the number of log lines read from Log Cache when
+// neither a time window nor a number of lines is requested.
+const DefaultRecentLogLines = 1000
+
+// GetLogCacheLogsForApplicationByNameAndSpace returns the logs of the
+// application that match the query, oldest first. Log Cache is read newest
+// first, one page at a time, until enough matching lines have been found or
+// no older logs remain.
+func (actor Actor) GetLogCacheLogsForApplicationByNameAndSpace(appName string, spaceGUID string, query LogQuery, client LogCacheClient) ([]LogMessage, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if query.Since.IsZero() && query.Until.IsZero() && query.Lines == 0 {
+		query.Lines = DefaultRecentLogLines
+	}
+
+	// Newest first until the end, when they are reversed.
+	var logMessages []LogMessage
+	endTime := query.Until
+	for query.Lines == 0 || len(logMessages) < query.Lines {
+		// Without a filter every log envelope is a line, so only the missing
+		// lines are requested. With a filter it is unknown how many envelopes
+		// will match, so full pages are read.
+		limit := logcache.MaxReadLimit
+		if query.Lines > 0 && query.Filter.IsEmpty() && query.Lines-len(logMessages) < limit {
+			limit = query.Lines - len(logMessages)
+		}
+
+		page, err := client.ReadEnvelopes(app.GUID, logcache.ReadOptions{
+			StartTime:     query.Since,
+			EndTime:       endTime,
+			EnvelopeTypes: []logcache.EnvelopeType{logcache.LogEnvelopeType},
+			Limit:         limit,
+			Descending:    true,
+		})
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, envelope := range page {
+			if envelope.Log == nil {
+				continue
+			}
+
+			message := newLogCacheMessage(app.Name, envelope)
+			if query.Filter.Matches(message) {
+				logMessages = append(logMessages, message)
+			}
+		}
+
+		if len(page) < limit {
+			break
+		}
+
+		// The end time is exclusive, so the next page starts right before the
+		// oldest envelope read so far.
+		endTime = page[len(page)-1].Timestamp
+	}
+
+	if query.Lines > 0 && len(logMessages) > query.Lines {
+		logMessages = logMessages[:query.Lines]
+	}
+
+	for i, j := 0, len(logMessages)-1; i < j; i, j = i+1, j-1 {
+		logMessages[i], logMessages[j] = logMessages[j], logMessages[i]
+	}
+
+	return logMessages, allWarnings, nil
+}
+
+func newLogCacheMessage(appName string, envelope logcache.Envelope) LogMessage {
+	messageType := events.LogMessage_OUT
+	if envelope.Log.Type == logcache.ErrLogType {
+		messageType = events.LogMessage_ERR
+	}
+
+	return LogMessage{
+		applicationName: appName,
+		message:         string(envelope.Log.Payload),
+		messageType:     messageType,
+		timestamp:       envelope.Timestamp,
+		sourceType:      envelope.Tags["source_type"],
+		sourceInstance:  envelope.InstanceID,
+	}
+}
@@ -0,0 +1,10 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/logcache"
+
+//go:generate counterfeiter . LogCacheClient
+
+// LogCacheClient is a client for reading logs from Log Cache.
+type LogCacheClient interface {
+	ReadEnvelopes(sourceID string, options logcache.ReadOptions) ([]logcache.Envelope, error)
+}
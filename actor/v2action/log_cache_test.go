@@ -0,0 +1,200 @@
+package v2action_test
+
+import (
+	"errors"
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/logcache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Log Cache Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+		fakeLogCacheClient        *v2actionfakes.FakeLogCacheClient
+	)
+
+	BeforeEach(func() {
+		actor, fakeCloudControllerClient, _, _ = NewTestActor()
+		fakeLogCacheClient = new(v2actionfakes.FakeLogCacheClient)
+	})
+
+	logEnvelope := func(nanoseconds int64, message string) logcache.Envelope {
+		return logcache.Envelope{
+			Timestamp:  time.Unix(0, nanoseconds),
+			InstanceID: "0",
+			Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+			Log:        &logcache.Log{Payload: []byte(message), Type: logcache.OutLogType},
+		}
+	}
+
+	Describe("GetLogCacheLogsForApplicationByNameAndSpace", func() {
+		var (
+			query LogQuery
+
+			messages   []LogMessage
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			query = LogQuery{}
+		})
+
+		JustBeforeEach(func() {
+			messages, warnings, executeErr = actor.GetLogCacheLogsForApplicationByNameAndSpace("some-app", "some-space-guid", query, fakeLogCacheClient)
+		})
+
+		When("the application can be found", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv2.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv2.Warnings{"some-app-warnings"},
+					nil,
+				)
+			})
+
+			When("no query is given", func() {
+				BeforeEach(func() {
+					fakeLogCacheClient.ReadEnvelopesReturns([]logcache.Envelope{
+						logEnvelope(20, "message-2"),
+						{Timestamp: time.Unix(0, 15)},
+						logEnvelope(10, "message-1"),
+					}, nil)
+				})
+
+				It("reads the default number of recent logs and returns them oldest first", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+
+					Expect(fakeLogCacheClient.ReadEnvelopesCallCount()).To(Equal(1))
+					sourceID, options := fakeLogCacheClient.ReadEnvelopesArgsForCall(0)
+					Expect(sourceID).To(Equal("some-app-guid"))
+					Expect(options).To(Equal(logcache.ReadOptions{
+						EnvelopeTypes: []logcache.EnvelopeType{logcache.LogEnvelopeType},
+						Limit:         DefaultRecentLogLines,
+						Descending:    true,
+					}))
+
+					Expect(messages).To(HaveLen(2))
+					Expect(messages[0].Message()).To(Equal("message-1"))
+					Expect(messages[0].Type()).To(Equal("OUT"))
+					Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
+					Expect(messages[0].SourceType()).To(Equal("APP/PROC/WEB"))
+					Expect(messages[0].SourceInstance()).To(Equal("0"))
+					Expect(messages[1].Message()).To(Equal("message-2"))
+				})
+			})
+
+			When("a time window is given", func() {
+				BeforeEach(func() {
+					query = LogQuery{Since: time.Unix(0, 1), Until: time.Unix(0, 100)}
+
+					page := make([]logcache.Envelope, logcache.MaxReadLimit)
+					for i := range page {
+						page[i] = logEnvelope(int64(100+logcache.MaxReadLimit-i), "some-message")
+					}
+					fakeLogCacheClient.ReadEnvelopesReturnsOnCall(0, page, nil)
+					fakeLogCacheClient.ReadEnvelopesReturnsOnCall(1, []logcache.Envelope{logEnvelope(50, "oldest-message")}, nil)
+				})
+
+				It("reads pages until there are no older logs", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeLogCacheClient.ReadEnvelopesCallCount()).To(Equal(2))
+
+					_, options := fakeLogCacheClient.ReadEnvelopesArgsForCall(0)
+					Expect(options.StartTime).To(Equal(time.Unix(0, 1)))
+					Expect(options.EndTime).To(Equal(time.Unix(0, 100)))
+					Expect(options.Limit).To(Equal(logcache.MaxReadLimit))
+
+					_, options = fakeLogCacheClient.ReadEnvelopesArgsForCall(1)
+					Expect(options.StartTime).To(Equal(time.Unix(0, 1)))
+					Expect(options.EndTime).To(Equal(time.Unix(0, 101)))
+
+					Expect(messages).To(HaveLen(logcache.MaxReadLimit + 1))
+					Expect(messages[0].Message()).To(Equal("oldest-message"))
+				})
+			})
+
+			When("a number of lines is given", func() {
+				BeforeEach(func() {
+					query = LogQuery{Lines: 2}
+					fakeLogCacheClient.ReadEnvelopesReturns([]logcache.Envelope{
+						logEnvelope(20, "message-2"),
+						logEnvelope(10, "message-1"),
+					}, nil)
+				})
+
+				It("reads no more than that number of lines", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeLogCacheClient.ReadEnvelopesCallCount()).To(Equal(1))
+					_, options := fakeLogCacheClient.ReadEnvelopesArgsForCall(0)
+					Expect(options.Limit).To(Equal(2))
+					Expect(messages).To(HaveLen(2))
+				})
+			})
+
+			When("a number of lines and a filter are given", func() {
+				BeforeEach(func() {
+					query = LogQuery{Lines: 2, Filter: LogFilter{Pattern: regexp.MustCompile("^match")}}
+
+					page := make([]logcache.Envelope, logcache.MaxReadLimit)
+					for i := range page {
+						page[i] = logEnvelope(int64(100+logcache.MaxReadLimit-i), "some-message")
+					}
+					page[0] = logEnvelope(int64(100+logcache.MaxReadLimit), "match-3")
+					fakeLogCacheClient.ReadEnvelopesReturnsOnCall(0, page, nil)
+					fakeLogCacheClient.ReadEnvelopesReturnsOnCall(1, []logcache.Envelope{
+						logEnvelope(60, "match-2"),
+						logEnvelope(55, "some-message"),
+						logEnvelope(50, "match-1"),
+					}, nil)
+				})
+
+				It("reads full pages until that number of matching lines is found", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeLogCacheClient.ReadEnvelopesCallCount()).To(Equal(2))
+
+					_, options := fakeLogCacheClient.ReadEnvelopesArgsForCall(0)
+					Expect(options.Limit).To(Equal(logcache.MaxReadLimit))
+					_, options = fakeLogCacheClient.ReadEnvelopesArgsForCall(1)
+					Expect(options.Limit).To(Equal(logcache.MaxReadLimit))
+					Expect(options.EndTime).To(Equal(time.Unix(0, 101)))
+
+					Expect(messages).To(HaveLen(2))
+					Expect(messages[0].Message()).To(Equal("match-2"))
+					Expect(messages[1].Message()).To(Equal("match-3"))
+				})
+			})
+
+			When("Log Cache errors", func() {
+				BeforeEach(func() {
+					fakeLogCacheClient.ReadEnvelopesReturns(nil, errors.New("some-log-cache-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("some-log-cache-error"))
+					Expect(warnings).To(ConsistOf("some-app-warnings"))
+				})
+			})
+		})
+
+		When("finding the application errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-app-warnings"}, errors.New("some-app-error"))
+			})
+
+			It("returns the error and warnings without reading logs", func() {
+				Expect(executeErr).To(MatchError("some-app-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+				Expect(fakeLogCacheClient.ReadEnvelopesCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
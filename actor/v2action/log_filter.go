@@ -0,0 +1,72 @@
+package v2action
+
+import (
+	"regexp"
+	"strings"
+)
+
+// LogFilter narrows down log messages on the client side. Empty fields match
+// every message.
+type LogFilter struct {
+	// SourceTypes matches messages whose source type is, or starts with, one of
+	// the given types, such as APP, RTR, STG, API or CELL. Matching is case
+	// insensitive, so APP matches APP/PROC/WEB.
+	SourceTypes []string
+
+	// SourceInstances matches messages from one of the given instances.
+	SourceInstances []string
+
+	// Types matches messages written to one of the given streams, OUT or ERR.
+	Types []string
+
+	// Pattern matches messages that contain a match of the regular expression.
+	Pattern *regexp.Regexp
+}
+
+// IsEmpty returns true if the filter matches every message.
+func (filter LogFilter) IsEmpty() bool {
+	return len(filter.SourceTypes) == 0 &&
+		len(filter.SourceInstances) == 0 &&
+		len(filter.Types) == 0 &&
+		filter.Pattern == nil
+}
+
+// Matches returns true if the message passes all of the filter's criteria.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 && !matchesSourceType(filter.SourceTypes, message.SourceType()) {
+		return false
+	}
+
+	if len(filter.SourceInstances) > 0 && !containsString(filter.SourceInstances, message.SourceInstance()) {
+		return false
+	}
+
+	if len(filter.Types) > 0 && !containsString(filter.Types, message.Type()) {
+		return false
+	}
+
+	if filter.Pattern != nil && !filter.Pattern.MatchString(message.Message()) {
+		return false
+	}
+
+	return true
+}
+
+func matchesSourceType(sourceTypes []string, sourceType string) bool {
+	for _, filterType := range sourceTypes {
+		if strings.EqualFold(sourceType, filterType) ||
+			strings.HasPrefix(strings.ToUpper(sourceType), strings.ToUpper(filterType)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
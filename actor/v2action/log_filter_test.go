@@ -0,0 +1,38 @@
+package v2action_test
+
+import (
+	"regexp"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"github.com/cloudfoundry/sonde-go/events"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogFilter", func() {
+	var message LogMessage
+
+	BeforeEach(func() {
+		message = *NewLogMessage("GET /healthz 200", int(events.LogMessage_ERR), time.Unix(0, 0), "APP/PROC/WEB", "1")
+	})
+
+	DescribeTable("Matches",
+		func(filter LogFilter, expected bool) {
+			Expect(filter.Matches(message)).To(Equal(expected))
+		},
+		Entry("an empty filter", LogFilter{}, true),
+		Entry("a source type prefix", LogFilter{SourceTypes: []string{"RTR", "app"}}, true),
+		Entry("the full source type", LogFilter{SourceTypes: []string{"APP/PROC/WEB"}}, true),
+		Entry("another source type", LogFilter{SourceTypes: []string{"STG"}}, false),
+		Entry("a partial source type", LogFilter{SourceTypes: []string{"AP"}}, false),
+		Entry("the instance", LogFilter{SourceInstances: []string{"0", "1"}}, true),
+		Entry("another instance", LogFilter{SourceInstances: []string{"0"}}, false),
+		Entry("the stream", LogFilter{Types: []string{"ERR"}}, true),
+		Entry("another stream", LogFilter{Types: []string{"OUT"}}, false),
+		Entry("a matching pattern", LogFilter{Pattern: regexp.MustCompile(`GET /\w+ 2\d\d`)}, true),
+		Entry("a pattern that does not match", LogFilter{Pattern: regexp.MustCompile(`POST`)}, false),
+		Entry("all criteria", LogFilter{SourceTypes: []string{"APP"}, SourceInstances: []string{"1"}, Types: []string{"ERR"}, Pattern: regexp.MustCompile("healthz")}, true),
+	)
+})
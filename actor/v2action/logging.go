@@ -17,11 +17,18 @@ const StagingLog = "STG"
 var flushInterval = 300 * time.Millisecond
 
 type LogMessage struct {
-	message        string
-	messageType    events.LogMessage_MessageType
-	timestamp      time.Time
-	sourceType     string
-	sourceInstance string
+	applicationName string
+	message         string
+	messageType     events.LogMessage_MessageType
+	timestamp       time.Time
+	sourceType      string
+	sourceInstance  string
+}
+
+// ApplicationName returns the name of the application that emitted the log,
+// if it is known.
+func (log LogMessage) ApplicationName() string {
+	return log.applicationName
 }
 
 func (log LogMessage) Message() string {
@@ -52,17 +59,62 @@ func (log LogMessage) SourceInstance() string {
 }
 
 func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
+	return NewApplicationLogMessage("", message, messageType, timestamp, sourceType, sourceInstance)
+}
+
+func NewApplicationLogMessage(applicationName string, message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
 	return &LogMessage{
-		message:        message,
-		messageType:    events.LogMessage_MessageType(messageType),
-		timestamp:      timestamp,
-		sourceType:     sourceType,
-		sourceInstance: sourceInstance,
+		applicationName: applicationName,
+		message:         message,
+		messageType:     events.LogMessage_MessageType(messageType),
+		timestamp:       timestamp,
+		sourceType:      sourceType,
+		sourceInstance:  sourceInstance,
 	}
 }
 
 type LogMessages []*LogMessage
 
+// LogQuery narrows down the recent logs of an application. Zero values leave
+// the logs unrestricted.
+type LogQuery struct {
+	// Since excludes logs emitted before it.
+	Since time.Time
+
+	// Until excludes logs emitted at or after it.
+	Until time.Time
+
+	// Lines is the maximum number of log lines, keeping the most recent ones.
+	// Only the lines that match the Filter are counted.
+	Lines int
+
+	// Filter excludes the messages that do not match it.
+	Filter LogFilter
+}
+
+// filter returns the messages that match the query. The messages must be
+// sorted oldest first.
+func (query LogQuery) filter(messages []LogMessage) []LogMessage {
+	var filtered []LogMessage
+	for _, message := range messages {
+		if !query.Since.IsZero() && message.timestamp.Before(query.Since) {
+			continue
+		}
+		if !query.Until.IsZero() && !message.timestamp.Before(query.Until) {
+			continue
+		}
+		if !query.Filter.Matches(message) {
+			continue
+		}
+		filtered = append(filtered, message)
+	}
+
+	if query.Lines > 0 && len(filtered) > query.Lines {
+		filtered = filtered[len(filtered)-query.Lines:]
+	}
+	return filtered
+}
+
 func (lm LogMessages) Len() int { return len(lm) }
 
 func (lm LogMessages) Less(i, j int) bool {
@@ -74,21 +126,12 @@ func (lm LogMessages) Swap(i, j int) {
 }
 
 func (actor Actor) GetStreamingLogs(appGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error) {
-	log.Info("Start Tailing Logs")
-
-	ready := actor.setOnConnectBlocker(client)
-
-	// Do not pass in token because client should have a TokenRefresher set
-	incomingLogStream, incomingErrStream := client.TailingLogs(appGUID, "")
-
-	outgoingLogStream, outgoingErrStream := actor.blockOnConnect(ready)
-
-	go actor.streamLogsBetween(incomingLogStream, incomingErrStream, outgoingLogStream, outgoingErrStream)
-
-	return outgoingLogStream, outgoingErrStream
+	return actor.tailLogs(Application{GUID: appGUID}, client)
 }
 
-func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient) ([]LogMessage, Warnings, error) {
+// GetRecentLogsForApplicationByNameAndSpace returns the logs of the
+// application that are buffered by Doppler and match the query.
+func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, query LogQuery, client NOAAClient) ([]LogMessage, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return nil, allWarnings, err
@@ -105,15 +148,16 @@ func (actor Actor) GetRecentLogsForApplicationByNameAndSpace(appName string, spa
 
 	for _, message := range noaaMessages {
 		logMessages = append(logMessages, LogMessage{
-			message:        string(message.GetMessage()),
-			messageType:    message.GetMessageType(),
-			timestamp:      time.Unix(0, message.GetTimestamp()),
-			sourceType:     message.GetSourceType(),
-			sourceInstance: message.GetSourceInstance(),
+			applicationName: app.Name,
+			message:         string(message.GetMessage()),
+			messageType:     message.GetMessageType(),
+			timestamp:       time.Unix(0, message.GetTimestamp()),
+			sourceType:      message.GetSourceType(),
+			sourceInstance:  message.GetSourceInstance(),
 		})
 	}
 
-	return logMessages, allWarnings, nil
+	return query.filter(logMessages), allWarnings, nil
 }
 
 func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
@@ -122,11 +166,87 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 		return nil, nil, allWarnings, err
 	}
 
-	messages, logErrs := actor.GetStreamingLogs(app.GUID, client)
+	messages, logErrs := actor.tailLogs(app, client)
 
 	return messages, logErrs, allWarnings, err
 }
 
+// GetStreamingLogsForApplicationsByNameAndSpace tails the logs of several
+// applications at once and merges them into a single stream. Every
+// application is tailed over its own connection of the same NOAA client,
+// which retries and refreshes the token of each connection independently.
+func (actor Actor) GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client NOAAClient) (<-chan *LogMessage, <-chan error, Warnings, error) {
+	var (
+		apps        []Application
+		allWarnings Warnings
+	)
+	for _, appName := range appNames {
+		app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, nil, allWarnings, err
+		}
+		apps = append(apps, app)
+	}
+
+	log.Info("Start Tailing Logs")
+
+	ready := actor.setOnConnectBlocker(client)
+
+	incomingLogStreams := make([]<-chan *events.LogMessage, len(apps))
+	incomingErrStreams := make([]<-chan error, len(apps))
+	for i, app := range apps {
+		// Do not pass in token because client should have a TokenRefresher set
+		incomingLogStreams[i], incomingErrStreams[i] = client.TailingLogs(app.GUID, "")
+	}
+
+	outgoingLogStream, outgoingErrStream := actor.blockOnConnect(ready)
+
+	var wg sync.WaitGroup
+	for i, app := range apps {
+		appLogStream := make(chan *LogMessage)
+		appErrStream := make(chan error)
+		go actor.streamLogsBetween(app.Name, incomingLogStreams[i], incomingErrStreams[i], appLogStream, appErrStream)
+
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for message := range appLogStream {
+				outgoingLogStream <- message
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for err := range appErrStream {
+				outgoingErrStream <- err
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(outgoingLogStream)
+		close(outgoingErrStream)
+	}()
+
+	return outgoingLogStream, outgoingErrStream, allWarnings, nil
+}
+
+func (actor Actor) tailLogs(app Application, client NOAAClient) (<-chan *LogMessage, <-chan error) {
+	log.Info("Start Tailing Logs")
+
+	ready := actor.setOnConnectBlocker(client)
+
+	// Do not pass in token because client should have a TokenRefresher set
+	incomingLogStream, incomingErrStream := client.TailingLogs(app.GUID, "")
+
+	outgoingLogStream, outgoingErrStream := actor.blockOnConnect(ready)
+
+	go actor.streamLogsBetween(app.Name, incomingLogStream, incomingErrStream, outgoingLogStream, outgoingErrStream)
+
+	return outgoingLogStream, outgoingErrStream
+}
+
 func (actor Actor) blockOnConnect(ready <-chan bool) (chan *LogMessage, chan error) {
 	outgoingLogStream := make(chan *LogMessage)
 	outgoingErrStream := make(chan error, 1)
@@ -171,7 +291,7 @@ func (Actor) setOnConnectBlocker(client NOAAClient) <-chan bool {
 	return ready
 }
 
-func (actor Actor) streamLogsBetween(incomingLogStream <-chan *events.LogMessage, incomingErrStream <-chan error, outgoingLogStream chan<- *LogMessage, outgoingErrStream chan<- error) {
+func (actor Actor) streamLogsBetween(appName string, incomingLogStream <-chan *events.LogMessage, incomingErrStream <-chan error, outgoingLogStream chan<- *LogMessage, outgoingErrStream chan<- error) {
 	log.Info("Processing Log Stream")
 
 	defer close(outgoingLogStream)
@@ -196,11 +316,12 @@ dance:
 			}
 
 			logsToBeSorted = append(logsToBeSorted, &LogMessage{
-				message:        string(event.GetMessage()),
-				messageType:    event.GetMessageType(),
-				timestamp:      time.Unix(0, event.GetTimestamp()),
-				sourceInstance: event.GetSourceInstance(),
-				sourceType:     event.GetSourceType(),
+				applicationName: appName,
+				message:         string(event.GetMessage()),
+				messageType:     event.GetMessageType(),
+				timestamp:       time.Unix(0, event.GetTimestamp()),
+				sourceInstance:  event.GetSourceInstance(),
+				sourceType:      event.GetSourceType(),
 			})
 		case err, ok := <-incomingErrStream:
 			if !ok {
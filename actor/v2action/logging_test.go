@@ -2,6 +2,7 @@ package v2action_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -359,9 +360,10 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns all the recent logs and warnings", func() {
-					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+					messages, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{}, fakeNOAAClient)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
+					Expect(messages[0].ApplicationName()).To(Equal("some-app"))
 					Expect(messages[0].Message()).To(Equal("message-1"))
 					Expect(messages[0].Type()).To(Equal("OUT"))
 					Expect(messages[0].Timestamp()).To(Equal(time.Unix(0, 10)))
@@ -374,6 +376,33 @@ var _ = Describe("Logging Actions", func() {
 					Expect(messages[1].SourceType()).To(Equal("some-source-type"))
 					Expect(messages[1].SourceInstance()).To(Equal("some-source-instance"))
 				})
+
+				It("only returns the logs within the queried time window", func() {
+					messages, _, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{Since: time.Unix(0, 15)}, fakeNOAAClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-2"))
+
+					messages, _, err = actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{Until: time.Unix(0, 20)}, fakeNOAAClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-1"))
+				})
+
+				It("only returns the most recent queried number of lines", func() {
+					messages, _, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{Lines: 1}, fakeNOAAClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-2"))
+				})
+
+				It("counts only the lines that match the filter", func() {
+					query := LogQuery{Lines: 1, Filter: LogFilter{Pattern: regexp.MustCompile("message-1")}}
+					messages, _, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", query, fakeNOAAClient)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(messages).To(HaveLen(1))
+					Expect(messages[0].Message()).To(Equal("message-1"))
+				})
 			})
 
 			When("NOAA errors", func() {
@@ -385,7 +414,7 @@ var _ = Describe("Logging Actions", func() {
 				})
 
 				It("returns error and warnings", func() {
-					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+					_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{}, fakeNOAAClient)
 					Expect(err).To(MatchError(expectedErr))
 					Expect(warnings).To(ConsistOf("some-app-warnings"))
 				})
@@ -405,7 +434,7 @@ var _ = Describe("Logging Actions", func() {
 			})
 
 			It("returns error and warnings", func() {
-				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", fakeNOAAClient)
+				_, warnings, err := actor.GetRecentLogsForApplicationByNameAndSpace("some-app", "some-space-guid", LogQuery{}, fakeNOAAClient)
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
@@ -498,6 +527,7 @@ var _ = Describe("Logging Actions", func() {
 				Expect(warnings).To(ConsistOf("some-app-warnings"))
 
 				message := <-messages
+				Expect(message.ApplicationName()).To(Equal("some-app"))
 				Expect(message.Message()).To(Equal("message-1"))
 				Expect(message.Type()).To(Equal("OUT"))
 				Expect(message.Timestamp()).To(Equal(time.Unix(0, 10)))
@@ -534,4 +564,131 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStreamingLogsForApplicationsByNameAndSpace", func() {
+		When("the applications can be found", func() {
+			var (
+				messages <-chan *LogMessage
+				logErrs  <-chan error
+			)
+
+			AfterEach(func() {
+				Eventually(messages).Should(BeClosed())
+				Eventually(logErrs).Should(BeClosed())
+			})
+
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsStub = func(filters ...ccv2.Filter) ([]ccv2.Application, ccv2.Warnings, error) {
+					appName := filters[0].Values[0]
+					return []ccv2.Application{{Name: appName, GUID: appName + "-guid"}}, ccv2.Warnings{appName + "-warnings"}, nil
+				}
+
+				fakeConfig.DialTimeoutReturns(60 * time.Minute)
+
+				fakeNOAAClient.TailingLogsStub = func(appGUID string, authToken string) (<-chan *events.LogMessage, <-chan error) {
+					Expect(authToken).To(BeEmpty())
+
+					Expect(fakeNOAAClient.SetOnConnectCallbackCallCount()).To(Equal(1))
+					onConnectOrOnRetry := fakeNOAAClient.SetOnConnectCallbackArgsForCall(0)
+
+					eventStream := make(chan *events.LogMessage)
+					errStream := make(chan error, 1)
+
+					go func() {
+						defer close(eventStream)
+						defer close(errStream)
+
+						onConnectOrOnRetry()
+
+						outMessage := events.LogMessage_OUT
+						ts := int64(10)
+						sourceType := "some-source-type"
+						sourceInstance := "some-source-instance"
+
+						eventStream <- &events.LogMessage{
+							Message:        []byte("message-from-" + appGUID),
+							MessageType:    &outMessage,
+							Timestamp:      &ts,
+							SourceType:     &sourceType,
+							SourceInstance: &sourceInstance,
+						}
+
+						if appGUID == "app-2-guid" {
+							errStream <- errors.New("app-2-error")
+						}
+					}()
+
+					return eventStream, errStream
+				}
+			})
+
+			It("tails every application over the same client and merges their logs and errors", func() {
+				var err error
+				var warnings Warnings
+				messages, logErrs, warnings, err = actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", fakeNOAAClient)
+
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-1-warnings", "app-2-warnings"))
+
+				Expect(fakeNOAAClient.SetOnConnectCallbackCallCount()).To(Equal(1))
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+				appGUID, _ := fakeNOAAClient.TailingLogsArgsForCall(0)
+				Expect(appGUID).To(Equal("app-1-guid"))
+				appGUID, _ = fakeNOAAClient.TailingLogsArgsForCall(1)
+				Expect(appGUID).To(Equal("app-2-guid"))
+
+				var receivedMessages []string
+				var receivedErrs []error
+				messageStream, errStream := messages, logErrs
+				for messageStream != nil || errStream != nil {
+					select {
+					case message, ok := <-messageStream:
+						if !ok {
+							messageStream = nil
+							continue
+						}
+						receivedMessages = append(receivedMessages, message.ApplicationName()+": "+message.Message())
+					case err, ok := <-errStream:
+						if !ok {
+							errStream = nil
+							continue
+						}
+						receivedErrs = append(receivedErrs, err)
+					}
+				}
+
+				Expect(receivedMessages).To(ConsistOf(
+					"app-1: message-from-app-1-guid",
+					"app-2: message-from-app-2-guid",
+				))
+				Expect(receivedErrs).To(ConsistOf(MatchError("app-2-error")))
+			})
+		})
+
+		When("finding one of the applications errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(0,
+					[]ccv2.Application{{Name: "app-1", GUID: "app-1-guid"}},
+					ccv2.Warnings{"app-1-warnings"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationsReturnsOnCall(1,
+					nil,
+					ccv2.Warnings{"app-2-warnings"},
+					expectedErr,
+				)
+			})
+
+			It("returns error and warnings", func() {
+				_, _, warnings, err := actor.GetStreamingLogsForApplicationsByNameAndSpace([]string{"app-1", "app-2"}, "some-space-guid", fakeNOAAClient)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("app-1-warnings", "app-2-warnings"))
+
+				Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -221,6 +221,60 @@ func (actor Actor) GetSpaceRoutes(spaceGUID string) ([]Route, Warnings, error) {
 	return routes, append(allWarnings, domainWarnings...), err
 }
 
+// GetApplicationRoutesBySpace returns the routes in the provided Space GUID
+// that are mapped to applications, keyed by application GUID.
+func (actor Actor) GetApplicationRoutesBySpace(spaceGUID string) (map[string]Routes, Warnings, error) {
+	routes, allWarnings, err := actor.GetSpaceRoutes(spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	routeApps, warnings, err := actor.GetRouteApplicationGUIDs(routes)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	appRoutes := map[string]Routes{}
+	for _, route := range routes {
+		for _, appGUID := range routeApps[route.GUID] {
+			appRoutes[appGUID] = append(appRoutes[appGUID], route)
+		}
+	}
+
+	return appRoutes, allWarnings, nil
+}
+
+// GetRouteApplicationGUIDs returns the GUIDs of the applications mapped to
+// the provided routes, keyed by route GUID. The route mappings of all the
+// routes are fetched with a single filtered request.
+func (actor Actor) GetRouteApplicationGUIDs(routes []Route) (map[string][]string, Warnings, error) {
+	routeApps := map[string][]string{}
+	if len(routes) == 0 {
+		return routeApps, nil, nil
+	}
+
+	var routeGUIDs []string
+	for _, route := range routes {
+		routeGUIDs = append(routeGUIDs, route.GUID)
+	}
+
+	mappings, warnings, err := actor.CloudControllerClient.GetRouteMappings(ccv2.Filter{
+		Type:     constant.RouteGUIDFilter,
+		Operator: constant.InOperator,
+		Values:   routeGUIDs,
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	for _, mapping := range mappings {
+		routeApps[mapping.RouteGUID] = append(routeApps[mapping.RouteGUID], mapping.AppGUID)
+	}
+
+	return routeApps, Warnings(warnings), nil
+}
+
 // DeleteRoute deletes the Route associated with the provided Route GUID.
 func (actor Actor) DeleteRoute(routeGUID string) (Warnings, error) {
 	warnings, err := actor.CloudControllerClient.DeleteRoute(routeGUID)
@@ -1210,6 +1210,137 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationRoutesBySpace", func() {
+		var (
+			appRoutes  map[string]Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			appRoutes, warnings, executeErr = actor.GetApplicationRoutesBySpace("some-space-guid")
+		})
+
+		When("the space has routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns([]ccv2.Route{
+					{GUID: "route-guid-1", SpaceGUID: "some-space-guid", Host: "host-1", DomainGUID: "domain-guid"},
+					{GUID: "route-guid-2", SpaceGUID: "some-space-guid", Host: "host-2", DomainGUID: "domain-guid"},
+				}, ccv2.Warnings{"get-space-routes-warning"}, nil)
+				fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{Name: "domain.com"}, nil, nil)
+				fakeCloudControllerClient.GetRouteMappingsReturns([]ccv2.RouteMapping{
+					{GUID: "mapping-guid-1", AppGUID: "app-guid-1", RouteGUID: "route-guid-1"},
+					{GUID: "mapping-guid-2", AppGUID: "app-guid-2", RouteGUID: "route-guid-1"},
+					{GUID: "mapping-guid-3", AppGUID: "app-guid-2", RouteGUID: "route-guid-2"},
+				}, ccv2.Warnings{"get-route-mappings-warning"}, nil)
+			})
+
+			It("returns the routes of each app and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-routes-warning", "get-route-mappings-warning"))
+
+				Expect(appRoutes).To(HaveLen(2))
+				Expect(appRoutes["app-guid-1"].Summary()).To(Equal("host-1.domain.com"))
+				Expect(appRoutes["app-guid-2"].Summary()).To(Equal("host-1.domain.com, host-2.domain.com"))
+
+				Expect(fakeCloudControllerClient.GetSpaceRoutesArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.RouteGUIDFilter,
+					Operator: constant.InOperator,
+					Values:   []string{"route-guid-1", "route-guid-2"},
+				}))
+			})
+
+			When("getting the route mappings fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"get-route-mappings-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("get-space-routes-warning", "get-route-mappings-warning"))
+				})
+			})
+		})
+
+		When("the space has no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceRoutesReturns(nil, ccv2.Warnings{"get-space-routes-warning"}, nil)
+			})
+
+			It("does not look up any route mappings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-space-routes-warning"))
+				Expect(appRoutes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRouteApplicationGUIDs", func() {
+		var (
+			routes     []Route
+			routeApps  map[string][]string
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			routeApps, warnings, executeErr = actor.GetRouteApplicationGUIDs(routes)
+		})
+
+		When("routes are provided", func() {
+			BeforeEach(func() {
+				routes = []Route{{GUID: "route-guid-1"}, {GUID: "route-guid-2"}, {GUID: "route-guid-3"}}
+				fakeCloudControllerClient.GetRouteMappingsReturns([]ccv2.RouteMapping{
+					{GUID: "mapping-guid-1", AppGUID: "app-guid-1", RouteGUID: "route-guid-1"},
+					{GUID: "mapping-guid-2", AppGUID: "app-guid-2", RouteGUID: "route-guid-1"},
+					{GUID: "mapping-guid-3", AppGUID: "app-guid-2", RouteGUID: "route-guid-2"},
+				}, ccv2.Warnings{"get-route-mappings-warning"}, nil)
+			})
+
+			It("returns the app GUIDs of each mapped route and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-route-mappings-warning"))
+				Expect(routeApps).To(Equal(map[string][]string{
+					"route-guid-1": {"app-guid-1", "app-guid-2"},
+					"route-guid-2": {"app-guid-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRouteMappingsArgsForCall(0)).To(ConsistOf(ccv2.Filter{
+					Type:     constant.RouteGUIDFilter,
+					Operator: constant.InOperator,
+					Values:   []string{"route-guid-1", "route-guid-2", "route-guid-3"},
+				}))
+			})
+
+			When("getting the route mappings fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetRouteMappingsReturns(nil, ccv2.Warnings{"get-route-mappings-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("get-route-mappings-warning"))
+				})
+			})
+		})
+
+		When("no routes are provided", func() {
+			BeforeEach(func() {
+				routes = nil
+			})
+
+			It("does not look up any route mappings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routeApps).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetRouteMappingsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("GetSpaceRoutes", func() {
 		When("the CC API client does not return any errors", func() {
 			BeforeEach(func() {
@@ -10,7 +10,11 @@ import (
 type UAAClient interface {
 	APIVersion() string
 	Authenticate(ID string, secret string, origin string, grantType constant.GrantType) (string, string, error)
+	AuthenticateWithAuthorizationCode(code string, codeVerifier string, redirectURI string) (string, string, error)
+	AuthenticateWithDeviceCode(deviceCode string) (string, string, error)
+	AuthorizationCodeURL(redirectURI string, challenge uaa.PKCEChallenge, state string) (string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 	GetSSHPasscode(accessToken string, sshOAuthClient string) (string, error)
 	RefreshAccessToken(refreshToken string) (uaa.RefreshedTokens, error)
+	RequestDeviceAuthorization() (uaa.DeviceAuthorization, error)
 }
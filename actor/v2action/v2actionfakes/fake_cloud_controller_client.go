@@ -537,6 +537,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv2.Warnings
 		result3 error
 	}
+	GetRouteMappingsStub        func(...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error)
+	getRouteMappingsMutex       sync.RWMutex
+	getRouteMappingsArgsForCall []struct {
+		arg1 []ccv2.Filter
+	}
+	getRouteMappingsReturns struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
+	getRouteMappingsReturnsOnCall map[int]struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}
 	GetRoutesStub        func(...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error)
 	getRoutesMutex       sync.RWMutex
 	getRoutesArgsForCall []struct {
@@ -3634,6 +3649,72 @@ func (fake *FakeCloudControllerClient) GetRouteApplicationsReturnsOnCall(i int,
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetRouteMappings(arg1 ...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error) {
+	fake.getRouteMappingsMutex.Lock()
+	ret, specificReturn := fake.getRouteMappingsReturnsOnCall[len(fake.getRouteMappingsArgsForCall)]
+	fake.getRouteMappingsArgsForCall = append(fake.getRouteMappingsArgsForCall, struct {
+		arg1 []ccv2.Filter
+	}{arg1})
+	fake.recordInvocation("GetRouteMappings", []interface{}{arg1})
+	fake.getRouteMappingsMutex.Unlock()
+	if fake.GetRouteMappingsStub != nil {
+		return fake.GetRouteMappingsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteMappingsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCallCount() int {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	return len(fake.getRouteMappingsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsCalls(stub func(...ccv2.Filter) ([]ccv2.RouteMapping, ccv2.Warnings, error)) {
+	fake.getRouteMappingsMutex.Lock()
+	defer fake.getRouteMappingsMutex.Unlock()
+	fake.GetRouteMappingsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsArgsForCall(i int) []ccv2.Filter {
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
+	argsForCall := fake.getRouteMappingsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturns(result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.getRouteMappingsMutex.Lock()
+	defer fake.getRouteMappingsMutex.Unlock()
+	fake.GetRouteMappingsStub = nil
+	fake.getRouteMappingsReturns = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteMappingsReturnsOnCall(i int, result1 []ccv2.RouteMapping, result2 ccv2.Warnings, result3 error) {
+	fake.getRouteMappingsMutex.Lock()
+	defer fake.getRouteMappingsMutex.Unlock()
+	fake.GetRouteMappingsStub = nil
+	if fake.getRouteMappingsReturnsOnCall == nil {
+		fake.getRouteMappingsReturnsOnCall = make(map[int]struct {
+			result1 []ccv2.RouteMapping
+			result2 ccv2.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteMappingsReturnsOnCall[i] = struct {
+		result1 []ccv2.RouteMapping
+		result2 ccv2.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetRoutes(arg1 ...ccv2.Filter) ([]ccv2.Route, ccv2.Warnings, error) {
 	fake.getRoutesMutex.Lock()
 	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
@@ -7159,6 +7240,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPrivateDomainMutex.RUnlock()
 	fake.getRouteApplicationsMutex.RLock()
 	defer fake.getRouteApplicationsMutex.RUnlock()
+	fake.getRouteMappingsMutex.RLock()
+	defer fake.getRouteMappingsMutex.RUnlock()
 	fake.getRoutesMutex.RLock()
 	defer fake.getRoutesMutex.RUnlock()
 	fake.getSecurityGroupSpacesMutex.RLock()
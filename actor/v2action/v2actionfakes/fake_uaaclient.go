@@ -38,6 +38,53 @@ type FakeUAAClient struct {
 		result2 string
 		result3 error
 	}
+	AuthenticateWithAuthorizationCodeStub        func(string, string, string) (string, string, error)
+	authenticateWithAuthorizationCodeMutex       sync.RWMutex
+	authenticateWithAuthorizationCodeArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}
+	authenticateWithAuthorizationCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithAuthorizationCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthenticateWithDeviceCodeStub        func(string) (string, string, error)
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 string
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 string
+		result2 string
+		result3 error
+	}
+	AuthorizationCodeURLStub        func(string, uaa.PKCEChallenge, string) (string, error)
+	authorizationCodeURLMutex       sync.RWMutex
+	authorizationCodeURLArgsForCall []struct {
+		arg1 string
+		arg2 uaa.PKCEChallenge
+		arg3 string
+	}
+	authorizationCodeURLReturns struct {
+		result1 string
+		result2 error
+	}
+	authorizationCodeURLReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	CreateUserStub        func(string, string, string) (uaa.User, error)
 	createUserMutex       sync.RWMutex
 	createUserArgsForCall []struct {
@@ -80,6 +127,18 @@ type FakeUAAClient struct {
 		result1 uaa.RefreshedTokens
 		result2 error
 	}
+	RequestDeviceAuthorizationStub        func() (uaa.DeviceAuthorization, error)
+	requestDeviceAuthorizationMutex       sync.RWMutex
+	requestDeviceAuthorizationArgsForCall []struct {
+	}
+	requestDeviceAuthorizationReturns struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
+	requestDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -205,6 +264,205 @@ func (fake *FakeUAAClient) AuthenticateReturnsOnCall(i int, result1 string, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCode(arg1 string, arg2 string, arg3 string) (string, string, error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithAuthorizationCodeReturnsOnCall[len(fake.authenticateWithAuthorizationCodeArgsForCall)]
+	fake.authenticateWithAuthorizationCodeArgsForCall = append(fake.authenticateWithAuthorizationCodeArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthenticateWithAuthorizationCode", []interface{}{arg1, arg2, arg3})
+	fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	if fake.AuthenticateWithAuthorizationCodeStub != nil {
+		return fake.AuthenticateWithAuthorizationCodeStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.authenticateWithAuthorizationCodeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeCallCount() int {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	return len(fake.authenticateWithAuthorizationCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeCalls(stub func(string, string, string) (string, string, error)) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = stub
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeArgsForCall(i int) (string, string, string) {
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithAuthorizationCodeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturns(result1 string, result2 string, result3 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	fake.authenticateWithAuthorizationCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithAuthorizationCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.authenticateWithAuthorizationCodeMutex.Lock()
+	defer fake.authenticateWithAuthorizationCodeMutex.Unlock()
+	fake.AuthenticateWithAuthorizationCodeStub = nil
+	if fake.authenticateWithAuthorizationCodeReturnsOnCall == nil {
+		fake.authenticateWithAuthorizationCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithAuthorizationCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCode(arg1 string) (string, string, error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeCalls(stub func(string) (string, string, error)) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeArgsForCall(i int) string {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturns(result1 string, result2 string, result3 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 string, result2 string, result3 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 string
+			result3 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 string
+		result2 string
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURL(arg1 string, arg2 uaa.PKCEChallenge, arg3 string) (string, error) {
+	fake.authorizationCodeURLMutex.Lock()
+	ret, specificReturn := fake.authorizationCodeURLReturnsOnCall[len(fake.authorizationCodeURLArgsForCall)]
+	fake.authorizationCodeURLArgsForCall = append(fake.authorizationCodeURLArgsForCall, struct {
+		arg1 string
+		arg2 uaa.PKCEChallenge
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("AuthorizationCodeURL", []interface{}{arg1, arg2, arg3})
+	fake.authorizationCodeURLMutex.Unlock()
+	if fake.AuthorizationCodeURLStub != nil {
+		return fake.AuthorizationCodeURLStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.authorizationCodeURLReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCallCount() int {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	return len(fake.authorizationCodeURLArgsForCall)
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLCalls(stub func(string, uaa.PKCEChallenge, string) (string, error)) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = stub
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLArgsForCall(i int) (string, uaa.PKCEChallenge, string) {
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
+	argsForCall := fake.authorizationCodeURLArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturns(result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	fake.authorizationCodeURLReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) AuthorizationCodeURLReturnsOnCall(i int, result1 string, result2 error) {
+	fake.authorizationCodeURLMutex.Lock()
+	defer fake.authorizationCodeURLMutex.Unlock()
+	fake.AuthorizationCodeURLStub = nil
+	if fake.authorizationCodeURLReturnsOnCall == nil {
+		fake.authorizationCodeURLReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.authorizationCodeURLReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) CreateUser(arg1 string, arg2 string, arg3 string) (uaa.User, error) {
 	fake.createUserMutex.Lock()
 	ret, specificReturn := fake.createUserReturnsOnCall[len(fake.createUserArgsForCall)]
@@ -397,6 +655,61 @@ func (fake *FakeUAAClient) RefreshAccessTokenReturnsOnCall(i int, result1 uaa.Re
 	}{result1, result2}
 }
 
+func (fake *FakeUAAClient) RequestDeviceAuthorization() (uaa.DeviceAuthorization, error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.requestDeviceAuthorizationReturnsOnCall[len(fake.requestDeviceAuthorizationArgsForCall)]
+	fake.requestDeviceAuthorizationArgsForCall = append(fake.requestDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestDeviceAuthorization", []interface{}{})
+	fake.requestDeviceAuthorizationMutex.Unlock()
+	if fake.RequestDeviceAuthorizationStub != nil {
+		return fake.RequestDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.requestDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCallCount() int {
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
+	return len(fake.requestDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationCalls(stub func() (uaa.DeviceAuthorization, error)) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturns(result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	fake.requestDeviceAuthorizationReturns = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUAAClient) RequestDeviceAuthorizationReturnsOnCall(i int, result1 uaa.DeviceAuthorization, result2 error) {
+	fake.requestDeviceAuthorizationMutex.Lock()
+	defer fake.requestDeviceAuthorizationMutex.Unlock()
+	fake.RequestDeviceAuthorizationStub = nil
+	if fake.requestDeviceAuthorizationReturnsOnCall == nil {
+		fake.requestDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 uaa.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.requestDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 uaa.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -404,12 +717,20 @@ func (fake *FakeUAAClient) Invocations() map[string][][]interface{} {
 	defer fake.aPIVersionMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithAuthorizationCodeMutex.RLock()
+	defer fake.authenticateWithAuthorizationCodeMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	fake.authorizationCodeURLMutex.RLock()
+	defer fake.authorizationCodeURLMutex.RUnlock()
 	fake.createUserMutex.RLock()
 	defer fake.createUserMutex.RUnlock()
 	fake.getSSHPasscodeMutex.RLock()
 	defer fake.getSSHPasscodeMutex.RUnlock()
 	fake.refreshAccessTokenMutex.RLock()
 	defer fake.refreshAccessTokenMutex.RUnlock()
+	fake.requestDeviceAuthorizationMutex.RLock()
+	defer fake.requestDeviceAuthorizationMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -0,0 +1,92 @@
+package v2v3action
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/versioncheck"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+// CreateApplicationManifestsBySpace returns a manifest application for every
+// app in the provided space, sorted by name. When the API supports v3
+// processes, the apps' non-web processes are included as well.
+func (actor *Actor) CreateApplicationManifestsBySpace(spaceGUID string) ([]manifest.Application, Warnings, error) {
+	apps, warnings, err := actor.V2Actor.GetApplicationsBySpace(spaceGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	sort.Slice(apps, func(i, j int) bool { return apps[i].Name < apps[j].Name })
+
+	meetsProcessVersion, err := versioncheck.IsMinimumAPIVersionMet(actor.V3Actor.CloudControllerAPIVersion(), ccversion.MinVersionApplicationFlowV3)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var manifestApps []manifest.Application
+	for _, app := range apps {
+		manifestApp, manifestWarnings, err := actor.CreateApplicationManifestByNameAndSpace(app.Name, spaceGUID)
+		allWarnings = append(allWarnings, manifestWarnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if meetsProcessVersion {
+			summary, summaryWarnings, err := actor.V3Actor.GetApplicationSummaryByNameAndSpace(app.Name, spaceGUID, true)
+			allWarnings = append(allWarnings, summaryWarnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+
+			manifestApp.Processes = manifestProcesses(summary.ProcessSummaries)
+		}
+
+		manifestApps = append(manifestApps, manifestApp)
+	}
+
+	return manifestApps, allWarnings, nil
+}
+
+// WriteApplicationsManifest writes all of the provided applications to a
+// single manifest.
+func (Actor) WriteApplicationsManifest(manifestApps []manifest.Application, manifestPath string) error {
+	return manifest.WriteApplicationsManifest(manifestApps, manifestPath)
+}
+
+// manifestProcesses converts the non-web processes of an app to manifest
+// processes. The web process is described by the top level attributes of the
+// manifest application.
+func manifestProcesses(processSummaries v3action.ProcessSummaries) []manifest.Process {
+	processSummaries.Sort()
+
+	var processes []manifest.Process
+	for _, summary := range processSummaries {
+		if summary.Type == constant.ProcessTypeWeb {
+			continue
+		}
+
+		process := manifest.Process{
+			Type:                         summary.Type,
+			Command:                      summary.Command,
+			HealthCheckInvocationTimeout: summary.HealthCheckInvocationTimeout,
+			Instances:                    summary.Instances,
+			DiskQuota:                    types.NullByteSizeInMb(summary.DiskInMB),
+			Memory:                       types.NullByteSizeInMb(summary.MemoryInMB),
+		}
+
+		if summary.HealthCheckType != "port" {
+			process.HealthCheckType = summary.HealthCheckType
+			if summary.HealthCheckType == "http" && summary.HealthCheckEndpoint != constant.ProcessHealthCheckEndpointDefault {
+				process.HealthCheckHTTPEndpoint = summary.HealthCheckEndpoint
+			}
+		}
+
+		processes = append(processes, process)
+	}
+	return processes
+}
@@ -0,0 +1,160 @@
+package v2v3action_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v2v3action/v2v3actionfakes"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
+)
+
+var _ = Describe("Space Manifest", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *v2v3actionfakes.FakeV2Actor
+		fakeV3Actor *v2v3actionfakes.FakeV3Actor
+
+		manifestApps []manifest.Application
+		warnings     Warnings
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(v2v3actionfakes.FakeV2Actor)
+		fakeV3Actor = new(v2v3actionfakes.FakeV3Actor)
+
+		actor = NewActor(fakeV2Actor, fakeV3Actor)
+	})
+
+	Describe("CreateApplicationManifestsBySpace", func() {
+		JustBeforeEach(func() {
+			manifestApps, warnings, executeErr = actor.CreateApplicationManifestsBySpace("some-space-guid")
+		})
+
+		When("getting the apps in the space fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, errors.New("apps-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("apps-error"))
+				Expect(warnings).To(ConsistOf("apps-warning"))
+			})
+		})
+
+		When("the space has apps", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{{Name: "app-b"}, {Name: "app-a"}},
+					v2action.Warnings{"apps-warning"},
+					nil,
+				)
+				fakeV2Actor.CreateApplicationManifestByNameAndSpaceStub = func(appName string, _ string) (manifest.Application, v2action.Warnings, error) {
+					return manifest.Application{Name: appName}, v2action.Warnings{appName + "-manifest-warning"}, nil
+				}
+				fakeV3Actor.CloudControllerAPIVersionReturns(ccversion.MinVersionApplicationFlowV3)
+				fakeV3Actor.GetApplicationSummaryByNameAndSpaceStub = func(appName string, _ string, _ bool) (v3action.ApplicationSummary, v3action.Warnings, error) {
+					if appName != "app-a" {
+						return v3action.ApplicationSummary{}, v3action.Warnings{appName + "-summary-warning"}, nil
+					}
+					return v3action.ApplicationSummary{
+						ProcessSummaries: v3action.ProcessSummaries{
+							{Process: v3action.Process(ccv3.Process{Type: "web", Command: "web-command"})},
+							{Process: v3action.Process(ccv3.Process{
+								Type:                "worker",
+								Command:             "worker-command",
+								HealthCheckType:     "http",
+								HealthCheckEndpoint: "/health",
+								Instances:           types.NullInt{Value: 2, IsSet: true},
+								MemoryInMB:          types.NullUint64{Value: 256, IsSet: true},
+								DiskInMB:            types.NullUint64{Value: 512, IsSet: true},
+							})},
+							{Process: v3action.Process(ccv3.Process{Type: "clock", HealthCheckType: "port"})},
+						},
+					}, v3action.Warnings{appName + "-summary-warning"}, nil
+				}
+			})
+
+			It("returns a manifest application for each app sorted by name", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf(
+					"apps-warning",
+					"app-a-manifest-warning", "app-a-summary-warning",
+					"app-b-manifest-warning", "app-b-summary-warning",
+				))
+
+				Expect(manifestApps).To(HaveLen(2))
+				Expect(manifestApps[0].Name).To(Equal("app-a"))
+				Expect(manifestApps[1].Name).To(Equal("app-b"))
+
+				Expect(fakeV2Actor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				_, spaceGUID := fakeV2Actor.CreateApplicationManifestByNameAndSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				_, spaceGUID, withObfuscatedValues := fakeV3Actor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(withObfuscatedValues).To(BeTrue())
+			})
+
+			It("includes the non-web processes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(manifestApps[0].Processes).To(Equal([]manifest.Process{
+					{Type: "clock"},
+					{
+						Type:                    "worker",
+						Command:                 "worker-command",
+						HealthCheckType:         "http",
+						HealthCheckHTTPEndpoint: "/health",
+						Instances:               types.NullInt{Value: 2, IsSet: true},
+						Memory:                  types.NullByteSizeInMb{Value: 256, IsSet: true},
+						DiskQuota:               types.NullByteSizeInMb{Value: 512, IsSet: true},
+					},
+				}))
+				Expect(manifestApps[1].Processes).To(BeEmpty())
+			})
+
+			When("the API does not support v3 processes", func() {
+				BeforeEach(func() {
+					fakeV3Actor.CloudControllerAPIVersionReturns("3.26.0")
+				})
+
+				It("does not look up the processes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(manifestApps).To(HaveLen(2))
+					Expect(fakeV3Actor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("creating an app's manifest fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.CreateApplicationManifestByNameAndSpaceReturns(manifest.Application{}, v2action.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+					fakeV2Actor.CreateApplicationManifestByNameAndSpaceStub = nil
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("manifest-error"))
+					Expect(warnings).To(ConsistOf("apps-warning", "manifest-warning"))
+				})
+			})
+
+			When("getting an app's processes fails", func() {
+				BeforeEach(func() {
+					fakeV3Actor.GetApplicationSummaryByNameAndSpaceStub = nil
+					fakeV3Actor.GetApplicationSummaryByNameAndSpaceReturns(v3action.ApplicationSummary{}, v3action.Warnings{"summary-warning"}, errors.New("summary-error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("summary-error"))
+					Expect(warnings).To(ConsistOf("apps-warning", "app-a-manifest-warning", "summary-warning"))
+				})
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 )
@@ -64,25 +65,16 @@ func (actor Actor) GetDeploymentState(deploymentGUID string) (constant.Deploymen
 }
 
 func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
-	timeout := time.Now().Add(actor.Config.StartupTimeout())
-	for time.Now().Before(timeout) {
-		deploymentState, warnings, err := actor.GetDeploymentState(deploymentGUID)
-		warningsChannel <- Warnings(warnings)
-		if err != nil {
-			return err
-		}
-		switch deploymentState {
-		case constant.DeploymentDeployed:
-			return nil
-		case constant.DeploymentCanceled:
-			return errors.New("Deployment has been canceled")
-		case constant.DeploymentDeploying:
-			time.Sleep(actor.Config.PollingInterval())
-		}
-	}
-
-	return actionerror.StartupTimeoutError{}
-
+	return sharedaction.PollDeployment(
+		func() (constant.DeploymentState, []string, error) {
+			return actor.GetDeploymentState(deploymentGUID)
+		},
+		func(warnings []string) {
+			warningsChannel <- Warnings(warnings)
+		},
+		actor.Config.PollingInterval(),
+		actor.Config.StartupTimeout(),
+	)
 }
 
 func (actor Actor) ZeroDowntimePollStart(appGUID string, warningsChannel chan<- Warnings) error {
@@ -17,6 +17,7 @@ type Application struct {
 	State               constant.ApplicationState
 	LifecycleType       constant.AppLifecycleType
 	LifecycleBuildpacks []string
+	Metadata            *Metadata
 }
 
 func (app Application) Started() bool {
@@ -204,6 +205,7 @@ func (Actor) convertCCToActorApplication(app ccv3.Application) Application {
 		StackName:           app.StackName,
 		LifecycleType:       app.LifecycleType,
 		LifecycleBuildpacks: app.LifecycleBuildpacks,
+		Metadata:            (*Metadata)(app.Metadata),
 		Name:                app.Name,
 		State:               app.State,
 	}
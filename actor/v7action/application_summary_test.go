@@ -3,7 +3,6 @@ package v7action_test
 import (
 	"errors"
 
-	"code.cloudfoundry.org/cli/actor/v2action"
 	. "code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -69,7 +68,6 @@ var _ = Describe("Application Summary Actions", func() {
 			appName              string
 			spaceGUID            string
 			withObfuscatedValues bool
-			fakeRouteActor       *v7actionfakes.FakeRouteActor
 
 			summary    ApplicationSummary
 			warnings   Warnings
@@ -80,333 +78,302 @@ var _ = Describe("Application Summary Actions", func() {
 			appName = "some-app-name"
 			spaceGUID = "some-space-guid"
 			withObfuscatedValues = true
-			fakeRouteActor = new(v7actionfakes.FakeRouteActor)
 		})
 
-		Context("when a route actor is not provided", func() {
-			JustBeforeEach(func() {
-				summary, warnings, executeErr = actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, withObfuscatedValues, nil)
+		JustBeforeEach(func() {
+			summary, warnings, executeErr = actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, withObfuscatedValues)
+		})
+
+		When("retrieving the application is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							Name:  "some-app-name",
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
 			})
 
-			When("retrieving the application is successful", func() {
+			When("retrieving the process information is successful", func() {
 				BeforeEach(func() {
-					fakeCloudControllerClient.GetApplicationsReturns(
-						[]ccv3.Application{
+					listedProcesses := []ccv3.Process{
+						{
+							GUID:       "some-process-guid",
+							Type:       "some-type",
+							Command:    "[Redacted Value]",
+							MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+						},
+						{
+							GUID:       "some-process-web-guid",
+							Type:       "web",
+							Command:    "[Redacted Value]",
+							MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+						},
+					}
+					fakeCloudControllerClient.GetApplicationProcessesReturns(
+						listedProcesses,
+						ccv3.Warnings{"some-process-warning"},
+						nil,
+					)
+
+					explicitlyCalledProcess := listedProcesses[0]
+					explicitlyCalledProcess.Command = "some-start-command"
+					fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(
+						0,
+						explicitlyCalledProcess,
+						ccv3.Warnings{"get-process-by-type-warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(
+						1,
+						listedProcesses[1],
+						ccv3.Warnings{"get-process-by-type-warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{
 							{
-								Name:  "some-app-name",
-								GUID:  "some-app-guid",
-								State: constant.ApplicationStarted,
+								State:       constant.ProcessInstanceRunning,
+								CPU:         0.01,
+								MemoryUsage: 1000000,
+								DiskUsage:   2000000,
+								MemoryQuota: 3000000,
+								DiskQuota:   4000000,
+								Index:       0,
 							},
 						},
-						ccv3.Warnings{"some-warning"},
+						ccv3.Warnings{"some-process-stats-warning"},
 						nil,
 					)
 				})
 
-				When("retrieving the process information is successful", func() {
+				When("app has droplet", func() {
 					BeforeEach(func() {
-						listedProcesses := []ccv3.Process{
-							{
-								GUID:       "some-process-guid",
-								Type:       "some-type",
-								Command:    "[Redacted Value]",
-								MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
-							},
-							{
-								GUID:       "some-process-web-guid",
-								Type:       "web",
-								Command:    "[Redacted Value]",
-								MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
-							},
-						}
-						fakeCloudControllerClient.GetApplicationProcessesReturns(
-							listedProcesses,
-							ccv3.Warnings{"some-process-warning"},
-							nil,
-						)
-
-						explicitlyCalledProcess := listedProcesses[0]
-						explicitlyCalledProcess.Command = "some-start-command"
-						fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(
-							0,
-							explicitlyCalledProcess,
-							ccv3.Warnings{"get-process-by-type-warning"},
-							nil,
-						)
-
-						fakeCloudControllerClient.GetApplicationProcessByTypeReturnsOnCall(
-							1,
-							listedProcesses[1],
-							ccv3.Warnings{"get-process-by-type-warning"},
-							nil,
-						)
-
-						fakeCloudControllerClient.GetProcessInstancesReturns(
-							[]ccv3.ProcessInstance{
-								{
-									State:       constant.ProcessInstanceRunning,
-									CPU:         0.01,
-									MemoryUsage: 1000000,
-									DiskUsage:   2000000,
-									MemoryQuota: 3000000,
-									DiskQuota:   4000000,
-									Index:       0,
+						fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+							ccv3.Droplet{
+								Stack: "some-stack",
+								Buildpacks: []ccv3.DropletBuildpack{
+									{
+										Name: "some-buildpack",
+									},
 								},
+								Image: "docker/some-image",
 							},
-							ccv3.Warnings{"some-process-stats-warning"},
+							ccv3.Warnings{"some-droplet-warning"},
 							nil,
 						)
 					})
 
-					When("app has droplet", func() {
-						BeforeEach(func() {
-							fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
-								ccv3.Droplet{
-									Stack: "some-stack",
-									Buildpacks: []ccv3.DropletBuildpack{
-										{
-											Name: "some-buildpack",
-										},
+					It("returns the summary and warnings with droplet information", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(summary).To(Equal(ApplicationSummary{
+							Application: Application{
+								Name:  "some-app-name",
+								GUID:  "some-app-guid",
+								State: constant.ApplicationStarted,
+							},
+							CurrentDroplet: Droplet{
+								Stack: "some-stack",
+								Image: "docker/some-image",
+								Buildpacks: []Buildpack{
+									{
+										Name: "some-buildpack",
 									},
-									Image: "docker/some-image",
 								},
-								ccv3.Warnings{"some-droplet-warning"},
-								nil,
-							)
-						})
-
-						It("returns the summary and warnings with droplet information", func() {
-							Expect(executeErr).ToNot(HaveOccurred())
-							Expect(summary).To(Equal(ApplicationSummary{
-								Application: Application{
-									Name:  "some-app-name",
-									GUID:  "some-app-guid",
-									State: constant.ApplicationStarted,
-								},
-								CurrentDroplet: Droplet{
-									Stack: "some-stack",
-									Image: "docker/some-image",
-									Buildpacks: []Buildpack{
+							},
+							ProcessSummaries: []ProcessSummary{
+								{
+									Process: Process{
+										GUID:       "some-process-web-guid",
+										Type:       "web",
+										Command:    "[Redacted Value]",
+										MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+									},
+									InstanceDetails: []ProcessInstance{
 										{
-											Name: "some-buildpack",
+											State:       constant.ProcessInstanceRunning,
+											CPU:         0.01,
+											MemoryUsage: 1000000,
+											DiskUsage:   2000000,
+											MemoryQuota: 3000000,
+											DiskQuota:   4000000,
+											Index:       0,
 										},
 									},
 								},
-								ProcessSummaries: []ProcessSummary{
-									{
-										Process: Process{
-											GUID:       "some-process-web-guid",
-											Type:       "web",
-											Command:    "[Redacted Value]",
-											MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
-										},
-										InstanceDetails: []ProcessInstance{
-											{
-												State:       constant.ProcessInstanceRunning,
-												CPU:         0.01,
-												MemoryUsage: 1000000,
-												DiskUsage:   2000000,
-												MemoryQuota: 3000000,
-												DiskQuota:   4000000,
-												Index:       0,
-											},
-										},
+								{
+									Process: Process{
+										GUID:       "some-process-guid",
+										MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+										Type:       "some-type",
+										Command:    "some-start-command",
 									},
-									{
-										Process: Process{
-											GUID:       "some-process-guid",
-											MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
-											Type:       "some-type",
-											Command:    "some-start-command",
-										},
-										InstanceDetails: []ProcessInstance{
-											{
-												State:       constant.ProcessInstanceRunning,
-												CPU:         0.01,
-												MemoryUsage: 1000000,
-												DiskUsage:   2000000,
-												MemoryQuota: 3000000,
-												DiskQuota:   4000000,
-												Index:       0,
-											},
+									InstanceDetails: []ProcessInstance{
+										{
+											State:       constant.ProcessInstanceRunning,
+											CPU:         0.01,
+											MemoryUsage: 1000000,
+											DiskUsage:   2000000,
+											MemoryQuota: 3000000,
+											DiskQuota:   4000000,
+											Index:       0,
 										},
 									},
 								},
-							}))
-							Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "get-process-by-type-warning", "some-process-stats-warning", "some-process-stats-warning", "some-droplet-warning"))
-
-							Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
-							Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
-								ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
-								ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
-							))
+							},
+						}))
+						Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "get-process-by-type-warning", "some-process-stats-warning", "some-process-stats-warning", "some-droplet-warning"))
 
-							Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
-							Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+							ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-app-name"}},
+							ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+						))
 
-							Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
-							Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+						Expect(fakeCloudControllerClient.GetApplicationDropletCurrentCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetApplicationDropletCurrentArgsForCall(0)).To(Equal("some-app-guid"))
 
-							Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(2))
-							appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
-							Expect(appGUID).To(Equal("some-app-guid"))
-							Expect(processType).To(Equal("some-type"))
+						Expect(fakeCloudControllerClient.GetApplicationProcessesCallCount()).To(Equal(1))
+						Expect(fakeCloudControllerClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
 
-							Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
-							Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
-						})
+						Expect(fakeCloudControllerClient.GetApplicationProcessByTypeCallCount()).To(Equal(2))
+						appGUID, processType := fakeCloudControllerClient.GetApplicationProcessByTypeArgsForCall(0)
+						Expect(appGUID).To(Equal("some-app-guid"))
+						Expect(processType).To(Equal("some-type"))
 
-						When("getting the current droplet returns an error", func() {
-							var expectedErr error
-
-							BeforeEach(func() {
-								expectedErr = errors.New("some error")
-								fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
-									ccv3.Droplet{},
-									ccv3.Warnings{"some-droplet-warning"},
-									expectedErr,
-								)
-							})
-
-							It("returns the error", func() {
-								Expect(executeErr).To(Equal(expectedErr))
-								Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "get-process-by-type-warning", "some-process-stats-warning", "some-process-stats-warning", "some-droplet-warning"))
-							})
-						})
+						Expect(fakeCloudControllerClient.GetProcessInstancesCallCount()).To(Equal(2))
+						Expect(fakeCloudControllerClient.GetProcessInstancesArgsForCall(0)).To(Equal("some-process-guid"))
 					})
 
-					When("app does not have current droplet", func() {
+					When("getting the current droplet returns an error", func() {
+						var expectedErr error
+
 						BeforeEach(func() {
+							expectedErr = errors.New("some error")
 							fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
 								ccv3.Droplet{},
 								ccv3.Warnings{"some-droplet-warning"},
-								ccerror.DropletNotFoundError{},
+								expectedErr,
 							)
 						})
 
-						It("returns the summary and warnings without droplet information", func() {
-							Expect(executeErr).ToNot(HaveOccurred())
-							Expect(summary).To(Equal(ApplicationSummary{
-								Application: Application{
-									Name:  "some-app-name",
-									GUID:  "some-app-guid",
-									State: constant.ApplicationStarted,
-								},
-								ProcessSummaries: []ProcessSummary{
-									{
-										Process: Process{
-											GUID:       "some-process-web-guid",
-											Type:       "web",
-											Command:    "[Redacted Value]",
-											MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
-										},
-										InstanceDetails: []ProcessInstance{
-											{
-												State:       constant.ProcessInstanceRunning,
-												CPU:         0.01,
-												MemoryUsage: 1000000,
-												DiskUsage:   2000000,
-												MemoryQuota: 3000000,
-												DiskQuota:   4000000,
-												Index:       0,
-											},
-										},
-									},
-									{
-										Process: Process{
-											GUID:       "some-process-guid",
-											MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
-											Type:       "some-type",
-											Command:    "some-start-command",
-										},
-										InstanceDetails: []ProcessInstance{
-											{
-												State:       constant.ProcessInstanceRunning,
-												CPU:         0.01,
-												MemoryUsage: 1000000,
-												DiskUsage:   2000000,
-												MemoryQuota: 3000000,
-												DiskQuota:   4000000,
-												Index:       0,
-											},
-										},
-									},
-								},
-							}))
+						It("returns the error", func() {
+							Expect(executeErr).To(Equal(expectedErr))
 							Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "get-process-by-type-warning", "some-process-stats-warning", "some-process-stats-warning", "some-droplet-warning"))
 						})
 					})
-
 				})
 
-				When("getting the app process instances returns an error", func() {
-					var expectedErr error
-
+				When("app does not have current droplet", func() {
 					BeforeEach(func() {
-						fakeCloudControllerClient.GetApplicationProcessesReturns(
-							[]ccv3.Process{
-								{
-									GUID: "some-process-guid",
-									Type: "some-type",
-								},
-							},
-							ccv3.Warnings{"some-process-warning"},
-							nil,
-						)
-
-						fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
-							ccv3.Process{},
-							ccv3.Warnings{"get-process-by-type-warning"},
-							nil,
-						)
-
-						expectedErr = errors.New("some error")
-						fakeCloudControllerClient.GetProcessInstancesReturns(
-							[]ccv3.ProcessInstance{},
-							ccv3.Warnings{"some-process-stats-warning"},
-							expectedErr,
+						fakeCloudControllerClient.GetApplicationDropletCurrentReturns(
+							ccv3.Droplet{},
+							ccv3.Warnings{"some-droplet-warning"},
+							ccerror.DropletNotFoundError{},
 						)
 					})
 
-					It("returns the error", func() {
-						Expect(executeErr).To(Equal(expectedErr))
-						Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "some-process-stats-warning"))
+					It("returns the summary and warnings without droplet information", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(summary).To(Equal(ApplicationSummary{
+							Application: Application{
+								Name:  "some-app-name",
+								GUID:  "some-app-guid",
+								State: constant.ApplicationStarted,
+							},
+							ProcessSummaries: []ProcessSummary{
+								{
+									Process: Process{
+										GUID:       "some-process-web-guid",
+										Type:       "web",
+										Command:    "[Redacted Value]",
+										MemoryInMB: types.NullUint64{Value: 64, IsSet: true},
+									},
+									InstanceDetails: []ProcessInstance{
+										{
+											State:       constant.ProcessInstanceRunning,
+											CPU:         0.01,
+											MemoryUsage: 1000000,
+											DiskUsage:   2000000,
+											MemoryQuota: 3000000,
+											DiskQuota:   4000000,
+											Index:       0,
+										},
+									},
+								},
+								{
+									Process: Process{
+										GUID:       "some-process-guid",
+										MemoryInMB: types.NullUint64{Value: 32, IsSet: true},
+										Type:       "some-type",
+										Command:    "some-start-command",
+									},
+									InstanceDetails: []ProcessInstance{
+										{
+											State:       constant.ProcessInstanceRunning,
+											CPU:         0.01,
+											MemoryUsage: 1000000,
+											DiskUsage:   2000000,
+											MemoryQuota: 3000000,
+											DiskQuota:   4000000,
+											Index:       0,
+										},
+									},
+								},
+							},
+						}))
+						Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "get-process-by-type-warning", "some-process-stats-warning", "some-process-stats-warning", "some-droplet-warning"))
 					})
 				})
+
 			})
 
-			When("getting the app processes returns an error", func() {
+			When("getting the app process instances returns an error", func() {
 				var expectedErr error
 
 				BeforeEach(func() {
-					fakeCloudControllerClient.GetApplicationsReturns(
-						[]ccv3.Application{
+					fakeCloudControllerClient.GetApplicationProcessesReturns(
+						[]ccv3.Process{
 							{
-								Name:  "some-app-name",
-								GUID:  "some-app-guid",
-								State: constant.ApplicationStarted,
+								GUID: "some-process-guid",
+								Type: "some-type",
 							},
 						},
-						ccv3.Warnings{"some-warning"},
+						ccv3.Warnings{"some-process-warning"},
+						nil,
+					)
+
+					fakeCloudControllerClient.GetApplicationProcessByTypeReturns(
+						ccv3.Process{},
+						ccv3.Warnings{"get-process-by-type-warning"},
 						nil,
 					)
 
 					expectedErr = errors.New("some error")
-					fakeCloudControllerClient.GetApplicationProcessesReturns(
-						[]ccv3.Process{{Type: constant.ProcessTypeWeb}},
-						ccv3.Warnings{"some-process-warning"},
+					fakeCloudControllerClient.GetProcessInstancesReturns(
+						[]ccv3.ProcessInstance{},
+						ccv3.Warnings{"some-process-stats-warning"},
 						expectedErr,
 					)
 				})
 
 				It("returns the error", func() {
 					Expect(executeErr).To(Equal(expectedErr))
-					Expect(warnings).To(ConsistOf("some-warning", "some-process-warning"))
+					Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "get-process-by-type-warning", "some-process-stats-warning"))
 				})
 			})
 		})
 
-		When("passed with a routeActor", func() {
+		When("getting the app processes returns an error", func() {
+			var expectedErr error
+
 			BeforeEach(func() {
 				fakeCloudControllerClient.GetApplicationsReturns(
 					[]ccv3.Application{
@@ -419,56 +386,133 @@ var _ = Describe("Application Summary Actions", func() {
 					ccv3.Warnings{"some-warning"},
 					nil,
 				)
+
+				expectedErr = errors.New("some error")
+				fakeCloudControllerClient.GetApplicationProcessesReturns(
+					[]ccv3.Process{{Type: constant.ProcessTypeWeb}},
+					ccv3.Warnings{"some-process-warning"},
+					expectedErr,
+				)
 			})
 
-			JustBeforeEach(func() {
-				summary, warnings, executeErr = actor.GetApplicationSummaryByNameAndSpace(appName, spaceGUID, withObfuscatedValues, fakeRouteActor)
+			It("returns the error", func() {
+				Expect(executeErr).To(Equal(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning"))
 			})
+		})
 
-			When("getting the routes is successful", func() {
-				BeforeEach(func() {
-					routes := v2action.Routes{
-						{GUID: "some-route-guid"},
-						{GUID: "some-other-route-guid"},
-					}
+		When("getting the application routes is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{
+							Name:  "some-app-name",
+							GUID:  "some-app-guid",
+							State: constant.ApplicationStarted,
+						},
+					},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
 
-					fakeRouteActor.GetApplicationRoutesReturns(routes, v2action.Warnings{"v2-routes-warnings"}, nil)
-				})
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{
+						{GUID: "some-route-guid", Host: "some-host", DomainGUID: "some-domain-guid", SpaceGUID: "some-space-guid"},
+					},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{{GUID: "some-domain-guid", Name: "some-domain.com"}},
+					nil,
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					nil,
+					nil,
+				)
+			})
 
-				It("retrieves and sets the application routes", func() {
-					Expect(warnings).To(ConsistOf("some-warning", "v2-routes-warnings"))
-					Expect(summary.Routes).To(ConsistOf(
-						v2action.Route{GUID: "some-route-guid"},
-						v2action.Route{GUID: "some-other-route-guid"},
-					))
+			It("retrieves and sets the application routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning", "get-routes-warning"))
+				Expect(summary.Routes).To(ConsistOf(
+					Route{
+						GUID:       "some-route-guid",
+						Host:       "some-host",
+						SpaceGUID:  "some-space-guid",
+						SpaceName:  "some-space",
+						DomainGUID: "some-domain-guid",
+						DomainName: "some-domain.com",
+					},
+				))
 
-					Expect(fakeRouteActor.GetApplicationRoutesCallCount()).To(Equal(1))
-					Expect(fakeRouteActor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
-				})
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+				))
 			})
+		})
 
-			When("getting the application routes errors", func() {
-				When("a generic error is returned", func() {
-					BeforeEach(func() {
-						fakeRouteActor.GetApplicationRoutesReturns(nil, v2action.Warnings{"v2-routes-warnings"}, errors.New("some-error"))
-					})
+		When("getting the application sidecars is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]ccv3.Sidecar{
+						{GUID: "sidecar-guid", Name: "log-shipper", Command: "./ship-logs", ProcessTypes: []string{"web"}},
+					},
+					ccv3.Warnings{"get-sidecars-warning"},
+					nil,
+				)
+			})
 
-					It("returns warnings and the error", func() {
-						Expect(executeErr).To(MatchError("some-error"))
-						Expect(warnings).To(ConsistOf("some-warning", "v2-routes-warnings"))
-					})
-				})
+			It("retrieves and sets the application sidecars", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning", "get-sidecars-warning"))
+				Expect(summary.Sidecars).To(ConsistOf(
+					Sidecar{GUID: "sidecar-guid", Name: "log-shipper", Command: "./ship-logs", ProcessTypes: []string{"web"}},
+				))
 
-				When("a ResourceNotFoundError is returned", func() {
-					BeforeEach(func() {
-						fakeRouteActor.GetApplicationRoutesReturns(nil, v2action.Warnings{"v2-routes-warnings"}, ccerror.ResourceNotFoundError{})
-					})
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(1))
+				appGUID, _ := fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
 
-					It("adds warnings and continues", func() {
-						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(warnings).To(ConsistOf("some-warning", "v2-routes-warnings"))
-					})
-				})
+		When("getting the application sidecars errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"get-sidecars-warning"}, errors.New("some-error"))
+			})
+
+			It("returns warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning", "get-sidecars-warning"))
+			})
+		})
+
+		When("getting the application routes errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app-name", GUID: "some-app-guid"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns warnings and the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning", "get-routes-warning"))
 			})
 		})
 	})
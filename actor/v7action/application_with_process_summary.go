@@ -9,13 +9,21 @@ type ApplicationWithProcessSummary struct {
 	ProcessSummaries ProcessSummaries
 }
 
-func (actor Actor) GetApplicationsWithProcessesBySpace(spaceGUID string) ([]ApplicationWithProcessSummary, Warnings, error) {
+// GetApplicationsWithProcessesBySpace returns the applications in the given
+// space along with a summary of their processes. When labelSelector is not
+// empty, only the applications matching it are returned.
+func (actor Actor) GetApplicationsWithProcessesBySpace(spaceGUID string, labelSelector string) ([]ApplicationWithProcessSummary, Warnings, error) {
 	var allWarnings Warnings
 
-	apps, warnings, err := actor.CloudControllerClient.GetApplications(
-		ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
-		ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
-	)
+	queries := []ccv3.Query{
+		{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	if labelSelector != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	apps, warnings, err := actor.CloudControllerClient.GetApplications(queries...)
 	allWarnings = Warnings(warnings)
 	if err != nil {
 		return nil, allWarnings, err
@@ -91,7 +91,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns app summaries and warnings", func() {
-				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				summaries, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(summaries).To(ConsistOf(
 					ApplicationWithProcessSummary{
@@ -143,6 +143,20 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 		})
 
+		When("a label selector is provided", func() {
+			It("filters the applications by the label selector", func() {
+				_, _, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "owner=team-a")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+					ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"owner=team-a"}},
+				))
+			})
+		})
+
 		When("getting the app processes returns an error", func() {
 			var expectedErr error
 
@@ -168,7 +182,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning"))
 			})
@@ -210,7 +224,7 @@ var _ = Describe("Application with ProcessSummary Actions", func() {
 			})
 
 			It("returns the error", func() {
-				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid")
+				_, warnings, err := actor.GetApplicationsWithProcessesBySpace("some-space-guid", "")
 				Expect(err).To(Equal(expectedErr))
 				Expect(warnings).To(ConsistOf("some-warning", "some-process-warning", "some-process-stats-warning"))
 			})
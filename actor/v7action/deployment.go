@@ -0,0 +1,32 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// CreateDeploymentByApplicationAndRevision starts a deployment that rolls the
+// application back to the given revision and returns the deployment's GUID.
+func (actor Actor) CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
+	deploymentGUID, warnings, err := actor.CloudControllerClient.CreateApplicationDeploymentByRevision(appGUID, revisionGUID)
+
+	return deploymentGUID, Warnings(warnings), err
+}
+
+// PollDeployment waits for the given deployment to finish deploying, sending
+// any warnings encountered on the warnings channel. It returns a
+// StartupTimeoutError if the deployment has not finished within the
+// configured startup timeout.
+func (actor Actor) PollDeployment(deploymentGUID string, warningsChannel chan<- Warnings) error {
+	return sharedaction.PollDeployment(
+		func() (constant.DeploymentState, []string, error) {
+			deployment, warnings, err := actor.CloudControllerClient.GetDeployment(deploymentGUID)
+			return deployment.State, warnings, err
+		},
+		func(warnings []string) {
+			warningsChannel <- Warnings(warnings)
+		},
+		actor.Config.PollingInterval(),
+		actor.Config.StartupTimeout(),
+	)
+}
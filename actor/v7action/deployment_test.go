@@ -0,0 +1,128 @@
+package v7action_test
+
+import (
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Deployment Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		fakeConfig                *v7actionfakes.FakeConfig
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		fakeConfig = new(v7actionfakes.FakeConfig)
+		actor = NewActor(fakeCloudControllerClient, fakeConfig, nil, nil)
+	})
+
+	Describe("CreateDeploymentByApplicationAndRevision", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateApplicationDeploymentByRevisionReturns("some-deployment-guid", ccv3.Warnings{"some-warning"}, nil)
+		})
+
+		It("creates a deployment from the revision", func() {
+			deploymentGUID, warnings, err := actor.CreateDeploymentByApplicationAndRevision("some-app-guid", "some-revision-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+			Expect(warnings).To(ConsistOf("some-warning"))
+
+			Expect(fakeCloudControllerClient.CreateApplicationDeploymentByRevisionCallCount()).To(Equal(1))
+			appGUID, revisionGUID := fakeCloudControllerClient.CreateApplicationDeploymentByRevisionArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(revisionGUID).To(Equal("some-revision-guid"))
+		})
+	})
+
+	Describe("PollDeployment", func() {
+		var (
+			warningsChannel chan Warnings
+			allWarnings     Warnings
+			funcDone        chan interface{}
+			executeErr      error
+		)
+
+		BeforeEach(func() {
+			fakeConfig.StartupTimeoutReturns(time.Second)
+			fakeConfig.PollingIntervalReturns(0)
+
+			warningsChannel = make(chan Warnings)
+			funcDone = make(chan interface{})
+			allWarnings = Warnings{}
+			go func() {
+				for {
+					select {
+					case warnings := <-warningsChannel:
+						allWarnings = append(allWarnings, warnings...)
+					case <-funcDone:
+						return
+					}
+				}
+			}()
+		})
+
+		JustBeforeEach(func() {
+			executeErr = actor.PollDeployment("some-deployment-guid", warningsChannel)
+			funcDone <- nil
+		})
+
+		When("the deployment finishes deploying", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(0, ccv3.Deployment{State: constant.DeploymentDeploying}, ccv3.Warnings{"warning-1"}, nil)
+				fakeCloudControllerClient.GetDeploymentReturnsOnCall(1, ccv3.Deployment{State: constant.DeploymentDeployed}, ccv3.Warnings{"warning-2"}, nil)
+			})
+
+			It("polls until the deployment is deployed and sends all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(allWarnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(fakeCloudControllerClient.GetDeploymentCallCount()).To(Equal(2))
+				Expect(fakeCloudControllerClient.GetDeploymentArgsForCall(0)).To(Equal("some-deployment-guid"))
+			})
+		})
+
+		When("the deployment is canceled", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: constant.DeploymentCanceled}, ccv3.Warnings{"warning-1"}, nil)
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("Deployment has been canceled"))
+				Expect(allWarnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("getting the deployment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{}, ccv3.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and sends all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(allWarnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the deployment does not finish before the startup timeout", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(10 * time.Millisecond)
+				fakeConfig.PollingIntervalReturns(5 * time.Millisecond)
+				fakeCloudControllerClient.GetDeploymentReturns(ccv3.Deployment{State: constant.DeploymentDeploying}, nil, nil)
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(actionerror.StartupTimeoutError{}))
+			})
+		})
+	})
+})
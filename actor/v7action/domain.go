@@ -0,0 +1,63 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Domain represents a V3 actor Domain.
+type Domain ccv3.Domain
+
+// Shared returns true if the domain is not owned by an organization.
+func (domain Domain) Shared() bool {
+	return ccv3.Domain(domain).Shared()
+}
+
+// GetOrganizationDomains returns the shared domains and the domains owned by
+// the given organization.
+func (actor Actor) GetOrganizationDomains(orgGUID string) ([]Domain, Warnings, error) {
+	ccDomains, warnings, err := actor.CloudControllerClient.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var domains []Domain
+	for _, domain := range ccDomains {
+		domains = append(domains, Domain(domain))
+	}
+
+	return domains, Warnings(warnings), nil
+}
+
+// GetDomainByName returns the domain with the given name.
+func (actor Actor) GetDomainByName(domainName string) (Domain, Warnings, error) {
+	domains, warnings, err := actor.CloudControllerClient.GetDomains(
+		ccv3.Query{Key: ccv3.NameFilter, Values: []string{domainName}},
+	)
+	if err != nil {
+		return Domain{}, Warnings(warnings), err
+	}
+
+	if len(domains) == 0 {
+		return Domain{}, Warnings(warnings), actionerror.DomainNotFoundError{Name: domainName}
+	}
+
+	return Domain(domains[0]), Warnings(warnings), nil
+}
+
+// GetDefaultDomain returns the first non-internal domain available to the
+// given organization.
+func (actor Actor) GetDefaultDomain(orgGUID string) (Domain, Warnings, error) {
+	domains, warnings, err := actor.GetOrganizationDomains(orgGUID)
+	if err != nil {
+		return Domain{}, warnings, err
+	}
+
+	for _, domain := range domains {
+		if !domain.Internal.Value {
+			return domain, warnings, nil
+		}
+	}
+
+	return Domain{}, warnings, actionerror.NoDomainsFoundError{OrganizationGUID: orgGUID}
+}
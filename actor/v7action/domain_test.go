@@ -0,0 +1,166 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Domain Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetOrganizationDomains", func() {
+		var (
+			domains    []Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domains, warnings, executeErr = actor.GetOrganizationDomains("some-org-guid")
+		})
+
+		When("the API call is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDomainsReturns(
+					[]ccv3.Domain{
+						{GUID: "shared-domain-guid", Name: "shared.com"},
+						{GUID: "private-domain-guid", Name: "private.com", OrganizationGUID: "some-org-guid"},
+					},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+			})
+
+			It("returns the domains and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+				Expect(domains).To(ConsistOf(
+					Domain{GUID: "shared-domain-guid", Name: "shared.com"},
+					Domain{GUID: "private-domain-guid", Name: "private.com", OrganizationGUID: "some-org-guid"},
+				))
+
+				Expect(fakeCloudControllerClient.GetOrganizationDomainsCallCount()).To(Equal(1))
+				orgGUID, _ := fakeCloudControllerClient.GetOrganizationDomainsArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		When("the API call returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+			})
+		})
+	})
+
+	Describe("GetDomainByName", func() {
+		var (
+			domain     Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domain, warnings, executeErr = actor.GetDomainByName("some-domain.com")
+		})
+
+		When("the domain exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{{GUID: "some-domain-guid", Name: "some-domain.com"}},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+			})
+
+			It("returns the domain and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+				Expect(domain).To(Equal(Domain{GUID: "some-domain-guid", Name: "some-domain.com"}))
+
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-domain.com"}},
+				))
+			})
+		})
+
+		When("the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+			})
+		})
+	})
+
+	Describe("GetDefaultDomain", func() {
+		var (
+			domain     Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domain, warnings, executeErr = actor.GetDefaultDomain("some-org-guid")
+		})
+
+		When("there are external domains", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDomainsReturns(
+					[]ccv3.Domain{
+						{GUID: "internal-domain-guid", Name: "apps.internal", Internal: types.NullBool{IsSet: true, Value: true}},
+						{GUID: "external-domain-guid", Name: "example.com", Internal: types.NullBool{IsSet: true, Value: false}},
+					},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+			})
+
+			It("returns the first external domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+				Expect(domain.GUID).To(Equal("external-domain-guid"))
+			})
+		})
+
+		When("there are only internal domains", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationDomainsReturns(
+					[]ccv3.Domain{
+						{GUID: "internal-domain-guid", Name: "apps.internal", Internal: types.NullBool{IsSet: true, Value: true}},
+					},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+			})
+
+			It("returns a NoDomainsFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoDomainsFoundError{OrganizationGUID: "some-org-guid"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+			})
+		})
+	})
+})
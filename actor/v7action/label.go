@@ -0,0 +1,91 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata represents the labels and annotations of a V3 resource.
+type Metadata ccv3.Metadata
+
+// GetApplicationLabels returns the labels of the application with the given
+// name in the given space.
+func (actor Actor) GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata((*ccv3.Metadata)(app.Metadata)), warnings, nil
+}
+
+// GetOrganizationLabels returns the labels of the organization with the given
+// name.
+func (actor Actor) GetOrganizationLabels(orgName string) (map[string]types.NullString, Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata(org.Metadata), warnings, nil
+}
+
+// GetSpaceLabels returns the labels of the space with the given name in the
+// given organization.
+func (actor Actor) GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	return labelsFromMetadata(space.Metadata), warnings, nil
+}
+
+// UpdateApplicationLabelsByApplicationName sets the given labels on the
+// application with the given name in the given space. Labels with an unset
+// value are removed from the application.
+func (actor Actor) UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (Warnings, error) {
+	app, warnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceLabels("app", app.GUID, labels, warnings)
+}
+
+// UpdateOrganizationLabelsByOrganizationName sets the given labels on the
+// organization with the given name. Labels with an unset value are removed
+// from the organization.
+func (actor Actor) UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (Warnings, error) {
+	org, warnings, err := actor.GetOrganizationByName(orgName)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceLabels("org", org.GUID, labels, warnings)
+}
+
+// UpdateSpaceLabelsBySpaceName sets the given labels on the space with the
+// given name in the given organization. Labels with an unset value are
+// removed from the space.
+func (actor Actor) UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (Warnings, error) {
+	space, warnings, err := actor.GetSpaceByNameAndOrganization(spaceName, orgGUID)
+	if err != nil {
+		return warnings, err
+	}
+
+	return actor.updateResourceLabels("space", space.GUID, labels, warnings)
+}
+
+func (actor Actor) updateResourceLabels(resource string, resourceGUID string, labels map[string]types.NullString, allWarnings Warnings) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.UpdateResourceMetadata(resource, resourceGUID, ccv3.Metadata{Labels: labels})
+	allWarnings = append(allWarnings, warnings...)
+	return allWarnings, err
+}
+
+func labelsFromMetadata(metadata *ccv3.Metadata) map[string]types.NullString {
+	if metadata == nil || metadata.Labels == nil {
+		return map[string]types.NullString{}
+	}
+	return metadata.Labels
+}
@@ -0,0 +1,265 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Label Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+		labels                    map[string]types.NullString
+		warnings                  Warnings
+		executeErr                error
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetApplicationLabels", func() {
+		JustBeforeEach(func() {
+			labels, warnings, executeErr = actor.GetApplicationLabels("some-app", "some-space-guid")
+		})
+
+		When("the app has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{
+						GUID: "some-app-guid",
+						Name: "some-app",
+						Metadata: &ccv3.Metadata{
+							Labels: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+						},
+					}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(labels).To(Equal(map[string]types.NullString{"owner": types.NewNullString("team-a")}))
+			})
+		})
+
+		When("the app has no metadata", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns no labels", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(labels).To(BeEmpty())
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+			})
+		})
+	})
+
+	Describe("GetOrganizationLabels", func() {
+		JustBeforeEach(func() {
+			labels, warnings, executeErr = actor.GetOrganizationLabels("some-org")
+		})
+
+		When("the org has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{{
+						GUID: "some-org-guid",
+						Name: "some-org",
+						Metadata: &ccv3.Metadata{
+							Labels: map[string]types.NullString{"cost-center": types.NewNullString("1234")},
+						},
+					}},
+					ccv3.Warnings{"get-orgs-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+				Expect(labels).To(Equal(map[string]types.NullString{"cost-center": types.NewNullString("1234")}))
+			})
+		})
+
+		When("the org does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-orgs-warning"}, nil)
+			})
+
+			It("returns an OrganizationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org"}))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+	})
+
+	Describe("GetSpaceLabels", func() {
+		JustBeforeEach(func() {
+			labels, warnings, executeErr = actor.GetSpaceLabels("some-space", "some-org-guid")
+		})
+
+		When("the space has labels", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{
+						GUID: "some-space-guid",
+						Name: "some-space",
+						Metadata: &ccv3.Metadata{
+							Labels: map[string]types.NullString{"env": types.NewNullString("prod")},
+						},
+					}},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("returns the labels and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(labels).To(Equal(map[string]types.NullString{"env": types.NewNullString("prod")}))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationLabelsByApplicationName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{
+				"owner": types.NewNullString("team-b"),
+				"env":   {},
+			}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UpdateApplicationLabelsByApplicationName("some-app", "some-space-guid", labels)
+		})
+
+		When("the app exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{GUID: "some-app-guid", Name: "some-app"}},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.UpdateResourceMetadataReturns(
+					ccv3.ResourceMetadata{},
+					ccv3.Warnings{"update-warning"},
+					nil,
+				)
+			})
+
+			It("updates the labels of the app and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning", "update-warning"))
+
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(1))
+				resource, resourceGUID, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+				Expect(resource).To(Equal("app"))
+				Expect(resourceGUID).To(Equal("some-app-guid"))
+				Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+			})
+
+			When("updating the metadata fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.UpdateResourceMetadataReturns(
+						ccv3.ResourceMetadata{},
+						ccv3.Warnings{"update-warning"},
+						errors.New("some-error"),
+					)
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("get-apps-warning", "update-warning"))
+				})
+			})
+		})
+
+		When("the app does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("does not update any metadata", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(fakeCloudControllerClient.UpdateResourceMetadataCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("UpdateOrganizationLabelsByOrganizationName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"owner": types.NewNullString("team-b")}
+			fakeCloudControllerClient.GetOrganizationsReturns(
+				[]ccv3.Organization{{GUID: "some-org-guid", Name: "some-org"}},
+				ccv3.Warnings{"get-orgs-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the org and returns all warnings", func() {
+			warnings, executeErr = actor.UpdateOrganizationLabelsByOrganizationName("some-org", labels)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-orgs-warning", "update-warning"))
+
+			resource, resourceGUID, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("org"))
+			Expect(resourceGUID).To(Equal("some-org-guid"))
+			Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+		})
+	})
+
+	Describe("UpdateSpaceLabelsBySpaceName", func() {
+		BeforeEach(func() {
+			labels = map[string]types.NullString{"owner": types.NewNullString("team-b")}
+			fakeCloudControllerClient.GetSpacesReturns(
+				[]ccv3.Space{{GUID: "some-space-guid", Name: "some-space"}},
+				ccv3.Warnings{"get-spaces-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateResourceMetadataReturns(ccv3.ResourceMetadata{}, ccv3.Warnings{"update-warning"}, nil)
+		})
+
+		It("updates the labels of the space and returns all warnings", func() {
+			warnings, executeErr = actor.UpdateSpaceLabelsBySpaceName("some-space", "some-org-guid", labels)
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("get-spaces-warning", "update-warning"))
+
+			Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.NameFilter, Values: []string{"some-space"}},
+				ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+			))
+
+			resource, resourceGUID, metadata := fakeCloudControllerClient.UpdateResourceMetadataArgsForCall(0)
+			Expect(resource).To(Equal("space"))
+			Expect(resourceGUID).To(Equal("some-space-guid"))
+			Expect(metadata).To(Equal(ccv3.Metadata{Labels: labels}))
+		})
+	})
+})
@@ -23,3 +23,27 @@ func (actor Actor) GetOrganizationByName(name string) (Organization, Warnings, e
 
 	return Organization(orgs[0]), Warnings(warnings), nil
 }
+
+// GetOrganizations returns all organizations ordered by name. When
+// labelSelector is not empty, only the organizations matching it are
+// returned.
+func (actor Actor) GetOrganizations(labelSelector string) ([]Organization, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	if labelSelector != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	ccOrgs, warnings, err := actor.CloudControllerClient.GetOrganizations(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var orgs []Organization
+	for _, ccOrg := range ccOrgs {
+		orgs = append(orgs, Organization(ccOrg))
+	}
+
+	return orgs, Warnings(warnings), nil
+}
@@ -88,4 +88,73 @@ var _ = Describe("Organization Actions", func() {
 			Expect(err).To(MatchError(actionerror.OrganizationNotFoundError{Name: "some-org-name"}))
 		})
 	})
+
+	Describe("GetOrganizations", func() {
+		var (
+			orgs       []Organization
+			warnings   Warnings
+			executeErr error
+			selector   string
+		)
+
+		BeforeEach(func() {
+			selector = ""
+		})
+
+		JustBeforeEach(func() {
+			orgs, warnings, executeErr = actor.GetOrganizations(selector)
+		})
+
+		When("the API call is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(
+					[]ccv3.Organization{
+						{Name: "org-1", GUID: "org-guid-1"},
+						{Name: "org-2", GUID: "org-guid-2"},
+					},
+					ccv3.Warnings{"get-orgs-warning"},
+					nil,
+				)
+			})
+
+			It("returns the organizations ordered by name and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+				Expect(orgs).To(Equal([]Organization{
+					{Name: "org-1", GUID: "org-guid-1"},
+					{Name: "org-2", GUID: "org-guid-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetOrganizationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					selector = "env in (prod,staging)"
+				})
+
+				It("filters the organizations by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetOrganizationsArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"env in (prod,staging)"}},
+					))
+				})
+			})
+		})
+
+		When("the API call returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetOrganizationsReturns(nil, ccv3.Warnings{"get-orgs-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-orgs-warning"))
+			})
+		})
+	})
 })
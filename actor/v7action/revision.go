@@ -0,0 +1,73 @@
+package v7action
+
+import (
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Revision represents a version of an application's droplet, environment
+// variables and process commands that can be deployed.
+type Revision ccv3.Revision
+
+// Revisions is a list of Revisions.
+type Revisions []Revision
+
+// GetRevisionsByApplicationNameAndSpace returns the revisions of the given
+// application, ordered by version.
+func (actor Actor) GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) (Revisions, Warnings, error) {
+	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(app.GUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	var revisions Revisions
+	for _, ccRevision := range ccRevisions {
+		revisions = append(revisions, Revision(ccRevision))
+	}
+	sort.Slice(revisions, func(i, j int) bool { return revisions[i].Version < revisions[j].Version })
+
+	return revisions, allWarnings, nil
+}
+
+// GetRevisionByApplicationAndVersion returns the revision of the given
+// application with the given version.
+func (actor Actor) GetRevisionByApplicationAndVersion(appGUID string, version int) (Revision, Warnings, error) {
+	ccRevisions, warnings, err := actor.CloudControllerClient.GetApplicationRevisions(
+		appGUID,
+		ccv3.Query{Key: ccv3.VersionsFilter, Values: []string{strconv.Itoa(version)}},
+	)
+	if err != nil {
+		return Revision{}, Warnings(warnings), err
+	}
+
+	if len(ccRevisions) == 0 {
+		return Revision{}, Warnings(warnings), actionerror.RevisionNotFoundError{Version: version}
+	}
+
+	return Revision(ccRevisions[0]), Warnings(warnings), nil
+}
+
+// GetRevisionEnvironmentVariables returns the environment variables the
+// application had when the given revision was created.
+func (actor Actor) GetRevisionEnvironmentVariables(revisionGUID string) (map[string]string, Warnings, error) {
+	ccEnvVars, warnings, err := actor.CloudControllerClient.GetRevisionEnvironmentVariables(revisionGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	envVars := map[string]string{}
+	for key, value := range ccEnvVars {
+		envVars[key] = value.Value
+	}
+
+	return envVars, Warnings(warnings), nil
+}
@@ -0,0 +1,182 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Revision Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("GetRevisionsByApplicationNameAndSpace", func() {
+		var (
+			revisions  Revisions
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revisions, warnings, executeErr = actor.GetRevisionsByApplicationNameAndSpace("some-app", "some-space-guid")
+		})
+
+		When("the application exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{{Name: "some-app", GUID: "some-app-guid"}},
+					ccv3.Warnings{"get-app-warning"},
+					nil,
+				)
+			})
+
+			When("getting the revisions succeeds", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationRevisionsReturns(
+						[]ccv3.Revision{
+							{GUID: "revision-2-guid", Version: 2},
+							{GUID: "revision-1-guid", Version: 1},
+						},
+						ccv3.Warnings{"get-revisions-warning"},
+						nil,
+					)
+				})
+
+				It("returns the revisions ordered by version and all warnings", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning"))
+					Expect(revisions).To(Equal(Revisions{
+						{GUID: "revision-1-guid", Version: 1},
+						{GUID: "revision-2-guid", Version: 2},
+					}))
+
+					appGUID, _ := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			When("getting the revisions fails", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"get-revisions-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and all warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("get-app-warning", "get-revisions-warning"))
+				})
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv3.Warnings{"get-app-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("get-app-warning"))
+				Expect(fakeCloudControllerClient.GetApplicationRevisionsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRevisionByApplicationAndVersion", func() {
+		var (
+			revision   Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = actor.GetRevisionByApplicationAndVersion("some-app-guid", 3)
+		})
+
+		When("the revision exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(
+					[]ccv3.Revision{{GUID: "revision-3-guid", Version: 3}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the revision filtered by version", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(revision).To(Equal(Revision{GUID: "revision-3-guid", Version: 3}))
+
+				appGUID, query := fakeCloudControllerClient.GetApplicationRevisionsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(ConsistOf(ccv3.Query{Key: ccv3.VersionsFilter, Values: []string{"3"}}))
+			})
+		})
+
+		When("the revision does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns a RevisionNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RevisionNotFoundError{Version: 3}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("getting the revisions fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationRevisionsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		When("the cloud controller returns environment variables", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(
+					ccv3.EnvironmentVariables{"RAILS_ENV": types.FilteredString{IsSet: true, Value: "production"}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment variables", func() {
+				envVars, warnings, err := actor.GetRevisionEnvironmentVariables("some-revision-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(envVars).To(Equal(map[string]string{"RAILS_ENV": "production"}))
+				Expect(fakeCloudControllerClient.GetRevisionEnvironmentVariablesArgsForCall(0)).To(Equal("some-revision-guid"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRevisionEnvironmentVariablesReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				_, warnings, err := actor.GetRevisionEnvironmentVariables("some-revision-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,368 @@
+package v7action
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Route represents a V3 actor Route.
+type Route struct {
+	GUID       string
+	Host       string
+	Path       string
+	SpaceGUID  string
+	SpaceName  string
+	DomainGUID string
+	DomainName string
+	// Destinations are the app processes the route sends traffic to.
+	Destinations []RouteDestination
+}
+
+// String formats the route in a human readable format.
+func (route Route) String() string {
+	routeString := route.DomainName
+
+	if route.Host != "" {
+		routeString = fmt.Sprintf("%s.%s", route.Host, routeString)
+	}
+
+	if route.Path != "" {
+		routeString = path.Join(routeString, route.Path)
+	}
+
+	return routeString
+}
+
+// appGUIDs returns the GUIDs of the applications the route sends traffic to.
+func (route Route) appGUIDs() []string {
+	var guids []string
+	for _, destination := range route.Destinations {
+		guids = append(guids, destination.AppGUID)
+	}
+	return uniqueStrings(guids)
+}
+
+// Routes is a list of Routes.
+type Routes []Route
+
+// Summary converts routes into a comma separated string.
+func (routes Routes) Summary() string {
+	formattedRoutes := []string{}
+	for _, route := range routes {
+		formattedRoutes = append(formattedRoutes, route.String())
+	}
+	return strings.Join(formattedRoutes, ", ")
+}
+
+// RouteSummary represents a route and the names of the applications it is
+// mapped to.
+type RouteSummary struct {
+	Route
+	AppNames []string
+}
+
+// RouteDestination represents the process of an application that a route
+// sends traffic to.
+type RouteDestination ccv3.RouteDestination
+
+// CreateRoute creates a route on the domain with the given name in the given
+// space.
+func (actor Actor) CreateRoute(spaceGUID string, domainName string, hostname string, path string) (Route, Warnings, error) {
+	domain, allWarnings, err := actor.GetDomainByName(domainName)
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	route := Route{
+		Host:       hostname,
+		Path:       path,
+		SpaceGUID:  spaceGUID,
+		DomainGUID: domain.GUID,
+		DomainName: domain.Name,
+	}
+
+	ccRoute, warnings, err := actor.CloudControllerClient.CreateRoute(ccv3.Route{
+		Host:       route.Host,
+		Path:       route.Path,
+		SpaceGUID:  route.SpaceGUID,
+		DomainGUID: route.DomainGUID,
+	})
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(ccerror.UnprocessableEntityError); ok {
+		return Route{}, allWarnings, actionerror.RouteAlreadyExistsError{Route: route.String()}
+	}
+	if err != nil {
+		return Route{}, allWarnings, err
+	}
+
+	route.GUID = ccRoute.GUID
+	route.Path = ccRoute.Path
+
+	return route, allWarnings, nil
+}
+
+// DeleteRoute deletes the route with the given domain, hostname and path.
+func (actor Actor) DeleteRoute(domainName string, hostname string, path string) (Warnings, error) {
+	domain, allWarnings, err := actor.GetDomainByName(domainName)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	route, warnings, err := actor.GetRouteByAttributes(domain.Name, domain.GUID, hostname, path)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	jobURL, apiWarnings, err := actor.CloudControllerClient.DeleteRoute(route.GUID)
+	allWarnings = append(allWarnings, apiWarnings...)
+	if err != nil {
+		return allWarnings, err
+	}
+
+	pollWarnings, err := actor.CloudControllerClient.PollJob(jobURL)
+	allWarnings = append(allWarnings, pollWarnings...)
+	return allWarnings, err
+}
+
+// GetRouteByAttributes returns the route with the given hostname and path on
+// the given domain.
+func (actor Actor) GetRouteByAttributes(domainName string, domainGUID string, hostname string, path string) (Route, Warnings, error) {
+	ccRoutes, warnings, err := actor.CloudControllerClient.GetRoutes(
+		ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{domainGUID}},
+		ccv3.Query{Key: ccv3.HostsFilter, Values: []string{hostname}},
+		ccv3.Query{Key: ccv3.PathsFilter, Values: []string{path}},
+	)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	if len(ccRoutes) == 0 {
+		return Route{}, Warnings(warnings), actionerror.RouteNotFoundError{
+			Host:       hostname,
+			DomainGUID: domainGUID,
+			Path:       path,
+		}
+	}
+
+	return Route{
+		GUID:       ccRoutes[0].GUID,
+		Host:       ccRoutes[0].Host,
+		Path:       ccRoutes[0].Path,
+		SpaceGUID:  ccRoutes[0].SpaceGUID,
+		DomainGUID: ccRoutes[0].DomainGUID,
+		DomainName: domainName,
+	}, Warnings(warnings), nil
+}
+
+// GetApplicationRoutes returns the routes mapped to the given application.
+func (actor Actor) GetApplicationRoutes(appGUID string) (Routes, Warnings, error) {
+	return actor.getRoutes(ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}})
+}
+
+// GetRoutesByApplications returns the routes mapped to each of the given
+// applications, keyed by application GUID. The routes of all the
+// applications are fetched with a single filtered request.
+func (actor Actor) GetRoutesByApplications(appGUIDs []string) (map[string]Routes, Warnings, error) {
+	appRoutes := map[string]Routes{}
+	if len(appGUIDs) == 0 {
+		return appRoutes, nil, nil
+	}
+
+	routes, warnings, err := actor.getRoutes(ccv3.Query{Key: ccv3.AppGUIDFilter, Values: appGUIDs})
+	if err != nil {
+		return nil, warnings, err
+	}
+
+	requested := map[string]bool{}
+	for _, appGUID := range appGUIDs {
+		requested[appGUID] = true
+	}
+
+	for _, route := range routes {
+		for _, appGUID := range route.appGUIDs() {
+			if requested[appGUID] {
+				appRoutes[appGUID] = append(appRoutes[appGUID], route)
+			}
+		}
+	}
+
+	return appRoutes, warnings, nil
+}
+
+// GetRoutesBySpace returns the routes in the given space.
+func (actor Actor) GetRoutesBySpace(spaceGUID string) (Routes, Warnings, error) {
+	return actor.getRoutes(ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{spaceGUID}})
+}
+
+// GetRoutesByOrg returns the routes in all the spaces of the given
+// organization.
+func (actor Actor) GetRoutesByOrg(orgGUID string) (Routes, Warnings, error) {
+	return actor.getRoutes(ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}})
+}
+
+// GetRouteSummaries returns the given routes along with the names of the
+// applications each route is mapped to. The applications are taken from the
+// destinations of the routes, so no route destinations are requested.
+func (actor Actor) GetRouteSummaries(routes Routes) ([]RouteSummary, Warnings, error) {
+	var allWarnings Warnings
+
+	var appGUIDs []string
+	for _, route := range routes {
+		appGUIDs = append(appGUIDs, route.appGUIDs()...)
+	}
+
+	appNames := map[string]string{}
+	if len(appGUIDs) > 0 {
+		apps, warnings, err := actor.CloudControllerClient.GetApplications(
+			ccv3.Query{Key: ccv3.GUIDFilter, Values: uniqueStrings(appGUIDs)},
+		)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		for _, app := range apps {
+			appNames[app.GUID] = app.Name
+		}
+	}
+
+	var summaries []RouteSummary
+	for _, route := range routes {
+		summary := RouteSummary{Route: route}
+		for _, appGUID := range route.appGUIDs() {
+			summary.AppNames = append(summary.AppNames, appNames[appGUID])
+		}
+		sort.Strings(summary.AppNames)
+		summaries = append(summaries, summary)
+	}
+
+	return summaries, allWarnings, nil
+}
+
+// GetRouteDestinationsByAppGUID returns the destinations of the route that
+// send traffic to the given application, one for each process type and port
+// the route is mapped to.
+func (actor Actor) GetRouteDestinationsByAppGUID(routeGUID string, appGUID string) ([]RouteDestination, Warnings, error) {
+	destinations, warnings, err := actor.CloudControllerClient.GetRouteDestinations(routeGUID)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var appDestinations []RouteDestination
+	for _, destination := range destinations {
+		if destination.AppGUID == appGUID {
+			appDestinations = append(appDestinations, RouteDestination(destination))
+		}
+	}
+
+	if len(appDestinations) > 0 {
+		return appDestinations, Warnings(warnings), nil
+	}
+
+	return nil, Warnings(warnings), actionerror.RouteDestinationNotFoundError{
+		AppGUID:   appGUID,
+		RouteGUID: routeGUID,
+	}
+}
+
+// MapRoute maps the route to the given process type and port of the
+// application. The Cloud Controller uses the web process and port 8080 when
+// no process type or port is provided.
+func (actor Actor) MapRoute(routeGUID string, appGUID string, processType string, port int) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.MapRoute(routeGUID, ccv3.RouteDestination{
+		AppGUID:     appGUID,
+		ProcessType: processType,
+		Port:        port,
+	})
+	return Warnings(warnings), err
+}
+
+// UnmapRoute removes the destination from the route.
+func (actor Actor) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.UnmapRoute(routeGUID, destinationGUID)
+	return Warnings(warnings), err
+}
+
+func (actor Actor) getRoutes(query ...ccv3.Query) (Routes, Warnings, error) {
+	ccRoutes, warnings, err := actor.CloudControllerClient.GetRoutes(query...)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(ccRoutes) == 0 {
+		return nil, allWarnings, nil
+	}
+
+	var domainGUIDs, spaceGUIDs []string
+	for _, ccRoute := range ccRoutes {
+		domainGUIDs = append(domainGUIDs, ccRoute.DomainGUID)
+		spaceGUIDs = append(spaceGUIDs, ccRoute.SpaceGUID)
+	}
+
+	domains, warnings, err := actor.CloudControllerClient.GetDomains(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: uniqueStrings(domainGUIDs)},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	spaces, warnings, err := actor.CloudControllerClient.GetSpaces(
+		ccv3.Query{Key: ccv3.GUIDFilter, Values: uniqueStrings(spaceGUIDs)},
+	)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	domainNames := map[string]string{}
+	for _, domain := range domains {
+		domainNames[domain.GUID] = domain.Name
+	}
+
+	spaceNames := map[string]string{}
+	for _, space := range spaces {
+		spaceNames[space.GUID] = space.Name
+	}
+
+	var routes Routes
+	for _, ccRoute := range ccRoutes {
+		var destinations []RouteDestination
+		for _, destination := range ccRoute.Destinations {
+			destinations = append(destinations, RouteDestination(destination))
+		}
+
+		routes = append(routes, Route{
+			GUID:         ccRoute.GUID,
+			Host:         ccRoute.Host,
+			Path:         ccRoute.Path,
+			SpaceGUID:    ccRoute.SpaceGUID,
+			SpaceName:    spaceNames[ccRoute.SpaceGUID],
+			DomainGUID:   ccRoute.DomainGUID,
+			DomainName:   domainNames[ccRoute.DomainGUID],
+			Destinations: destinations,
+		})
+	}
+
+	return routes, allWarnings, nil
+}
+
+func uniqueStrings(values []string) []string {
+	seen := map[string]bool{}
+	var unique []string
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
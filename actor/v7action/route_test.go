@@ -0,0 +1,487 @@
+package v7action_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Route Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	DescribeTable("Route.String",
+		func(route Route, expected string) {
+			Expect(route.String()).To(Equal(expected))
+		},
+
+		Entry("domain only", Route{DomainName: "example.com"}, "example.com"),
+		Entry("host and domain", Route{Host: "host", DomainName: "example.com"}, "host.example.com"),
+		Entry("host, domain and path", Route{Host: "host", DomainName: "example.com", Path: "/path"}, "host.example.com/path"),
+	)
+
+	Describe("CreateRoute", func() {
+		var (
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]ccv3.Domain{{GUID: "some-domain-guid", Name: "some-domain.com"}},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = actor.CreateRoute("some-space-guid", "some-domain.com", "some-host", "/some-path")
+		})
+
+		When("the route is created", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteReturns(
+					ccv3.Route{GUID: "some-route-guid", Host: "some-host", Path: "/some-path", SpaceGUID: "some-space-guid", DomainGUID: "some-domain-guid"},
+					ccv3.Warnings{"create-route-warning"},
+					nil,
+				)
+			})
+
+			It("returns the route and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "create-route-warning"))
+				Expect(route).To(Equal(Route{
+					GUID:       "some-route-guid",
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+					DomainName: "some-domain.com",
+				}))
+
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.CreateRouteArgsForCall(0)).To(Equal(ccv3.Route{
+					Host:       "some-host",
+					Path:       "/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+				}))
+			})
+		})
+
+		When("the route already exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateRouteReturns(
+					ccv3.Route{},
+					ccv3.Warnings{"create-route-warning"},
+					ccerror.UnprocessableEntityError{Message: "Route already exists."},
+				)
+			})
+
+			It("returns a RouteAlreadyExistsError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteAlreadyExistsError{Route: "some-host.some-domain.com/some-path"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "create-route-warning"))
+			})
+		})
+
+		When("the domain does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetDomainsReturns(nil, ccv3.Warnings{"get-domains-warning"}, nil)
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "some-domain.com"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning"))
+				Expect(fakeCloudControllerClient.CreateRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("DeleteRoute", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetDomainsReturns(
+				[]ccv3.Domain{{GUID: "some-domain-guid", Name: "some-domain.com"}},
+				ccv3.Warnings{"get-domains-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.DeleteRoute("some-domain.com", "some-host", "")
+		})
+
+		When("the route exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{{GUID: "some-route-guid"}},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.DeleteRouteReturns(ccv3.JobURL("some-job-url"), ccv3.Warnings{"delete-route-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv3.Warnings{"poll-job-warning"}, nil)
+			})
+
+			It("deletes the route and polls the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning", "delete-route-warning", "poll-job-warning"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.DomainGUIDFilter, Values: []string{"some-domain-guid"}},
+					ccv3.Query{Key: ccv3.HostsFilter, Values: []string{"some-host"}},
+					ccv3.Query{Key: ccv3.PathsFilter, Values: []string{""}},
+				))
+				Expect(fakeCloudControllerClient.DeleteRouteArgsForCall(0)).To(Equal("some-route-guid"))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv3.JobURL("some-job-url")))
+			})
+		})
+
+		When("the route does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{Host: "some-host", DomainGUID: "some-domain-guid"}))
+				Expect(warnings).To(ConsistOf("get-domains-warning", "get-routes-warning"))
+				Expect(fakeCloudControllerClient.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRoutesByApplications", func() {
+		var (
+			appRoutes  map[string]Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			appRoutes, warnings, executeErr = actor.GetRoutesByApplications([]string{"app-guid-1", "app-guid-2", "app-guid-3"})
+		})
+
+		When("the apps have routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{
+						{
+							GUID:       "route-guid-1",
+							Host:       "host-1",
+							SpaceGUID:  "some-space-guid",
+							DomainGUID: "domain-guid",
+							Destinations: []ccv3.RouteDestination{
+								{AppGUID: "app-guid-1", ProcessType: "web"},
+								{AppGUID: "app-guid-1", ProcessType: "worker"},
+								{AppGUID: "app-guid-2", ProcessType: "web"},
+								{AppGUID: "other-app-guid", ProcessType: "web"},
+							},
+						},
+						{
+							GUID:         "route-guid-2",
+							Host:         "host-2",
+							SpaceGUID:    "some-space-guid",
+							DomainGUID:   "domain-guid",
+							Destinations: []ccv3.RouteDestination{{AppGUID: "app-guid-2", ProcessType: "web"}},
+						},
+					},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{{GUID: "domain-guid", Name: "example.com"}},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("returns the routes of each app from a single routes request", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domains-warning", "get-spaces-warning"))
+
+				Expect(appRoutes).To(HaveLen(2))
+				Expect(appRoutes["app-guid-1"].Summary()).To(Equal("host-1.example.com"))
+				Expect(appRoutes["app-guid-2"].Summary()).To(Equal("host-1.example.com, host-2.example.com"))
+				Expect(appRoutes).ToNot(HaveKey("app-guid-3"))
+
+				Expect(fakeCloudControllerClient.GetRoutesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"app-guid-1", "app-guid-2", "app-guid-3"}},
+				))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("GetRoutesBySpace", func() {
+		var (
+			routes     Routes
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = actor.GetRoutesBySpace("some-space-guid")
+		})
+
+		When("the space has routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(
+					[]ccv3.Route{
+						{
+							GUID:         "route-guid-1",
+							Host:         "host-1",
+							SpaceGUID:    "some-space-guid",
+							DomainGUID:   "domain-guid-1",
+							Destinations: []ccv3.RouteDestination{{GUID: "destination-guid", AppGUID: "app-guid", ProcessType: "web"}},
+						},
+						{GUID: "route-guid-2", Path: "/path", SpaceGUID: "some-space-guid", DomainGUID: "domain-guid-2"},
+					},
+					ccv3.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetDomainsReturns(
+					[]ccv3.Domain{
+						{GUID: "domain-guid-1", Name: "domain-1.com"},
+						{GUID: "domain-guid-2", Name: "domain-2.com"},
+					},
+					ccv3.Warnings{"get-domains-warning"},
+					nil,
+				)
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{{GUID: "some-space-guid", Name: "some-space"}},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("returns the routes with their domain and space names and destinations", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domains-warning", "get-spaces-warning"))
+				Expect(routes).To(Equal(Routes{
+					{
+						GUID:         "route-guid-1",
+						Host:         "host-1",
+						SpaceGUID:    "some-space-guid",
+						SpaceName:    "some-space",
+						DomainGUID:   "domain-guid-1",
+						DomainName:   "domain-1.com",
+						Destinations: []RouteDestination{{GUID: "destination-guid", AppGUID: "app-guid", ProcessType: "web"}},
+					},
+					{GUID: "route-guid-2", Path: "/path", SpaceGUID: "some-space-guid", SpaceName: "some-space", DomainGUID: "domain-guid-2", DomainName: "domain-2.com"},
+				}))
+				Expect(routes.Summary()).To(Equal("host-1.domain-1.com, domain-2.com/path"))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				))
+				Expect(fakeCloudControllerClient.GetDomainsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"domain-guid-1", "domain-guid-2"}},
+				))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"some-space-guid"}},
+				))
+			})
+		})
+
+		When("the space has no routes", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("does not look up domains or spaces", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(routes).To(BeEmpty())
+				Expect(fakeCloudControllerClient.GetDomainsCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv3.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
+	Describe("GetRouteSummaries", func() {
+		var (
+			routes     Routes
+			summaries  []RouteSummary
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			summaries, warnings, executeErr = actor.GetRouteSummaries(routes)
+		})
+
+		When("the routes have destinations", func() {
+			BeforeEach(func() {
+				routes = Routes{
+					{
+						GUID: "route-guid-1",
+						Host: "host-1",
+						Destinations: []RouteDestination{
+							{GUID: "destination-1", AppGUID: "app-guid-2", ProcessType: "web"},
+							{GUID: "destination-2", AppGUID: "app-guid-1", ProcessType: "web"},
+							{GUID: "destination-3", AppGUID: "app-guid-1", ProcessType: "worker"},
+						},
+					},
+					{GUID: "route-guid-2", Host: "host-2"},
+				}
+				fakeCloudControllerClient.GetApplicationsReturns(
+					[]ccv3.Application{
+						{GUID: "app-guid-1", Name: "app-1"},
+						{GUID: "app-guid-2", Name: "app-2"},
+					},
+					ccv3.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("returns the routes with the sorted names of their apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-apps-warning"))
+				Expect(summaries).To(Equal([]RouteSummary{
+					{Route: routes[0], AppNames: []string{"app-1", "app-2"}},
+					{Route: routes[1]},
+				}))
+
+				Expect(fakeCloudControllerClient.GetRouteDestinationsCallCount()).To(Equal(0))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetApplicationsArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.GUIDFilter, Values: []string{"app-guid-2", "app-guid-1"}},
+				))
+			})
+		})
+
+		When("the routes have no destinations", func() {
+			BeforeEach(func() {
+				routes = Routes{{GUID: "route-guid-1", Host: "host-1"}}
+			})
+
+			It("does not look up any apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(summaries).To(Equal([]RouteSummary{{Route: routes[0]}}))
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("GetRouteDestinationsByAppGUID", func() {
+		var (
+			destinations []RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetRouteDestinationsReturns(
+				[]ccv3.RouteDestination{
+					{GUID: "destination-1", AppGUID: "app-guid-1"},
+					{GUID: "destination-2", AppGUID: "app-guid-2", ProcessType: "web", Port: 8080},
+					{GUID: "destination-3", AppGUID: "app-guid-2", ProcessType: "worker", Port: 9090},
+				},
+				ccv3.Warnings{"destinations-warning"},
+				nil,
+			)
+		})
+
+		When("the route is mapped to the app", func() {
+			JustBeforeEach(func() {
+				destinations, warnings, executeErr = actor.GetRouteDestinationsByAppGUID("some-route-guid", "app-guid-2")
+			})
+
+			It("returns every destination of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("destinations-warning"))
+				Expect(destinations).To(Equal([]RouteDestination{
+					{GUID: "destination-2", AppGUID: "app-guid-2", ProcessType: "web", Port: 8080},
+					{GUID: "destination-3", AppGUID: "app-guid-2", ProcessType: "worker", Port: 9090},
+				}))
+				Expect(fakeCloudControllerClient.GetRouteDestinationsArgsForCall(0)).To(Equal("some-route-guid"))
+			})
+		})
+
+		When("the route is not mapped to the app", func() {
+			JustBeforeEach(func() {
+				destinations, warnings, executeErr = actor.GetRouteDestinationsByAppGUID("some-route-guid", "app-guid-3")
+			})
+
+			It("returns a RouteDestinationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(actionerror.RouteDestinationNotFoundError{AppGUID: "app-guid-3", RouteGUID: "some-route-guid"}))
+				Expect(warnings).To(ConsistOf("destinations-warning"))
+				Expect(destinations).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("MapRoute", func() {
+		It("maps the route to the process of the app", func() {
+			fakeCloudControllerClient.MapRouteReturns(nil, ccv3.Warnings{"map-route-warning"}, nil)
+
+			warnings, err := actor.MapRoute("some-route-guid", "some-app-guid", "worker", 9090)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("map-route-warning"))
+
+			Expect(fakeCloudControllerClient.MapRouteCallCount()).To(Equal(1))
+			routeGUID, destinations := fakeCloudControllerClient.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-route-guid"))
+			Expect(destinations).To(ConsistOf(ccv3.RouteDestination{AppGUID: "some-app-guid", ProcessType: "worker", Port: 9090}))
+		})
+	})
+
+	Describe("UnmapRoute", func() {
+		It("removes the destination from the route", func() {
+			fakeCloudControllerClient.UnmapRouteReturns(ccv3.Warnings{"unmap-route-warning"}, errors.New("some-error"))
+
+			warnings, err := actor.UnmapRoute("some-route-guid", "some-destination-guid")
+			Expect(err).To(MatchError("some-error"))
+			Expect(warnings).To(ConsistOf("unmap-route-warning"))
+
+			routeGUID, destinationGUID := fakeCloudControllerClient.UnmapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("some-route-guid"))
+			Expect(destinationGUID).To(Equal("some-destination-guid"))
+		})
+	})
+})
@@ -0,0 +1,48 @@
+package v7action
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+)
+
+// Sidecar represents a command run alongside one or more of an application's
+// processes.
+type Sidecar ccv3.Sidecar
+
+// Sidecars is a list of Sidecars.
+type Sidecars []Sidecar
+
+// ForProcessType returns the sidecars that run alongside processes of the
+// given type.
+func (sidecars Sidecars) ForProcessType(processType string) Sidecars {
+	var matching Sidecars
+	for _, sidecar := range sidecars {
+		for _, sidecarProcessType := range sidecar.ProcessTypes {
+			if sidecarProcessType == processType {
+				matching = append(matching, sidecar)
+				break
+			}
+		}
+	}
+	return matching
+}
+
+// GetApplicationSidecars returns the sidecars of the given application.
+func (actor Actor) GetApplicationSidecars(appGUID string) (Sidecars, Warnings, error) {
+	ccSidecars, warnings, err := actor.CloudControllerClient.GetApplicationSidecars(appGUID)
+	return convertCCToActorSidecars(ccSidecars), Warnings(warnings), err
+}
+
+// GetProcessSidecars returns the sidecars that run alongside the given
+// process.
+func (actor Actor) GetProcessSidecars(processGUID string) (Sidecars, Warnings, error) {
+	ccSidecars, warnings, err := actor.CloudControllerClient.GetProcessSidecars(processGUID)
+	return convertCCToActorSidecars(ccSidecars), Warnings(warnings), err
+}
+
+func convertCCToActorSidecars(ccSidecars []ccv3.Sidecar) Sidecars {
+	var sidecars Sidecars
+	for _, ccSidecar := range ccSidecars {
+		sidecars = append(sidecars, Sidecar(ccSidecar))
+	}
+	return sidecars
+}
@@ -0,0 +1,115 @@
+package v7action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7action/v7actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Sidecar Actions", func() {
+	var (
+		actor                     *Actor
+		fakeCloudControllerClient *v7actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v7actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil, nil, nil)
+	})
+
+	Describe("Sidecars", func() {
+		Describe("ForProcessType", func() {
+			It("returns the sidecars that run alongside the process type", func() {
+				sidecars := Sidecars{
+					{Name: "web-and-worker", ProcessTypes: []string{"web", "worker"}},
+					{Name: "worker-only", ProcessTypes: []string{"worker"}},
+					{Name: "web-only", ProcessTypes: []string{"web"}},
+				}
+
+				Expect(sidecars.ForProcessType("web")).To(Equal(Sidecars{
+					{Name: "web-and-worker", ProcessTypes: []string{"web", "worker"}},
+					{Name: "web-only", ProcessTypes: []string{"web"}},
+				}))
+				Expect(sidecars.ForProcessType("clock")).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		When("the cloud controller returns sidecars", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(
+					[]ccv3.Sidecar{{GUID: "sidecar-guid", Name: "log-shipper", ProcessTypes: []string{"web"}}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the sidecars and warnings", func() {
+				sidecars, warnings, err := actor.GetApplicationSidecars("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(sidecars).To(Equal(Sidecars{
+					{GUID: "sidecar-guid", Name: "log-shipper", ProcessTypes: []string{"web"}},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationSidecarsCallCount()).To(Equal(1))
+				appGUID, _ := fakeCloudControllerClient.GetApplicationSidecarsArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationSidecarsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationSidecars("some-app-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessSidecars", func() {
+		When("the cloud controller returns sidecars", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessSidecarsReturns(
+					[]ccv3.Sidecar{{GUID: "sidecar-guid", Name: "log-shipper", ProcessTypes: []string{"web"}}},
+					ccv3.Warnings{"some-warning"},
+					nil,
+				)
+			})
+
+			It("returns the sidecars and warnings", func() {
+				sidecars, warnings, err := actor.GetProcessSidecars("some-process-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warning"))
+				Expect(sidecars).To(Equal(Sidecars{
+					{GUID: "sidecar-guid", Name: "log-shipper", ProcessTypes: []string{"web"}},
+				}))
+
+				processGUID, _ := fakeCloudControllerClient.GetProcessSidecarsArgsForCall(0)
+				Expect(processGUID).To(Equal("some-process-guid"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetProcessSidecarsReturns(nil, ccv3.Warnings{"some-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetProcessSidecars("some-process-guid")
+				Expect(err).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+})
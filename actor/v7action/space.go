@@ -55,3 +55,28 @@ func (actor Actor) GetSpaceByNameAndOrganization(spaceName string, orgGUID strin
 
 	return Space(spaces[0]), Warnings(warnings), nil
 }
+
+// GetOrganizationSpaces returns the spaces in the given organization ordered
+// by name. When labelSelector is not empty, only the spaces matching it are
+// returned.
+func (actor Actor) GetOrganizationSpaces(orgGUID string, labelSelector string) ([]Space, Warnings, error) {
+	queries := []ccv3.Query{
+		{Key: ccv3.OrganizationGUIDFilter, Values: []string{orgGUID}},
+		{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+	}
+	if labelSelector != "" {
+		queries = append(queries, ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{labelSelector}})
+	}
+
+	ccSpaces, warnings, err := actor.CloudControllerClient.GetSpaces(queries...)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var spaces []Space
+	for _, ccSpace := range ccSpaces {
+		spaces = append(spaces, Space(ccSpace))
+	}
+
+	return spaces, Warnings(warnings), nil
+}
@@ -220,4 +220,75 @@ var _ = Describe("Space", func() {
 		})
 
 	})
+
+	Describe("GetOrganizationSpaces", func() {
+		var (
+			spaces     []Space
+			warnings   Warnings
+			executeErr error
+			selector   string
+		)
+
+		BeforeEach(func() {
+			selector = ""
+		})
+
+		JustBeforeEach(func() {
+			spaces, warnings, executeErr = actor.GetOrganizationSpaces("some-org-guid", selector)
+		})
+
+		When("the API call is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(
+					[]ccv3.Space{
+						{Name: "space-1", GUID: "space-guid-1"},
+						{Name: "space-2", GUID: "space-guid-2"},
+					},
+					ccv3.Warnings{"get-spaces-warning"},
+					nil,
+				)
+			})
+
+			It("returns the spaces in the organization and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+				Expect(spaces).To(Equal([]Space{
+					{Name: "space-1", GUID: "space-guid-1"},
+					{Name: "space-2", GUID: "space-guid-2"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetSpacesCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+					ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+					ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+				))
+			})
+
+			When("a label selector is provided", func() {
+				BeforeEach(func() {
+					selector = "owner=team-a"
+				})
+
+				It("filters the spaces by the label selector", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeCloudControllerClient.GetSpacesArgsForCall(0)).To(ConsistOf(
+						ccv3.Query{Key: ccv3.OrganizationGUIDFilter, Values: []string{"some-org-guid"}},
+						ccv3.Query{Key: ccv3.OrderBy, Values: []string{ccv3.NameOrder}},
+						ccv3.Query{Key: ccv3.LabelSelectorFilter, Values: []string{"owner=team-a"}},
+					))
+				})
+			})
+		})
+
+		When("the API call returns an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpacesReturns(nil, ccv3.Warnings{"get-spaces-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(warnings).To(ConsistOf("get-spaces-warning"))
+			})
+		})
+	})
 })
@@ -59,24 +59,118 @@ func (actor Actor) GetSecureShellConfigurationByApplicationNameSpaceProcessTypeA
 	}, allWarnings, err
 }
 
+// InstanceSSHAuthentication is the SSH authentication for one instance of a
+// process.
+type InstanceSSHAuthentication struct {
+	SSHAuthentication
+	Index uint
+}
+
+// GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes
+// returns the SSH authentication for each of the given instances of the
+// process, or for every running instance when no indexes are given. Each
+// instance gets its own passcode, since a passcode can only be used once.
+func (actor Actor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(
+	appName string, spaceGUID string, processType string, processIndexes []uint,
+) ([]InstanceSSHAuthentication, Warnings, error) {
+	var allWarnings Warnings
+
+	endpoint := actor.CloudControllerClient.AppSSHEndpoint()
+	if endpoint == "" {
+		return nil, nil, actionerror.SSHEndpointNotSetError{}
+	}
+
+	fingerprint := actor.CloudControllerClient.AppSSHHostKeyFingerprint()
+	if fingerprint == "" {
+		return nil, nil, actionerror.SSHHostKeyFingerprintNotSetError{}
+	}
+
+	application, appWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+	allWarnings = append(allWarnings, appWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if !application.Started() {
+		return nil, allWarnings, actionerror.ApplicationNotStartedError{Name: appName}
+	}
+
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	allWarnings = append(allWarnings, processWarnings...)
+	if err != nil {
+		return nil, allWarnings, err
+	}
+
+	if len(processIndexes) == 0 {
+		for _, instance := range processSummary.InstanceDetails {
+			if instance.Running() {
+				processIndexes = append(processIndexes, uint(instance.Index))
+			}
+		}
+
+		if len(processIndexes) == 0 {
+			return nil, allWarnings, actionerror.NoRunningProcessInstancesError{ProcessType: processType}
+		}
+	} else {
+		for _, processIndex := range processIndexes {
+			err = checkProcessInstanceRunning(processSummary, processType, processIndex)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+	}
+
+	var sshAuths []InstanceSSHAuthentication
+	for _, processIndex := range processIndexes {
+		passcode, err := actor.UAAClient.GetSSHPasscode(actor.Config.AccessToken(), actor.Config.SSHOAuthClient())
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		sshAuths = append(sshAuths, InstanceSSHAuthentication{
+			SSHAuthentication: SSHAuthentication{
+				Endpoint:           endpoint,
+				HostKeyFingerprint: fingerprint,
+				Passcode:           passcode,
+				Username:           fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex),
+			},
+			Index: processIndex,
+		})
+	}
+
+	return sshAuths, allWarnings, nil
+}
+
 func (actor Actor) getUsername(application Application, processType string, processIndex uint) (string, Warnings, error) {
-	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	processSummary, processWarnings, err := actor.getProcessSummaryByType(application, processType)
+	if err != nil {
+		return "", processWarnings, err
+	}
+
+	err = checkProcessInstanceRunning(processSummary, processType, processIndex)
 	if err != nil {
 		return "", processWarnings, err
 	}
 
-	var processSummary ProcessSummary
+	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+}
+
+func (actor Actor) getProcessSummaryByType(application Application, processType string) (ProcessSummary, Warnings, error) {
+	processSummaries, processWarnings, err := actor.getProcessSummariesForApp(application.GUID, false)
+	if err != nil {
+		return ProcessSummary{}, processWarnings, err
+	}
+
 	for _, appProcessSummary := range processSummaries {
 		if appProcessSummary.Type == processType {
-			processSummary = appProcessSummary
-			break
+			return appProcessSummary, processWarnings, nil
 		}
 	}
 
-	if processSummary.GUID == "" {
-		return "", processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
-	}
+	return ProcessSummary{}, processWarnings, actionerror.ProcessNotFoundError{ProcessType: processType}
+}
 
+func checkProcessInstanceRunning(processSummary ProcessSummary, processType string, processIndex uint) error {
 	var processInstance ProcessInstance
 	for _, instance := range processSummary.InstanceDetails {
 		if uint(instance.Index) == processIndex {
@@ -86,12 +180,12 @@ func (actor Actor) getUsername(application Application, processType string, proc
 	}
 
 	if processInstance == (ProcessInstance{}) {
-		return "", processWarnings, actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
+		return actionerror.ProcessInstanceNotFoundError{ProcessType: processType, InstanceIndex: processIndex}
 	}
 
 	if !processInstance.Running() {
-		return "", processWarnings, actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
+		return actionerror.ProcessInstanceNotRunningError{ProcessType: processType, InstanceIndex: processIndex}
 	}
 
-	return fmt.Sprintf("cf:%s/%d", processSummary.GUID, processIndex), processWarnings, nil
+	return nil
 }
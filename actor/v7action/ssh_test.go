@@ -205,4 +205,149 @@ var _ = Describe("SSH Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes", func() {
+		var (
+			sshAuths       []InstanceSSHAuthentication
+			processIndexes []uint
+		)
+
+		BeforeEach(func() {
+			processIndexes = nil
+
+			fakeConfig.AccessTokenReturns("some-access-token")
+			fakeConfig.SSHOAuthClientReturns("some-access-oauth-client")
+			fakeCloudControllerClient.AppSSHEndpointReturns("some-app-ssh-endpoint")
+			fakeCloudControllerClient.AppSSHHostKeyFingerprintReturns("some-app-ssh-fingerprint")
+			fakeUAAClient.GetSSHPasscodeReturnsOnCall(0, "some-ssh-passcode-0", nil)
+			fakeUAAClient.GetSSHPasscodeReturnsOnCall(1, "some-ssh-passcode-1", nil)
+
+			fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStarted}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			fakeCloudControllerClient.GetApplicationProcessesReturns([]ccv3.Process{{Type: "some-process-type", GUID: "some-process-guid"}}, ccv3.Warnings{"some-process-warnings"}, nil)
+			fakeCloudControllerClient.GetProcessInstancesReturns(
+				[]ccv3.ProcessInstance{
+					{State: constant.ProcessInstanceRunning, Index: 0},
+					{State: constant.ProcessInstanceDown, Index: 1},
+					{State: constant.ProcessInstanceRunning, Index: 2},
+				},
+				ccv3.Warnings{"some-instance-warnings"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			sshAuths, warnings, executeErr = actor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes("some-app", "some-space-guid", "some-process-type", processIndexes)
+		})
+
+		When("the app ssh endpoint is empty", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.AppSSHEndpointReturns("")
+			})
+
+			It("creates an ssh-endpoint-not-set error", func() {
+				Expect(executeErr).To(MatchError(actionerror.SSHEndpointNotSetError{}))
+			})
+		})
+
+		When("no indexes are given", func() {
+			It("returns a configuration with its own passcode for every running instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+
+				Expect(sshAuths).To(Equal([]InstanceSSHAuthentication{
+					{
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Passcode:           "some-ssh-passcode-0",
+							Username:           "cf:some-process-guid/0",
+						},
+						Index: 0,
+					},
+					{
+						SSHAuthentication: SSHAuthentication{
+							Endpoint:           "some-app-ssh-endpoint",
+							HostKeyFingerprint: "some-app-ssh-fingerprint",
+							Passcode:           "some-ssh-passcode-1",
+							Username:           "cf:some-process-guid/2",
+						},
+						Index: 2,
+					},
+				}))
+
+				Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(2))
+				accessToken, oauthClient := fakeUAAClient.GetSSHPasscodeArgsForCall(0)
+				Expect(accessToken).To(Equal("some-access-token"))
+				Expect(oauthClient).To(Equal("some-access-oauth-client"))
+			})
+
+			When("no instances are running", func() {
+				BeforeEach(func() {
+					fakeCloudControllerClient.GetProcessInstancesReturns([]ccv3.ProcessInstance{{State: constant.ProcessInstanceDown, Index: 0}}, ccv3.Warnings{"some-instance-warnings"}, nil)
+				})
+
+				It("returns a NoRunningProcessInstancesError and all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+					Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+					Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		When("indexes are given", func() {
+			BeforeEach(func() {
+				processIndexes = []uint{2}
+			})
+
+			It("returns a configuration for each of them", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(sshAuths).To(HaveLen(1))
+				Expect(sshAuths[0].Index).To(Equal(uint(2)))
+				Expect(sshAuths[0].Username).To(Equal("cf:some-process-guid/2"))
+			})
+
+			When("one of them is not running", func() {
+				BeforeEach(func() {
+					processIndexes = []uint{0, 1}
+				})
+
+				It("returns a ProcessInstanceNotRunningError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotRunningError{ProcessType: "some-process-type", InstanceIndex: 1}))
+					Expect(fakeUAAClient.GetSSHPasscodeCallCount()).To(Equal(0))
+				})
+			})
+
+			When("one of them does not exist", func() {
+				BeforeEach(func() {
+					processIndexes = []uint{5}
+				})
+
+				It("returns a ProcessInstanceNotFoundError", func() {
+					Expect(executeErr).To(MatchError(actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 5}))
+				})
+			})
+		})
+
+		When("getting a passcode fails", func() {
+			BeforeEach(func() {
+				fakeUAAClient.GetSSHPasscodeReturnsOnCall(1, "", errors.New("some-passcode-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("some-passcode-error"))
+				Expect(warnings).To(ConsistOf("some-app-warnings", "some-process-warnings", "some-instance-warnings"))
+			})
+		})
+
+		When("the application is stopped", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationsReturns([]ccv3.Application{{Name: "some-app", State: constant.ApplicationStopped}}, ccv3.Warnings{"some-app-warnings"}, nil)
+			})
+
+			It("returns a ApplicationNotStartedError", func() {
+				Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+				Expect(warnings).To(ConsistOf("some-app-warnings"))
+			})
+		})
+	})
 })
@@ -55,6 +55,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateApplicationDeploymentByRevisionStub        func(string, string) (string, ccv3.Warnings, error)
+	createApplicationDeploymentByRevisionMutex       sync.RWMutex
+	createApplicationDeploymentByRevisionArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createApplicationDeploymentByRevisionReturns struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createApplicationDeploymentByRevisionReturnsOnCall map[int]struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateApplicationProcessScaleStub        func(string, ccv3.Process) (ccv3.Process, ccv3.Warnings, error)
 	createApplicationProcessScaleMutex       sync.RWMutex
 	createApplicationProcessScaleArgsForCall []struct {
@@ -102,6 +118,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateDomainStub        func(ccv3.Domain) (ccv3.Domain, ccv3.Warnings, error)
+	createDomainMutex       sync.RWMutex
+	createDomainArgsForCall []struct {
+		arg1 ccv3.Domain
+	}
+	createDomainReturns struct {
+		result1 ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createDomainReturnsOnCall map[int]struct {
+		result1 ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	CreateIsolationSegmentStub        func(ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error)
 	createIsolationSegmentMutex       sync.RWMutex
 	createIsolationSegmentArgsForCall []struct {
@@ -132,6 +163,21 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	CreateRouteStub        func(ccv3.Route) (ccv3.Route, ccv3.Warnings, error)
+	createRouteMutex       sync.RWMutex
+	createRouteArgsForCall []struct {
+		arg1 ccv3.Route
+	}
+	createRouteReturns struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	createRouteReturnsOnCall map[int]struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteApplicationStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
 	deleteApplicationMutex       sync.RWMutex
 	deleteApplicationArgsForCall []struct {
@@ -162,6 +208,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteDomainStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteDomainMutex       sync.RWMutex
+	deleteDomainArgsForCall []struct {
+		arg1 string
+	}
+	deleteDomainReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteDomainReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteIsolationSegmentStub        func(string) (ccv3.Warnings, error)
 	deleteIsolationSegmentMutex       sync.RWMutex
 	deleteIsolationSegmentArgsForCall []struct {
@@ -189,6 +250,21 @@ type FakeCloudControllerClient struct {
 		result1 ccv3.Warnings
 		result2 error
 	}
+	DeleteRouteStub        func(string) (ccv3.JobURL, ccv3.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		arg1 string
+	}
+	deleteRouteReturns struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}
 	DeleteServiceInstanceRelationshipsSharedSpaceStub        func(string, string) (ccv3.Warnings, error)
 	deleteServiceInstanceRelationshipsSharedSpaceMutex       sync.RWMutex
 	deleteServiceInstanceRelationshipsSharedSpaceArgsForCall []struct {
@@ -295,6 +371,38 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetApplicationRevisionsStub        func(string, ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error)
+	getApplicationRevisionsMutex       sync.RWMutex
+	getApplicationRevisionsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getApplicationRevisionsReturns struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationRevisionsReturnsOnCall map[int]struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetApplicationSidecarsStub        func(string, ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error)
+	getApplicationSidecarsMutex       sync.RWMutex
+	getApplicationSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getApplicationSidecarsReturns struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getApplicationSidecarsReturnsOnCall map[int]struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetApplicationTasksStub        func(string, ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
 	getApplicationTasksMutex       sync.RWMutex
 	getApplicationTasksArgsForCall []struct {
@@ -341,6 +449,36 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetDeploymentStub        func(string) (ccv3.Deployment, ccv3.Warnings, error)
+	getDeploymentMutex       sync.RWMutex
+	getDeploymentArgsForCall []struct {
+		arg1 string
+	}
+	getDeploymentReturns struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDeploymentReturnsOnCall map[int]struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetDomainsStub        func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)
+	getDomainsMutex       sync.RWMutex
+	getDomainsArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getDomainsReturns struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getDomainsReturnsOnCall map[int]struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetDropletStub        func(string) (ccv3.Droplet, ccv3.Warnings, error)
 	getDropletMutex       sync.RWMutex
 	getDropletArgsForCall []struct {
@@ -431,6 +569,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetOrganizationDomainsStub        func(string, ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)
+	getOrganizationDomainsMutex       sync.RWMutex
+	getOrganizationDomainsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getOrganizationDomainsReturns struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getOrganizationDomainsReturnsOnCall map[int]struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetOrganizationsStub        func(...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error)
 	getOrganizationsMutex       sync.RWMutex
 	getOrganizationsArgsForCall []struct {
@@ -491,6 +645,67 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	GetProcessSidecarsStub        func(string, ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error)
+	getProcessSidecarsMutex       sync.RWMutex
+	getProcessSidecarsArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}
+	getProcessSidecarsReturns struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getProcessSidecarsReturnsOnCall map[int]struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetRevisionEnvironmentVariablesStub        func(string) (ccv3.EnvironmentVariables, ccv3.Warnings, error)
+	getRevisionEnvironmentVariablesMutex       sync.RWMutex
+	getRevisionEnvironmentVariablesArgsForCall []struct {
+		arg1 string
+	}
+	getRevisionEnvironmentVariablesReturns struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRevisionEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetRouteDestinationsStub        func(string) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	getRouteDestinationsMutex       sync.RWMutex
+	getRouteDestinationsArgsForCall []struct {
+		arg1 string
+	}
+	getRouteDestinationsReturns struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRouteDestinationsReturnsOnCall map[int]struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	GetRoutesStub        func(...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error)
+	getRoutesMutex       sync.RWMutex
+	getRoutesArgsForCall []struct {
+		arg1 []ccv3.Query
+	}
+	getRoutesReturns struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
+	getRoutesReturnsOnCall map[int]struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}
 	GetServiceInstancesStub        func(...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error)
 	getServiceInstancesMutex       sync.RWMutex
 	getServiceInstancesArgsForCall []struct {
@@ -536,6 +751,22 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	MapRouteStub        func(string, ...ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error)
+	mapRouteMutex       sync.RWMutex
+	mapRouteArgsForCall []struct {
+		arg1 string
+		arg2 []ccv3.RouteDestination
+	}
+	mapRouteReturns struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
+	mapRouteReturnsOnCall map[int]struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}
 	PatchApplicationProcessHealthCheckStub        func(string, string, string, int) (ccv3.Process, ccv3.Warnings, error)
 	patchApplicationProcessHealthCheckMutex       sync.RWMutex
 	patchApplicationProcessHealthCheckArgsForCall []struct {
@@ -599,6 +830,20 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UnmapRouteStub        func(string, string) (ccv3.Warnings, error)
+	unmapRouteMutex       sync.RWMutex
+	unmapRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	unmapRouteReturns struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
+	unmapRouteReturnsOnCall map[int]struct {
+		result1 ccv3.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(ccv3.Application) (ccv3.Application, ccv3.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -707,6 +952,23 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
+	UpdateResourceMetadataStub        func(string, string, ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error)
+	updateResourceMetadataMutex       sync.RWMutex
+	updateResourceMetadataArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 ccv3.Metadata
+	}
+	updateResourceMetadataReturns struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}
+	updateResourceMetadataReturnsOnCall map[int]struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}
 	UpdateSpaceIsolationSegmentRelationshipStub        func(string, string) (ccv3.Relationship, ccv3.Warnings, error)
 	updateSpaceIsolationSegmentRelationshipMutex       sync.RWMutex
 	updateSpaceIsolationSegmentRelationshipArgsForCall []struct {
@@ -998,6 +1260,73 @@ func (fake *FakeCloudControllerClient) CreateApplicationReturnsOnCall(i int, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevision(arg1 string, arg2 string) (string, ccv3.Warnings, error) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	ret, specificReturn := fake.createApplicationDeploymentByRevisionReturnsOnCall[len(fake.createApplicationDeploymentByRevisionArgsForCall)]
+	fake.createApplicationDeploymentByRevisionArgsForCall = append(fake.createApplicationDeploymentByRevisionArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateApplicationDeploymentByRevision", []interface{}{arg1, arg2})
+	fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	if fake.CreateApplicationDeploymentByRevisionStub != nil {
+		return fake.CreateApplicationDeploymentByRevisionStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createApplicationDeploymentByRevisionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCallCount() int {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	return len(fake.createApplicationDeploymentByRevisionArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionCalls(stub func(string, string) (string, ccv3.Warnings, error)) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	fake.CreateApplicationDeploymentByRevisionStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionArgsForCall(i int) (string, string) {
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
+	argsForCall := fake.createApplicationDeploymentByRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturns(result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	fake.createApplicationDeploymentByRevisionReturns = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateApplicationDeploymentByRevisionReturnsOnCall(i int, result1 string, result2 ccv3.Warnings, result3 error) {
+	fake.createApplicationDeploymentByRevisionMutex.Lock()
+	defer fake.createApplicationDeploymentByRevisionMutex.Unlock()
+	fake.CreateApplicationDeploymentByRevisionStub = nil
+	if fake.createApplicationDeploymentByRevisionReturnsOnCall == nil {
+		fake.createApplicationDeploymentByRevisionReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createApplicationDeploymentByRevisionReturnsOnCall[i] = struct {
+		result1 string
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateApplicationProcessScale(arg1 string, arg2 ccv3.Process) (ccv3.Process, ccv3.Warnings, error) {
 	fake.createApplicationProcessScaleMutex.Lock()
 	ret, specificReturn := fake.createApplicationProcessScaleReturnsOnCall[len(fake.createApplicationProcessScaleArgsForCall)]
@@ -1198,6 +1527,72 @@ func (fake *FakeCloudControllerClient) CreateBuildReturnsOnCall(i int, result1 c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateDomain(arg1 ccv3.Domain) (ccv3.Domain, ccv3.Warnings, error) {
+	fake.createDomainMutex.Lock()
+	ret, specificReturn := fake.createDomainReturnsOnCall[len(fake.createDomainArgsForCall)]
+	fake.createDomainArgsForCall = append(fake.createDomainArgsForCall, struct {
+		arg1 ccv3.Domain
+	}{arg1})
+	fake.recordInvocation("CreateDomain", []interface{}{arg1})
+	fake.createDomainMutex.Unlock()
+	if fake.CreateDomainStub != nil {
+		return fake.CreateDomainStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createDomainReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateDomainCallCount() int {
+	fake.createDomainMutex.RLock()
+	defer fake.createDomainMutex.RUnlock()
+	return len(fake.createDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateDomainCalls(stub func(ccv3.Domain) (ccv3.Domain, ccv3.Warnings, error)) {
+	fake.createDomainMutex.Lock()
+	defer fake.createDomainMutex.Unlock()
+	fake.CreateDomainStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateDomainArgsForCall(i int) ccv3.Domain {
+	fake.createDomainMutex.RLock()
+	defer fake.createDomainMutex.RUnlock()
+	argsForCall := fake.createDomainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateDomainReturns(result1 ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.createDomainMutex.Lock()
+	defer fake.createDomainMutex.Unlock()
+	fake.CreateDomainStub = nil
+	fake.createDomainReturns = struct {
+		result1 ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateDomainReturnsOnCall(i int, result1 ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.createDomainMutex.Lock()
+	defer fake.createDomainMutex.Unlock()
+	fake.CreateDomainStub = nil
+	if fake.createDomainReturnsOnCall == nil {
+		fake.createDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createDomainReturnsOnCall[i] = struct {
+		result1 ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) CreateIsolationSegment(arg1 ccv3.IsolationSegment) (ccv3.IsolationSegment, ccv3.Warnings, error) {
 	fake.createIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.createIsolationSegmentReturnsOnCall[len(fake.createIsolationSegmentArgsForCall)]
@@ -1330,6 +1725,72 @@ func (fake *FakeCloudControllerClient) CreatePackageReturnsOnCall(i int, result1
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) CreateRoute(arg1 ccv3.Route) (ccv3.Route, ccv3.Warnings, error) {
+	fake.createRouteMutex.Lock()
+	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
+	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
+		arg1 ccv3.Route
+	}{arg1})
+	fake.recordInvocation("CreateRoute", []interface{}{arg1})
+	fake.createRouteMutex.Unlock()
+	if fake.CreateRouteStub != nil {
+		return fake.CreateRouteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteCallCount() int {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return len(fake.createRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteCalls(stub func(ccv3.Route) (ccv3.Route, ccv3.Warnings, error)) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = stub
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteArgsForCall(i int) ccv3.Route {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	argsForCall := fake.createRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteReturns(result1 ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	fake.createRouteReturns = struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) CreateRouteReturnsOnCall(i int, result1 ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	if fake.createRouteReturnsOnCall == nil {
+		fake.createRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.createRouteReturnsOnCall[i] = struct {
+		result1 ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteApplication(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
 	fake.deleteApplicationMutex.Lock()
 	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
@@ -1461,6 +1922,72 @@ func (fake *FakeCloudControllerClient) DeleteApplicationProcessInstanceReturnsOn
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteDomain(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteDomainMutex.Lock()
+	ret, specificReturn := fake.deleteDomainReturnsOnCall[len(fake.deleteDomainArgsForCall)]
+	fake.deleteDomainArgsForCall = append(fake.deleteDomainArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteDomain", []interface{}{arg1})
+	fake.deleteDomainMutex.Unlock()
+	if fake.DeleteDomainStub != nil {
+		return fake.DeleteDomainStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteDomainReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteDomainCallCount() int {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	return len(fake.deleteDomainArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteDomainCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteDomainArgsForCall(i int) string {
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
+	argsForCall := fake.deleteDomainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteDomainReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	fake.deleteDomainReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteDomainReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteDomainMutex.Lock()
+	defer fake.deleteDomainMutex.Unlock()
+	fake.DeleteDomainStub = nil
+	if fake.deleteDomainReturnsOnCall == nil {
+		fake.deleteDomainReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteDomainReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteIsolationSegment(arg1 string) (ccv3.Warnings, error) {
 	fake.deleteIsolationSegmentMutex.Lock()
 	ret, specificReturn := fake.deleteIsolationSegmentReturnsOnCall[len(fake.deleteIsolationSegmentArgsForCall)]
@@ -1588,6 +2115,72 @@ func (fake *FakeCloudControllerClient) DeleteIsolationSegmentOrganizationReturns
 	}{result1, result2}
 }
 
+func (fake *FakeCloudControllerClient) DeleteRoute(arg1 string) (ccv3.JobURL, ccv3.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteRoute", []interface{}{arg1})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.deleteRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteCalls(stub func(string) (ccv3.JobURL, ccv3.Warnings, error)) {
+	fake.deleteRouteMutex.Lock()
+	defer fake.deleteRouteMutex.Unlock()
+	fake.DeleteRouteStub = stub
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	argsForCall := fake.deleteRouteArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteReturns(result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteRouteMutex.Lock()
+	defer fake.deleteRouteMutex.Unlock()
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) DeleteRouteReturnsOnCall(i int, result1 ccv3.JobURL, result2 ccv3.Warnings, result3 error) {
+	fake.deleteRouteMutex.Lock()
+	defer fake.deleteRouteMutex.Unlock()
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv3.JobURL
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 ccv3.JobURL
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) DeleteServiceInstanceRelationshipsSharedSpace(arg1 string, arg2 string) (ccv3.Warnings, error) {
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.Lock()
 	ret, specificReturn := fake.deleteServiceInstanceRelationshipsSharedSpaceReturnsOnCall[len(fake.deleteServiceInstanceRelationshipsSharedSpaceArgsForCall)]
@@ -2055,6 +2648,140 @@ func (fake *FakeCloudControllerClient) GetApplicationProcessesReturnsOnCall(i in
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetApplicationRevisions(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	ret, specificReturn := fake.getApplicationRevisionsReturnsOnCall[len(fake.getApplicationRevisionsArgsForCall)]
+	fake.getApplicationRevisionsArgsForCall = append(fake.getApplicationRevisionsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationRevisions", []interface{}{arg1, arg2})
+	fake.getApplicationRevisionsMutex.Unlock()
+	if fake.GetApplicationRevisionsStub != nil {
+		return fake.GetApplicationRevisionsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRevisionsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCallCount() int {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	return len(fake.getApplicationRevisionsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsCalls(stub func(string, ...ccv3.Query) ([]ccv3.Revision, ccv3.Warnings, error)) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	argsForCall := fake.getApplicationRevisionsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturns(result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = nil
+	fake.getApplicationRevisionsReturns = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationRevisionsReturnsOnCall(i int, result1 []ccv3.Revision, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationRevisionsMutex.Lock()
+	defer fake.getApplicationRevisionsMutex.Unlock()
+	fake.GetApplicationRevisionsStub = nil
+	if fake.getApplicationRevisionsReturnsOnCall == nil {
+		fake.getApplicationRevisionsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Revision
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRevisionsReturnsOnCall[i] = struct {
+		result1 []ccv3.Revision
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecars(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	ret, specificReturn := fake.getApplicationSidecarsReturnsOnCall[len(fake.getApplicationSidecarsArgsForCall)]
+	fake.getApplicationSidecarsArgsForCall = append(fake.getApplicationSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationSidecars", []interface{}{arg1, arg2})
+	fake.getApplicationSidecarsMutex.Unlock()
+	if fake.GetApplicationSidecarsStub != nil {
+		return fake.GetApplicationSidecarsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationSidecarsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCallCount() int {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	return len(fake.getApplicationSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsCalls(stub func(string, ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error)) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
+	argsForCall := fake.getApplicationSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturns(result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	fake.getApplicationSidecarsReturns = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetApplicationSidecarsReturnsOnCall(i int, result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getApplicationSidecarsMutex.Lock()
+	defer fake.getApplicationSidecarsMutex.Unlock()
+	fake.GetApplicationSidecarsStub = nil
+	if fake.getApplicationSidecarsReturnsOnCall == nil {
+		fake.getApplicationSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationSidecarsReturnsOnCall[i] = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetApplicationTasks(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error) {
 	fake.getApplicationTasksMutex.Lock()
 	ret, specificReturn := fake.getApplicationTasksReturnsOnCall[len(fake.getApplicationTasksArgsForCall)]
@@ -2236,19 +2963,151 @@ func (fake *FakeCloudControllerClient) GetBuildReturns(result1 ccv3.Build, resul
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
-	fake.getBuildMutex.Lock()
-	defer fake.getBuildMutex.Unlock()
-	fake.GetBuildStub = nil
-	if fake.getBuildReturnsOnCall == nil {
-		fake.getBuildReturnsOnCall = make(map[int]struct {
-			result1 ccv3.Build
+func (fake *FakeCloudControllerClient) GetBuildReturnsOnCall(i int, result1 ccv3.Build, result2 ccv3.Warnings, result3 error) {
+	fake.getBuildMutex.Lock()
+	defer fake.getBuildMutex.Unlock()
+	fake.GetBuildStub = nil
+	if fake.getBuildReturnsOnCall == nil {
+		fake.getBuildReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Build
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getBuildReturnsOnCall[i] = struct {
+		result1 ccv3.Build
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeployment(arg1 string) (ccv3.Deployment, ccv3.Warnings, error) {
+	fake.getDeploymentMutex.Lock()
+	ret, specificReturn := fake.getDeploymentReturnsOnCall[len(fake.getDeploymentArgsForCall)]
+	fake.getDeploymentArgsForCall = append(fake.getDeploymentArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDeployment", []interface{}{arg1})
+	fake.getDeploymentMutex.Unlock()
+	if fake.GetDeploymentStub != nil {
+		return fake.GetDeploymentStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDeploymentReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCallCount() int {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	return len(fake.getDeploymentArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentCalls(stub func(string) (ccv3.Deployment, ccv3.Warnings, error)) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentArgsForCall(i int) string {
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	argsForCall := fake.getDeploymentArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturns(result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	fake.getDeploymentReturns = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDeploymentReturnsOnCall(i int, result1 ccv3.Deployment, result2 ccv3.Warnings, result3 error) {
+	fake.getDeploymentMutex.Lock()
+	defer fake.getDeploymentMutex.Unlock()
+	fake.GetDeploymentStub = nil
+	if fake.getDeploymentReturnsOnCall == nil {
+		fake.getDeploymentReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Deployment
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getDeploymentReturnsOnCall[i] = struct {
+		result1 ccv3.Deployment
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomains(arg1 ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error) {
+	fake.getDomainsMutex.Lock()
+	ret, specificReturn := fake.getDomainsReturnsOnCall[len(fake.getDomainsArgsForCall)]
+	fake.getDomainsArgsForCall = append(fake.getDomainsArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetDomains", []interface{}{arg1})
+	fake.getDomainsMutex.Unlock()
+	if fake.GetDomainsStub != nil {
+		return fake.GetDomainsStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDomainsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCallCount() int {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	return len(fake.getDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsCalls(stub func(...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsArgsForCall(i int) []ccv3.Query {
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
+	argsForCall := fake.getDomainsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturns(result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	fake.getDomainsReturns = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetDomainsReturnsOnCall(i int, result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getDomainsMutex.Lock()
+	defer fake.getDomainsMutex.Unlock()
+	fake.GetDomainsStub = nil
+	if fake.getDomainsReturnsOnCall == nil {
+		fake.getDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Domain
 			result2 ccv3.Warnings
 			result3 error
 		})
 	}
-	fake.getBuildReturnsOnCall[i] = struct {
-		result1 ccv3.Build
+	fake.getDomainsReturnsOnCall[i] = struct {
+		result1 []ccv3.Domain
 		result2 ccv3.Warnings
 		result3 error
 	}{result1, result2, result3}
@@ -2650,6 +3509,73 @@ func (fake *FakeCloudControllerClient) GetOrganizationDefaultIsolationSegmentRet
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetOrganizationDomains(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
+	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetOrganizationDomains", []interface{}{arg1, arg2})
+	fake.getOrganizationDomainsMutex.Unlock()
+	if fake.GetOrganizationDomainsStub != nil {
+		return fake.GetOrganizationDomainsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationDomainsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDomainsCallCount() int {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return len(fake.getOrganizationDomainsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDomainsCalls(stub func(string, ...ccv3.Query) ([]ccv3.Domain, ccv3.Warnings, error)) {
+	fake.getOrganizationDomainsMutex.Lock()
+	defer fake.getOrganizationDomainsMutex.Unlock()
+	fake.GetOrganizationDomainsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDomainsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	argsForCall := fake.getOrganizationDomainsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDomainsReturns(result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	defer fake.getOrganizationDomainsMutex.Unlock()
+	fake.GetOrganizationDomainsStub = nil
+	fake.getOrganizationDomainsReturns = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetOrganizationDomainsReturnsOnCall(i int, result1 []ccv3.Domain, result2 ccv3.Warnings, result3 error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	defer fake.getOrganizationDomainsMutex.Unlock()
+	fake.GetOrganizationDomainsStub = nil
+	if fake.getOrganizationDomainsReturnsOnCall == nil {
+		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Domain
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
+		result1 []ccv3.Domain
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetOrganizations(arg1 ...ccv3.Query) ([]ccv3.Organization, ccv3.Warnings, error) {
 	fake.getOrganizationsMutex.Lock()
 	ret, specificReturn := fake.getOrganizationsReturnsOnCall[len(fake.getOrganizationsArgsForCall)]
@@ -2914,6 +3840,271 @@ func (fake *FakeCloudControllerClient) GetProcessInstancesReturnsOnCall(i int, r
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) GetProcessSidecars(arg1 string, arg2 ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error) {
+	fake.getProcessSidecarsMutex.Lock()
+	ret, specificReturn := fake.getProcessSidecarsReturnsOnCall[len(fake.getProcessSidecarsArgsForCall)]
+	fake.getProcessSidecarsArgsForCall = append(fake.getProcessSidecarsArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.Query
+	}{arg1, arg2})
+	fake.recordInvocation("GetProcessSidecars", []interface{}{arg1, arg2})
+	fake.getProcessSidecarsMutex.Unlock()
+	if fake.GetProcessSidecarsStub != nil {
+		return fake.GetProcessSidecarsStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProcessSidecarsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetProcessSidecarsCallCount() int {
+	fake.getProcessSidecarsMutex.RLock()
+	defer fake.getProcessSidecarsMutex.RUnlock()
+	return len(fake.getProcessSidecarsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetProcessSidecarsCalls(stub func(string, ...ccv3.Query) ([]ccv3.Sidecar, ccv3.Warnings, error)) {
+	fake.getProcessSidecarsMutex.Lock()
+	defer fake.getProcessSidecarsMutex.Unlock()
+	fake.GetProcessSidecarsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetProcessSidecarsArgsForCall(i int) (string, []ccv3.Query) {
+	fake.getProcessSidecarsMutex.RLock()
+	defer fake.getProcessSidecarsMutex.RUnlock()
+	argsForCall := fake.getProcessSidecarsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) GetProcessSidecarsReturns(result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getProcessSidecarsMutex.Lock()
+	defer fake.getProcessSidecarsMutex.Unlock()
+	fake.GetProcessSidecarsStub = nil
+	fake.getProcessSidecarsReturns = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetProcessSidecarsReturnsOnCall(i int, result1 []ccv3.Sidecar, result2 ccv3.Warnings, result3 error) {
+	fake.getProcessSidecarsMutex.Lock()
+	defer fake.getProcessSidecarsMutex.Unlock()
+	fake.GetProcessSidecarsStub = nil
+	if fake.getProcessSidecarsReturnsOnCall == nil {
+		fake.getProcessSidecarsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Sidecar
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessSidecarsReturnsOnCall[i] = struct {
+		result1 []ccv3.Sidecar
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariables(arg1 string) (ccv3.EnvironmentVariables, ccv3.Warnings, error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.getRevisionEnvironmentVariablesReturnsOnCall[len(fake.getRevisionEnvironmentVariablesArgsForCall)]
+	fake.getRevisionEnvironmentVariablesArgsForCall = append(fake.getRevisionEnvironmentVariablesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRevisionEnvironmentVariables", []interface{}{arg1})
+	fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	if fake.GetRevisionEnvironmentVariablesStub != nil {
+		return fake.GetRevisionEnvironmentVariablesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRevisionEnvironmentVariablesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCallCount() int {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	return len(fake.getRevisionEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesCalls(stub func(string) (ccv3.EnvironmentVariables, ccv3.Warnings, error)) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesArgsForCall(i int) string {
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	argsForCall := fake.getRevisionEnvironmentVariablesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturns(result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	fake.getRevisionEnvironmentVariablesReturns = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRevisionEnvironmentVariablesReturnsOnCall(i int, result1 ccv3.EnvironmentVariables, result2 ccv3.Warnings, result3 error) {
+	fake.getRevisionEnvironmentVariablesMutex.Lock()
+	defer fake.getRevisionEnvironmentVariablesMutex.Unlock()
+	fake.GetRevisionEnvironmentVariablesStub = nil
+	if fake.getRevisionEnvironmentVariablesReturnsOnCall == nil {
+		fake.getRevisionEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 ccv3.EnvironmentVariables
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRevisionEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 ccv3.EnvironmentVariables
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinations(arg1 string) ([]ccv3.RouteDestination, ccv3.Warnings, error) {
+	fake.getRouteDestinationsMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsReturnsOnCall[len(fake.getRouteDestinationsArgsForCall)]
+	fake.getRouteDestinationsArgsForCall = append(fake.getRouteDestinationsArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetRouteDestinations", []interface{}{arg1})
+	fake.getRouteDestinationsMutex.Unlock()
+	if fake.GetRouteDestinationsStub != nil {
+		return fake.GetRouteDestinationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteDestinationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsCallCount() int {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	return len(fake.getRouteDestinationsArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsCalls(stub func(string) ([]ccv3.RouteDestination, ccv3.Warnings, error)) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsArgsForCall(i int) string {
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	argsForCall := fake.getRouteDestinationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturns(result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = nil
+	fake.getRouteDestinationsReturns = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRouteDestinationsReturnsOnCall(i int, result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.getRouteDestinationsMutex.Lock()
+	defer fake.getRouteDestinationsMutex.Unlock()
+	fake.GetRouteDestinationsStub = nil
+	if fake.getRouteDestinationsReturnsOnCall == nil {
+		fake.getRouteDestinationsReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.RouteDestination
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsReturnsOnCall[i] = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutes(arg1 ...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error) {
+	fake.getRoutesMutex.Lock()
+	ret, specificReturn := fake.getRoutesReturnsOnCall[len(fake.getRoutesArgsForCall)]
+	fake.getRoutesArgsForCall = append(fake.getRoutesArgsForCall, struct {
+		arg1 []ccv3.Query
+	}{arg1})
+	fake.recordInvocation("GetRoutes", []interface{}{arg1})
+	fake.getRoutesMutex.Unlock()
+	if fake.GetRoutesStub != nil {
+		return fake.GetRoutesStub(arg1...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCallCount() int {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	return len(fake.getRoutesArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesCalls(stub func(...ccv3.Query) ([]ccv3.Route, ccv3.Warnings, error)) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = stub
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesArgsForCall(i int) []ccv3.Query {
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
+	argsForCall := fake.getRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturns(result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = nil
+	fake.getRoutesReturns = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) GetRoutesReturnsOnCall(i int, result1 []ccv3.Route, result2 ccv3.Warnings, result3 error) {
+	fake.getRoutesMutex.Lock()
+	defer fake.getRoutesMutex.Unlock()
+	fake.GetRoutesStub = nil
+	if fake.getRoutesReturnsOnCall == nil {
+		fake.getRoutesReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.Route
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesReturnsOnCall[i] = struct {
+		result1 []ccv3.Route
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) GetServiceInstances(arg1 ...ccv3.Query) ([]ccv3.ServiceInstance, ccv3.Warnings, error) {
 	fake.getServiceInstancesMutex.Lock()
 	ret, specificReturn := fake.getServiceInstancesReturnsOnCall[len(fake.getServiceInstancesArgsForCall)]
@@ -3112,6 +4303,73 @@ func (fake *FakeCloudControllerClient) GetSpacesReturnsOnCall(i int, result1 []c
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) MapRoute(arg1 string, arg2 ...ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error) {
+	fake.mapRouteMutex.Lock()
+	ret, specificReturn := fake.mapRouteReturnsOnCall[len(fake.mapRouteArgsForCall)]
+	fake.mapRouteArgsForCall = append(fake.mapRouteArgsForCall, struct {
+		arg1 string
+		arg2 []ccv3.RouteDestination
+	}{arg1, arg2})
+	fake.recordInvocation("MapRoute", []interface{}{arg1, arg2})
+	fake.mapRouteMutex.Unlock()
+	if fake.MapRouteStub != nil {
+		return fake.MapRouteStub(arg1, arg2...)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.mapRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) MapRouteCallCount() int {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	return len(fake.mapRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) MapRouteCalls(stub func(string, ...ccv3.RouteDestination) ([]ccv3.RouteDestination, ccv3.Warnings, error)) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = stub
+}
+
+func (fake *FakeCloudControllerClient) MapRouteArgsForCall(i int) (string, []ccv3.RouteDestination) {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	argsForCall := fake.mapRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) MapRouteReturns(result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	fake.mapRouteReturns = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) MapRouteReturnsOnCall(i int, result1 []ccv3.RouteDestination, result2 ccv3.Warnings, result3 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	if fake.mapRouteReturnsOnCall == nil {
+		fake.mapRouteReturnsOnCall = make(map[int]struct {
+			result1 []ccv3.RouteDestination
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.mapRouteReturnsOnCall[i] = struct {
+		result1 []ccv3.RouteDestination
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) PatchApplicationProcessHealthCheck(arg1 string, arg2 string, arg3 string, arg4 int) (ccv3.Process, ccv3.Warnings, error) {
 	fake.patchApplicationProcessHealthCheckMutex.Lock()
 	ret, specificReturn := fake.patchApplicationProcessHealthCheckReturnsOnCall[len(fake.patchApplicationProcessHealthCheckArgsForCall)]
@@ -3383,6 +4641,70 @@ func (fake *FakeCloudControllerClient) ShareServiceInstanceToSpacesReturnsOnCall
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UnmapRoute(arg1 string, arg2 string) (ccv3.Warnings, error) {
+	fake.unmapRouteMutex.Lock()
+	ret, specificReturn := fake.unmapRouteReturnsOnCall[len(fake.unmapRouteArgsForCall)]
+	fake.unmapRouteArgsForCall = append(fake.unmapRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("UnmapRoute", []interface{}{arg1, arg2})
+	fake.unmapRouteMutex.Unlock()
+	if fake.UnmapRouteStub != nil {
+		return fake.UnmapRouteStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unmapRouteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteCallCount() int {
+	fake.unmapRouteMutex.RLock()
+	defer fake.unmapRouteMutex.RUnlock()
+	return len(fake.unmapRouteArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteCalls(stub func(string, string) (ccv3.Warnings, error)) {
+	fake.unmapRouteMutex.Lock()
+	defer fake.unmapRouteMutex.Unlock()
+	fake.UnmapRouteStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteArgsForCall(i int) (string, string) {
+	fake.unmapRouteMutex.RLock()
+	defer fake.unmapRouteMutex.RUnlock()
+	argsForCall := fake.unmapRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteReturns(result1 ccv3.Warnings, result2 error) {
+	fake.unmapRouteMutex.Lock()
+	defer fake.unmapRouteMutex.Unlock()
+	fake.UnmapRouteStub = nil
+	fake.unmapRouteReturns = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCloudControllerClient) UnmapRouteReturnsOnCall(i int, result1 ccv3.Warnings, result2 error) {
+	fake.unmapRouteMutex.Lock()
+	defer fake.unmapRouteMutex.Unlock()
+	fake.UnmapRouteStub = nil
+	if fake.unmapRouteReturnsOnCall == nil {
+		fake.unmapRouteReturnsOnCall = make(map[int]struct {
+			result1 ccv3.Warnings
+			result2 error
+		})
+	}
+	fake.unmapRouteReturnsOnCall[i] = struct {
+		result1 ccv3.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCloudControllerClient) UpdateApplication(arg1 ccv3.Application) (ccv3.Application, ccv3.Warnings, error) {
 	fake.updateApplicationMutex.Lock()
 	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
@@ -3853,6 +5175,74 @@ func (fake *FakeCloudControllerClient) UpdateOrganizationDefaultIsolationSegment
 	}{result1, result2, result3}
 }
 
+func (fake *FakeCloudControllerClient) UpdateResourceMetadata(arg1 string, arg2 string, arg3 ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error) {
+	fake.updateResourceMetadataMutex.Lock()
+	ret, specificReturn := fake.updateResourceMetadataReturnsOnCall[len(fake.updateResourceMetadataArgsForCall)]
+	fake.updateResourceMetadataArgsForCall = append(fake.updateResourceMetadataArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 ccv3.Metadata
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("UpdateResourceMetadata", []interface{}{arg1, arg2, arg3})
+	fake.updateResourceMetadataMutex.Unlock()
+	if fake.UpdateResourceMetadataStub != nil {
+		return fake.UpdateResourceMetadataStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.updateResourceMetadataReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataCallCount() int {
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
+	return len(fake.updateResourceMetadataArgsForCall)
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataCalls(stub func(string, string, ccv3.Metadata) (ccv3.ResourceMetadata, ccv3.Warnings, error)) {
+	fake.updateResourceMetadataMutex.Lock()
+	defer fake.updateResourceMetadataMutex.Unlock()
+	fake.UpdateResourceMetadataStub = stub
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataArgsForCall(i int) (string, string, ccv3.Metadata) {
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
+	argsForCall := fake.updateResourceMetadataArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturns(result1 ccv3.ResourceMetadata, result2 ccv3.Warnings, result3 error) {
+	fake.updateResourceMetadataMutex.Lock()
+	defer fake.updateResourceMetadataMutex.Unlock()
+	fake.UpdateResourceMetadataStub = nil
+	fake.updateResourceMetadataReturns = struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeCloudControllerClient) UpdateResourceMetadataReturnsOnCall(i int, result1 ccv3.ResourceMetadata, result2 ccv3.Warnings, result3 error) {
+	fake.updateResourceMetadataMutex.Lock()
+	defer fake.updateResourceMetadataMutex.Unlock()
+	fake.UpdateResourceMetadataStub = nil
+	if fake.updateResourceMetadataReturnsOnCall == nil {
+		fake.updateResourceMetadataReturnsOnCall = make(map[int]struct {
+			result1 ccv3.ResourceMetadata
+			result2 ccv3.Warnings
+			result3 error
+		})
+	}
+	fake.updateResourceMetadataReturnsOnCall[i] = struct {
+		result1 ccv3.ResourceMetadata
+		result2 ccv3.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeCloudControllerClient) UpdateSpaceIsolationSegmentRelationship(arg1 string, arg2 string) (ccv3.Relationship, ccv3.Warnings, error) {
 	fake.updateSpaceIsolationSegmentRelationshipMutex.Lock()
 	ret, specificReturn := fake.updateSpaceIsolationSegmentRelationshipReturnsOnCall[len(fake.updateSpaceIsolationSegmentRelationshipArgsForCall)]
@@ -4138,24 +5528,34 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
+	fake.createApplicationDeploymentByRevisionMutex.RLock()
+	defer fake.createApplicationDeploymentByRevisionMutex.RUnlock()
 	fake.createApplicationProcessScaleMutex.RLock()
 	defer fake.createApplicationProcessScaleMutex.RUnlock()
 	fake.createApplicationTaskMutex.RLock()
 	defer fake.createApplicationTaskMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createDomainMutex.RLock()
+	defer fake.createDomainMutex.RUnlock()
 	fake.createIsolationSegmentMutex.RLock()
 	defer fake.createIsolationSegmentMutex.RUnlock()
 	fake.createPackageMutex.RLock()
 	defer fake.createPackageMutex.RUnlock()
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
 	fake.deleteApplicationMutex.RLock()
 	defer fake.deleteApplicationMutex.RUnlock()
 	fake.deleteApplicationProcessInstanceMutex.RLock()
 	defer fake.deleteApplicationProcessInstanceMutex.RUnlock()
+	fake.deleteDomainMutex.RLock()
+	defer fake.deleteDomainMutex.RUnlock()
 	fake.deleteIsolationSegmentMutex.RLock()
 	defer fake.deleteIsolationSegmentMutex.RUnlock()
 	fake.deleteIsolationSegmentOrganizationMutex.RLock()
 	defer fake.deleteIsolationSegmentOrganizationMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
 	fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RLock()
 	defer fake.deleteServiceInstanceRelationshipsSharedSpaceMutex.RUnlock()
 	fake.entitleIsolationSegmentToOrganizationsMutex.RLock()
@@ -4170,12 +5570,20 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getApplicationProcessByTypeMutex.RUnlock()
 	fake.getApplicationProcessesMutex.RLock()
 	defer fake.getApplicationProcessesMutex.RUnlock()
+	fake.getApplicationRevisionsMutex.RLock()
+	defer fake.getApplicationRevisionsMutex.RUnlock()
+	fake.getApplicationSidecarsMutex.RLock()
+	defer fake.getApplicationSidecarsMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
 	fake.getApplicationsMutex.RLock()
 	defer fake.getApplicationsMutex.RUnlock()
 	fake.getBuildMutex.RLock()
 	defer fake.getBuildMutex.RUnlock()
+	fake.getDeploymentMutex.RLock()
+	defer fake.getDeploymentMutex.RUnlock()
+	fake.getDomainsMutex.RLock()
+	defer fake.getDomainsMutex.RUnlock()
 	fake.getDropletMutex.RLock()
 	defer fake.getDropletMutex.RUnlock()
 	fake.getDropletsMutex.RLock()
@@ -4188,6 +5596,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getIsolationSegmentsMutex.RUnlock()
 	fake.getOrganizationDefaultIsolationSegmentMutex.RLock()
 	defer fake.getOrganizationDefaultIsolationSegmentMutex.RUnlock()
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
 	fake.getOrganizationsMutex.RLock()
 	defer fake.getOrganizationsMutex.RUnlock()
 	fake.getPackageMutex.RLock()
@@ -4196,12 +5606,22 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.getPackagesMutex.RUnlock()
 	fake.getProcessInstancesMutex.RLock()
 	defer fake.getProcessInstancesMutex.RUnlock()
+	fake.getProcessSidecarsMutex.RLock()
+	defer fake.getProcessSidecarsMutex.RUnlock()
+	fake.getRevisionEnvironmentVariablesMutex.RLock()
+	defer fake.getRevisionEnvironmentVariablesMutex.RUnlock()
+	fake.getRouteDestinationsMutex.RLock()
+	defer fake.getRouteDestinationsMutex.RUnlock()
+	fake.getRoutesMutex.RLock()
+	defer fake.getRoutesMutex.RUnlock()
 	fake.getServiceInstancesMutex.RLock()
 	defer fake.getServiceInstancesMutex.RUnlock()
 	fake.getSpaceIsolationSegmentMutex.RLock()
 	defer fake.getSpaceIsolationSegmentMutex.RUnlock()
 	fake.getSpacesMutex.RLock()
 	defer fake.getSpacesMutex.RUnlock()
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
 	fake.patchApplicationProcessHealthCheckMutex.RLock()
 	defer fake.patchApplicationProcessHealthCheckMutex.RUnlock()
 	fake.pollJobMutex.RLock()
@@ -4210,6 +5630,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.setApplicationDropletMutex.RUnlock()
 	fake.shareServiceInstanceToSpacesMutex.RLock()
 	defer fake.shareServiceInstanceToSpacesMutex.RUnlock()
+	fake.unmapRouteMutex.RLock()
+	defer fake.unmapRouteMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
 	fake.updateApplicationApplyManifestMutex.RLock()
@@ -4224,6 +5646,8 @@ func (fake *FakeCloudControllerClient) Invocations() map[string][][]interface{}
 	defer fake.updateApplicationStopMutex.RUnlock()
 	fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateOrganizationDefaultIsolationSegmentRelationshipMutex.RUnlock()
+	fake.updateResourceMetadataMutex.RLock()
+	defer fake.updateResourceMetadataMutex.RUnlock()
 	fake.updateSpaceIsolationSegmentRelationshipMutex.RLock()
 	defer fake.updateSpaceIsolationSegmentRelationshipMutex.RUnlock()
 	fake.updateTaskCancelMutex.RLock()
@@ -1,121 +0,0 @@
-// Code generated by counterfeiter. DO NOT EDIT.
-package v7actionfakes
-
-import (
-	sync "sync"
-
-	v2action "code.cloudfoundry.org/cli/actor/v2action"
-	v7action "code.cloudfoundry.org/cli/actor/v7action"
-)
-
-type FakeRouteActor struct {
-	GetApplicationRoutesStub        func(string) (v2action.Routes, v2action.Warnings, error)
-	getApplicationRoutesMutex       sync.RWMutex
-	getApplicationRoutesArgsForCall []struct {
-		arg1 string
-	}
-	getApplicationRoutesReturns struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}
-	getApplicationRoutesReturnsOnCall map[int]struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutes(arg1 string) (v2action.Routes, v2action.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{arg1})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.getApplicationRoutesReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutesCalls(stub func(string) (v2action.Routes, v2action.Warnings, error)) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = stub
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutesArgsForCall(i int) string {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	argsForCall := fake.getApplicationRoutesArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutesReturns(result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeRouteActor) GetApplicationRoutesReturnsOnCall(i int, result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = nil
-	if fake.getApplicationRoutesReturnsOnCall == nil {
-		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
-			result1 v2action.Routes
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.getApplicationRoutesReturnsOnCall[i] = struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeRouteActor) Invocations() map[string][][]interface{} {
-	fake.invocationsMutex.RLock()
-	defer fake.invocationsMutex.RUnlock()
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	copiedInvocations := map[string][][]interface{}{}
-	for key, value := range fake.invocations {
-		copiedInvocations[key] = value
-	}
-	return copiedInvocations
-}
-
-func (fake *FakeRouteActor) recordInvocation(key string, args []interface{}) {
-	fake.invocationsMutex.Lock()
-	defer fake.invocationsMutex.Unlock()
-	if fake.invocations == nil {
-		fake.invocations = map[string][][]interface{}{}
-	}
-	if fake.invocations[key] == nil {
-		fake.invocations[key] = [][]interface{}{}
-	}
-	fake.invocations[key] = append(fake.invocations[key], args)
-}
-
-var _ v7action.RouteActor = new(FakeRouteActor)
@@ -0,0 +1,93 @@
+package v7pushaction
+
+import (
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AppEvent is an event, a set of warnings, an updated push state or an error
+// of a single application, emitted by ActualizeConcurrently.
+type AppEvent struct {
+	AppName  string
+	Event    Event
+	Warnings Warnings
+	State    *PushState
+	Err      error
+}
+
+// ActualizeConcurrently actualizes up to parallelism push states at the same
+// time and multiplexes the streams of every application into a single stream
+// of application events. Each application gets its own progress bar from
+// newProgressBar. The stream is closed once every application is either
+// complete or failed; a failing application does not stop the others.
+func (actor Actor) ActualizeConcurrently(states []PushState, parallelism int, newProgressBar func(appName string) ProgressBar) <-chan AppEvent {
+	if parallelism < 1 {
+		parallelism = 1
+	}
+
+	appEventStream := make(chan AppEvent)
+
+	go func() {
+		defer close(appEventStream)
+
+		semaphore := make(chan struct{}, parallelism)
+		var wg sync.WaitGroup
+		for _, state := range states {
+			semaphore <- struct{}{}
+			wg.Add(1)
+			go func(state PushState) {
+				defer wg.Done()
+				defer func() { <-semaphore }()
+
+				appName := state.Application.Name
+				log.WithField("app_name", appName).Info("actualizing concurrently")
+				stateStream, eventStream, warningsStream, errorStream := actor.Actualize(state, newProgressBar(appName))
+				forwardAppEvents(appName, stateStream, eventStream, warningsStream, errorStream, appEventStream)
+			}(state)
+		}
+		wg.Wait()
+	}()
+
+	return appEventStream
+}
+
+func forwardAppEvents(
+	appName string,
+	stateStream <-chan PushState,
+	eventStream <-chan Event,
+	warningsStream <-chan Warnings,
+	errorStream <-chan error,
+	appEventStream chan<- AppEvent,
+) {
+	for stateStream != nil || eventStream != nil || warningsStream != nil || errorStream != nil {
+		select {
+		case state, ok := <-stateStream:
+			if !ok {
+				stateStream = nil
+				break
+			}
+			appEventStream <- AppEvent{AppName: appName, State: &state}
+		case event, ok := <-eventStream:
+			if !ok {
+				eventStream = nil
+				break
+			}
+			appEventStream <- AppEvent{AppName: appName, Event: event}
+		case warnings, ok := <-warningsStream:
+			if !ok {
+				warningsStream = nil
+				break
+			}
+			if len(warnings) > 0 {
+				appEventStream <- AppEvent{AppName: appName, Warnings: warnings}
+			}
+		case err, ok := <-errorStream:
+			if !ok {
+				errorStream = nil
+				break
+			}
+			appEventStream <- AppEvent{AppName: appName, Err: err}
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package v7pushaction_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ActualizeConcurrently", func() {
+	var (
+		actor           *Actor
+		fakeV7Actor     *v7pushactionfakes.FakeV7Actor
+		fakeSharedActor *v7pushactionfakes.FakeSharedActor
+
+		states       []PushState
+		parallelism  int
+		progressBars map[string]*v7pushactionfakes.FakeProgressBar
+		barsLock     sync.Mutex
+
+		appEvents []AppEvent
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, fakeSharedActor = getTestPushActor()
+		fakeSharedActor.ReadArchiveReturns(new(v7pushactionfakes.FakeReadCloser), 0, nil)
+
+		fakeV7Actor.CreateApplicationInSpaceStub = func(app v7action.Application, _ string) (v7action.Application, v7action.Warnings, error) {
+			app.GUID = app.Name + "-guid"
+			return app, v7action.Warnings{app.Name + "-warning"}, nil
+		}
+
+		states = []PushState{
+			{Application: v7action.Application{Name: "app-1"}},
+			{Application: v7action.Application{Name: "app-2"}},
+			{Application: v7action.Application{Name: "app-3"}},
+		}
+		parallelism = 2
+		progressBars = map[string]*v7pushactionfakes.FakeProgressBar{}
+	})
+
+	JustBeforeEach(func() {
+		appEventStream := actor.ActualizeConcurrently(states, parallelism, func(appName string) ProgressBar {
+			barsLock.Lock()
+			defer barsLock.Unlock()
+			progressBars[appName] = new(v7pushactionfakes.FakeProgressBar)
+			return progressBars[appName]
+		})
+
+		appEvents = nil
+		for appEvent := range appEventStream {
+			appEvents = append(appEvents, appEvent)
+		}
+	})
+
+	eventsOf := func(appName string) []Event {
+		var events []Event
+		for _, appEvent := range appEvents {
+			if appEvent.AppName == appName && appEvent.Event != "" {
+				events = append(events, appEvent.Event)
+			}
+		}
+		return events
+	}
+
+	It("actualizes every app with its own progress bar and tags every event with the app name", func() {
+		for _, appName := range []string{"app-1", "app-2", "app-3"} {
+			Expect(eventsOf(appName)).To(ContainElement(CreatedApplication))
+			Expect(eventsOf(appName)).To(ContainElement(Complete))
+			Expect(appEvents).To(ContainElement(AppEvent{AppName: appName, Warnings: Warnings{appName + "-warning"}}))
+			Expect(progressBars[appName].NewProgressBarWrapperCallCount()).To(Equal(1))
+		}
+
+		var updatedStates []string
+		for _, appEvent := range appEvents {
+			if appEvent.State != nil {
+				Expect(appEvent.State.Application.GUID).To(Equal(appEvent.AppName + "-guid"))
+				updatedStates = append(updatedStates, appEvent.AppName)
+			}
+		}
+		Expect(updatedStates).To(ConsistOf("app-1", "app-2", "app-3"))
+	})
+
+	When("an app fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.CreateApplicationInSpaceStub = func(app v7action.Application, _ string) (v7action.Application, v7action.Warnings, error) {
+				if app.Name == "app-2" {
+					return v7action.Application{}, nil, errors.New("app-2-error")
+				}
+				app.GUID = app.Name + "-guid"
+				return app, nil, nil
+			}
+		})
+
+		It("reports the error and keeps pushing the other apps", func() {
+			Expect(appEvents).To(ContainElement(AppEvent{AppName: "app-2", Err: errors.New("app-2-error")}))
+			Expect(eventsOf("app-2")).ToNot(ContainElement(Complete))
+			Expect(eventsOf("app-1")).To(ContainElement(Complete))
+			Expect(eventsOf("app-3")).To(ContainElement(Complete))
+		})
+	})
+
+	Describe("concurrency", func() {
+		var (
+			lock        sync.Mutex
+			inFlight    int
+			maxInFlight int
+		)
+
+		BeforeEach(func() {
+			inFlight, maxInFlight = 0, 0
+			fakeV7Actor.CreateApplicationInSpaceStub = func(app v7action.Application, _ string) (v7action.Application, v7action.Warnings, error) {
+				lock.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				lock.Unlock()
+
+				time.Sleep(100 * time.Millisecond)
+
+				lock.Lock()
+				inFlight--
+				lock.Unlock()
+				return app, nil, nil
+			}
+		})
+
+		It("pushes at most parallelism apps at the same time", func() {
+			Expect(fakeV7Actor.CreateApplicationInSpaceCallCount()).To(Equal(3))
+			Expect(maxInFlight).To(Equal(2))
+		})
+
+		When("parallelism is less than one", func() {
+			BeforeEach(func() {
+				parallelism = 0
+			})
+
+			It("pushes one app at a time", func() {
+				Expect(fakeV7Actor.CreateApplicationInSpaceCallCount()).To(Equal(3))
+				Expect(maxInFlight).To(Equal(1))
+			})
+		})
+	})
+})
@@ -6,7 +6,6 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
@@ -85,7 +84,6 @@ func getNextEvent(c <-chan PushState, e <-chan Event, w <-chan Warnings) func()
 var _ = Describe("Actualize", func() {
 	var (
 		actor           *Actor
-		fakeV2Actor     *v7pushactionfakes.FakeV2Actor
 		fakeV7Actor     *v7pushactionfakes.FakeV7Actor
 		fakeSharedActor *v7pushactionfakes.FakeSharedActor
 
@@ -99,11 +97,10 @@ var _ = Describe("Actualize", func() {
 	)
 
 	BeforeEach(func() {
-		fakeV2Actor = new(v7pushactionfakes.FakeV2Actor)
 		fakeV7Actor = new(v7pushactionfakes.FakeV7Actor)
 		fakeSharedActor = new(v7pushactionfakes.FakeSharedActor)
 		fakeSharedActor.ReadArchiveReturns(new(v7pushactionfakes.FakeReadCloser), 0, nil)
-		actor = NewActor(fakeV2Actor, fakeV7Actor, fakeSharedActor)
+		actor = NewActor(fakeV7Actor, fakeSharedActor)
 
 		fakeProgressBar = new(v7pushactionfakes.FakeProgressBar)
 		state = PushState{
@@ -113,14 +110,12 @@ var _ = Describe("Actualize", func() {
 			SpaceGUID: "some-space-guid",
 		}
 
-		fakeV2Actor.GetOrganizationDomainsReturns(
-			[]v2action.Domain{
-				{
-					GUID: "some-domain-guid",
-					Name: "some-domain",
-				},
+		fakeV7Actor.GetDefaultDomainReturns(
+			v7action.Domain{
+				GUID: "some-domain-guid",
+				Name: "some-domain",
 			},
-			v2action.Warnings{"domain-warning"},
+			v7action.Warnings{"domain-warning"},
 			nil,
 		)
 	})
@@ -368,35 +363,39 @@ var _ = Describe("Actualize", func() {
 	Describe("default route creation", func() {
 		When("route creation and mapping is successful", func() {
 			BeforeEach(func() {
-				fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(
-					v2action.Route{},
-					v2action.Warnings{"route-warning"},
+				fakeV7Actor.GetRouteByAttributesReturns(
+					v7action.Route{},
+					v7action.Warnings{"route-warning"},
 					actionerror.RouteNotFoundError{},
 				)
 
-				fakeV2Actor.CreateRouteReturns(
-					v2action.Route{
-						GUID: "some-route-guid",
-						Host: "some-app",
-						Domain: v2action.Domain{
-							Name: "some-domain",
-							GUID: "some-domain-guid",
-						},
-						SpaceGUID: "some-space-guid",
+				fakeV7Actor.CreateRouteReturns(
+					v7action.Route{
+						GUID:       "some-route-guid",
+						Host:       "some-app",
+						DomainName: "some-domain",
+						DomainGUID: "some-domain-guid",
+						SpaceGUID:  "some-space-guid",
 					},
-					v2action.Warnings{"route-create-warning"},
+					v7action.Warnings{"route-create-warning"},
+					nil,
+				)
+
+				fakeV7Actor.GetRouteDestinationsByAppGUIDReturns(
 					nil,
+					v7action.Warnings{"destination-warning"},
+					actionerror.RouteDestinationNotFoundError{},
 				)
 
-				fakeV2Actor.MapRouteToApplicationReturns(
-					v2action.Warnings{"map-warning"},
+				fakeV7Actor.MapRouteReturns(
+					v7action.Warnings{"map-warning"},
 					nil,
 				)
 			})
 
 			It("creates the route, maps it to the app, and returns any warnings", func() {
 				Eventually(getNextEvent(stateStream, eventStream, warningsStream)).Should(Equal(CreatingAndMappingRoutes))
-				Eventually(warningsStream).Should(Receive(ConsistOf("domain-warning", "route-warning", "route-create-warning", "map-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("domain-warning", "route-warning", "route-create-warning", "destination-warning", "map-warning")))
 				Eventually(getNextEvent(stateStream, eventStream, warningsStream)).Should(Equal(CreatedRoutes))
 			})
 		})
@@ -406,14 +405,9 @@ var _ = Describe("Actualize", func() {
 
 			BeforeEach(func() {
 				expectedErr = errors.New("some route error")
-				fakeV2Actor.GetOrganizationDomainsReturns(
-					[]v2action.Domain{
-						{
-							GUID: "some-domain-guid",
-							Name: "some-domain",
-						},
-					},
-					v2action.Warnings{"domain-warning"},
+				fakeV7Actor.GetDefaultDomainReturns(
+					v7action.Domain{},
+					v7action.Warnings{"domain-warning"},
 					expectedErr,
 				)
 			})
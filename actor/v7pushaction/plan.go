@@ -0,0 +1,97 @@
+package v7pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	log "github.com/sirupsen/logrus"
+)
+
+// PushPlan describes the changes a push would make to an application, its web
+// process and its routes.
+type PushPlan struct {
+	CurrentApplication v7action.Application
+	DesiredApplication v7action.Application
+
+	CurrentWebProcess v7action.Process
+	DesiredWebProcess v7action.Process
+
+	CurrentRoutes []string
+	DesiredRoutes []string
+
+	BitsPath string
+}
+
+// CreatingApplication returns true if the push would create the application.
+func (plan PushPlan) CreatingApplication() bool {
+	return plan.CurrentApplication.GUID == ""
+}
+
+// Plan compares the push state with the live application and returns the
+// changes Actualize would make. It does not create, update or map anything.
+func (actor Actor) Plan(state PushState) (PushPlan, Warnings, error) {
+	log.WithField("Name", state.Application.Name).Info("planning push")
+
+	plan := PushPlan{
+		DesiredApplication: state.Application,
+		BitsPath:           state.BitsPath,
+	}
+
+	var allWarnings Warnings
+	if state.Application.GUID != "" {
+		currentApp, warnings, err := actor.V7Actor.GetApplicationByNameAndSpace(state.Application.Name, state.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PushPlan{}, allWarnings, err
+		}
+		plan.CurrentApplication = currentApp
+
+		process, warnings, err := actor.V7Actor.GetProcessByTypeAndApplication(constant.ProcessTypeWeb, currentApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if _, ok := err.(actionerror.ProcessNotFoundError); !ok && err != nil {
+			return PushPlan{}, allWarnings, err
+		}
+		plan.CurrentWebProcess = process
+
+		routes, warnings, err := actor.V7Actor.GetApplicationRoutes(currentApp.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return PushPlan{}, allWarnings, err
+		}
+		for _, route := range routes {
+			plan.CurrentRoutes = append(plan.CurrentRoutes, route.String())
+		}
+	}
+
+	plan.DesiredWebProcess = plan.CurrentWebProcess
+	plan.DesiredWebProcess.Type = constant.ProcessTypeWeb
+	if state.Overrides.Memory.IsSet {
+		plan.DesiredWebProcess.MemoryInMB = state.Overrides.Memory
+	}
+	if state.Overrides.HealthCheckType != "" {
+		plan.DesiredWebProcess.HealthCheckType = state.Overrides.HealthCheckType
+	}
+
+	defaultDomain, warnings, err := actor.V7Actor.GetDefaultDomain(state.OrgGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return PushPlan{}, allWarnings, err
+	}
+
+	defaultRoute := v7action.Route{Host: state.Application.Name, DomainName: defaultDomain.Name}.String()
+	plan.DesiredRoutes = append([]string{}, plan.CurrentRoutes...)
+	if !containsString(plan.DesiredRoutes, defaultRoute) {
+		plan.DesiredRoutes = append(plan.DesiredRoutes, defaultRoute)
+	}
+
+	return plan, allWarnings, nil
+}
+
+func containsString(list []string, item string) bool {
+	for _, element := range list {
+		if element == item {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,191 @@
+package v7pushaction_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		actor       *Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
+
+		state PushState
+
+		plan       PushPlan
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, fakeV7Actor, _ = getTestPushActor()
+
+		state = PushState{
+			Application: v7action.Application{Name: "some-app"},
+			SpaceGUID:   "some-space-guid",
+			OrgGUID:     "some-org-guid",
+			BitsPath:    "/some/path",
+			AllResources: []sharedaction.Resource{
+				{Filename: "some-file"},
+			},
+		}
+
+		fakeV7Actor.GetDefaultDomainReturns(
+			v7action.Domain{Name: "example.com", GUID: "some-domain-guid"},
+			v7action.Warnings{"domain-warning"},
+			nil)
+	})
+
+	JustBeforeEach(func() {
+		plan, warnings, executeErr = actor.Plan(state)
+	})
+
+	When("the application does not exist", func() {
+		BeforeEach(func() {
+			state.Overrides.Memory = types.NullUint64{Value: 256, IsSet: true}
+		})
+
+		It("plans to create the application with the default route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("domain-warning"))
+
+			Expect(plan.CreatingApplication()).To(BeTrue())
+			Expect(plan.DesiredApplication).To(Equal(state.Application))
+			Expect(plan.CurrentRoutes).To(BeEmpty())
+			Expect(plan.DesiredRoutes).To(Equal([]string{"some-app.example.com"}))
+			Expect(plan.DesiredWebProcess.Type).To(Equal(constant.ProcessTypeWeb))
+			Expect(plan.DesiredWebProcess.MemoryInMB).To(Equal(types.NullUint64{Value: 256, IsSet: true}))
+			Expect(plan.BitsPath).To(Equal("/some/path"))
+
+			Expect(fakeV7Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.GetDefaultDomainArgsForCall(0)).To(Equal("some-org-guid"))
+		})
+
+		It("does not change anything", func() {
+			Expect(fakeV7Actor.CreateApplicationInSpaceCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.CreateRouteCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.CreateBitsPackageByApplicationCallCount()).To(Equal(0))
+			Expect(fakeV7Actor.StageApplicationPackageCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the application exists", func() {
+		BeforeEach(func() {
+			state.Application = v7action.Application{
+				Name:                "some-app",
+				GUID:                "some-app-guid",
+				LifecycleBuildpacks: []string{"new-buildpack"},
+			}
+			state.Overrides.HealthCheckType = "process"
+
+			fakeV7Actor.GetApplicationByNameAndSpaceReturns(
+				v7action.Application{
+					Name:                "some-app",
+					GUID:                "some-app-guid",
+					LifecycleBuildpacks: []string{"old-buildpack"},
+				},
+				v7action.Warnings{"app-warning"},
+				nil)
+			fakeV7Actor.GetProcessByTypeAndApplicationReturns(
+				v7action.Process{
+					Type:            constant.ProcessTypeWeb,
+					HealthCheckType: "port",
+					MemoryInMB:      types.NullUint64{Value: 64, IsSet: true},
+				},
+				v7action.Warnings{"process-warning"},
+				nil)
+			fakeV7Actor.GetApplicationRoutesReturns(
+				v7action.Routes{{Host: "other", DomainName: "example.com"}},
+				v7action.Warnings{"routes-warning"},
+				nil)
+		})
+
+		It("compares the desired state with the live application", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("app-warning", "process-warning", "routes-warning", "domain-warning"))
+
+			Expect(plan.CreatingApplication()).To(BeFalse())
+			Expect(plan.CurrentApplication.LifecycleBuildpacks).To(Equal([]string{"old-buildpack"}))
+			Expect(plan.DesiredApplication.LifecycleBuildpacks).To(Equal([]string{"new-buildpack"}))
+
+			Expect(plan.CurrentWebProcess.HealthCheckType).To(Equal("port"))
+			Expect(plan.DesiredWebProcess.HealthCheckType).To(Equal("process"))
+			Expect(plan.DesiredWebProcess.MemoryInMB).To(Equal(types.NullUint64{Value: 64, IsSet: true}))
+
+			Expect(plan.CurrentRoutes).To(Equal([]string{"other.example.com"}))
+			Expect(plan.DesiredRoutes).To(Equal([]string{"other.example.com", "some-app.example.com"}))
+
+			appName, spaceGUID := fakeV7Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			processType, appGUID := fakeV7Actor.GetProcessByTypeAndApplicationArgsForCall(0)
+			Expect(processType).To(Equal(constant.ProcessTypeWeb))
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(fakeV7Actor.GetApplicationRoutesArgsForCall(0)).To(Equal("some-app-guid"))
+
+			Expect(fakeV7Actor.UpdateApplicationCallCount()).To(Equal(0))
+		})
+
+		When("the default route is already mapped", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetApplicationRoutesReturns(
+					v7action.Routes{{Host: "some-app", DomainName: "example.com"}},
+					nil,
+					nil)
+			})
+
+			It("does not plan to map it again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.DesiredRoutes).To(Equal([]string{"some-app.example.com"}))
+			})
+		})
+
+		When("the application has no web process", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetProcessByTypeAndApplicationReturns(
+					v7action.Process{},
+					nil,
+					actionerror.ProcessNotFoundError{ProcessType: constant.ProcessTypeWeb})
+			})
+
+			It("plans the web process from scratch", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(plan.CurrentWebProcess).To(Equal(v7action.Process{}))
+				Expect(plan.DesiredWebProcess.HealthCheckType).To(Equal("process"))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeV7Actor.GetApplicationRoutesReturns(nil, v7action.Warnings{"routes-warning"}, errors.New("routes-error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("routes-error"))
+				Expect(warnings).To(ConsistOf("app-warning", "process-warning", "routes-warning"))
+			})
+		})
+	})
+
+	When("getting the default domain fails", func() {
+		BeforeEach(func() {
+			fakeV7Actor.GetDefaultDomainReturns(v7action.Domain{}, v7action.Warnings{"domain-warning"}, errors.New("domain-error"))
+		})
+
+		It("returns the error and all warnings", func() {
+			Expect(executeErr).To(MatchError("domain-error"))
+			Expect(warnings).To(ConsistOf("domain-warning"))
+		})
+	})
+})
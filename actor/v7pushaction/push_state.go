@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
 )
 
 type PushState struct {
@@ -41,20 +42,54 @@ func (state PushState) String() string {
 	)
 }
 
-func (actor Actor) Conceptualize(appName string, spaceGUID string, orgGUID string, currentDir string, flagOverrides FlagOverrides) ([]PushState, Warnings, error) {
+// IsEmpty returns true when none of the flag overrides are set.
+func (overrides FlagOverrides) IsEmpty() bool {
+	return len(overrides.Buildpacks) == 0 &&
+		overrides.HealthCheckType == "" &&
+		!overrides.Memory.IsSet &&
+		overrides.ProvidedAppPath == ""
+}
+
+// Conceptualize returns a push state for every app that is pushed. Without a
+// manifest that is only appName. With a manifest it is every manifest app, or
+// only appName when it is provided.
+func (actor Actor) Conceptualize(appName string, manifestApps []manifest.Application, spaceGUID string, orgGUID string, currentDir string, flagOverrides FlagOverrides) ([]PushState, Warnings, error) {
+	apps := []manifest.Application{{Name: appName}}
+	if len(manifestApps) > 0 {
+		var err error
+		apps, err = selectManifestApps(appName, manifestApps)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	if len(apps) > 1 && !flagOverrides.IsEmpty() {
+		return nil, nil, actionerror.CommandLineOptionsWithMultipleAppsError{}
+	}
+
 	var (
-		application v7action.Application
-		warnings    v7action.Warnings
-		err         error
+		desiredState []PushState
+		allWarnings  Warnings
 	)
+	for _, app := range apps {
+		state, warnings, err := actor.conceptualizeApp(app, spaceGUID, orgGUID, currentDir, flagOverrides)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+		desiredState = append(desiredState, state)
+	}
+	return desiredState, allWarnings, nil
+}
 
-	application, warnings, err = actor.V7Actor.GetApplicationByNameAndSpace(appName, spaceGUID)
+func (actor Actor) conceptualizeApp(app manifest.Application, spaceGUID string, orgGUID string, currentDir string, flagOverrides FlagOverrides) (PushState, Warnings, error) {
+	application, warnings, err := actor.V7Actor.GetApplicationByNameAndSpace(app.Name, spaceGUID)
 	if _, ok := err.(actionerror.ApplicationNotFoundError); ok {
 		application = v7action.Application{
-			Name: appName,
+			Name: app.Name,
 		}
 	} else if err != nil {
-		return nil, Warnings(warnings), err
+		return PushState{}, Warnings(warnings), err
 	}
 
 	if len(flagOverrides.Buildpacks) != 0 {
@@ -65,20 +100,34 @@ func (actor Actor) Conceptualize(appName string, spaceGUID string, orgGUID strin
 	bitsPath := currentDir
 	if flagOverrides.ProvidedAppPath != "" {
 		bitsPath = flagOverrides.ProvidedAppPath
+	} else if app.Path != "" {
+		bitsPath = app.Path
 	}
 
 	resources, err := actor.SharedActor.GatherDirectoryResources(bitsPath)
 
-	desiredState := []PushState{
-		{
-			Application: application,
-			SpaceGUID:   spaceGUID,
-			OrgGUID:     orgGUID,
-			Overrides:   flagOverrides,
+	return PushState{
+		Application: application,
+		SpaceGUID:   spaceGUID,
+		OrgGUID:     orgGUID,
+		Overrides:   flagOverrides,
+
+		BitsPath:     bitsPath,
+		AllResources: resources,
+	}, Warnings(warnings), err
+}
 
-			BitsPath:     bitsPath,
-			AllResources: resources,
-		},
+// selectManifestApps returns the manifest app called appName, or all manifest
+// apps when appName is empty.
+func selectManifestApps(appName string, manifestApps []manifest.Application) ([]manifest.Application, error) {
+	if appName == "" {
+		return manifestApps, nil
+	}
+
+	for _, app := range manifestApps {
+		if app.Name == appName {
+			return []manifest.Application{app}, nil
+		}
 	}
-	return desiredState, Warnings(warnings), err
+	return nil, actionerror.AppNotFoundInManifestError{Name: appName}
 }
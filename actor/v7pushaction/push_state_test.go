@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/manifest"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -27,12 +28,13 @@ var _ = Describe("Push State", func() {
 	)
 
 	BeforeEach(func() {
-		actor, _, fakeV7Actor, fakeSharedActor = getTestPushActor()
+		actor, fakeV7Actor, fakeSharedActor = getTestPushActor()
 	})
 
 	Describe("Conceptualize", func() {
 		var (
 			appName       string
+			manifestApps  []manifest.Application
 			spaceGUID     string
 			orgGUID       string
 			currentDir    string
@@ -48,6 +50,7 @@ var _ = Describe("Push State", func() {
 			pwd, err = os.Getwd()
 			Expect(err).ToNot(HaveOccurred())
 			appName = "some-app-name"
+			manifestApps = nil
 			currentDir = pwd
 			flagOverrides = FlagOverrides{}
 
@@ -56,7 +59,7 @@ var _ = Describe("Push State", func() {
 		})
 
 		JustBeforeEach(func() {
-			states, warnings, executeErr = actor.Conceptualize(appName, spaceGUID, orgGUID, currentDir, flagOverrides)
+			states, warnings, executeErr = actor.Conceptualize(appName, manifestApps, spaceGUID, orgGUID, currentDir, flagOverrides)
 		})
 
 		Describe("application", func() {
@@ -193,6 +196,83 @@ var _ = Describe("Push State", func() {
 			})
 		})
 
+		When("manifest apps are passed", func() {
+			BeforeEach(func() {
+				appName = ""
+				manifestApps = []manifest.Application{
+					{Name: "app-1", Path: "/manifest/app-1"},
+					{Name: "app-2"},
+				}
+
+				fakeV7Actor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v7action.Application, v7action.Warnings, error) {
+					return v7action.Application{}, v7action.Warnings{name + "-warning"}, actionerror.ApplicationNotFoundError{Name: name}
+				}
+			})
+
+			It("returns a push state for every manifest app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+				Expect(states).To(HaveLen(2))
+
+				Expect(states[0].Application.Name).To(Equal("app-1"))
+				Expect(states[0].BitsPath).To(Equal("/manifest/app-1"))
+				Expect(states[1].Application.Name).To(Equal("app-2"))
+				Expect(states[1].BitsPath).To(Equal(pwd))
+
+				Expect(fakeSharedActor.GatherDirectoryResourcesCallCount()).To(Equal(2))
+				Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(0)).To(Equal("/manifest/app-1"))
+				Expect(fakeSharedActor.GatherDirectoryResourcesArgsForCall(1)).To(Equal(pwd))
+			})
+
+			When("an app name is provided", func() {
+				BeforeEach(func() {
+					appName = "app-1"
+				})
+
+				It("returns a push state for that manifest app only", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(states).To(HaveLen(1))
+					Expect(states[0].Application.Name).To(Equal("app-1"))
+					Expect(states[0].BitsPath).To(Equal("/manifest/app-1"))
+				})
+			})
+
+			When("the app name is not in the manifest", func() {
+				BeforeEach(func() {
+					appName = "some-other-app"
+				})
+
+				It("returns an AppNotFoundInManifestError", func() {
+					Expect(executeErr).To(MatchError(actionerror.AppNotFoundInManifestError{Name: "some-other-app"}))
+					Expect(fakeV7Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("flag overrides are passed for several apps", func() {
+				BeforeEach(func() {
+					flagOverrides.Memory = types.NullUint64{IsSet: true, Value: 123456}
+				})
+
+				It("returns a CommandLineOptionsWithMultipleAppsError", func() {
+					Expect(executeErr).To(MatchError(actionerror.CommandLineOptionsWithMultipleAppsError{}))
+					Expect(fakeV7Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("looking up an app fails", func() {
+				BeforeEach(func() {
+					fakeV7Actor.GetApplicationByNameAndSpaceStub = nil
+					fakeV7Actor.GetApplicationByNameAndSpaceReturnsOnCall(0, v7action.Application{}, v7action.Warnings{"app-1-warning"}, actionerror.ApplicationNotFoundError{Name: "app-1"})
+					fakeV7Actor.GetApplicationByNameAndSpaceReturnsOnCall(1, v7action.Application{}, v7action.Warnings{"app-2-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and the warnings so far", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("app-1-warning", "app-2-warning"))
+				})
+			})
+		})
+
 		When("flag overrides are passed", func() {
 			BeforeEach(func() {
 				flagOverrides.Memory = types.NullUint64{IsSet: true, Value: 123456}
@@ -40,10 +40,9 @@ func getCurrentDir() string {
 	return pwd
 }
 
-func getTestPushActor() (*Actor, *v7pushactionfakes.FakeV2Actor, *v7pushactionfakes.FakeV7Actor, *v7pushactionfakes.FakeSharedActor) {
-	fakeV2Actor := new(v7pushactionfakes.FakeV2Actor)
+func getTestPushActor() (*Actor, *v7pushactionfakes.FakeV7Actor, *v7pushactionfakes.FakeSharedActor) {
 	fakeV7Actor := new(v7pushactionfakes.FakeV7Actor)
 	fakeSharedActor := new(v7pushactionfakes.FakeSharedActor)
-	actor := NewActor(fakeV2Actor, fakeV7Actor, fakeSharedActor)
-	return actor, fakeV2Actor, fakeV7Actor, fakeSharedActor
+	actor := NewActor(fakeV7Actor, fakeSharedActor)
+	return actor, fakeV7Actor, fakeSharedActor
 }
@@ -0,0 +1,9 @@
+package v7pushaction
+
+import "code.cloudfoundry.org/cli/util/manifest"
+
+// ReadManifest returns the apps of the manifest at pathToManifest.
+func (Actor) ReadManifest(pathToManifest string) ([]manifest.Application, error) {
+	// Cover method to make testing easier
+	return manifest.ReadAndInterpolateManifest(pathToManifest, nil, nil)
+}
@@ -0,0 +1,69 @@
+package v7pushaction_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/util/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReadManifest", func() {
+	var (
+		actor *Actor
+
+		tmpDir       string
+		manifestPath string
+
+		apps       []manifest.Application
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		actor, _, _ = getTestPushActor()
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "read-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		apps, executeErr = actor.ReadManifest(manifestPath)
+	})
+
+	When("the manifest contains several apps", func() {
+		BeforeEach(func() {
+			manifest := []byte(`---
+applications:
+- name: app-1
+  path: app-1-dir
+- name: app-2
+`)
+			Expect(ioutil.WriteFile(manifestPath, manifest, 0666)).To(Succeed())
+		})
+
+		It("returns every app with its path relative to the manifest", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(apps).To(HaveLen(2))
+			Expect(apps[0].Name).To(Equal("app-1"))
+			Expect(apps[0].Path).To(Equal(filepath.Join(tmpDir, "app-1-dir")))
+			Expect(apps[1].Name).To(Equal("app-2"))
+			Expect(apps[1].Path).To(BeEmpty())
+		})
+	})
+
+	When("the manifest does not exist", func() {
+		It("returns the error", func() {
+			Expect(os.IsNotExist(executeErr)).To(BeTrue())
+		})
+	})
+})
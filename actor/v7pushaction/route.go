@@ -5,7 +5,6 @@ import (
 	"strings"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	log "github.com/sirupsen/logrus"
 )
@@ -13,80 +12,49 @@ import (
 func (actor Actor) CreateAndMapDefaultApplicationRoute(orgGUID string, spaceGUID string, app v7action.Application) (Warnings, error) {
 	log.Info("default route creation/mapping")
 	var warnings Warnings
-	defaultRoute, domainWarnings, err := actor.getDefaultRoute(orgGUID, spaceGUID, app.Name)
+	defaultDomain, domainWarnings, err := actor.V7Actor.GetDefaultDomain(orgGUID)
 	warnings = append(warnings, domainWarnings...)
 	if err != nil {
-		log.Errorln("getting default route:", err)
+		log.Errorln("getting default domain:", err)
 		return warnings, err
 	}
-	log.WithField("defaultRoute", defaultRoute.String()).Debug("calculated default route")
+	log.WithField("defaultDomain", defaultDomain.Name).Debug("calculated default domain")
 
-	boundRoutes, appRouteWarnings, err := actor.V2Actor.GetApplicationRoutes(app.GUID)
-	warnings = append(warnings, appRouteWarnings...)
-	if err != nil {
-		log.Errorln("getting application routes:", err)
-		return warnings, err
-	}
-	log.WithField("boundRoutes", boundRoutes.Summary()).Debug("existing app routes")
-
-	_, routeAlreadyBound := actor.routeInListBySettings(defaultRoute, boundRoutes)
-	if routeAlreadyBound {
-		return warnings, err
-	}
-
-	spaceRoute, spaceRouteWarnings, err := actor.V2Actor.FindRouteBoundToSpaceWithSettings(defaultRoute)
-	warnings = append(warnings, spaceRouteWarnings...)
-	routeAlreadyExists := true
+	route, routeWarnings, err := actor.V7Actor.GetRouteByAttributes(defaultDomain.Name, defaultDomain.GUID, app.Name, "")
+	warnings = append(warnings, routeWarnings...)
 	if _, ok := err.(actionerror.RouteNotFoundError); ok {
-		routeAlreadyExists = false
+		log.Debug("creating default route")
+		var createRouteWarnings v7action.Warnings
+		route, createRouteWarnings, err = actor.V7Actor.CreateRoute(spaceGUID, defaultDomain.Name, app.Name, "")
+		warnings = append(warnings, createRouteWarnings...)
+		if err != nil {
+			log.Errorln("creating route:", err)
+			return warnings, err
+		}
 	} else if err != nil {
-		log.Errorln("checking if route is in space:", err)
+		log.Errorln("checking if route exists:", err)
 		return warnings, err
 	} else {
 		log.Debug("route already exists")
 	}
 
-	if !routeAlreadyExists {
-		log.Debug("creating default route")
-		var createRouteWarning v2action.Warnings
-		spaceRoute, createRouteWarning, err = actor.V2Actor.CreateRoute(defaultRoute, false)
-		warnings = append(warnings, createRouteWarning...)
-		if err != nil {
-			log.Errorln("creating route:", err)
-			return warnings, err
-		}
+	_, destinationWarnings, err := actor.V7Actor.GetRouteDestinationsByAppGUID(route.GUID, app.GUID)
+	warnings = append(warnings, destinationWarnings...)
+	if err == nil {
+		log.Debug("route already mapped to app")
+		return warnings, nil
+	}
+	if _, ok := err.(actionerror.RouteDestinationNotFoundError); !ok {
+		log.Errorln("getting route destinations:", err)
+		return warnings, err
 	}
 
 	log.Debug("mapping default route")
-	mapWarnings, err := actor.V2Actor.MapRouteToApplication(spaceRoute.GUID, app.GUID)
+	mapWarnings, err := actor.V7Actor.MapRoute(route.GUID, app.GUID, "", 0)
 	warnings = append(warnings, mapWarnings...)
 	return warnings, err
 }
 
-func (actor Actor) getDefaultRoute(orgGUID string, spaceGUID string, appName string) (v2action.Route, Warnings, error) {
-	defaultDomain, domainWarnings, err := actor.DefaultDomain(orgGUID)
-	if err != nil {
-		return v2action.Route{}, domainWarnings, err
-	}
-
-	return v2action.Route{
-		Host:      appName,
-		Domain:    defaultDomain,
-		SpaceGUID: spaceGUID,
-	}, domainWarnings, nil
-}
-
-func (Actor) routeInListBySettings(route v2action.Route, routes []v2action.Route) (v2action.Route, bool) {
-	for _, r := range routes {
-		if r.Host == route.Host && r.Path == route.Path && r.Port == route.Port &&
-			r.SpaceGUID == route.SpaceGUID && r.Domain.GUID == route.Domain.GUID {
-			return r, true
-		}
-	}
-
-	return v2action.Route{}, false
-}
-
 func (Actor) sanitize(name string) string {
 	name = strings.ToLower(name)
 
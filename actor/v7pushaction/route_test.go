@@ -4,24 +4,22 @@ import (
 	"errors"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	. "code.cloudfoundry.org/cli/actor/v7pushaction"
 	"code.cloudfoundry.org/cli/actor/v7pushaction/v7pushactionfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	. "github.com/onsi/gomega/gstruct"
 )
 
 var _ = Describe("Routes", func() {
 	var (
 		actor       *Actor
-		fakeV2Actor *v7pushactionfakes.FakeV2Actor
+		fakeV7Actor *v7pushactionfakes.FakeV7Actor
 	)
 
 	BeforeEach(func() {
-		actor, fakeV2Actor, _, _ = getTestPushActor()
+		actor, fakeV7Actor, _ = getTestPushActor()
 	})
 
 	Describe("CreateAndMapDefaultApplicationRoute", func() {
@@ -44,49 +42,32 @@ var _ = Describe("Routes", func() {
 			warnings, executeErr = actor.CreateAndMapDefaultApplicationRoute(orgGUID, spaceGUID, application)
 		})
 
-		When("getting organization domains errors", func() {
+		When("getting the default domain errors", func() {
 			BeforeEach(func() {
-				fakeV2Actor.GetOrganizationDomainsReturns(
-					[]v2action.Domain{},
-					v2action.Warnings{"domain-warning"},
+				fakeV7Actor.GetDefaultDomainReturns(
+					v7action.Domain{},
+					v7action.Warnings{"domain-warning"},
 					errors.New("some-error"))
 			})
 
 			It("returns the error", func() {
 				Expect(executeErr).To(MatchError("some-error"))
 				Expect(warnings).To(ConsistOf("domain-warning"))
+
+				Expect(fakeV7Actor.GetDefaultDomainCallCount()).To(Equal(1))
+				Expect(fakeV7Actor.GetDefaultDomainArgsForCall(0)).To(Equal(orgGUID))
 			})
 		})
 
-		When("getting organization domains succeeds", func() {
+		When("getting the default domain succeeds", func() {
 			BeforeEach(func() {
-				fakeV2Actor.GetOrganizationDomainsReturns(
-					[]v2action.Domain{
-						{
-							GUID: "some-domain-guid",
-							Name: "some-domain",
-						},
-					},
-					v2action.Warnings{"domain-warning"},
+				fakeV7Actor.GetDefaultDomainReturns(
+					v7action.Domain{GUID: "some-domain-guid", Name: "some-domain.com"},
+					v7action.Warnings{"domain-warning"},
 					nil,
 				)
 			})
 
-			When("getting the application routes errors", func() {
-				BeforeEach(func() {
-					fakeV2Actor.GetApplicationRoutesReturns(
-						[]v2action.Route{},
-						v2action.Warnings{"route-warning"},
-						errors.New("some-error"),
-					)
-				})
-
-				It("returns the error", func() {
-					Expect(executeErr).To(MatchError("some-error"))
-					Expect(warnings).To(ConsistOf("domain-warning", "route-warning"))
-				})
-			})
-
 			PDescribe("sanitization", func() {
 				When("the app name is partially sanitized", func() {
 					BeforeEach(func() {
@@ -96,11 +77,9 @@ var _ = Describe("Routes", func() {
 					// Test name taken from the v6 version of push
 					It("generates a route whose hostname is a sanitized app name and two randomly generated words", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(1))
-						Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsArgsForCall(0)).To(MatchFields(IgnoreExtras,
-							Fields{
-								"Host": Equal("to-be-determined"),
-							}))
+						Expect(fakeV7Actor.GetRouteByAttributesCallCount()).To(Equal(1))
+						_, _, hostname, _ := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+						Expect(hostname).To(Equal("to-be-determined"))
 					})
 				})
 
@@ -112,226 +91,175 @@ var _ = Describe("Routes", func() {
 					// Test name taken from the v6 version of push
 					It("generates a route whose hostname is a sanitized app name and two randomly generated words", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(1))
-						Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsArgsForCall(0)).To(MatchFields(IgnoreExtras,
-							Fields{
-								"Host": Equal("to-be-determined"),
-							}))
+						Expect(fakeV7Actor.GetRouteByAttributesCallCount()).To(Equal(1))
+						_, _, hostname, _ := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+						Expect(hostname).To(Equal("to-be-determined"))
 					})
 				})
 			})
 
-			When("getting the application routes succeeds", func() {
-				When("the route is already bound to the app", func() {
+			When("finding the route errors", func() {
+				BeforeEach(func() {
+					fakeV7Actor.GetRouteByAttributesReturns(
+						v7action.Route{},
+						v7action.Warnings{"route-warning"},
+						errors.New("some-error"))
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(warnings).To(ConsistOf("domain-warning", "route-warning"))
+				})
+			})
+
+			When("the route exists", func() {
+				BeforeEach(func() {
+					fakeV7Actor.GetRouteByAttributesReturns(
+						v7action.Route{GUID: "some-route-guid", Host: "some-app", DomainName: "some-domain.com", DomainGUID: "some-domain-guid"},
+						v7action.Warnings{"route-warning"},
+						nil,
+					)
+				})
+
+				It("looks up the route by the app name and default domain", func() {
+					Expect(fakeV7Actor.GetRouteByAttributesCallCount()).To(Equal(1))
+					domainName, domainGUID, hostname, path := fakeV7Actor.GetRouteByAttributesArgsForCall(0)
+					Expect(domainName).To(Equal("some-domain.com"))
+					Expect(domainGUID).To(Equal("some-domain-guid"))
+					Expect(hostname).To(Equal("some-app"))
+					Expect(path).To(BeEmpty())
+
+					Expect(fakeV7Actor.CreateRouteCallCount()).To(Equal(0))
+				})
+
+				When("the route is already mapped to the app", func() {
 					BeforeEach(func() {
-						fakeV2Actor.GetApplicationRoutesReturns(
-							[]v2action.Route{
-								{
-									Host: "some-app",
-									Domain: v2action.Domain{
-										GUID: "some-domain-guid",
-										Name: "some-domain",
-									},
-									GUID:      "some-route-guid",
-									SpaceGUID: "some-space-guid",
-								},
-							},
-							v2action.Warnings{"route-warning"},
+						fakeV7Actor.GetRouteDestinationsByAppGUIDReturns(
+							[]v7action.RouteDestination{{GUID: "some-destination-guid", AppGUID: "some-app-guid"}},
+							v7action.Warnings{"destination-warning"},
 							nil,
 						)
 					})
 
-					It("returns any warnings", func() {
+					It("does not map the route and returns any warnings", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
-						Expect(warnings).To(ConsistOf("domain-warning", "route-warning"))
+						Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "destination-warning"))
 
-						Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(1), "Expected GetOrganizationDomains to be called once, but it was not")
-						orgGUID := fakeV2Actor.GetOrganizationDomainsArgsForCall(0)
-						Expect(orgGUID).To(Equal("some-org-guid"))
-
-						Expect(fakeV2Actor.GetApplicationRoutesCallCount()).To(Equal(1), "Expected GetApplicationRoutes to be called once, but it was not")
-						appGUID := fakeV2Actor.GetApplicationRoutesArgsForCall(0)
+						Expect(fakeV7Actor.GetRouteDestinationsByAppGUIDCallCount()).To(Equal(1))
+						routeGUID, appGUID := fakeV7Actor.GetRouteDestinationsByAppGUIDArgsForCall(0)
+						Expect(routeGUID).To(Equal("some-route-guid"))
 						Expect(appGUID).To(Equal("some-app-guid"))
 
-						Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(0), "Expected CreateRoute to not be called but it was")
-						Expect(fakeV2Actor.MapRouteToApplicationCallCount()).To(Equal(0), "Expected MapRouteToApplication to not be called but it was")
+						Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(0))
 					})
 				})
 
-				When("the route isn't bound to the app", func() {
-					When("finding route in space errors", func() {
+				When("getting the route destinations errors", func() {
+					BeforeEach(func() {
+						fakeV7Actor.GetRouteDestinationsByAppGUIDReturns(
+							nil,
+							v7action.Warnings{"destination-warning"},
+							errors.New("some-error"),
+						)
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "destination-warning"))
+						Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(0))
+					})
+				})
+
+				When("the route is not mapped to the app", func() {
+					BeforeEach(func() {
+						fakeV7Actor.GetRouteDestinationsByAppGUIDReturns(
+							nil,
+							v7action.Warnings{"destination-warning"},
+							actionerror.RouteDestinationNotFoundError{},
+						)
+					})
+
+					When("the map command returns an error", func() {
 						BeforeEach(func() {
-							fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(
-								v2action.Route{},
-								v2action.Warnings{"route-warning"},
-								errors.New("some-error"),
-							)
+							fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, errors.New("some-error"))
 						})
 
 						It("returns the error", func() {
 							Expect(executeErr).To(MatchError("some-error"))
-							Expect(warnings).To(ConsistOf("domain-warning", "route-warning"))
+							Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "destination-warning", "map-warning"))
 						})
 					})
 
-					When("the route exists", func() {
+					When("the map command succeeds", func() {
 						BeforeEach(func() {
-							fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(
-								v2action.Route{
-									GUID: "some-route-guid",
-									Host: "some-app",
-									Domain: v2action.Domain{
-										Name: "some-domain",
-										GUID: "some-domain-guid",
-									},
-									SpaceGUID: "some-space-guid",
-								},
-								v2action.Warnings{"route-warning"},
-								nil,
-							)
+							fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, nil)
 						})
 
-						When("the map command returns an error", func() {
-							BeforeEach(func() {
-								fakeV2Actor.MapRouteToApplicationReturns(
-									v2action.Warnings{"map-warning"},
-									errors.New("some-error"),
-								)
-							})
-
-							It("returns the error", func() {
-								Expect(executeErr).To(MatchError("some-error"))
-								Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "map-warning"))
-							})
-						})
+						It("maps the route to the app and returns any warnings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "destination-warning", "map-warning"))
 
-						When("the map command succeeds", func() {
-							BeforeEach(func() {
-								fakeV2Actor.MapRouteToApplicationReturns(
-									v2action.Warnings{"map-warning"},
-									nil,
-								)
-							})
-
-							It("maps the route to the app and returns any warnings", func() {
-								Expect(executeErr).ToNot(HaveOccurred())
-								Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "map-warning"))
-
-								Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(1), "Expected FindRouteBoundToSpaceWithSettings to be called once, but it was not")
-								spaceRoute := fakeV2Actor.FindRouteBoundToSpaceWithSettingsArgsForCall(0)
-								Expect(spaceRoute).To(Equal(v2action.Route{
-									Host: "some-app",
-									Domain: v2action.Domain{
-										Name: "some-domain",
-										GUID: "some-domain-guid",
-									},
-									SpaceGUID: "some-space-guid",
-								}))
-
-								Expect(fakeV2Actor.MapRouteToApplicationCallCount()).To(Equal(1), "Expected MapRouteToApplication to be called once, but it was not")
-								routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
-								Expect(routeGUID).To(Equal("some-route-guid"))
-								Expect(appGUID).To(Equal("some-app-guid"))
-							})
+							Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(1))
+							routeGUID, appGUID, processType, port := fakeV7Actor.MapRouteArgsForCall(0)
+							Expect(routeGUID).To(Equal("some-route-guid"))
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(processType).To(BeEmpty())
+							Expect(port).To(BeZero())
 						})
 					})
+				})
+			})
 
-					When("the route does not exist", func() {
-						BeforeEach(func() {
-							fakeV2Actor.FindRouteBoundToSpaceWithSettingsReturns(
-								v2action.Route{},
-								v2action.Warnings{"route-warning"},
-								actionerror.RouteNotFoundError{},
-							)
-						})
+			When("the route does not exist", func() {
+				BeforeEach(func() {
+					fakeV7Actor.GetRouteByAttributesReturns(
+						v7action.Route{},
+						v7action.Warnings{"route-warning"},
+						actionerror.RouteNotFoundError{},
+					)
+				})
 
-						When("the create route command errors", func() {
-							BeforeEach(func() {
-								fakeV2Actor.CreateRouteReturns(
-									v2action.Route{},
-									v2action.Warnings{"route-create-warning"},
-									errors.New("some-error"),
-								)
-							})
-
-							It("returns the error", func() {
-								Expect(executeErr).To(MatchError("some-error"))
-								Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-create-warning"))
-							})
-						})
+				When("the create route command errors", func() {
+					BeforeEach(func() {
+						fakeV7Actor.CreateRouteReturns(v7action.Route{}, v7action.Warnings{"route-create-warning"}, errors.New("some-error"))
+					})
 
-						When("the create route command succeeds", func() {
-							BeforeEach(func() {
-								fakeV2Actor.CreateRouteReturns(
-									v2action.Route{
-										GUID: "some-route-guid",
-										Host: "some-app",
-										Domain: v2action.Domain{
-											Name: "some-domain",
-											GUID: "some-domain-guid",
-										},
-										SpaceGUID: "some-space-guid",
-									},
-									v2action.Warnings{"route-create-warning"},
-									nil,
-								)
-							})
-
-							When("the map command errors", func() {
-								BeforeEach(func() {
-									fakeV2Actor.MapRouteToApplicationReturns(
-										v2action.Warnings{"map-warning"},
-										errors.New("some-error"),
-									)
-								})
-
-								It("returns the error", func() {
-									Expect(executeErr).To(MatchError("some-error"))
-									Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-create-warning", "map-warning"))
-								})
-							})
-							When("the map command succeeds", func() {
-								BeforeEach(func() {
-									fakeV2Actor.MapRouteToApplicationReturns(
-										v2action.Warnings{"map-warning"},
-										nil,
-									)
-								})
-
-								It("creates the route, maps it to the app, and returns any warnings", func() {
-									Expect(executeErr).ToNot(HaveOccurred())
-									Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-create-warning", "map-warning"))
-
-									Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(1), "Expected CreateRoute to be called once, but it was not")
-									defaultRoute, shouldGeneratePort := fakeV2Actor.CreateRouteArgsForCall(0)
-									Expect(defaultRoute).To(Equal(v2action.Route{
-										Host: "some-app",
-										Domain: v2action.Domain{
-											Name: "some-domain",
-											GUID: "some-domain-guid",
-										},
-										SpaceGUID: "some-space-guid",
-									}))
-									Expect(shouldGeneratePort).To(BeFalse())
-
-									Expect(fakeV2Actor.FindRouteBoundToSpaceWithSettingsCallCount()).To(Equal(1), "Expected FindRouteBoundToSpaceWithSettings to be called once, but it was not")
-									spaceRoute := fakeV2Actor.FindRouteBoundToSpaceWithSettingsArgsForCall(0)
-									Expect(spaceRoute).To(Equal(v2action.Route{
-										Host: "some-app",
-										Domain: v2action.Domain{
-											Name: "some-domain",
-											GUID: "some-domain-guid",
-										},
-										SpaceGUID: "some-space-guid",
-									}))
-
-									Expect(fakeV2Actor.MapRouteToApplicationCallCount()).To(Equal(1), "Expected MapRouteToApplication to be called once, but it was not")
-									routeGUID, appGUID := fakeV2Actor.MapRouteToApplicationArgsForCall(0)
-									Expect(routeGUID).To(Equal("some-route-guid"))
-									Expect(appGUID).To(Equal("some-app-guid"))
-								})
-							})
-						})
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-error"))
+						Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-create-warning"))
+					})
+				})
+
+				When("the create route command succeeds", func() {
+					BeforeEach(func() {
+						fakeV7Actor.CreateRouteReturns(
+							v7action.Route{GUID: "some-route-guid", Host: "some-app", DomainName: "some-domain.com", DomainGUID: "some-domain-guid", SpaceGUID: spaceGUID},
+							v7action.Warnings{"route-create-warning"},
+							nil,
+						)
+						fakeV7Actor.GetRouteDestinationsByAppGUIDReturns(
+							nil,
+							v7action.Warnings{"destination-warning"},
+							actionerror.RouteDestinationNotFoundError{},
+						)
+						fakeV7Actor.MapRouteReturns(v7action.Warnings{"map-warning"}, nil)
+					})
+
+					It("creates the route, maps it to the app, and returns any warnings", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(warnings).To(ConsistOf("domain-warning", "route-warning", "route-create-warning", "destination-warning", "map-warning"))
+
+						Expect(fakeV7Actor.CreateRouteCallCount()).To(Equal(1))
+						passedSpaceGUID, domainName, hostname, path := fakeV7Actor.CreateRouteArgsForCall(0)
+						Expect(passedSpaceGUID).To(Equal(spaceGUID))
+						Expect(domainName).To(Equal("some-domain.com"))
+						Expect(hostname).To(Equal("some-app"))
+						Expect(path).To(BeEmpty())
+
+						Expect(fakeV7Actor.MapRouteCallCount()).To(Equal(1))
+						routeGUID, appGUID, _, _ := fakeV7Actor.MapRouteArgsForCall(0)
+						Expect(routeGUID).To(Equal("some-route-guid"))
+						Expect(appGUID).To(Equal("some-app-guid"))
 					})
 				})
 			})
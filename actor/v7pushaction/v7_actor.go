@@ -12,7 +12,14 @@ import (
 type V7Actor interface {
 	CreateApplicationInSpace(app v7action.Application, spaceGUID string) (v7action.Application, v7action.Warnings, error)
 	CreateBitsPackageByApplication(appGUID string) (v7action.Package, v7action.Warnings, error)
+	CreateRoute(spaceGUID string, domainName string, hostname string, path string) (v7action.Route, v7action.Warnings, error)
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetApplicationRoutes(appGUID string) (v7action.Routes, v7action.Warnings, error)
+	GetDefaultDomain(orgGUID string) (v7action.Domain, v7action.Warnings, error)
+	GetProcessByTypeAndApplication(processType string, appGUID string) (v7action.Process, v7action.Warnings, error)
+	GetRouteByAttributes(domainName string, domainGUID string, hostname string, path string) (v7action.Route, v7action.Warnings, error)
+	GetRouteDestinationsByAppGUID(routeGUID string, appGUID string) ([]v7action.RouteDestination, v7action.Warnings, error)
+	MapRoute(routeGUID string, appGUID string, processType string, port int) (v7action.Warnings, error)
 	PollBuild(buildGUID string, appName string) (v7action.Droplet, v7action.Warnings, error)
 	PollPackage(pkg v7action.Package) (v7action.Package, v7action.Warnings, error)
 	ScaleProcessByApplication(appGUID string, process v7action.Process) (v7action.Warnings, error)
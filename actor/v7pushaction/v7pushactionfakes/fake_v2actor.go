@@ -1,452 +0,0 @@
-// Code generated by counterfeiter. DO NOT EDIT.
-package v7pushactionfakes
-
-import (
-	sync "sync"
-
-	v2action "code.cloudfoundry.org/cli/actor/v2action"
-	v7pushaction "code.cloudfoundry.org/cli/actor/v7pushaction"
-)
-
-type FakeV2Actor struct {
-	CreateRouteStub        func(v2action.Route, bool) (v2action.Route, v2action.Warnings, error)
-	createRouteMutex       sync.RWMutex
-	createRouteArgsForCall []struct {
-		arg1 v2action.Route
-		arg2 bool
-	}
-	createRouteReturns struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}
-	createRouteReturnsOnCall map[int]struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}
-	FindRouteBoundToSpaceWithSettingsStub        func(v2action.Route) (v2action.Route, v2action.Warnings, error)
-	findRouteBoundToSpaceWithSettingsMutex       sync.RWMutex
-	findRouteBoundToSpaceWithSettingsArgsForCall []struct {
-		arg1 v2action.Route
-	}
-	findRouteBoundToSpaceWithSettingsReturns struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}
-	findRouteBoundToSpaceWithSettingsReturnsOnCall map[int]struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}
-	GetApplicationRoutesStub        func(string) (v2action.Routes, v2action.Warnings, error)
-	getApplicationRoutesMutex       sync.RWMutex
-	getApplicationRoutesArgsForCall []struct {
-		arg1 string
-	}
-	getApplicationRoutesReturns struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}
-	getApplicationRoutesReturnsOnCall map[int]struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}
-	GetOrganizationDomainsStub        func(string) ([]v2action.Domain, v2action.Warnings, error)
-	getOrganizationDomainsMutex       sync.RWMutex
-	getOrganizationDomainsArgsForCall []struct {
-		arg1 string
-	}
-	getOrganizationDomainsReturns struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}
-	getOrganizationDomainsReturnsOnCall map[int]struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}
-	MapRouteToApplicationStub        func(string, string) (v2action.Warnings, error)
-	mapRouteToApplicationMutex       sync.RWMutex
-	mapRouteToApplicationArgsForCall []struct {
-		arg1 string
-		arg2 string
-	}
-	mapRouteToApplicationReturns struct {
-		result1 v2action.Warnings
-		result2 error
-	}
-	mapRouteToApplicationReturnsOnCall map[int]struct {
-		result1 v2action.Warnings
-		result2 error
-	}
-	invocations      map[string][][]interface{}
-	invocationsMutex sync.RWMutex
-}
-
-func (fake *FakeV2Actor) CreateRoute(arg1 v2action.Route, arg2 bool) (v2action.Route, v2action.Warnings, error) {
-	fake.createRouteMutex.Lock()
-	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
-	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
-		arg1 v2action.Route
-		arg2 bool
-	}{arg1, arg2})
-	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2})
-	fake.createRouteMutex.Unlock()
-	if fake.CreateRouteStub != nil {
-		return fake.CreateRouteStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.createRouteReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeV2Actor) CreateRouteCallCount() int {
-	fake.createRouteMutex.RLock()
-	defer fake.createRouteMutex.RUnlock()
-	return len(fake.createRouteArgsForCall)
-}
-
-func (fake *FakeV2Actor) CreateRouteCalls(stub func(v2action.Route, bool) (v2action.Route, v2action.Warnings, error)) {
-	fake.createRouteMutex.Lock()
-	defer fake.createRouteMutex.Unlock()
-	fake.CreateRouteStub = stub
-}
-
-func (fake *FakeV2Actor) CreateRouteArgsForCall(i int) (v2action.Route, bool) {
-	fake.createRouteMutex.RLock()
-	defer fake.createRouteMutex.RUnlock()
-	argsForCall := fake.createRouteArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeV2Actor) CreateRouteReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.createRouteMutex.Lock()
-	defer fake.createRouteMutex.Unlock()
-	fake.CreateRouteStub = nil
-	fake.createRouteReturns = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.createRouteMutex.Lock()
-	defer fake.createRouteMutex.Unlock()
-	fake.CreateRouteStub = nil
-	if fake.createRouteReturnsOnCall == nil {
-		fake.createRouteReturnsOnCall = make(map[int]struct {
-			result1 v2action.Route
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.createRouteReturnsOnCall[i] = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettings(arg1 v2action.Route) (v2action.Route, v2action.Warnings, error) {
-	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
-	ret, specificReturn := fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)]
-	fake.findRouteBoundToSpaceWithSettingsArgsForCall = append(fake.findRouteBoundToSpaceWithSettingsArgsForCall, struct {
-		arg1 v2action.Route
-	}{arg1})
-	fake.recordInvocation("FindRouteBoundToSpaceWithSettings", []interface{}{arg1})
-	fake.findRouteBoundToSpaceWithSettingsMutex.Unlock()
-	if fake.FindRouteBoundToSpaceWithSettingsStub != nil {
-		return fake.FindRouteBoundToSpaceWithSettingsStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.findRouteBoundToSpaceWithSettingsReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsCallCount() int {
-	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
-	return len(fake.findRouteBoundToSpaceWithSettingsArgsForCall)
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsCalls(stub func(v2action.Route) (v2action.Route, v2action.Warnings, error)) {
-	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.Unlock()
-	fake.FindRouteBoundToSpaceWithSettingsStub = stub
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsArgsForCall(i int) v2action.Route {
-	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
-	argsForCall := fake.findRouteBoundToSpaceWithSettingsArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.Unlock()
-	fake.FindRouteBoundToSpaceWithSettingsStub = nil
-	fake.findRouteBoundToSpaceWithSettingsReturns = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) FindRouteBoundToSpaceWithSettingsReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.findRouteBoundToSpaceWithSettingsMutex.Lock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.Unlock()
-	fake.FindRouteBoundToSpaceWithSettingsStub = nil
-	if fake.findRouteBoundToSpaceWithSettingsReturnsOnCall == nil {
-		fake.findRouteBoundToSpaceWithSettingsReturnsOnCall = make(map[int]struct {
-			result1 v2action.Route
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.findRouteBoundToSpaceWithSettingsReturnsOnCall[i] = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutes(arg1 string) (v2action.Routes, v2action.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{arg1})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.getApplicationRoutesReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutesCalls(stub func(string) (v2action.Routes, v2action.Warnings, error)) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = stub
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	argsForCall := fake.getApplicationRoutesArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 v2action.Routes, result2 v2action.Warnings, result3 error) {
-	fake.getApplicationRoutesMutex.Lock()
-	defer fake.getApplicationRoutesMutex.Unlock()
-	fake.GetApplicationRoutesStub = nil
-	if fake.getApplicationRoutesReturnsOnCall == nil {
-		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
-			result1 v2action.Routes
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.getApplicationRoutesReturnsOnCall[i] = struct {
-		result1 v2action.Routes
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomains(arg1 string) ([]v2action.Domain, v2action.Warnings, error) {
-	fake.getOrganizationDomainsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
-	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
-		arg1 string
-	}{arg1})
-	fake.recordInvocation("GetOrganizationDomains", []interface{}{arg1})
-	fake.getOrganizationDomainsMutex.Unlock()
-	if fake.GetOrganizationDomainsStub != nil {
-		return fake.GetOrganizationDomainsStub(arg1)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
-	}
-	fakeReturns := fake.getOrganizationDomainsReturns
-	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomainsCallCount() int {
-	fake.getOrganizationDomainsMutex.RLock()
-	defer fake.getOrganizationDomainsMutex.RUnlock()
-	return len(fake.getOrganizationDomainsArgsForCall)
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomainsCalls(stub func(string) ([]v2action.Domain, v2action.Warnings, error)) {
-	fake.getOrganizationDomainsMutex.Lock()
-	defer fake.getOrganizationDomainsMutex.Unlock()
-	fake.GetOrganizationDomainsStub = stub
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomainsArgsForCall(i int) string {
-	fake.getOrganizationDomainsMutex.RLock()
-	defer fake.getOrganizationDomainsMutex.RUnlock()
-	argsForCall := fake.getOrganizationDomainsArgsForCall[i]
-	return argsForCall.arg1
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomainsReturns(result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
-	fake.getOrganizationDomainsMutex.Lock()
-	defer fake.getOrganizationDomainsMutex.Unlock()
-	fake.GetOrganizationDomainsStub = nil
-	fake.getOrganizationDomainsReturns = struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
-	fake.getOrganizationDomainsMutex.Lock()
-	defer fake.getOrganizationDomainsMutex.Unlock()
-	fake.GetOrganizationDomainsStub = nil
-	if fake.getOrganizationDomainsReturnsOnCall == nil {
-		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
-			result1 []v2action.Domain
-			result2 v2action.Warnings
-			result3 error
-		})
-	}
-	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
-}
-
-func (fake *FakeV2Actor) MapRouteToApplication(arg1 string, arg2 string) (v2action.Warnings, error) {
-	fake.mapRouteToApplicationMutex.Lock()
-	ret, specificReturn := fake.mapRouteToApplicationReturnsOnCall[len(fake.mapRouteToApplicationArgsForCall)]
-	fake.mapRouteToApplicationArgsForCall = append(fake.mapRouteToApplicationArgsForCall, struct {
-		arg1 string
-		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("MapRouteToApplication", []interface{}{arg1, arg2})
-	fake.mapRouteToApplicationMutex.Unlock()
-	if fake.MapRouteToApplicationStub != nil {
-		return fake.MapRouteToApplicationStub(arg1, arg2)
-	}
-	if specificReturn {
-		return ret.result1, ret.result2
-	}
-	fakeReturns := fake.mapRouteToApplicationReturns
-	return fakeReturns.result1, fakeReturns.result2
-}
-
-func (fake *FakeV2Actor) MapRouteToApplicationCallCount() int {
-	fake.mapRouteToApplicationMutex.RLock()
-	defer fake.mapRouteToApplicationMutex.RUnlock()
-	return len(fake.mapRouteToApplicationArgsForCall)
-}
-
-func (fake *FakeV2Actor) MapRouteToApplicationCalls(stub func(string, string) (v2action.Warnings, error)) {
-	fake.mapRouteToApplicationMutex.Lock()
-	defer fake.mapRouteToApplicationMutex.Unlock()
-	fake.MapRouteToApplicationStub = stub
-}
-
-func (fake *FakeV2Actor) MapRouteToApplicationArgsForCall(i int) (string, string) {
-	fake.mapRouteToApplicationMutex.RLock()
-	defer fake.mapRouteToApplicationMutex.RUnlock()
-	argsForCall := fake.mapRouteToApplicationArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
-}
-
-func (fake *FakeV2Actor) MapRouteToApplicationReturns(result1 v2action.Warnings, result2 error) {
-	fake.mapRouteToApplicationMutex.Lock()
-	defer fake.mapRouteToApplicationMutex.Unlock()
-	fake.MapRouteToApplicationStub = nil
-	fake.mapRouteToApplicationReturns = struct {
-		result1 v2action.Warnings
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeV2Actor) MapRouteToApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
-	fake.mapRouteToApplicationMutex.Lock()
-	defer fake.mapRouteToApplicationMutex.Unlock()
-	fake.MapRouteToApplicationStub = nil
-	if fake.mapRouteToApplicationReturnsOnCall == nil {
-		fake.mapRouteToApplicationReturnsOnCall = make(map[int]struct {
-			result1 v2action.Warnings
-			result2 error
-		})
-	}
-	fake.mapRouteToApplicationReturnsOnCall[i] = struct {
-		result1 v2action.Warnings
-		result2 error
-	}{result1, result2}
-}
-
-func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
-	fake.invocationsMutex.RLock()
-	defer fake.invocationsMutex.RUnlock()
-	fake.createRouteMutex.RLock()
-	defer fake.createRouteMutex.RUnlock()
-	fake.findRouteBoundToSpaceWithSettingsMutex.RLock()
-	defer fake.findRouteBoundToSpaceWithSettingsMutex.RUnlock()
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	fake.getOrganizationDomainsMutex.RLock()
-	defer fake.getOrganizationDomainsMutex.RUnlock()
-	fake.mapRouteToApplicationMutex.RLock()
-	defer fake.mapRouteToApplicationMutex.RUnlock()
-	copiedInvocations := map[string][][]interface{}{}
-	for key, value := range fake.invocations {
-		copiedInvocations[key] = value
-	}
-	return copiedInvocations
-}
-
-func (fake *FakeV2Actor) recordInvocation(key string, args []interface{}) {
-	fake.invocationsMutex.Lock()
-	defer fake.invocationsMutex.Unlock()
-	if fake.invocations == nil {
-		fake.invocations = map[string][][]interface{}{}
-	}
-	if fake.invocations[key] == nil {
-		fake.invocations[key] = [][]interface{}{}
-	}
-	fake.invocations[key] = append(fake.invocations[key], args)
-}
-
-var _ v7pushaction.V2Actor = new(FakeV2Actor)
@@ -42,6 +42,24 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	CreateRouteStub        func(string, string, string, string) (v7action.Route, v7action.Warnings, error)
+	createRouteMutex       sync.RWMutex
+	createRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	createRouteReturns struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	createRouteReturnsOnCall map[int]struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -58,6 +76,102 @@ type FakeV7Actor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetApplicationRoutesStub        func(string) (v7action.Routes, v7action.Warnings, error)
+	getApplicationRoutesMutex       sync.RWMutex
+	getApplicationRoutesArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationRoutesReturns struct {
+		result1 v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationRoutesReturnsOnCall map[int]struct {
+		result1 v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDefaultDomainStub        func(string) (v7action.Domain, v7action.Warnings, error)
+	getDefaultDomainMutex       sync.RWMutex
+	getDefaultDomainArgsForCall []struct {
+		arg1 string
+	}
+	getDefaultDomainReturns struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDefaultDomainReturnsOnCall map[int]struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetProcessByTypeAndApplicationStub        func(string, string) (v7action.Process, v7action.Warnings, error)
+	getProcessByTypeAndApplicationMutex       sync.RWMutex
+	getProcessByTypeAndApplicationArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getProcessByTypeAndApplicationReturns struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
+	getProcessByTypeAndApplicationReturnsOnCall map[int]struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteByAttributesStub        func(string, string, string, string) (v7action.Route, v7action.Warnings, error)
+	getRouteByAttributesMutex       sync.RWMutex
+	getRouteByAttributesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	getRouteByAttributesReturns struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteByAttributesReturnsOnCall map[int]struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteDestinationsByAppGUIDStub        func(string, string) ([]v7action.RouteDestination, v7action.Warnings, error)
+	getRouteDestinationsByAppGUIDMutex       sync.RWMutex
+	getRouteDestinationsByAppGUIDArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteDestinationsByAppGUIDReturns struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteDestinationsByAppGUIDReturnsOnCall map[int]struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	MapRouteStub        func(string, string, string, int) (v7action.Warnings, error)
+	mapRouteMutex       sync.RWMutex
+	mapRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	mapRouteReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	mapRouteReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
 	PollBuildStub        func(string, string) (v7action.Droplet, v7action.Warnings, error)
 	pollBuildMutex       sync.RWMutex
 	pollBuildArgsForCall []struct {
@@ -319,6 +433,75 @@ func (fake *FakeV7Actor) CreateBitsPackageByApplicationReturnsOnCall(i int, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Route, v7action.Warnings, error) {
+	fake.createRouteMutex.Lock()
+	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
+	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createRouteMutex.Unlock()
+	if fake.CreateRouteStub != nil {
+		return fake.CreateRouteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) CreateRouteCallCount() int {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return len(fake.createRouteArgsForCall)
+}
+
+func (fake *FakeV7Actor) CreateRouteCalls(stub func(string, string, string, string) (v7action.Route, v7action.Warnings, error)) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = stub
+}
+
+func (fake *FakeV7Actor) CreateRouteArgsForCall(i int) (string, string, string, string) {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	argsForCall := fake.createRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeV7Actor) CreateRouteReturns(result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	fake.createRouteReturns = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) CreateRouteReturnsOnCall(i int, result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	if fake.createRouteReturnsOnCall == nil {
+		fake.createRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createRouteReturnsOnCall[i] = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeV7Actor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
 	fake.getApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
@@ -386,6 +569,407 @@ func (fake *FakeV7Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV7Actor) GetApplicationRoutes(arg1 string) (v7action.Routes, v7action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{arg1})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesCalls(stub func(string) (v7action.Routes, v7action.Warnings, error)) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = stub
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	argsForCall := fake.getApplicationRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesReturns(result1 v7action.Routes, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetApplicationRoutesReturnsOnCall(i int, result1 v7action.Routes, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationRoutesMutex.Lock()
+	defer fake.getApplicationRoutesMutex.Unlock()
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Routes
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDefaultDomain(arg1 string) (v7action.Domain, v7action.Warnings, error) {
+	fake.getDefaultDomainMutex.Lock()
+	ret, specificReturn := fake.getDefaultDomainReturnsOnCall[len(fake.getDefaultDomainArgsForCall)]
+	fake.getDefaultDomainArgsForCall = append(fake.getDefaultDomainArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDefaultDomain", []interface{}{arg1})
+	fake.getDefaultDomainMutex.Unlock()
+	if fake.GetDefaultDomainStub != nil {
+		return fake.GetDefaultDomainStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDefaultDomainReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetDefaultDomainCallCount() int {
+	fake.getDefaultDomainMutex.RLock()
+	defer fake.getDefaultDomainMutex.RUnlock()
+	return len(fake.getDefaultDomainArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetDefaultDomainCalls(stub func(string) (v7action.Domain, v7action.Warnings, error)) {
+	fake.getDefaultDomainMutex.Lock()
+	defer fake.getDefaultDomainMutex.Unlock()
+	fake.GetDefaultDomainStub = stub
+}
+
+func (fake *FakeV7Actor) GetDefaultDomainArgsForCall(i int) string {
+	fake.getDefaultDomainMutex.RLock()
+	defer fake.getDefaultDomainMutex.RUnlock()
+	argsForCall := fake.getDefaultDomainArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV7Actor) GetDefaultDomainReturns(result1 v7action.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDefaultDomainMutex.Lock()
+	defer fake.getDefaultDomainMutex.Unlock()
+	fake.GetDefaultDomainStub = nil
+	fake.getDefaultDomainReturns = struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetDefaultDomainReturnsOnCall(i int, result1 v7action.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDefaultDomainMutex.Lock()
+	defer fake.getDefaultDomainMutex.Unlock()
+	fake.GetDefaultDomainStub = nil
+	if fake.getDefaultDomainReturnsOnCall == nil {
+		fake.getDefaultDomainReturnsOnCall = make(map[int]struct {
+			result1 v7action.Domain
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDefaultDomainReturnsOnCall[i] = struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplication(arg1 string, arg2 string) (v7action.Process, v7action.Warnings, error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	ret, specificReturn := fake.getProcessByTypeAndApplicationReturnsOnCall[len(fake.getProcessByTypeAndApplicationArgsForCall)]
+	fake.getProcessByTypeAndApplicationArgsForCall = append(fake.getProcessByTypeAndApplicationArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetProcessByTypeAndApplication", []interface{}{arg1, arg2})
+	fake.getProcessByTypeAndApplicationMutex.Unlock()
+	if fake.GetProcessByTypeAndApplicationStub != nil {
+		return fake.GetProcessByTypeAndApplicationStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getProcessByTypeAndApplicationReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCallCount() int {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	return len(fake.getProcessByTypeAndApplicationArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationCalls(stub func(string, string) (v7action.Process, v7action.Warnings, error)) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = stub
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationArgsForCall(i int) (string, string) {
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	argsForCall := fake.getProcessByTypeAndApplicationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturns(result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	fake.getProcessByTypeAndApplicationReturns = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetProcessByTypeAndApplicationReturnsOnCall(i int, result1 v7action.Process, result2 v7action.Warnings, result3 error) {
+	fake.getProcessByTypeAndApplicationMutex.Lock()
+	defer fake.getProcessByTypeAndApplicationMutex.Unlock()
+	fake.GetProcessByTypeAndApplicationStub = nil
+	if fake.getProcessByTypeAndApplicationReturnsOnCall == nil {
+		fake.getProcessByTypeAndApplicationReturnsOnCall = make(map[int]struct {
+			result1 v7action.Process
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getProcessByTypeAndApplicationReturnsOnCall[i] = struct {
+		result1 v7action.Process
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributes(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Route, v7action.Warnings, error) {
+	fake.getRouteByAttributesMutex.Lock()
+	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
+	fake.getRouteByAttributesArgsForCall = append(fake.getRouteByAttributesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetRouteByAttributes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRouteByAttributesMutex.Unlock()
+	if fake.GetRouteByAttributesStub != nil {
+		return fake.GetRouteByAttributesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteByAttributesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributesCallCount() int {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	return len(fake.getRouteByAttributesArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributesCalls(stub func(string, string, string, string) (v7action.Route, v7action.Warnings, error)) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = stub
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributesArgsForCall(i int) (string, string, string, string) {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	argsForCall := fake.getRouteByAttributesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributesReturns(result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = nil
+	fake.getRouteByAttributesReturns = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetRouteByAttributesReturnsOnCall(i int, result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = nil
+	if fake.getRouteByAttributesReturnsOnCall == nil {
+		fake.getRouteByAttributesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteByAttributesReturnsOnCall[i] = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUID(arg1 string, arg2 string) ([]v7action.RouteDestination, v7action.Warnings, error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsByAppGUIDReturnsOnCall[len(fake.getRouteDestinationsByAppGUIDArgsForCall)]
+	fake.getRouteDestinationsByAppGUIDArgsForCall = append(fake.getRouteDestinationsByAppGUIDArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRouteDestinationsByAppGUID", []interface{}{arg1, arg2})
+	fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	if fake.GetRouteDestinationsByAppGUIDStub != nil {
+		return fake.GetRouteDestinationsByAppGUIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteDestinationsByAppGUIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUIDCallCount() int {
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	return len(fake.getRouteDestinationsByAppGUIDArgsForCall)
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUIDCalls(stub func(string, string) ([]v7action.RouteDestination, v7action.Warnings, error)) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = stub
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUIDArgsForCall(i int) (string, string) {
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	argsForCall := fake.getRouteDestinationsByAppGUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUIDReturns(result1 []v7action.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = nil
+	fake.getRouteDestinationsByAppGUIDReturns = struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) GetRouteDestinationsByAppGUIDReturnsOnCall(i int, result1 []v7action.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = nil
+	if fake.getRouteDestinationsByAppGUIDReturnsOnCall == nil {
+		fake.getRouteDestinationsByAppGUIDReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteDestination
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsByAppGUIDReturnsOnCall[i] = struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV7Actor) MapRoute(arg1 string, arg2 string, arg3 string, arg4 int) (v7action.Warnings, error) {
+	fake.mapRouteMutex.Lock()
+	ret, specificReturn := fake.mapRouteReturnsOnCall[len(fake.mapRouteArgsForCall)]
+	fake.mapRouteArgsForCall = append(fake.mapRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("MapRoute", []interface{}{arg1, arg2, arg3, arg4})
+	fake.mapRouteMutex.Unlock()
+	if fake.MapRouteStub != nil {
+		return fake.MapRouteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.mapRouteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV7Actor) MapRouteCallCount() int {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	return len(fake.mapRouteArgsForCall)
+}
+
+func (fake *FakeV7Actor) MapRouteCalls(stub func(string, string, string, int) (v7action.Warnings, error)) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = stub
+}
+
+func (fake *FakeV7Actor) MapRouteArgsForCall(i int) (string, string, string, int) {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	argsForCall := fake.mapRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeV7Actor) MapRouteReturns(result1 v7action.Warnings, result2 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	fake.mapRouteReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV7Actor) MapRouteReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	if fake.mapRouteReturnsOnCall == nil {
+		fake.mapRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeV7Actor) PollBuild(arg1 string, arg2 string) (v7action.Droplet, v7action.Warnings, error) {
 	fake.pollBuildMutex.Lock()
 	ret, specificReturn := fake.pollBuildReturnsOnCall[len(fake.pollBuildArgsForCall)]
@@ -927,8 +1511,22 @@ func (fake *FakeV7Actor) Invocations() map[string][][]interface{} {
 	defer fake.createApplicationInSpaceMutex.RUnlock()
 	fake.createBitsPackageByApplicationMutex.RLock()
 	defer fake.createBitsPackageByApplicationMutex.RUnlock()
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getDefaultDomainMutex.RLock()
+	defer fake.getDefaultDomainMutex.RUnlock()
+	fake.getProcessByTypeAndApplicationMutex.RLock()
+	defer fake.getProcessByTypeAndApplicationMutex.RUnlock()
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
 	fake.pollBuildMutex.RLock()
 	defer fake.pollBuildMutex.RUnlock()
 	fake.pollPackageMutex.RLock()
@@ -0,0 +1,13 @@
+package ccerror
+
+import "fmt"
+
+// InvalidRequestPathError is returned when a raw request path is not an
+// absolute path on the targeted Cloud Controller.
+type InvalidRequestPathError struct {
+	Path string
+}
+
+func (e InvalidRequestPathError) Error() string {
+	return fmt.Sprintf("Request path %s must start with / and stay on the targeted API", e.Path)
+}
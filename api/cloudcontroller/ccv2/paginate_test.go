@@ -0,0 +1,85 @@
+package ccv2_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paginate", func() {
+	var (
+		client *Client
+
+		failingPage string
+
+		apps       []Application
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient(Config{PaginationWorkers: 2})
+		failingPage = ""
+
+		server.RouteToHandler(http.MethodGet, "/v2/apps", func(w http.ResponseWriter, req *http.Request) {
+			page := req.URL.Query().Get("page")
+			if page == "" {
+				page = "1"
+			}
+
+			w.Header().Set("X-Cf-Warnings", "warning-"+page)
+			if page == failingPage {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"code": 100004, "description": "The app could not be found", "error_code": "CF-AppNotFound"}`)
+				return
+			}
+
+			next := "null"
+			if page == "1" {
+				next = `"/v2/apps?q=space_guid:some-space-guid&page=2&results-per-page=1"`
+			}
+			fmt.Fprintf(w, `{
+				"total_pages": 4,
+				"next_url": %s,
+				"resources": [{"metadata": {"guid": "app-guid-%s"}, "entity": {"name": "app-name-%s"}}]
+			}`, next, page, page)
+		})
+	})
+
+	JustBeforeEach(func() {
+		apps, warnings, executeErr = client.GetApplications(Filter{
+			Type:     constant.SpaceGUIDFilter,
+			Operator: constant.EqualOperator,
+			Values:   []string{"some-space-guid"},
+		})
+	})
+
+	When("the first page reports the total number of pages", func() {
+		It("fetches the remaining pages and returns the resources and warnings in page order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+
+			var names []string
+			for _, app := range apps {
+				names = append(names, app.Name)
+			}
+			Expect(names).To(Equal([]string{"app-name-1", "app-name-2", "app-name-3", "app-name-4"}))
+		})
+	})
+
+	When("one of the remaining pages fails", func() {
+		BeforeEach(func() {
+			failingPage = "3"
+		})
+
+		It("returns the error and the warnings up to the failing page", func() {
+			Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found"}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+		})
+	})
+})
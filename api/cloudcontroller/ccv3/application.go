@@ -20,6 +20,8 @@ type Application struct {
 	LifecycleBuildpacks []string
 	// LifecycleType is the type of the lifecycle.
 	LifecycleType constant.AppLifecycleType
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata
 	// Name is the name given to the application.
 	Name string
 	// Relationships list the relationships to the application.
@@ -33,6 +35,7 @@ func (a Application) MarshalJSON() ([]byte, error) {
 	ccApp := ccApplication{
 		Name:          a.Name,
 		Relationships: a.Relationships,
+		Metadata:      a.Metadata,
 	}
 
 	if a.LifecycleType == constant.AppLifecycleTypeDocker {
@@ -66,6 +69,7 @@ func (a *Application) UnmarshalJSON(data []byte) error {
 	a.StackName = lifecycle.Data.Stack
 	a.LifecycleBuildpacks = lifecycle.Data.Buildpacks
 	a.LifecycleType = lifecycle.Type
+	a.Metadata = ccApp.Metadata
 	a.Name = ccApp.Name
 	a.Relationships = ccApp.Relationships
 	a.State = ccApp.State
@@ -94,6 +98,7 @@ type ccApplication struct {
 	Lifecycle     interface{}               `json:"lifecycle,omitempty"`
 	GUID          string                    `json:"guid,omitempty"`
 	State         constant.ApplicationState `json:"state,omitempty"`
+	Metadata      *Metadata                 `json:"metadata,omitempty"`
 }
 
 func (ccApp *ccApplication) setAutodetectedBuildpackLifecycle(a Application) {
@@ -222,6 +227,7 @@ func (client *Client) UpdateApplicationStart(appGUID string) (Application, Warni
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PostApplicationActionStartRequest,
 		URIParams:   map[string]string{"app_guid": appGUID},
+		Idempotent:  true,
 	})
 	if err != nil {
 		return Application{}, nil, err
@@ -241,6 +247,7 @@ func (client *Client) UpdateApplicationStop(appGUID string) (Application, Warnin
 	request, err := client.newHTTPRequest(requestOptions{
 		RequestName: internal.PostApplicationActionStopRequest,
 		URIParams:   map[string]string{"app_guid": appGUID},
+		Idempotent:  true,
 	})
 	if err != nil {
 		return Application{}, nil, err
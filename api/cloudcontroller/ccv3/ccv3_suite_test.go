@@ -128,6 +128,15 @@ func SetupV3Response() {
 			},
 			"deployments": {
 				"href": "SERVER_URL/v3/deployments"
+			},
+			"domains": {
+				"href": "SERVER_URL/v3/domains"
+			},
+			"revisions": {
+				"href": "SERVER_URL/v3/revisions"
+			},
+			"routes": {
+				"href": "SERVER_URL/v3/routes"
 			}
 		}
 	}`, "SERVER_URL", serverURL, -1)
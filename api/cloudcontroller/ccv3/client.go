@@ -97,6 +97,7 @@ type Client struct {
 
 	jobPollingInterval time.Duration
 	jobPollingTimeout  time.Duration
+	paginationWorkers  int
 
 	clock Clock
 }
@@ -115,6 +116,10 @@ type Config struct {
 	// JobPollingInterval is the wait time between job polls.
 	JobPollingInterval time.Duration
 
+	// PaginationWorkers is the number of pages fetched at the same time.
+	// Defaults to cloudcontroller.DefaultPaginationWorkers.
+	PaginationWorkers int
+
 	// Wrappers that apply to the client connection.
 	Wrappers []ConnectionWrapper
 }
@@ -127,6 +132,7 @@ func NewClient(config Config) *Client {
 		userAgent:          userAgent,
 		jobPollingInterval: config.JobPollingInterval,
 		jobPollingTimeout:  config.JobPollingTimeout,
+		paginationWorkers:  config.PaginationWorkers,
 		wrappers:           append([]ConnectionWrapper{newErrorWrapper()}, config.Wrappers...),
 	}
 }
@@ -9,6 +9,13 @@ const (
 	// application.
 	RelationshipTypeApplication RelationshipType = "app"
 
+	// RelationshipTypeDomain is a relationship with a Cloud Controller domain.
+	RelationshipTypeDomain RelationshipType = "domain"
+
+	// RelationshipTypeOrganization is a relationship with a Cloud Controller
+	// organization.
+	RelationshipTypeOrganization RelationshipType = "organization"
+
 	// RelationshipTypeSpace is a relationship with a CloudController space.
 	RelationshipTypeSpace RelationshipType = "space"
 )
@@ -14,6 +14,7 @@ type Deployment struct {
 	GUID          string
 	State         constant.DeploymentState
 	DropletGUID   string
+	RevisionGUID  string
 	CreatedAt     string
 	UpdatedAt     string
 	Relationships Relationships
@@ -25,8 +26,13 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		GUID string `json:"guid,omitempty"`
 	}
 
+	type Revision struct {
+		GUID string `json:"guid,omitempty"`
+	}
+
 	var ccDeployment struct {
 		Droplet       *Droplet      `json:"droplet,omitempty"`
+		Revision      *Revision     `json:"revision,omitempty"`
 		Relationships Relationships `json:"relationships,omitempty"`
 	}
 
@@ -34,6 +40,10 @@ func (d Deployment) MarshalJSON() ([]byte, error) {
 		ccDeployment.Droplet = &Droplet{d.DropletGUID}
 	}
 
+	if d.RevisionGUID != "" {
+		ccDeployment.Revision = &Revision{d.RevisionGUID}
+	}
+
 	ccDeployment.Relationships = d.Relationships
 
 	return json.Marshal(ccDeployment)
@@ -47,6 +57,9 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 		Relationships Relationships            `json:"relationships,omitempty"`
 		State         constant.DeploymentState `json:"state,omitempty"`
 		Droplet       Droplet                  `json:"droplet,omitempty"`
+		Revision      struct {
+			GUID string `json:"guid"`
+		} `json:"revision,omitempty"`
 	}
 	err := cloudcontroller.DecodeJSON(data, &ccDeployment)
 	if err != nil {
@@ -58,6 +71,7 @@ func (d *Deployment) UnmarshalJSON(data []byte) error {
 	d.Relationships = ccDeployment.Relationships
 	d.State = ccDeployment.State
 	d.DropletGUID = ccDeployment.Droplet.GUID
+	d.RevisionGUID = ccDeployment.Revision.GUID
 
 	return nil
 }
@@ -84,6 +98,22 @@ func (client *Client) CreateApplicationDeployment(appGUID string, dropletGUID st
 		DropletGUID:   dropletGUID,
 		Relationships: Relationships{constant.RelationshipTypeApplication: Relationship{GUID: appGUID}},
 	}
+
+	return client.createDeployment(dep)
+}
+
+// CreateApplicationDeploymentByRevision creates a deployment that rolls the
+// application back to the given revision.
+func (client *Client) CreateApplicationDeploymentByRevision(appGUID string, revisionGUID string) (string, Warnings, error) {
+	dep := Deployment{
+		RevisionGUID:  revisionGUID,
+		Relationships: Relationships{constant.RelationshipTypeApplication: Relationship{GUID: appGUID}},
+	}
+
+	return client.createDeployment(dep)
+}
+
+func (client *Client) createDeployment(dep Deployment) (string, Warnings, error) {
 	bodyBytes, err := json.Marshal(dep)
 
 	if err != nil {
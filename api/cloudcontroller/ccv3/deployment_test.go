@@ -186,6 +186,77 @@ var _ = Describe("Task", func() {
 		})
 	})
 
+	Describe("CreateApplicationDeploymentByRevision", func() {
+		var (
+			deploymentGUID string
+			warnings       Warnings
+			executeErr     error
+		)
+
+		JustBeforeEach(func() {
+			deploymentGUID, warnings, executeErr = client.CreateApplicationDeploymentByRevision("some-app-guid", "some-revision-guid")
+		})
+
+		When("creating the deployment succeeds", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-deployment-guid",
+					"revision": {
+						"guid": "some-revision-guid",
+						"version": 2
+					},
+					"relationships": {
+						"app": {
+							"data": {
+								"guid": "some-app-guid"
+							}
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						VerifyJSON(`{"revision":{"guid":"some-revision-guid"}, "relationships":{"app":{"data":{"guid":"some-app-guid"}}}}`),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("creates the deployment from the revision and returns all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Unable to deploy this revision, the droplet for this revision no longer exists.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/deployments"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Unable to deploy this revision, the droplet for this revision no longer exists.",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
 	Describe("GetDeployment", func() {
 		var response string
 		Context("When the deployments exists", func() {
@@ -0,0 +1,146 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Domain represents a Cloud Controller V3 Domain.
+type Domain struct {
+	// GUID is the unique domain identifier.
+	GUID string
+	// Name is the fully qualified name of the domain.
+	Name string
+	// Internal indicates whether the domain is only available for internal
+	// (container to container) traffic.
+	Internal types.NullBool
+	// OrganizationGUID is the GUID of the organization that owns the domain. It
+	// is empty for shared domains.
+	OrganizationGUID string
+}
+
+// MarshalJSON converts a Domain into a Cloud Controller Domain.
+func (d Domain) MarshalJSON() ([]byte, error) {
+	ccDomain := struct {
+		Name          string        `json:"name"`
+		Internal      *bool         `json:"internal,omitempty"`
+		Relationships Relationships `json:"relationships,omitempty"`
+	}{
+		Name: d.Name,
+	}
+
+	if d.Internal.IsSet {
+		ccDomain.Internal = &d.Internal.Value
+	}
+
+	if d.OrganizationGUID != "" {
+		ccDomain.Relationships = Relationships{
+			constant.RelationshipTypeOrganization: Relationship{GUID: d.OrganizationGUID},
+		}
+	}
+
+	return json.Marshal(ccDomain)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Domain response.
+func (d *Domain) UnmarshalJSON(data []byte) error {
+	var ccDomain struct {
+		GUID          string        `json:"guid"`
+		Name          string        `json:"name"`
+		Internal      bool          `json:"internal"`
+		Relationships Relationships `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccDomain)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDomain.GUID
+	d.Name = ccDomain.Name
+	d.Internal = types.NullBool{IsSet: true, Value: ccDomain.Internal}
+	d.OrganizationGUID = ccDomain.Relationships[constant.RelationshipTypeOrganization].GUID
+
+	return nil
+}
+
+// Shared returns true if the domain is not owned by an organization.
+func (d Domain) Shared() bool {
+	return d.OrganizationGUID == ""
+}
+
+// CreateDomain creates a domain with the given settings. A shared domain is
+// created when the domain has no organization GUID.
+func (client *Client) CreateDomain(domain Domain) (Domain, Warnings, error) {
+	bodyBytes, err := json.Marshal(domain)
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostDomainRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Domain{}, nil, err
+	}
+
+	var responseDomain Domain
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseDomain,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDomain, response.Warnings, err
+}
+
+// GetDomains lists domains with optional filters.
+func (client *Client) GetDomains(query ...Query) ([]Domain, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetDomainsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateDomains(request)
+}
+
+// GetOrganizationDomains lists the shared domains and the domains owned by or
+// shared with the given organization, with optional filters.
+func (client *Client) GetOrganizationDomains(orgGUID string, query ...Query) ([]Domain, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetOrganizationDomainsRequest,
+		URIParams:   internal.Params{"organization_guid": orgGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateDomains(request)
+}
+
+func (client *Client) paginateDomains(request *cloudcontroller.Request) ([]Domain, Warnings, error) {
+	var fullDomainsList []Domain
+	warnings, err := client.paginate(request, Domain{}, func(item interface{}) error {
+		if domain, ok := item.(Domain); ok {
+			fullDomainsList = append(fullDomainsList, domain)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Domain{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullDomainsList, warnings, err
+}
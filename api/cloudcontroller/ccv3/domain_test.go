@@ -0,0 +1,296 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Domain", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("CreateDomain", func() {
+		var (
+			domain     Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domain, warnings, executeErr = client.CreateDomain(domain)
+		})
+
+		When("the domain is private", func() {
+			BeforeEach(func() {
+				domain = Domain{Name: "private.com", OrganizationGUID: "some-org-guid"}
+
+				response := `{
+					"guid": "some-domain-guid",
+					"name": "private.com",
+					"internal": false,
+					"relationships": {
+						"organization": {
+							"data": { "guid": "some-org-guid" }
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"name": "private.com",
+					"relationships": map[string]interface{}{
+						"organization": map[string]interface{}{
+							"data": map[string]string{"guid": "some-org-guid"},
+						},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/domains"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created domain and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(domain).To(Equal(Domain{
+					GUID:             "some-domain-guid",
+					Name:             "private.com",
+					Internal:         types.NullBool{IsSet: true, Value: false},
+					OrganizationGUID: "some-org-guid",
+				}))
+				Expect(domain.Shared()).To(BeFalse())
+			})
+		})
+
+		When("the domain is shared and internal", func() {
+			BeforeEach(func() {
+				domain = Domain{Name: "apps.internal", Internal: types.NullBool{IsSet: true, Value: true}}
+
+				response := `{
+					"guid": "some-domain-guid",
+					"name": "apps.internal",
+					"internal": true,
+					"relationships": {
+						"organization": {
+							"data": null
+						}
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"name":     "apps.internal",
+					"internal": true,
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/domains"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created domain and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(domain).To(Equal(Domain{
+					GUID:     "some-domain-guid",
+					Name:     "apps.internal",
+					Internal: types.NullBool{IsSet: true, Value: true},
+				}))
+				Expect(domain.Shared()).To(BeTrue())
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				domain = Domain{Name: "private.com"}
+
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "The request is semantically invalid: name must be unique",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/domains"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "The request is semantically invalid: name must be unique"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetDomains", func() {
+		var (
+			query      Query
+			domains    []Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domains, warnings, executeErr = client.GetDomains(query)
+		})
+
+		When("domains exist", func() {
+			BeforeEach(func() {
+				query = Query{Key: NameFilter, Values: []string{"domain-1.com", "domain-2.com"}}
+
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/domains?names=domain-1.com,domain-2.com&page=2&per_page=1"
+						}
+					},
+					"resources": [
+						{
+							"guid": "domain-guid-1",
+							"name": "domain-1.com",
+							"internal": false,
+							"relationships": {
+								"organization": { "data": null }
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "domain-guid-2",
+							"name": "domain-2.com",
+							"internal": false,
+							"relationships": {
+								"organization": { "data": { "guid": "some-org-guid" } }
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/domains", "names=domain-1.com,domain-2.com"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/domains", "names=domain-1.com,domain-2.com&page=2&per_page=1"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the domains and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(domains).To(ConsistOf(
+					Domain{GUID: "domain-guid-1", Name: "domain-1.com", Internal: types.NullBool{IsSet: true, Value: false}},
+					Domain{GUID: "domain-guid-2", Name: "domain-2.com", Internal: types.NullBool{IsSet: true, Value: false}, OrganizationGUID: "some-org-guid"},
+				))
+			})
+		})
+	})
+
+	Describe("GetOrganizationDomains", func() {
+		var (
+			domains    []Domain
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			domains, warnings, executeErr = client.GetOrganizationDomains("some-org-guid")
+		})
+
+		When("the organization has domains", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "domain-guid-1",
+							"name": "domain-1.com",
+							"internal": false,
+							"relationships": {
+								"organization": { "data": null }
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/organizations/some-org-guid/domains"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the domains and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(domains).To(ConsistOf(
+					Domain{GUID: "domain-guid-1", Name: "domain-1.com", Internal: types.NullBool{IsSet: true, Value: false}},
+				))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Organization not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/organizations/some-org-guid/domains"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Organization not found"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
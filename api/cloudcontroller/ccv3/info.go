@@ -18,6 +18,9 @@ type Info struct {
 		// CCV3 is the link to the Cloud Controller V3 API.
 		CCV3 APILink `json:"cloud_controller_v3"`
 
+		// LogCache is the link to the Log Cache API.
+		LogCache APILink `json:"log_cache"`
+
 		// Logging is the link to the Logging API.
 		Logging APILink `json:"logging"`
 
@@ -46,6 +49,11 @@ func (info Info) CloudControllerAPIVersion() string {
 	return info.Links.CCV3.Meta.Version
 }
 
+// LogCache returns the HREF of the Log Cache API.
+func (info Info) LogCache() string {
+	return info.Links.LogCache.HREF
+}
+
 // Logging returns the HREF of the Loggregator Traffic Controller.
 func (info Info) Logging() string {
 	return info.Links.Logging.HREF
@@ -4,11 +4,14 @@ const (
 	AppsResource              = "apps"
 	BuildsResource            = "builds"
 	DeploymentsResource       = "deployments"
+	DomainsResource           = "domains"
 	DropletsResource          = "droplets"
 	IsolationSegmentsResource = "isolation_segments"
 	OrgsResource              = "organizations"
 	PackagesResource          = "packages"
 	ProcessesResource         = "processes"
+	RevisionsResource         = "revisions"
+	RoutesResource            = "routes"
 	ServiceInstancesResource  = "service_instances"
 	SpacesResource            = "spaces"
 	TasksResource             = "tasks"
@@ -27,6 +27,40 @@ func (client *Client) DeleteApplication(appGUID string) (JobURL, Warnings, error
 	return JobURL(response.ResourceLocationURL), response.Warnings, err
 }
 
+// DeleteDomain deletes the domain with the given GUID. Returns back a
+// resulting job URL to poll.
+func (client *Client) DeleteDomain(domainGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteDomainRequest,
+		URIParams:   internal.Params{"domain_guid": domainGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
+// DeleteRoute deletes the route with the given GUID. Returns back a resulting
+// job URL to poll.
+func (client *Client) DeleteRoute(routeGUID string) (JobURL, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+
+	return JobURL(response.ResourceLocationURL), response.Warnings, err
+}
+
 // UpdateApplicationApplyManifest applies the manifest to the given
 // application. Returns back a resulting job URL to poll.
 func (client *Client) UpdateApplicationApplyManifest(appGUID string, rawManifest []byte) (JobURL, Warnings, error) {
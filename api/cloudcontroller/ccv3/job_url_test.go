@@ -95,6 +95,124 @@ var _ = Describe("Job URL", func() {
 		})
 	})
 
+	Describe("DeleteDomain", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeleteDomain("some-domain-guid")
+		})
+
+		When("the domain is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/domains/some-domain-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("deleting the domain returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Domain not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/domains/some-domain-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Domain not found"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
+	Describe("DeleteRoute", func() {
+		var (
+			jobLocation JobURL
+			warnings    Warnings
+			executeErr  error
+		)
+
+		JustBeforeEach(func() {
+			jobLocation, warnings, executeErr = client.DeleteRoute("some-route-guid")
+		})
+
+		When("the route is deleted successfully", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/routes/some-route-guid"),
+						RespondWith(http.StatusAccepted, ``,
+							http.Header{
+								"X-Cf-Warnings": {"some-warning"},
+								"Location":      {"/v3/jobs/some-location"},
+							},
+						),
+					),
+				)
+			})
+
+			It("returns the job URL and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(jobLocation).To(Equal(JobURL("/v3/jobs/some-location")))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+
+		When("deleting the route returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+  "errors": [
+    {
+      "code": 10010,
+      "detail": "Route not found",
+      "title": "CF-ResourceNotFound"
+    }
+  ]
+}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/routes/some-route-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"some-warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Route not found"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("UpdateApplicationApplyManifest", func() {
 		var (
 			manifestBody []byte
@@ -0,0 +1,67 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Metadata is used for custom tagging of API resources.
+type Metadata struct {
+	// Labels are key/value pairs that can be used to select resources.
+	Labels map[string]types.NullString `json:"labels,omitempty"`
+	// Annotations are key/value pairs that hold additional information about
+	// a resource.
+	Annotations map[string]types.NullString `json:"annotations,omitempty"`
+}
+
+// ResourceMetadata represents the metadata of any resource that supports it.
+type ResourceMetadata struct {
+	Metadata *Metadata `json:"metadata,omitempty"`
+}
+
+// UpdateResourceMetadata updates the metadata of the resource of the given
+// type. Labels and annotations that are set to null are removed from the
+// resource; all others are added or replaced.
+func (client *Client) UpdateResourceMetadata(resource string, resourceGUID string, metadata Metadata) (ResourceMetadata, Warnings, error) {
+	var options requestOptions
+	switch resource {
+	case "app":
+		options.RequestName = internal.PatchApplicationRequest
+		options.URIParams = internal.Params{"app_guid": resourceGUID}
+	case "org":
+		options.RequestName = internal.PatchOrganizationRequest
+		options.URIParams = internal.Params{"organization_guid": resourceGUID}
+	case "process":
+		options.RequestName = internal.PatchProcessRequest
+		options.URIParams = internal.Params{"process_guid": resourceGUID}
+	case "space":
+		options.RequestName = internal.PatchSpaceRequest
+		options.URIParams = internal.Params{"space_guid": resourceGUID}
+	default:
+		return ResourceMetadata{}, nil, fmt.Errorf("unknown resource type (%s) requested", resource)
+	}
+
+	bodyBytes, err := json.Marshal(ResourceMetadata{Metadata: &metadata})
+	if err != nil {
+		return ResourceMetadata{}, nil, err
+	}
+	options.Body = bytes.NewReader(bodyBytes)
+
+	request, err := client.newHTTPRequest(options)
+	if err != nil {
+		return ResourceMetadata{}, nil, err
+	}
+
+	var responseMetadata ResourceMetadata
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseMetadata,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseMetadata, response.Warnings, err
+}
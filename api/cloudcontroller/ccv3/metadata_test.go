@@ -0,0 +1,113 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Metadata", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("UpdateResourceMetadata", func() {
+		var (
+			metadata         Metadata
+			resourceMetadata ResourceMetadata
+			warnings         Warnings
+			executeErr       error
+		)
+
+		BeforeEach(func() {
+			metadata = Metadata{
+				Labels: map[string]types.NullString{
+					"owner":       types.NewNullString("team-a"),
+					"cost-center": {},
+				},
+			}
+		})
+
+		DescribeTable("updating the metadata of each resource type",
+			func(resource string, expectedPath string) {
+				expectedBody := map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"labels": map[string]interface{}{
+							"owner":       "team-a",
+							"cost-center": nil,
+						},
+					},
+				}
+				response := `{
+					"metadata": {
+						"labels": {
+							"owner": "team-a"
+						},
+						"annotations": {}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, expectedPath),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+
+				resourceMetadata, warnings, executeErr = client.UpdateResourceMetadata(resource, "some-guid", metadata)
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+				Expect(resourceMetadata.Metadata.Labels).To(Equal(map[string]types.NullString{
+					"owner": types.NewNullString("team-a"),
+				}))
+			},
+			Entry("app", "app", "/v3/apps/some-guid"),
+			Entry("org", "org", "/v3/organizations/some-guid"),
+			Entry("process", "process", "/v3/processes/some-guid"),
+			Entry("space", "space", "/v3/spaces/some-guid"),
+		)
+
+		When("the resource type is unknown", func() {
+			It("returns an error", func() {
+				_, _, executeErr = client.UpdateResourceMetadata("stack", "some-guid", metadata)
+				Expect(executeErr).To(MatchError("unknown resource type (stack) requested"))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Metadata label key error: 'bad key' contains invalid characters",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPatch, "/v3/apps/some-guid"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, executeErr = client.UpdateResourceMetadata("app", "some-guid", metadata)
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{
+					Message: "Metadata label key error: 'bad key' contains invalid characters",
+				}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -11,6 +11,8 @@ type Organization struct {
 	GUID string `json:"guid"`
 	// Name is the name of the organization.
 	Name string `json:"name"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 // GetIsolationSegmentOrganizations lists organizations
@@ -6,6 +6,9 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// paginate fetches every page of the request and passes the resources to
+// appendToExternalList in order. When the first page reports the total number
+// of pages, the remaining pages are fetched concurrently.
 func (client Client) paginate(request *cloudcontroller.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
 	fullWarningsList := Warnings{}
 
@@ -26,17 +29,21 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 			return fullWarningsList, err
 		}
 
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
+		err = appendAll(list, appendToExternalList)
+		if err != nil {
+			return fullWarningsList, err
 		}
 
 		if wrapper.NextPage() == "" {
 			break
 		}
 
+		if pageURLs, ok := cloudcontroller.RemainingPageURLs(wrapper.NextPage(), wrapper.TotalPages()); ok {
+			warnings, err := client.paginateConcurrently(pageURLs, obj, appendToExternalList)
+			fullWarningsList = append(fullWarningsList, warnings...)
+			return fullWarningsList, err
+		}
+
 		request, err = client.newHTTPRequest(requestOptions{
 			URL:    wrapper.NextPage(),
 			Method: http.MethodGet,
@@ -48,3 +55,55 @@ func (client Client) paginate(request *cloudcontroller.Request, obj interface{},
 
 	return fullWarningsList, nil
 }
+
+// paginateConcurrently fetches the pages at pageURLs with a bounded number of
+// workers and passes their resources to appendToExternalList in page order.
+func (client Client) paginateConcurrently(pageURLs []string, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
+	pages := cloudcontroller.FetchPages(pageURLs, client.paginationWorkers, func(pageURL string) cloudcontroller.Page {
+		request, err := client.newHTTPRequest(requestOptions{
+			URL:    pageURL,
+			Method: http.MethodGet,
+		})
+		if err != nil {
+			return cloudcontroller.Page{Err: err}
+		}
+
+		wrapper := NewPaginatedResources(obj)
+		response := cloudcontroller.Response{
+			DecodeJSONResponseInto: &wrapper,
+		}
+
+		err = client.connection.Make(request, &response)
+		if err != nil {
+			return cloudcontroller.Page{Warnings: response.Warnings, Err: err}
+		}
+
+		list, err := wrapper.Resources()
+		return cloudcontroller.Page{Resources: list, Warnings: response.Warnings, Err: err}
+	})
+
+	warnings := Warnings{}
+	for _, page := range pages {
+		warnings = append(warnings, page.Warnings...)
+		if page.Err != nil {
+			return warnings, page.Err
+		}
+
+		err := appendAll(page.Resources, appendToExternalList)
+		if err != nil {
+			return warnings, err
+		}
+	}
+
+	return warnings, nil
+}
+
+func appendAll(list []interface{}, appendToExternalList func(interface{}) error) error {
+	for _, item := range list {
+		err := appendToExternalList(item)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paginate", func() {
+	var (
+		client *Client
+
+		failingPage string
+
+		apps       []Application
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client, _ = NewTestClient(Config{AppName: "CF CLI API V3 Test", AppVersion: "Unknown", PaginationWorkers: 2})
+		failingPage = ""
+
+		server.RouteToHandler(http.MethodGet, "/v3/apps", func(w http.ResponseWriter, req *http.Request) {
+			page := req.URL.Query().Get("page")
+			if page == "" {
+				page = "1"
+			}
+
+			w.Header().Set("X-Cf-Warnings", "warning-"+page)
+			if page == failingPage {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, `{"errors": [{"code": 10010, "detail": "App not found", "title": "CF-ResourceNotFound"}]}`)
+				return
+			}
+
+			next := "null"
+			if page == "1" {
+				next = fmt.Sprintf(`{"href": "%s/v3/apps?names=some-app-name&page=2&per_page=1"}`, server.URL())
+			}
+			fmt.Fprintf(w, `{
+				"pagination": {
+					"total_pages": 4,
+					"next": %s
+				},
+				"resources": [{"name": "app-name-%s", "guid": "app-guid-%s"}]
+			}`, next, page, page)
+		})
+	})
+
+	JustBeforeEach(func() {
+		apps, warnings, executeErr = client.GetApplications(Query{Key: NameFilter, Values: []string{"some-app-name"}})
+	})
+
+	When("the first page reports the total number of pages", func() {
+		It("fetches the remaining pages and returns the resources and warnings in page order", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			Expect(apps).To(Equal([]Application{
+				{Name: "app-name-1", GUID: "app-guid-1"},
+				{Name: "app-name-2", GUID: "app-guid-2"},
+				{Name: "app-name-3", GUID: "app-guid-3"},
+				{Name: "app-name-4", GUID: "app-guid-4"},
+			}))
+		})
+	})
+
+	When("one of the remaining pages fails", func() {
+		BeforeEach(func() {
+			failingPage = "3"
+		})
+
+		It("returns the error and the warnings up to the failing page", func() {
+			Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+		})
+	})
+})
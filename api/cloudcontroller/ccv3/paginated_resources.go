@@ -23,6 +23,8 @@ type PaginatedResources struct {
 			// HREF is the HREF of the next page.
 			HREF string `json:"href"`
 		} `json:"next"`
+		// TotalPages is the number of pages of resources.
+		TotalPages int `json:"total_pages"`
 	} `json:"pagination"`
 	// ResourceBytes is the list of resources for the current page.
 	ResourcesBytes json.RawMessage `json:"resources"`
@@ -34,6 +36,11 @@ func (pr PaginatedResources) NextPage() string {
 	return pr.Pagination.Next.HREF
 }
 
+// TotalPages returns the number of pages of results, or 0 if it is unknown.
+func (pr PaginatedResources) TotalPages() int {
+	return pr.Pagination.TotalPages
+}
+
 // Resources unmarshals JSON representing a page of resources and returns a
 // slice of the given resource type.
 func (pr PaginatedResources) Resources() ([]interface{}, error) {
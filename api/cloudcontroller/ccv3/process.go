@@ -22,6 +22,8 @@ type Process struct {
 	Instances                    types.NullInt    `json:"instances,omitempty"`
 	MemoryInMB                   types.NullUint64 `json:"memory_in_mb,omitempty"`
 	DiskInMB                     types.NullUint64 `json:"disk_in_mb,omitempty"`
+	// Metadata is used for custom tagging of API resources.
+	Metadata *Metadata `json:"metadata,omitempty"`
 }
 
 func (p Process) MarshalJSON() ([]byte, error) {
@@ -39,6 +41,7 @@ func (p Process) MarshalJSON() ([]byte, error) {
 		DiskInMB   json.Number `json:"disk_in_mb,omitempty"`
 
 		HealthCheck *healthCheck `json:"health_check,omitempty"`
+		Metadata    *Metadata    `json:"metadata,omitempty"`
 	}
 
 	if p.Instances.IsSet {
@@ -51,6 +54,8 @@ func (p Process) MarshalJSON() ([]byte, error) {
 		ccProcess.DiskInMB = json.Number(fmt.Sprint(p.DiskInMB.Value))
 	}
 
+	ccProcess.Metadata = p.Metadata
+
 	if p.HealthCheckType != "" || p.HealthCheckEndpoint != "" || p.HealthCheckInvocationTimeout != 0 {
 		ccProcess.HealthCheck = new(healthCheck)
 		ccProcess.HealthCheck.Type = p.HealthCheckType
@@ -350,6 +350,11 @@ var _ = Describe("Process", func() {
 							"endpoint": "/health",
 							"invocation_timeout": 42
 						}
+					},
+					"metadata": {
+						"labels": {
+							"owner": "team-a"
+						}
 					}
 				}`
 				server.AppendHandlers(
@@ -373,6 +378,9 @@ var _ = Describe("Process", func() {
 					"HealthCheckType":              Equal("http"),
 					"HealthCheckEndpoint":          Equal("/health"),
 					"HealthCheckInvocationTimeout": Equal(42),
+					"Metadata": Equal(&Metadata{
+						Labels: map[string]types.NullString{"owner": types.NewNullString("team-a")},
+					}),
 				}))
 			})
 		})
@@ -11,16 +11,26 @@ type QueryKey string
 const (
 	// AppGUIDFilter is a query parameter for listing objects by app GUID.
 	AppGUIDFilter QueryKey = "app_guids"
+	// DomainGUIDFilter is a query parameter for listing objects by domain GUID.
+	DomainGUIDFilter QueryKey = "domain_guids"
 	// GUIDFilter is a query parameter for listing objects by GUID.
 	GUIDFilter QueryKey = "guids"
+	// HostsFilter is a query parameter for listing routes by hostname.
+	HostsFilter QueryKey = "hosts"
+	// LabelSelectorFilter is a query parameter for listing objects by label.
+	LabelSelectorFilter QueryKey = "label_selector"
 	// NameFilter is a query parameter for listing objects by name.
 	NameFilter QueryKey = "names"
 	// OrganizationGUIDFilter is a query parameter for listing objects by Organization GUID.
 	OrganizationGUIDFilter QueryKey = "organization_guids"
+	// PathsFilter is a query parameter for listing routes by path.
+	PathsFilter QueryKey = "paths"
 	// SequenceIDFilter is a query parameter for listing objects by sequence ID.
 	SequenceIDFilter QueryKey = "sequence_ids"
 	// SpaceGUIDFilter is a query parameter for listing objects by Space GUID.
 	SpaceGUIDFilter QueryKey = "space_guids"
+	// VersionsFilter is a query parameter for listing revisions by version.
+	VersionsFilter QueryKey = "versions"
 
 	// OrderBy is a query parameter to specify how to order objects.
 	OrderBy QueryKey = "order_by"
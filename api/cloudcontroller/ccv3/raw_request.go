@@ -0,0 +1,83 @@
+package ccv3
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+)
+
+// RawResponse is an unparsed Cloud Controller response.
+type RawResponse struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// MakeRawRequest sends an authenticated request to path, relative to the
+// Cloud Controller API root, and returns the response as is. Responses with
+// an error status code are returned rather than converted into errors, so
+// that the caller can inspect them. The path must start with / and cannot
+// point at a different host, so that the access token is only ever sent to
+// the Cloud Controller.
+func (client *Client) MakeRawRequest(method string, path string, body []byte) (RawResponse, Warnings, error) {
+	requestURL, err := client.rawRequestURL(path)
+	if err != nil {
+		return RawResponse{}, nil, err
+	}
+
+	var requestBody io.ReadSeeker
+	if len(body) > 0 {
+		requestBody = bytes.NewReader(body)
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		Method: method,
+		URL:    requestURL,
+		Body:   requestBody,
+	})
+	if err != nil {
+		return RawResponse{}, nil, err
+	}
+
+	response := cloudcontroller.Response{}
+	err = client.connection.Make(request, &response)
+	if response.HTTPResponse == nil || (err != nil && response.HTTPResponse.StatusCode < http.StatusBadRequest) {
+		return RawResponse{}, response.Warnings, err
+	}
+
+	return RawResponse{
+		StatusCode: response.HTTPResponse.StatusCode,
+		Header:     response.HTTPResponse.Header,
+		Body:       response.RawResponse,
+	}, response.Warnings, nil
+}
+
+// rawRequestURL resolves path against the Cloud Controller URL and makes sure
+// the result is still on the Cloud Controller.
+func (client *Client) rawRequestURL(path string) (string, error) {
+	if !strings.HasPrefix(path, "/") || strings.HasPrefix(path, "//") {
+		return "", ccerror.InvalidRequestPathError{Path: path}
+	}
+
+	baseURL, err := url.Parse(client.cloudControllerURL)
+	if err != nil {
+		return "", err
+	}
+
+	pathURL, err := url.Parse(path)
+	if err != nil {
+		return "", ccerror.InvalidRequestPathError{Path: path}
+	}
+
+	requestURL := baseURL.ResolveReference(pathURL)
+	if requestURL.Scheme != baseURL.Scheme || requestURL.Host != baseURL.Host {
+		return "", ccerror.InvalidRequestPathError{Path: path}
+	}
+
+	return requestURL.String(), nil
+}
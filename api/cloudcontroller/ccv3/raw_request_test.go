@@ -0,0 +1,96 @@
+package ccv3_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("MakeRawRequest", func() {
+	var (
+		client     *Client
+		path       string
+		body       []byte
+		response   RawResponse
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+		path = "/v3/some-path?some=query"
+		body = nil
+	})
+
+	JustBeforeEach(func() {
+		response, warnings, executeErr = client.MakeRawRequest(http.MethodPost, path, body)
+	})
+
+	When("the request succeeds", func() {
+		BeforeEach(func() {
+			body = []byte(`{"some":"body"}`)
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/some-path", "some=query"),
+					VerifyHeaderKV("Content-Type", "application/json"),
+					VerifyBody([]byte(`{"some":"body"}`)),
+					RespondWith(http.StatusCreated, `{"some":"response"}`, http.Header{
+						"X-Cf-Warnings": {"warning-1"},
+						"Some-Header":   {"some-value"},
+					}),
+				),
+			)
+		})
+
+		It("returns the response and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+			Expect(response.Header.Get("Some-Header")).To(Equal("some-value"))
+			Expect(string(response.Body)).To(Equal(`{"some":"response"}`))
+			Expect(warnings).To(ConsistOf("warning-1"))
+		})
+	})
+
+	When("the Cloud Controller responds with an error status", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v3/some-path"),
+					RespondWith(http.StatusUnprocessableEntity, `{"errors":[{"detail":"some-detail"}]}`),
+				),
+			)
+		})
+
+		It("returns the response instead of an error", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(response.StatusCode).To(Equal(http.StatusUnprocessableEntity))
+			Expect(string(response.Body)).To(Equal(`{"errors":[{"detail":"some-detail"}]}`))
+		})
+	})
+
+	When("the path does not start with /", func() {
+		BeforeEach(func() {
+			path = "v3/some-path"
+		})
+
+		It("returns an InvalidRequestPathError", func() {
+			Expect(executeErr).To(MatchError(ccerror.InvalidRequestPathError{Path: "v3/some-path"}))
+			Expect(response).To(Equal(RawResponse{}))
+		})
+	})
+
+	When("the path points at another host", func() {
+		BeforeEach(func() {
+			path = "//example.com/v3/some-path"
+		})
+
+		It("returns an InvalidRequestPathError", func() {
+			Expect(executeErr).To(MatchError(ccerror.InvalidRequestPathError{Path: "//example.com/v3/some-path"}))
+			Expect(response).To(Equal(RawResponse{}))
+		})
+	})
+})
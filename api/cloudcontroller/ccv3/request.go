@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
 // requestOptions contains all the options to create an HTTP request.
@@ -27,6 +28,8 @@ type requestOptions struct {
 	URL string
 	// Body is the content of the request.
 	Body io.ReadSeeker
+	// Idempotent marks a POST that is safe to retry.
+	Idempotent bool
 }
 
 // newHTTPRequest returns a constructed HTTP.Request with some defaults.
@@ -64,5 +67,9 @@ func (client *Client) newHTTPRequest(passedRequest requestOptions) (*cloudcontro
 		request.Header.Set("Content-Type", "application/json")
 	}
 
+	if passedRequest.Idempotent {
+		request = retry.MarkIdempotent(request)
+	}
+
 	return cloudcontroller.NewRequest(request, passedRequest.Body), nil
 }
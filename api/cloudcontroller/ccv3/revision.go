@@ -0,0 +1,121 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Revision represents a Cloud Controller V3 Revision. A revision is created
+// every time an application's droplet, environment variables or process
+// commands change, and can be deployed to roll the application back.
+type Revision struct {
+	// GUID is the unique revision identifier.
+	GUID string
+	// Version is the revision's number, incremented for every revision of an
+	// application.
+	Version int
+	// Description describes what changed in the revision.
+	Description string
+	// Deployable is true when the revision's droplet is still available and
+	// the revision can be deployed.
+	Deployable bool
+	// DropletGUID is the GUID of the droplet the revision runs.
+	DropletGUID string
+	// CreatedAt is the time the revision was created.
+	CreatedAt string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Revision response.
+func (r *Revision) UnmarshalJSON(data []byte) error {
+	var ccRevision struct {
+		GUID        string `json:"guid"`
+		Version     int    `json:"version"`
+		Description string `json:"description"`
+		Deployable  bool   `json:"deployable"`
+		Droplet     struct {
+			GUID string `json:"guid"`
+		} `json:"droplet"`
+		CreatedAt string `json:"created_at"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccRevision)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRevision.GUID
+	r.Version = ccRevision.Version
+	r.Description = ccRevision.Description
+	r.Deployable = ccRevision.Deployable
+	r.DropletGUID = ccRevision.Droplet.GUID
+	r.CreatedAt = ccRevision.CreatedAt
+
+	return nil
+}
+
+// GetApplicationRevisions lists the revisions of the given application.
+func (client *Client) GetApplicationRevisions(appGUID string, query ...Query) ([]Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationRevisionsRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRevisionsList []Revision
+	warnings, err := client.paginate(request, Revision{}, func(item interface{}) error {
+		if revision, ok := item.(Revision); ok {
+			fullRevisionsList = append(fullRevisionsList, revision)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Revision{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRevisionsList, warnings, err
+}
+
+// GetRevision returns the revision with the given GUID.
+func (client *Client) GetRevision(revisionGUID string) (Revision, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRevisionRequest,
+		URIParams:   internal.Params{"revision_guid": revisionGUID},
+	})
+	if err != nil {
+		return Revision{}, nil, err
+	}
+
+	var responseRevision Revision
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseRevision,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseRevision, response.Warnings, err
+}
+
+// GetRevisionEnvironmentVariables returns the environment variables the
+// application had when the given revision was created.
+func (client *Client) GetRevisionEnvironmentVariables(revisionGUID string) (EnvironmentVariables, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRevisionEnvironmentVariablesRequest,
+		URIParams:   internal.Params{"revision_guid": revisionGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseEnvVars EnvironmentVariables
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseEnvVars,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseEnvVars, response.Warnings, err
+}
@@ -0,0 +1,238 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Revision", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetApplicationRevisions", func() {
+		var (
+			revisions  []Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revisions, warnings, executeErr = client.GetApplicationRevisions("some-app-guid", Query{Key: VersionsFilter, Values: []string{"1", "2"}})
+		})
+
+		When("the application has revisions", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/apps/some-app-guid/revisions?versions=1,2&page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "revision-1-guid",
+							"version": 1,
+							"description": "Initial revision.",
+							"deployable": false,
+							"droplet": {
+								"guid": "droplet-1-guid"
+							},
+							"created_at": "2019-03-28T17:34:02Z"
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "revision-2-guid",
+							"version": 2,
+							"description": "New droplet deployed.",
+							"deployable": true,
+							"droplet": {
+								"guid": "droplet-2-guid"
+							},
+							"created_at": "2019-03-29T17:34:02Z"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions", "versions=1,2&page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the revisions and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(revisions).To(ConsistOf(
+					Revision{
+						GUID:        "revision-1-guid",
+						Version:     1,
+						Description: "Initial revision.",
+						DropletGUID: "droplet-1-guid",
+						CreatedAt:   "2019-03-28T17:34:02Z",
+					},
+					Revision{
+						GUID:        "revision-2-guid",
+						Version:     2,
+						Description: "New droplet deployed.",
+						Deployable:  true,
+						DropletGUID: "droplet-2-guid",
+						CreatedAt:   "2019-03-29T17:34:02Z",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/revisions"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("GetRevision", func() {
+		var (
+			revision   Revision
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			revision, warnings, executeErr = client.GetRevision("some-revision-guid")
+		})
+
+		When("the revision exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-revision-guid",
+					"version": 3,
+					"description": "Rolled back to revision 1.",
+					"deployable": true,
+					"droplet": {
+						"guid": "some-droplet-guid"
+					},
+					"created_at": "2019-03-30T17:34:02Z"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/revisions/some-revision-guid"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the revision and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(revision).To(Equal(Revision{
+					GUID:        "some-revision-guid",
+					Version:     3,
+					Description: "Rolled back to revision 1.",
+					Deployable:  true,
+					DropletGUID: "some-droplet-guid",
+					CreatedAt:   "2019-03-30T17:34:02Z",
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Revision not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/revisions/some-revision-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ResourceNotFoundError{Message: "Revision not found"}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("GetRevisionEnvironmentVariables", func() {
+		var (
+			envVars    EnvironmentVariables
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			envVars, warnings, executeErr = client.GetRevisionEnvironmentVariables("some-revision-guid")
+		})
+
+		When("the revision exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"var": {
+						"RAILS_ENV": "production"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/revisions/some-revision-guid/environment_variables"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables of the revision and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envVars).To(Equal(EnvironmentVariables{
+					"RAILS_ENV": types.FilteredString{IsSet: true, Value: "production"},
+				}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+})
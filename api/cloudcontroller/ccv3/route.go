@@ -0,0 +1,254 @@
+package ccv3
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+)
+
+// Route represents a Cloud Controller V3 Route.
+type Route struct {
+	// GUID is the unique route identifier.
+	GUID string
+	// Host is the hostname of the route.
+	Host string
+	// Path is the path of the route.
+	Path string
+	// URL is the fully qualified route URL, as generated by the Cloud
+	// Controller.
+	URL string
+	// SpaceGUID is the GUID of the space the route belongs to.
+	SpaceGUID string
+	// DomainGUID is the GUID of the domain the route belongs to.
+	DomainGUID string
+	// Destinations are the app processes the route sends traffic to. They are
+	// only populated when reading routes from the Cloud Controller.
+	Destinations []RouteDestination
+}
+
+// MarshalJSON converts a Route into a Cloud Controller Route.
+func (r Route) MarshalJSON() ([]byte, error) {
+	ccRoute := struct {
+		Host          string        `json:"host,omitempty"`
+		Path          string        `json:"path,omitempty"`
+		Relationships Relationships `json:"relationships"`
+	}{
+		Host: r.Host,
+		Path: r.Path,
+		Relationships: Relationships{
+			constant.RelationshipTypeSpace:  Relationship{GUID: r.SpaceGUID},
+			constant.RelationshipTypeDomain: Relationship{GUID: r.DomainGUID},
+		},
+	}
+
+	return json.Marshal(ccRoute)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Route response.
+func (r *Route) UnmarshalJSON(data []byte) error {
+	var ccRoute struct {
+		GUID          string             `json:"guid"`
+		Host          string             `json:"host"`
+		Path          string             `json:"path"`
+		URL           string             `json:"url"`
+		Destinations  []RouteDestination `json:"destinations"`
+		Relationships Relationships      `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccRoute)
+	if err != nil {
+		return err
+	}
+
+	r.GUID = ccRoute.GUID
+	r.Host = ccRoute.Host
+	r.Path = ccRoute.Path
+	r.URL = ccRoute.URL
+	r.Destinations = ccRoute.Destinations
+	r.SpaceGUID = ccRoute.Relationships[constant.RelationshipTypeSpace].GUID
+	r.DomainGUID = ccRoute.Relationships[constant.RelationshipTypeDomain].GUID
+
+	return nil
+}
+
+// RouteDestination represents the process of an application that a route
+// sends traffic to.
+type RouteDestination struct {
+	// GUID is the unique destination identifier.
+	GUID string
+	// AppGUID is the GUID of the destination application.
+	AppGUID string
+	// ProcessType is the process type of the destination application. The
+	// Cloud Controller defaults this to "web".
+	ProcessType string
+	// Port is the port on the destination process that receives traffic. The
+	// Cloud Controller defaults this to 8080.
+	Port int
+}
+
+type ccRouteDestination struct {
+	GUID string `json:"guid,omitempty"`
+	App  struct {
+		GUID    string `json:"guid"`
+		Process *struct {
+			Type string `json:"type"`
+		} `json:"process,omitempty"`
+	} `json:"app"`
+	Port int `json:"port,omitempty"`
+}
+
+// MarshalJSON converts a RouteDestination into a Cloud Controller
+// destination.
+func (d RouteDestination) MarshalJSON() ([]byte, error) {
+	var ccDestination ccRouteDestination
+	ccDestination.GUID = d.GUID
+	ccDestination.App.GUID = d.AppGUID
+	ccDestination.Port = d.Port
+
+	if d.ProcessType != "" {
+		ccDestination.App.Process = &struct {
+			Type string `json:"type"`
+		}{Type: d.ProcessType}
+	}
+
+	return json.Marshal(ccDestination)
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller destination.
+func (d *RouteDestination) UnmarshalJSON(data []byte) error {
+	var ccDestination ccRouteDestination
+	err := cloudcontroller.DecodeJSON(data, &ccDestination)
+	if err != nil {
+		return err
+	}
+
+	d.GUID = ccDestination.GUID
+	d.AppGUID = ccDestination.App.GUID
+	d.Port = ccDestination.Port
+	if ccDestination.App.Process != nil {
+		d.ProcessType = ccDestination.App.Process.Type
+	}
+
+	return nil
+}
+
+// CreateRoute creates a route with the given settings.
+func (client *Client) CreateRoute(route Route) (Route, Warnings, error) {
+	bodyBytes, err := json.Marshal(route)
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteRequest,
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return Route{}, nil, err
+	}
+
+	var responseRoute Route
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseRoute,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseRoute, response.Warnings, err
+}
+
+// GetRoutes lists routes with optional filters.
+func (client *Client) GetRoutes(query ...Query) ([]Route, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRoutesRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullRoutesList []Route
+	warnings, err := client.paginate(request, Route{}, func(item interface{}) error {
+		if route, ok := item.(Route); ok {
+			fullRoutesList = append(fullRoutesList, route)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Route{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullRoutesList, warnings, err
+}
+
+// GetRouteDestinations lists the destinations the route sends traffic to.
+func (client *Client) GetRouteDestinations(routeGUID string) ([]RouteDestination, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var destinations struct {
+		Destinations []RouteDestination `json:"destinations"`
+	}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &destinations,
+	}
+	err = client.connection.Make(request, &response)
+
+	return destinations.Destinations, response.Warnings, err
+}
+
+// MapRoute adds the given destinations to the route. Existing destinations
+// are left in place.
+func (client *Client) MapRoute(routeGUID string, destinations ...RouteDestination) ([]RouteDestination, Warnings, error) {
+	bodyBytes, err := json.Marshal(struct {
+		Destinations []RouteDestination `json:"destinations"`
+	}{Destinations: destinations})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostRouteDestinationsRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID},
+		Body:        bytes.NewReader(bodyBytes),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var responseDestinations struct {
+		Destinations []RouteDestination `json:"destinations"`
+	}
+	response := cloudcontroller.Response{
+		DecodeJSONResponseInto: &responseDestinations,
+	}
+	err = client.connection.Make(request, &response)
+
+	return responseDestinations.Destinations, response.Warnings, err
+}
+
+// UnmapRoute removes the destination with the given GUID from the route.
+func (client *Client) UnmapRoute(routeGUID string, destinationGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteDestinationRequest,
+		URIParams:   internal.Params{"route_guid": routeGUID, "destination_guid": destinationGUID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+
+	return response.Warnings, err
+}
@@ -0,0 +1,402 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Route", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("CreateRoute", func() {
+		var (
+			route      Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			route, warnings, executeErr = client.CreateRoute(Route{
+				Host:       "some-host",
+				Path:       "/some-path",
+				SpaceGUID:  "some-space-guid",
+				DomainGUID: "some-domain-guid",
+			})
+		})
+
+		When("the route is created successfully", func() {
+			BeforeEach(func() {
+				response := `{
+					"guid": "some-route-guid",
+					"host": "some-host",
+					"path": "/some-path",
+					"url": "some-host.some-domain.com/some-path",
+					"relationships": {
+						"space": { "data": { "guid": "some-space-guid" } },
+						"domain": { "data": { "guid": "some-domain-guid" } }
+					}
+				}`
+
+				expectedBody := map[string]interface{}{
+					"host": "some-host",
+					"path": "/some-path",
+					"relationships": map[string]interface{}{
+						"space": map[string]interface{}{
+							"data": map[string]string{"guid": "some-space-guid"},
+						},
+						"domain": map[string]interface{}{
+							"data": map[string]string{"guid": "some-domain-guid"},
+						},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created route and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+
+				Expect(route).To(Equal(Route{
+					GUID:       "some-route-guid",
+					Host:       "some-host",
+					Path:       "/some-path",
+					URL:        "some-host.some-domain.com/some-path",
+					SpaceGUID:  "some-space-guid",
+					DomainGUID: "some-domain-guid",
+				}))
+			})
+		})
+
+		When("the cloud controller returns errors and warnings", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Route already exists.",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Route already exists."}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("GetRoutes", func() {
+		var (
+			routes     []Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = client.GetRoutes(
+				Query{Key: SpaceGUIDFilter, Values: []string{"some-space-guid"}},
+				Query{Key: HostsFilter, Values: []string{"some-host"}},
+			)
+		})
+
+		When("routes exist", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/routes?space_guids=some-space-guid&hosts=some-host&page=2&per_page=1"
+						}
+					},
+					"resources": [
+						{
+							"guid": "route-guid-1",
+							"host": "some-host",
+							"path": "",
+							"url": "some-host.domain-1.com",
+							"destinations": [
+								{
+									"guid": "destination-guid-1",
+									"app": {
+										"guid": "app-guid-1",
+										"process": { "type": "web" }
+									},
+									"port": 8080
+								}
+							],
+							"relationships": {
+								"space": { "data": { "guid": "some-space-guid" } },
+								"domain": { "data": { "guid": "domain-guid-1" } }
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "route-guid-2",
+							"host": "some-host",
+							"path": "/path",
+							"url": "some-host.domain-2.com/path",
+							"relationships": {
+								"space": { "data": { "guid": "some-space-guid" } },
+								"domain": { "data": { "guid": "domain-guid-2" } }
+							}
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes", "space_guids=some-space-guid&hosts=some-host"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes", "space_guids=some-space-guid&hosts=some-host&page=2&per_page=1"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns the routes and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+
+				Expect(routes).To(ConsistOf(
+					Route{
+						GUID:       "route-guid-1",
+						Host:       "some-host",
+						URL:        "some-host.domain-1.com",
+						SpaceGUID:  "some-space-guid",
+						DomainGUID: "domain-guid-1",
+						Destinations: []RouteDestination{
+							{GUID: "destination-guid-1", AppGUID: "app-guid-1", ProcessType: "web", Port: 8080},
+						},
+					},
+					Route{GUID: "route-guid-2", Host: "some-host", Path: "/path", URL: "some-host.domain-2.com/path", SpaceGUID: "some-space-guid", DomainGUID: "domain-guid-2"},
+				))
+			})
+		})
+	})
+
+	Describe("GetRouteDestinations", func() {
+		var (
+			destinations []RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			destinations, warnings, executeErr = client.GetRouteDestinations("some-route-guid")
+		})
+
+		When("the route has destinations", func() {
+			BeforeEach(func() {
+				response := `{
+					"destinations": [
+						{
+							"guid": "destination-guid-1",
+							"app": {
+								"guid": "app-guid-1",
+								"process": { "type": "web" }
+							},
+							"port": 8080
+						},
+						{
+							"guid": "destination-guid-2",
+							"app": {
+								"guid": "app-guid-2",
+								"process": { "type": "worker" }
+							},
+							"port": 9000
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/routes/some-route-guid/destinations"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the destinations and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(destinations).To(ConsistOf(
+					RouteDestination{GUID: "destination-guid-1", AppGUID: "app-guid-1", ProcessType: "web", Port: 8080},
+					RouteDestination{GUID: "destination-guid-2", AppGUID: "app-guid-2", ProcessType: "worker", Port: 9000},
+				))
+			})
+		})
+	})
+
+	Describe("MapRoute", func() {
+		var (
+			destination  RouteDestination
+			destinations []RouteDestination
+			warnings     Warnings
+			executeErr   error
+		)
+
+		JustBeforeEach(func() {
+			destinations, warnings, executeErr = client.MapRoute("some-route-guid", destination)
+		})
+
+		When("only the app is provided", func() {
+			BeforeEach(func() {
+				destination = RouteDestination{AppGUID: "some-app-guid"}
+
+				response := `{
+					"destinations": [
+						{
+							"guid": "destination-guid",
+							"app": {
+								"guid": "some-app-guid",
+								"process": { "type": "web" }
+							},
+							"port": 8080
+						}
+					]
+				}`
+
+				expectedBody := map[string]interface{}{
+					"destinations": []map[string]interface{}{
+						{"app": map[string]interface{}{"guid": "some-app-guid"}},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/some-route-guid/destinations"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the route destinations and all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+
+				Expect(destinations).To(ConsistOf(
+					RouteDestination{GUID: "destination-guid", AppGUID: "some-app-guid", ProcessType: "web", Port: 8080},
+				))
+			})
+		})
+
+		When("the process type and port are provided", func() {
+			BeforeEach(func() {
+				destination = RouteDestination{AppGUID: "some-app-guid", ProcessType: "worker", Port: 9000}
+
+				expectedBody := map[string]interface{}{
+					"destinations": []map[string]interface{}{
+						{
+							"app": map[string]interface{}{
+								"guid":    "some-app-guid",
+								"process": map[string]string{"type": "worker"},
+							},
+							"port": 9000,
+						},
+					},
+				}
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/some-route-guid/destinations"),
+						VerifyJSONRepresenting(expectedBody),
+						RespondWith(http.StatusOK, `{"destinations": []}`),
+					),
+				)
+			})
+
+			It("sends the process type and port", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				destination = RouteDestination{AppGUID: "some-app-guid"}
+
+				response := `{
+					"errors": [
+						{
+							"code": 10008,
+							"detail": "Routes cannot be mapped to destinations in different spaces",
+							"title": "CF-UnprocessableEntity"
+						}
+					]
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v3/routes/some-route-guid/destinations"),
+						RespondWith(http.StatusUnprocessableEntity, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.UnprocessableEntityError{Message: "Routes cannot be mapped to destinations in different spaces"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("UnmapRoute", func() {
+		var (
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			warnings, executeErr = client.UnmapRoute("some-route-guid", "some-destination-guid")
+		})
+
+		When("the destination is removed", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v3/routes/some-route-guid/destinations/some-destination-guid"),
+						RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns all warnings", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+})
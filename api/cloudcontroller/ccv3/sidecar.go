@@ -0,0 +1,105 @@
+package ccv3
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// Sidecar represents a Cloud Controller V3 Sidecar. A sidecar is an
+// additional command run in the same container as one or more of an
+// application's processes.
+type Sidecar struct {
+	// GUID is the unique sidecar identifier.
+	GUID string
+	// Name is the name of the sidecar.
+	Name string
+	// Command is the command used to start the sidecar.
+	Command string
+	// ProcessTypes are the types of the application's processes the sidecar
+	// runs alongside.
+	ProcessTypes []string
+	// MemoryInMB is the amount of memory reserved for the sidecar out of the
+	// process' memory.
+	MemoryInMB types.NullUint64
+	// Origin is where the sidecar was defined, either "user" for sidecars
+	// defined in a manifest or through the API, or "buildpack".
+	Origin string
+	// AppGUID is the GUID of the application the sidecar belongs to.
+	AppGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Sidecar response.
+func (s *Sidecar) UnmarshalJSON(data []byte) error {
+	var ccSidecar struct {
+		GUID          string           `json:"guid"`
+		Name          string           `json:"name"`
+		Command       string           `json:"command"`
+		ProcessTypes  []string         `json:"process_types"`
+		MemoryInMB    types.NullUint64 `json:"memory_in_mb"`
+		Origin        string           `json:"origin"`
+		Relationships Relationships    `json:"relationships"`
+	}
+
+	err := cloudcontroller.DecodeJSON(data, &ccSidecar)
+	if err != nil {
+		return err
+	}
+
+	s.GUID = ccSidecar.GUID
+	s.Name = ccSidecar.Name
+	s.Command = ccSidecar.Command
+	s.ProcessTypes = ccSidecar.ProcessTypes
+	s.MemoryInMB = ccSidecar.MemoryInMB
+	s.Origin = ccSidecar.Origin
+	s.AppGUID = ccSidecar.Relationships[constant.RelationshipTypeApplication].GUID
+
+	return nil
+}
+
+// GetApplicationSidecars lists the sidecars of the given application.
+func (client *Client) GetApplicationSidecars(appGUID string, query ...Query) ([]Sidecar, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetApplicationSidecarsRequest,
+		URIParams:   internal.Params{"app_guid": appGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateSidecars(request)
+}
+
+// GetProcessSidecars lists the sidecars that run alongside the given process.
+func (client *Client) GetProcessSidecars(processGUID string, query ...Query) ([]Sidecar, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetProcessSidecarsRequest,
+		URIParams:   internal.Params{"process_guid": processGUID},
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return client.paginateSidecars(request)
+}
+
+func (client *Client) paginateSidecars(request *cloudcontroller.Request) ([]Sidecar, Warnings, error) {
+	var fullSidecarsList []Sidecar
+	warnings, err := client.paginate(request, Sidecar{}, func(item interface{}) error {
+		if sidecar, ok := item.(Sidecar); ok {
+			fullSidecarsList = append(fullSidecarsList, sidecar)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Sidecar{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullSidecarsList, warnings, err
+}
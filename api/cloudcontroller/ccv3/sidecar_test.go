@@ -0,0 +1,225 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Sidecar", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client, _ = NewTestClient()
+	})
+
+	Describe("GetApplicationSidecars", func() {
+		var (
+			sidecars   []Sidecar
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = client.GetApplicationSidecars("some-app-guid")
+		})
+
+		When("the application has sidecars", func() {
+			BeforeEach(func() {
+				response1 := fmt.Sprintf(`{
+					"pagination": {
+						"next": {
+							"href": "%s/v3/apps/some-app-guid/sidecars?page=2"
+						}
+					},
+					"resources": [
+						{
+							"guid": "sidecar-1-guid",
+							"name": "auth-sidecar",
+							"command": "bundle exec rackup",
+							"process_types": ["web", "worker"],
+							"memory_in_mb": 300,
+							"origin": "user",
+							"relationships": {
+								"app": {
+									"data": {
+										"guid": "some-app-guid"
+									}
+								}
+							}
+						}
+					]
+				}`, server.URL())
+				response2 := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "sidecar-2-guid",
+							"name": "log-shipper",
+							"command": "./ship-logs",
+							"process_types": ["web"],
+							"memory_in_mb": null,
+							"origin": "buildpack",
+							"relationships": {
+								"app": {
+									"data": {
+										"guid": "some-app-guid"
+									}
+								}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars", "page=2"),
+						RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"warning-2"}}),
+					),
+				)
+			})
+
+			It("returns all the sidecars and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(sidecars).To(ConsistOf(
+					Sidecar{
+						GUID:         "sidecar-1-guid",
+						Name:         "auth-sidecar",
+						Command:      "bundle exec rackup",
+						ProcessTypes: []string{"web", "worker"},
+						MemoryInMB:   types.NullUint64{IsSet: true, Value: 300},
+						Origin:       "user",
+						AppGUID:      "some-app-guid",
+					},
+					Sidecar{
+						GUID:         "sidecar-2-guid",
+						Name:         "log-shipper",
+						Command:      "./ship-logs",
+						ProcessTypes: []string{"web"},
+						Origin:       "buildpack",
+						AppGUID:      "some-app-guid",
+					},
+				))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+
+		When("the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "App not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/apps/some-app-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns an ApplicationNotFoundError and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ApplicationNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+
+	Describe("GetProcessSidecars", func() {
+		var (
+			sidecars   []Sidecar
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			sidecars, warnings, executeErr = client.GetProcessSidecars("some-process-guid")
+		})
+
+		When("the process has sidecars", func() {
+			BeforeEach(func() {
+				response := `{
+					"pagination": {
+						"next": null
+					},
+					"resources": [
+						{
+							"guid": "sidecar-1-guid",
+							"name": "auth-sidecar",
+							"command": "bundle exec rackup",
+							"process_types": ["web"],
+							"origin": "user",
+							"relationships": {
+								"app": {
+									"data": {
+										"guid": "some-app-guid"
+									}
+								}
+							}
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes/some-process-guid/sidecars"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"warning-1"}}),
+					),
+				)
+			})
+
+			It("returns the sidecars and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(sidecars).To(ConsistOf(Sidecar{
+					GUID:         "sidecar-1-guid",
+					Name:         "auth-sidecar",
+					Command:      "bundle exec rackup",
+					ProcessTypes: []string{"web"},
+					Origin:       "user",
+					AppGUID:      "some-app-guid",
+				}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		When("the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"errors": [
+						{
+							"code": 10010,
+							"detail": "Process not found",
+							"title": "CF-ResourceNotFound"
+						}
+					]
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v3/processes/some-process-guid/sidecars"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError(ccerror.ProcessNotFoundError{}))
+				Expect(warnings).To(ConsistOf("warning"))
+			})
+		})
+	})
+})
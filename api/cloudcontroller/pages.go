@@ -0,0 +1,104 @@
+package cloudcontroller
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultPaginationWorkers is the default number of pages that are fetched
+// at the same time.
+const DefaultPaginationWorkers = 4
+
+// Page is a page of resources fetched by FetchPages.
+type Page struct {
+	Resources []interface{}
+	Warnings  []string
+	Err       error
+}
+
+// RemainingPageURLs returns the URLs of pages 2 through totalPages given the
+// URL of page 2. It returns false if the URL does not select the page with a
+// 'page' query parameter, in which case the pages have to be followed one by
+// one.
+func RemainingPageURLs(secondPageURL string, totalPages int) ([]string, bool) {
+	if totalPages < 2 {
+		return nil, false
+	}
+
+	parsedURL, err := url.Parse(secondPageURL)
+	if err != nil {
+		return nil, false
+	}
+
+	params := strings.Split(parsedURL.RawQuery, "&")
+	pageIndex := -1
+	for i, param := range params {
+		if param == "page=2" {
+			pageIndex = i
+			break
+		}
+	}
+	if pageIndex == -1 {
+		return nil, false
+	}
+
+	urls := make([]string, 0, totalPages-1)
+	for page := 2; page <= totalPages; page++ {
+		params[pageIndex] = "page=" + strconv.Itoa(page)
+		pageURL := *parsedURL
+		pageURL.RawQuery = strings.Join(params, "&")
+		urls = append(urls, pageURL.String())
+	}
+	return urls, true
+}
+
+// FetchPages calls fetch for every URL using at most workers goroutines and
+// returns the pages in the same order as urls. Once a page fails, pages that
+// have not been requested yet are skipped and returned empty.
+func FetchPages(urls []string, workers int, fetch func(pageURL string) Page) []Page {
+	if workers < 1 {
+		workers = DefaultPaginationWorkers
+	}
+
+	pages := make([]Page, len(urls))
+	indexes := make(chan int)
+
+	var (
+		wg     sync.WaitGroup
+		lock   sync.Mutex
+		failed bool
+	)
+
+	for i := 0; i < workers && i < len(urls); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for index := range indexes {
+				lock.Lock()
+				skip := failed
+				lock.Unlock()
+				if skip {
+					continue
+				}
+
+				page := fetch(urls[index])
+				if page.Err != nil {
+					lock.Lock()
+					failed = true
+					lock.Unlock()
+				}
+				pages[index] = page
+			}
+		}()
+	}
+
+	for index := range urls {
+		indexes <- index
+	}
+	close(indexes)
+	wg.Wait()
+
+	return pages
+}
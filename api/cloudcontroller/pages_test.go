@@ -0,0 +1,101 @@
+package cloudcontroller_test
+
+import (
+	"errors"
+	"sync/atomic"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pages", func() {
+	Describe("RemainingPageURLs", func() {
+		It("returns the URLs of pages 2 through the last page", func() {
+			urls, ok := RemainingPageURLs("https://api.com/v3/apps?names=a,b&page=2&per_page=50", 4)
+			Expect(ok).To(BeTrue())
+			Expect(urls).To(Equal([]string{
+				"https://api.com/v3/apps?names=a,b&page=2&per_page=50",
+				"https://api.com/v3/apps?names=a,b&page=3&per_page=50",
+				"https://api.com/v3/apps?names=a,b&page=4&per_page=50",
+			}))
+		})
+
+		It("supports relative URLs", func() {
+			urls, ok := RemainingPageURLs("/v2/apps?q=space_guid:some-guid&page=2", 3)
+			Expect(ok).To(BeTrue())
+			Expect(urls).To(Equal([]string{
+				"/v2/apps?q=space_guid:some-guid&page=2",
+				"/v2/apps?q=space_guid:some-guid&page=3",
+			}))
+		})
+
+		When("the total number of pages is unknown", func() {
+			It("returns false", func() {
+				_, ok := RemainingPageURLs("/v2/apps?page=2", 0)
+				Expect(ok).To(BeFalse())
+			})
+		})
+
+		When("the URL does not select page 2", func() {
+			It("returns false", func() {
+				_, ok := RemainingPageURLs("/v3/apps?cursor=abc", 3)
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("FetchPages", func() {
+		var urls []string
+
+		BeforeEach(func() {
+			urls = []string{"page-2", "page-3", "page-4", "page-5", "page-6"}
+		})
+
+		It("returns the pages in order", func() {
+			pages := FetchPages(urls, 3, func(pageURL string) Page {
+				return Page{Resources: []interface{}{pageURL}, Warnings: []string{pageURL + "-warning"}}
+			})
+
+			Expect(pages).To(HaveLen(5))
+			for i, page := range pages {
+				Expect(page.Resources).To(ConsistOf(urls[i]))
+				Expect(page.Warnings).To(ConsistOf(urls[i] + "-warning"))
+			}
+		})
+
+		It("fetches at most the given number of pages at the same time", func() {
+			var inFlight, maxInFlight int32
+			FetchPages(urls, 2, func(pageURL string) Page {
+				current := atomic.AddInt32(&inFlight, 1)
+				for {
+					observed := atomic.LoadInt32(&maxInFlight)
+					if current <= observed || atomic.CompareAndSwapInt32(&maxInFlight, observed, current) {
+						break
+					}
+				}
+				atomic.AddInt32(&inFlight, -1)
+				return Page{}
+			})
+
+			Expect(maxInFlight).To(BeNumerically("<=", 2))
+		})
+
+		When("a page fails", func() {
+			It("returns the error with that page and skips pages that were not requested yet", func() {
+				pages := FetchPages(urls, 1, func(pageURL string) Page {
+					if pageURL == "page-3" {
+						return Page{Err: errors.New("some-error")}
+					}
+					return Page{Resources: []interface{}{pageURL}}
+				})
+
+				Expect(pages[0].Resources).To(ConsistOf("page-2"))
+				Expect(pages[1].Err).To(MatchError("some-error"))
+				Expect(pages[2].Resources).To(BeEmpty())
+				Expect(pages[4].Resources).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -282,9 +282,17 @@ var _ = Describe("Request Logger", func() {
 					response = &cloudcontroller.Response{}
 				})
 
-				It("outputs nothing", func() {
+				It("outputs the error as the response", func() {
 					Expect(makeErr).To(MatchError(expectedErr))
 					Expect(fakeOutput.DisplayResponseHeaderCallCount()).To(Equal(0))
+
+					Expect(fakeOutput.DisplayTypeCallCount()).To(Equal(2))
+					name, _ := fakeOutput.DisplayTypeArgsForCall(1)
+					Expect(name).To(Equal("RESPONSE"))
+
+					Expect(fakeOutput.DisplayRequestErrorCallCount()).To(Equal(1))
+					Expect(fakeOutput.DisplayRequestErrorArgsForCall(0)).To(MatchError(expectedErr))
+					Expect(fakeOutput.StopCallCount()).To(Equal(2))
 				})
 			})
 
@@ -369,6 +377,12 @@ var _ = Describe("Request Logger", func() {
 			Expect(fakeOutput.StopCallCount()).To(Equal(2))
 		})
 
+		It("identifies the request to the output for both the request and the response", func() {
+			Expect(fakeOutput.SetRequestCallCount()).To(Equal(2))
+			Expect(fakeOutput.SetRequestArgsForCall(0)).To(BeIdenticalTo(request.Request))
+			Expect(fakeOutput.SetRequestArgsForCall(1)).To(BeIdenticalTo(request.Request))
+		})
+
 		When("displaying the logs have an error", func() {
 			var expectedErr error
 			BeforeEach(func() {
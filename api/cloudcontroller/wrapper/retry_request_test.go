@@ -5,11 +5,13 @@ import (
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -43,7 +45,7 @@ var _ = Describe("Retry Request", func() {
 				return expectedErr
 			}
 
-			wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 			err = wrapper.Make(request, response)
 			Expect(err).To(MatchError(expectedErr))
 			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
@@ -59,6 +61,8 @@ var _ = Describe("Retry Request", func() {
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
 
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
 		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
 
@@ -73,13 +77,41 @@ var _ = Describe("Retry Request", func() {
 		}
 
 		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
-		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 
 		err = wrapper.Make(request, response)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
 
+	It("waits between attempts as the policy dictates", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request := cloudcontroller.NewRequest(req, nil)
+		response := &cloudcontroller.Response{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": {"3"}},
+			},
+		}
+
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeReturns(ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests})
+
+		var delays []time.Duration
+		policy := retry.Policy{
+			MaxRetries: 2,
+			MaxDelay:   time.Minute,
+			Sleep:      func(delay time.Duration) { delays = append(delays, delay) },
+		}
+		wrapper := NewRetryRequest(policy).Wrap(fakeConnection)
+
+		err = wrapper.Make(request, response)
+		Expect(err).To(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+		Expect(delays).To(Equal([]time.Duration{3 * time.Second, 3 * time.Second}))
+	})
+
 	When("a PipeSeekError is returned from ResetBody", func() {
 		var (
 			expectedErr error
@@ -105,7 +137,7 @@ var _ = Describe("Retry Request", func() {
 			expectedErr = errors.New("oh noes")
 			fakeConnection.MakeReturns(expectedErr)
 
-			wrapper = NewRetryRequest(2).Wrap(fakeConnection)
+			wrapper = NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
 		})
 
 		It("sets the err on PipeSeekError", func() {
@@ -1,6 +1,8 @@
 package wrapper
 
 import (
+	"sync"
+
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
@@ -24,11 +26,13 @@ type TokenCache interface {
 }
 
 // UAAAuthentication wraps connections and adds authentication headers to all
-// requests
+// requests. It is safe to use from multiple goroutines; concurrent requests
+// that fail with an expired token share a single token refresh.
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+	tokenLock  sync.Mutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -48,18 +52,19 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 		return t.connection.Make(request, passedResponse)
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	t.tokenLock.Lock()
+	sentToken := t.cache.AccessToken()
+	t.tokenLock.Unlock()
+
+	request.Header.Set("Authorization", sentToken)
 
 	requestErr := t.connection.Make(request, passedResponse)
 	if _, ok := requestErr.(ccerror.InvalidAuthTokenError); ok {
-		tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err := t.refreshToken(sentToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(tokens.AuthorizationToken())
-		t.cache.SetRefreshToken(tokens.RefreshToken)
-
 		if request.Body != nil {
 			err = request.ResetBody()
 			if err != nil {
@@ -69,13 +74,34 @@ func (t *UAAAuthentication) Make(request *cloudcontroller.Request, passedRespons
 				return err
 			}
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		requestErr = t.connection.Make(request, passedResponse)
 	}
 
 	return requestErr
 }
 
+// refreshToken refreshes the access token unless another request already
+// replaced sentToken, and returns the access token to retry with.
+func (t *UAAAuthentication) refreshToken(sentToken string) (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	if accessToken := t.cache.AccessToken(); accessToken != sentToken {
+		return accessToken, nil
+	}
+
+	tokens, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+	if err != nil {
+		return "", err
+	}
+
+	t.cache.SetAccessToken(tokens.AuthorizationToken())
+	t.cache.SetRefreshToken(tokens.RefreshToken)
+
+	return t.cache.AccessToken(), nil
+}
+
 // SetClient sets the UAA client that the wrapper will use.
 func (t *UAAAuthentication) SetClient(client UAAClient) {
 	t.client = client
@@ -190,6 +190,27 @@ var _ = Describe("UAA Authentication", func() {
 					Expect(executeErr).To(MatchError(ccerror.PipeSeekError{Err: ccerror.InvalidAuthTokenError{}}))
 				})
 			})
+
+			When("another request refreshed the token in the meantime", func() {
+				BeforeEach(func() {
+					fakeConnection.MakeStub = func(request *cloudcontroller.Request, response *cloudcontroller.Response) error {
+						if fakeConnection.MakeCallCount() == 1 {
+							inMemoryCache.SetAccessToken("bearer refreshed-elsewhere")
+							return ccerror.InvalidAuthTokenError{}
+						}
+						return nil
+					}
+				})
+
+				It("resends the request with the new token without refreshing again", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+					requestArg, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(requestArg.Header.Get("Authorization")).To(Equal("bearer refreshed-elsewhere"))
+				})
+			})
 		})
 	})
 })
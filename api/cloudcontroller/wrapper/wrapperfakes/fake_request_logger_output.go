@@ -2,6 +2,7 @@
 package wrapperfakes
 
 import (
+	http "net/http"
 	sync "sync"
 	time "time"
 
@@ -54,6 +55,17 @@ type FakeRequestLoggerOutput struct {
 	displayMessageReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisplayRequestErrorStub        func(error) error
+	displayRequestErrorMutex       sync.RWMutex
+	displayRequestErrorArgsForCall []struct {
+		arg1 error
+	}
+	displayRequestErrorReturns struct {
+		result1 error
+	}
+	displayRequestErrorReturnsOnCall map[int]struct {
+		result1 error
+	}
 	DisplayRequestHeaderStub        func(string, string, string) error
 	displayRequestHeaderMutex       sync.RWMutex
 	displayRequestHeaderArgsForCall []struct {
@@ -96,6 +108,11 @@ type FakeRequestLoggerOutput struct {
 	handleInternalErrorArgsForCall []struct {
 		arg1 error
 	}
+	SetRequestStub        func(*http.Request)
+	setRequestMutex       sync.RWMutex
+	setRequestArgsForCall []struct {
+		arg1 *http.Request
+	}
 	StartStub        func() error
 	startMutex       sync.RWMutex
 	startArgsForCall []struct {
@@ -366,6 +383,66 @@ func (fake *FakeRequestLoggerOutput) DisplayMessageReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeRequestLoggerOutput) DisplayRequestError(arg1 error) error {
+	fake.displayRequestErrorMutex.Lock()
+	ret, specificReturn := fake.displayRequestErrorReturnsOnCall[len(fake.displayRequestErrorArgsForCall)]
+	fake.displayRequestErrorArgsForCall = append(fake.displayRequestErrorArgsForCall, struct {
+		arg1 error
+	}{arg1})
+	fake.recordInvocation("DisplayRequestError", []interface{}{arg1})
+	fake.displayRequestErrorMutex.Unlock()
+	if fake.DisplayRequestErrorStub != nil {
+		return fake.DisplayRequestErrorStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.displayRequestErrorReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestErrorCallCount() int {
+	fake.displayRequestErrorMutex.RLock()
+	defer fake.displayRequestErrorMutex.RUnlock()
+	return len(fake.displayRequestErrorArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestErrorCalls(stub func(error) error) {
+	fake.displayRequestErrorMutex.Lock()
+	defer fake.displayRequestErrorMutex.Unlock()
+	fake.DisplayRequestErrorStub = stub
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestErrorArgsForCall(i int) error {
+	fake.displayRequestErrorMutex.RLock()
+	defer fake.displayRequestErrorMutex.RUnlock()
+	argsForCall := fake.displayRequestErrorArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestErrorReturns(result1 error) {
+	fake.displayRequestErrorMutex.Lock()
+	defer fake.displayRequestErrorMutex.Unlock()
+	fake.DisplayRequestErrorStub = nil
+	fake.displayRequestErrorReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeRequestLoggerOutput) DisplayRequestErrorReturnsOnCall(i int, result1 error) {
+	fake.displayRequestErrorMutex.Lock()
+	defer fake.displayRequestErrorMutex.Unlock()
+	fake.DisplayRequestErrorStub = nil
+	if fake.displayRequestErrorReturnsOnCall == nil {
+		fake.displayRequestErrorReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.displayRequestErrorReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeRequestLoggerOutput) DisplayRequestHeader(arg1 string, arg2 string, arg3 string) error {
 	fake.displayRequestHeaderMutex.Lock()
 	ret, specificReturn := fake.displayRequestHeaderReturnsOnCall[len(fake.displayRequestHeaderArgsForCall)]
@@ -581,6 +658,37 @@ func (fake *FakeRequestLoggerOutput) HandleInternalErrorArgsForCall(i int) error
 	return argsForCall.arg1
 }
 
+func (fake *FakeRequestLoggerOutput) SetRequest(arg1 *http.Request) {
+	fake.setRequestMutex.Lock()
+	fake.setRequestArgsForCall = append(fake.setRequestArgsForCall, struct {
+		arg1 *http.Request
+	}{arg1})
+	fake.recordInvocation("SetRequest", []interface{}{arg1})
+	fake.setRequestMutex.Unlock()
+	if fake.SetRequestStub != nil {
+		fake.SetRequestStub(arg1)
+	}
+}
+
+func (fake *FakeRequestLoggerOutput) SetRequestCallCount() int {
+	fake.setRequestMutex.RLock()
+	defer fake.setRequestMutex.RUnlock()
+	return len(fake.setRequestArgsForCall)
+}
+
+func (fake *FakeRequestLoggerOutput) SetRequestCalls(stub func(*http.Request)) {
+	fake.setRequestMutex.Lock()
+	defer fake.setRequestMutex.Unlock()
+	fake.SetRequestStub = stub
+}
+
+func (fake *FakeRequestLoggerOutput) SetRequestArgsForCall(i int) *http.Request {
+	fake.setRequestMutex.RLock()
+	defer fake.setRequestMutex.RUnlock()
+	argsForCall := fake.setRequestArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeRequestLoggerOutput) Start() error {
 	fake.startMutex.Lock()
 	ret, specificReturn := fake.startReturnsOnCall[len(fake.startArgsForCall)]
@@ -696,6 +804,8 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayJSONBodyMutex.RUnlock()
 	fake.displayMessageMutex.RLock()
 	defer fake.displayMessageMutex.RUnlock()
+	fake.displayRequestErrorMutex.RLock()
+	defer fake.displayRequestErrorMutex.RUnlock()
 	fake.displayRequestHeaderMutex.RLock()
 	defer fake.displayRequestHeaderMutex.RUnlock()
 	fake.displayResponseHeaderMutex.RLock()
@@ -704,6 +814,8 @@ func (fake *FakeRequestLoggerOutput) Invocations() map[string][][]interface{} {
 	defer fake.displayTypeMutex.RUnlock()
 	fake.handleInternalErrorMutex.RLock()
 	defer fake.handleInternalErrorMutex.RUnlock()
+	fake.setRequestMutex.RLock()
+	defer fake.setRequestMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
 	fake.stopMutex.RLock()
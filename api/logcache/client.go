@@ -0,0 +1,60 @@
+// Package logcache is a GoLang library that interacts with the CloudFoundry
+// Log Cache API.
+package logcache
+
+import (
+	"fmt"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/api/logcache/internal"
+
+	"github.com/tedsuo/rata"
+)
+
+// Client is a client that can be used to talk to Log Cache.
+type Client struct {
+	connection Connection
+	router     *rata.RequestGenerator
+	userAgent  string
+}
+
+// Config allows the Client to be configured
+type Config struct {
+	// AppName is the name of the application/process using the client.
+	AppName string
+
+	// AppVersion is the version of the application/process using the client.
+	AppVersion string
+
+	// ConnectionConfig is the configuration for the client connection.
+	ConnectionConfig
+
+	// LogCacheEndpoint is the url of the Log Cache API.
+	LogCacheEndpoint string
+
+	// Wrappers that apply to the client connection.
+	Wrappers []ConnectionWrapper
+}
+
+// NewClient returns a new Log Cache Client.
+func NewClient(config Config) *Client {
+	userAgent := fmt.Sprintf("%s/%s (%s; %s %s)",
+		config.AppName,
+		config.AppVersion,
+		runtime.Version(),
+		runtime.GOARCH,
+		runtime.GOOS,
+	)
+
+	client := Client{
+		userAgent:  userAgent,
+		router:     rata.NewRequestGenerator(config.LogCacheEndpoint, internal.APIRoutes),
+		connection: NewConnection(config.ConnectionConfig),
+	}
+
+	for _, wrapper := range config.Wrappers {
+		client.connection = wrapper.Wrap(client.connection)
+	}
+
+	return &client
+}
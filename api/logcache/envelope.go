@@ -0,0 +1,164 @@
+package logcache
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/logcache/internal"
+)
+
+// MaxReadLimit is the largest number of envelopes Log Cache returns from a
+// single read.
+const MaxReadLimit = 1000
+
+// EnvelopeType is the type of data carried by an envelope.
+type EnvelopeType string
+
+const (
+	LogEnvelopeType   EnvelopeType = "LOG"
+	EventEnvelopeType EnvelopeType = "EVENT"
+)
+
+// LogType is the output stream a log line was written to.
+type LogType string
+
+const (
+	OutLogType LogType = "OUT"
+	ErrLogType LogType = "ERR"
+)
+
+// Envelope is a single piece of data read from Log Cache.
+type Envelope struct {
+	// Timestamp is the time the envelope was emitted.
+	Timestamp time.Time
+
+	// SourceID is the ID of the source that emitted the envelope; for
+	// applications this is the application GUID.
+	SourceID string
+
+	// InstanceID is the index of the source instance.
+	InstanceID string
+
+	// Tags are the metadata attached to the envelope, such as source_type.
+	Tags map[string]string
+
+	// Log is the log line carried by the envelope, if any.
+	Log *Log
+}
+
+// Log is the log line carried by an envelope.
+type Log struct {
+	Payload []byte
+	Type    LogType
+}
+
+// UnmarshalJSON helps unmarshal a Log Cache envelope.
+func (envelope *Envelope) UnmarshalJSON(data []byte) error {
+	var ccEnvelope struct {
+		Timestamp  string            `json:"timestamp"`
+		SourceID   string            `json:"source_id"`
+		InstanceID string            `json:"instance_id"`
+		Tags       map[string]string `json:"tags"`
+		Log        *struct {
+			Payload []byte  `json:"payload"`
+			Type    LogType `json:"type"`
+		} `json:"log"`
+	}
+	err := json.Unmarshal(data, &ccEnvelope)
+	if err != nil {
+		return err
+	}
+
+	if ccEnvelope.Timestamp != "" {
+		nanoseconds, err := strconv.ParseInt(ccEnvelope.Timestamp, 10, 64)
+		if err != nil {
+			return err
+		}
+		envelope.Timestamp = time.Unix(0, nanoseconds)
+	}
+
+	envelope.SourceID = ccEnvelope.SourceID
+	envelope.InstanceID = ccEnvelope.InstanceID
+	envelope.Tags = ccEnvelope.Tags
+
+	if ccEnvelope.Log != nil {
+		envelope.Log = &Log{
+			Payload: ccEnvelope.Log.Payload,
+			Type:    ccEnvelope.Log.Type,
+		}
+		// Log Cache omits the type of stdout lines since it is the default.
+		if envelope.Log.Type == "" {
+			envelope.Log.Type = OutLogType
+		}
+	}
+
+	return nil
+}
+
+// ReadOptions narrow down the envelopes returned by ReadEnvelopes.
+type ReadOptions struct {
+	// StartTime excludes envelopes emitted before it.
+	StartTime time.Time
+
+	// EndTime excludes envelopes emitted at or after it. Log Cache defaults
+	// it to the current time.
+	EndTime time.Time
+
+	// EnvelopeTypes only returns envelopes of the given types.
+	EnvelopeTypes []EnvelopeType
+
+	// Limit is the maximum number of envelopes to return, up to MaxReadLimit.
+	Limit int
+
+	// Descending returns the newest envelopes first.
+	Descending bool
+}
+
+// ReadEnvelopes returns the envelopes emitted by the given source.
+func (client Client) ReadEnvelopes(sourceID string, options ReadOptions) ([]Envelope, error) {
+	query := url.Values{}
+	if !options.StartTime.IsZero() {
+		query.Set("start_time", strconv.FormatInt(options.StartTime.UnixNano(), 10))
+	}
+	if !options.EndTime.IsZero() {
+		query.Set("end_time", strconv.FormatInt(options.EndTime.UnixNano(), 10))
+	}
+	for _, envelopeType := range options.EnvelopeTypes {
+		query.Add("envelope_types", string(envelopeType))
+	}
+	if options.Limit > 0 {
+		query.Set("limit", strconv.Itoa(options.Limit))
+	}
+	if options.Descending {
+		query.Set("descending", "true")
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetReadRequest,
+		URIParams:   Params{"source_id": sourceID},
+		Query:       query,
+		Method:      http.MethodGet,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var readResponse struct {
+		Envelopes struct {
+			Batch []Envelope `json:"batch"`
+		} `json:"envelopes"`
+	}
+	response := Response{
+		Result: &readResponse,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return readResponse.Envelopes.Batch, nil
+}
@@ -0,0 +1,129 @@
+package logcache_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Envelope", func() {
+	Describe("ReadEnvelopes", func() {
+		var (
+			client  *Client
+			options ReadOptions
+
+			envelopes  []Envelope
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			client = NewTestLogCacheClient()
+			options = ReadOptions{}
+		})
+
+		JustBeforeEach(func() {
+			envelopes, executeErr = client.ReadEnvelopes("some-app-guid", options)
+		})
+
+		When("Log Cache returns envelopes", func() {
+			BeforeEach(func() {
+				options = ReadOptions{
+					StartTime:     time.Unix(0, 1000),
+					EndTime:       time.Unix(0, 2000),
+					EnvelopeTypes: []EnvelopeType{LogEnvelopeType},
+					Limit:         2,
+					Descending:    true,
+				}
+
+				response := `{
+					"envelopes": {
+						"batch": [
+							{
+								"timestamp": "1500",
+								"source_id": "some-app-guid",
+								"instance_id": "1",
+								"tags": {"source_type": "APP/PROC/WEB"},
+								"log": {"payload": "c29tZS1lcnJvcg==", "type": "ERR"}
+							},
+							{
+								"timestamp": "1200",
+								"source_id": "some-app-guid",
+								"instance_id": "0",
+								"tags": {"source_type": "STG"},
+								"log": {"payload": "c29tZS1vdXRwdXQ="}
+							}
+						]
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid", "descending=true&end_time=2000&envelope_types=LOG&limit=2&start_time=1000"),
+						RespondWith(http.StatusOK, response),
+					),
+				)
+			})
+
+			It("returns the envelopes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(Equal([]Envelope{
+					{
+						Timestamp:  time.Unix(0, 1500),
+						SourceID:   "some-app-guid",
+						InstanceID: "1",
+						Tags:       map[string]string{"source_type": "APP/PROC/WEB"},
+						Log:        &Log{Payload: []byte("some-error"), Type: ErrLogType},
+					},
+					{
+						Timestamp:  time.Unix(0, 1200),
+						SourceID:   "some-app-guid",
+						InstanceID: "0",
+						Tags:       map[string]string{"source_type": "STG"},
+						Log:        &Log{Payload: []byte("some-output"), Type: OutLogType},
+					},
+				}))
+			})
+		})
+
+		When("no options are given", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						func(_ http.ResponseWriter, request *http.Request) {
+							Expect(request.URL.RawQuery).To(BeEmpty())
+						},
+						RespondWith(http.StatusOK, `{"envelopes": {"batch": []}}`),
+					),
+				)
+			})
+
+			It("does not send any query parameters", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(envelopes).To(BeEmpty())
+			})
+		})
+
+		When("Log Cache returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/api/v1/read/some-app-guid"),
+						RespondWith(http.StatusForbidden, `{"error": "forbidden"}`),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(logcacheerror.RawHTTPStatusError{
+					StatusCode:  http.StatusForbidden,
+					RawResponse: []byte(`{"error": "forbidden"}`),
+				}))
+			})
+		})
+	})
+})
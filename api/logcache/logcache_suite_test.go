@@ -0,0 +1,48 @@
+package logcache_test
+
+import (
+	"bytes"
+	"log"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+
+	"testing"
+)
+
+func TestLogCache(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Log Cache Suite")
+}
+
+var server *Server
+
+var _ = SynchronizedBeforeSuite(func() []byte {
+	return []byte{}
+}, func(data []byte) {
+	server = NewTLSServer()
+
+	// Suppresses ginkgo server logs
+	server.HTTPTestServer.Config.ErrorLog = log.New(&bytes.Buffer{}, "", 0)
+})
+
+var _ = SynchronizedAfterSuite(func() {
+	server.Close()
+}, func() {})
+
+var _ = BeforeEach(func() {
+	server.Reset()
+})
+
+func NewTestLogCacheClient() *logcache.Client {
+	return logcache.NewClient(logcache.Config{
+		AppName:          "TestApp",
+		AppVersion:       "1.2.3",
+		LogCacheEndpoint: server.URL(),
+		ConnectionConfig: logcache.ConnectionConfig{
+			SkipSSLValidation: true,
+		},
+	})
+}
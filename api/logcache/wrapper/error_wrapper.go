@@ -0,0 +1,38 @@
+package wrapper
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+)
+
+// ErrorWrapper is the wrapper that converts responses with 4xx and 5xx status
+// codes to an error.
+type ErrorWrapper struct {
+	connection logcache.Connection
+}
+
+func NewErrorWrapper() *ErrorWrapper {
+	return new(ErrorWrapper)
+}
+
+// Make converts an unauthorized response to an InvalidAuthTokenError so that
+// the token can be refreshed. Log Cache responds with a 401 to any token it
+// cannot verify, including expired ones.
+func (e *ErrorWrapper) Make(request *logcache.Request, passedResponse *logcache.Response) error {
+	err := e.connection.Make(request, passedResponse)
+
+	if rawHTTPStatusErr, ok := err.(logcacheerror.RawHTTPStatusError); ok {
+		if rawHTTPStatusErr.StatusCode == http.StatusUnauthorized {
+			return logcacheerror.InvalidAuthTokenError{Message: "Token is expired"}
+		}
+	}
+
+	return err
+}
+
+func (e *ErrorWrapper) Wrap(connection logcache.Connection) logcache.Connection {
+	e.connection = connection
+	return e
+}
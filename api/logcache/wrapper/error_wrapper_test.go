@@ -0,0 +1,63 @@
+package wrapper_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+	. "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Error Wrapper", func() {
+	var (
+		fakeConnection *logcachefakes.FakeConnection
+		wrapper        logcache.Connection
+		request        *logcache.Request
+		response       *logcache.Response
+		makeErr        error
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(logcachefakes.FakeConnection)
+		wrapper = NewErrorWrapper().Wrap(fakeConnection)
+		request = &logcache.Request{}
+		response = &logcache.Response{}
+	})
+
+	JustBeforeEach(func() {
+		makeErr = wrapper.Make(request, response)
+	})
+
+	When("the request is unauthorized", func() {
+		BeforeEach(func() {
+			fakeConnection.MakeReturns(logcacheerror.RawHTTPStatusError{StatusCode: http.StatusUnauthorized})
+		})
+
+		It("returns an InvalidAuthTokenError", func() {
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(makeErr).To(MatchError(logcacheerror.InvalidAuthTokenError{Message: "Token is expired"}))
+		})
+	})
+
+	When("the request fails with any other status code", func() {
+		var serverErr logcacheerror.RawHTTPStatusError
+
+		BeforeEach(func() {
+			serverErr = logcacheerror.RawHTTPStatusError{StatusCode: http.StatusForbidden, RawResponse: []byte("forbidden")}
+			fakeConnection.MakeReturns(serverErr)
+		})
+
+		It("returns the raw error", func() {
+			Expect(makeErr).To(MatchError(serverErr))
+		})
+	})
+
+	When("the request succeeds", func() {
+		It("returns nil", func() {
+			Expect(makeErr).ToNot(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,52 @@
+package wrapper
+
+import (
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/retry"
+)
+
+// RetryRequest is a wrapper that retries failed requests according to a
+// retry.Policy.
+type RetryRequest struct {
+	policy     retry.Policy
+	connection logcache.Connection
+}
+
+// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+func NewRetryRequest(policy retry.Policy) *RetryRequest {
+	return &RetryRequest{
+		policy: policy,
+	}
+}
+
+// Make retries the request if it comes back with a retryable status code or
+// connection error, waiting between attempts as the policy dictates.
+func (retryRequest *RetryRequest) Make(request *logcache.Request, passedResponse *logcache.Response) error {
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		err = retryRequest.connection.Make(request, passedResponse)
+		if err == nil {
+			return nil
+		}
+
+		delay, ok := retryRequest.policy.NextDelay(attempt, request.Request, passedResponse.HTTPResponse)
+		if !ok {
+			break
+		}
+
+		resetErr := request.ResetBody()
+		if resetErr != nil {
+			return resetErr
+		}
+
+		retryRequest.policy.Wait(delay)
+	}
+	return err
+}
+
+// Wrap sets the connection in the RetryRequest and returns itself.
+func (retryRequest *RetryRequest) Wrap(innerconnection logcache.Connection) logcache.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
+}
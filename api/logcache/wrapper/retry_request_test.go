@@ -0,0 +1,77 @@
+package wrapper_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+	. "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/api/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Retry Request", func() {
+	DescribeTable("number of retries",
+		func(requestMethod string, responseStatusCode int, expectedNumberOfRetries int) {
+			rawRequestBody := "banana pants"
+			body := strings.NewReader(rawRequestBody)
+
+			req, err := http.NewRequest(requestMethod, "https://foo.bar.com/banana", body)
+			Expect(err).NotTo(HaveOccurred())
+			request := logcache.NewRequest(req, body)
+
+			response := &logcache.Response{
+				HTTPResponse: &http.Response{
+					StatusCode: responseStatusCode,
+				},
+			}
+
+			fakeConnection := new(logcachefakes.FakeConnection)
+			expectedErr := logcacheerror.RawHTTPStatusError{
+				StatusCode: responseStatusCode,
+			}
+			fakeConnection.MakeStub = func(req *logcache.Request, passedResponse *logcache.Response) error {
+				defer req.Body.Close()
+				body, readErr := ioutil.ReadAll(request.Body)
+				Expect(readErr).ToNot(HaveOccurred())
+				Expect(string(body)).To(Equal(rawRequestBody))
+				return expectedErr
+			}
+
+			wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+			err = wrapper.Make(request, response)
+			Expect(err).To(MatchError(expectedErr))
+			Expect(fakeConnection.MakeCallCount()).To(Equal(expectedNumberOfRetries))
+		},
+
+		Entry("maxRetries for Non-Post (500) Internal Server Error", http.MethodGet, http.StatusInternalServerError, 3),
+		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
+		Entry("maxRetries for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 3),
+
+		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
+		Entry("1 for Get 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
+	)
+
+	It("does not retry on success", func() {
+		req, err := http.NewRequest(http.MethodGet, "https://foo.bar.com/banana", nil)
+		Expect(err).NotTo(HaveOccurred())
+		request := logcache.NewRequest(req, nil)
+		response := &logcache.Response{
+			HTTPResponse: &http.Response{
+				StatusCode: http.StatusOK,
+			},
+		}
+
+		fakeConnection := new(logcachefakes.FakeConnection)
+		wrapper := NewRetryRequest(retry.Policy{MaxRetries: 2}).Wrap(fakeConnection)
+
+		err = wrapper.Make(request, response)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
+})
@@ -0,0 +1,173 @@
+package wrapper_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/logcache"
+	"code.cloudfoundry.org/cli/api/logcache/logcacheerror"
+	"code.cloudfoundry.org/cli/api/logcache/logcachefakes"
+	. "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/api/logcache/wrapper/wrapperfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/wrapper/util"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("UAA Authentication", func() {
+	var (
+		fakeConnection *logcachefakes.FakeConnection
+		fakeClient     *wrapperfakes.FakeUAAClient
+		inMemoryCache  *util.InMemoryCache
+
+		wrapper logcache.Connection
+		request *logcache.Request
+		inner   *UAAAuthentication
+	)
+
+	BeforeEach(func() {
+		fakeConnection = new(logcachefakes.FakeConnection)
+		fakeClient = new(wrapperfakes.FakeUAAClient)
+		inMemoryCache = util.NewInMemoryTokenCache()
+		inMemoryCache.SetAccessToken("a-ok")
+
+		inner = NewUAAAuthentication(fakeClient, inMemoryCache)
+		wrapper = inner.Wrap(fakeConnection)
+
+		request = &logcache.Request{
+			Request: &http.Request{
+				Header: http.Header{},
+			},
+		}
+	})
+
+	Describe("Make", func() {
+		When("the client is nil", func() {
+			BeforeEach(func() {
+				inner.SetClient(nil)
+
+				fakeConnection.MakeReturns(logcacheerror.InvalidAuthTokenError{})
+			})
+
+			It("calls the connection without any side effects", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).To(MatchError(logcacheerror.InvalidAuthTokenError{}))
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the token is valid", func() {
+			It("adds authentication headers", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+				headers := authenticatedRequest.Header
+				Expect(headers["Authorization"]).To(ConsistOf([]string{"a-ok"}))
+			})
+
+			When("the request already has headers", func() {
+				It("preserves existing headers", func() {
+					request.Header.Add("Existing", "header")
+					err := wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+					authenticatedRequest, _ := fakeConnection.MakeArgsForCall(0)
+					headers := authenticatedRequest.Header
+					Expect(headers["Existing"]).To(ConsistOf([]string{"header"}))
+				})
+			})
+
+			When("the wrapped connection returns nil", func() {
+				It("returns nil", func() {
+					fakeConnection.MakeReturns(nil)
+
+					err := wrapper.Make(request, nil)
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			When("the wrapped connection returns an error", func() {
+				It("returns the error", func() {
+					innerError := errors.New("inner error")
+					fakeConnection.MakeReturns(innerError)
+
+					err := wrapper.Make(request, nil)
+					Expect(err).To(Equal(innerError))
+				})
+			})
+		})
+
+		When("the token is invalid", func() {
+			var (
+				expectedBody string
+				request      *logcache.Request
+				executeErr   error
+			)
+
+			BeforeEach(func() {
+				expectedBody = "this body content should be preserved"
+				body := strings.NewReader(expectedBody)
+				request = logcache.NewRequest(&http.Request{
+					Header: http.Header{},
+					Body:   ioutil.NopCloser(body),
+				}, body)
+
+				makeCount := 0
+				fakeConnection.MakeStub = func(request *logcache.Request, response *logcache.Response) error {
+					body, err := ioutil.ReadAll(request.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal(expectedBody))
+
+					if makeCount == 0 {
+						makeCount++
+						return logcacheerror.InvalidAuthTokenError{}
+					} else {
+						return nil
+					}
+				}
+
+				inMemoryCache.SetAccessToken("what")
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshedTokens{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			JustBeforeEach(func() {
+				executeErr = wrapper.Make(request, nil)
+			})
+
+			It("should refresh the token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+			})
+
+			It("should resend the request", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+				requestArg, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(requestArg.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+
+			It("should save the refresh token", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
+			})
+		})
+	})
+})
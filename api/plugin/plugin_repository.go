@@ -15,6 +15,8 @@ type PluginBinary struct {
 	Platform string `json:"platform"`
 	URL      string `json:"url"`
 	Checksum string `json:"checksum"`
+	// Signature is the base64 encoded ed25519 signature of the binary.
+	Signature string `json:"signature"`
 }
 
 type Plugin struct {
@@ -18,10 +18,12 @@ type RequestLoggerOutput interface {
 	DisplayHeader(name string, value string) error
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
+	DisplayRequestError(err error) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
+	SetRequest(request *http.Request)
 	Start() error
 	Stop() error
 }
@@ -50,7 +52,12 @@ func (logger *RequestLogger) Make(request *http.Request, passedResponse *plugin.
 	err = logger.connection.Make(request, passedResponse, proxyReader)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(request, passedResponse)
+		if displayErr != nil {
+			logger.output.HandleInternalError(displayErr)
+		}
+	} else if err != nil {
+		displayErr := logger.displayRequestError(request, err)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -71,6 +78,7 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 		return err
 	}
 	defer logger.output.Stop()
+	logger.output.SetRequest(request)
 
 	err = logger.output.DisplayType("REQUEST", time.Now())
 	if err != nil {
@@ -106,12 +114,13 @@ func (logger *RequestLogger) displayRequest(request *http.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) error {
+func (logger *RequestLogger) displayResponse(request *http.Request, passedResponse *plugin.Response) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
+	logger.output.SetRequest(request)
 
 	err = logger.output.DisplayType("RESPONSE", time.Now())
 	if err != nil {
@@ -136,6 +145,21 @@ func (logger *RequestLogger) displayResponse(passedResponse *plugin.Response) er
 	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
 }
 
+func (logger *RequestLogger) displayRequestError(request *http.Request, requestErr error) error {
+	err := logger.output.Start()
+	if err != nil {
+		return err
+	}
+	defer logger.output.Stop()
+	logger.output.SetRequest(request)
+
+	err = logger.output.DisplayType("RESPONSE", time.Now())
+	if err != nil {
+		return err
+	}
+	return logger.output.DisplayRequestError(requestErr)
+}
+
 func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 	keys := []string{}
 	for key, _ := range headers {
@@ -6,24 +6,26 @@ import (
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/retry"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests according to a
+// retry.Policy.
 type RetryRequest struct {
-	maxRetries int
+	policy     retry.Policy
 	connection plugin.Connection
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+func NewRetryRequest(policy retry.Policy) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
-func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
+// Make retries the request if it comes back with a retryable status code or
+// connection error, waiting between attempts as the policy dictates.
+func (retryRequest *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response, proxyReader plugin.ProxyReader) error {
 	var err error
 	var rawRequestBody []byte
 
@@ -35,35 +37,26 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Re
 		}
 	}
 
-	for i := 0; i < retry.maxRetries+1; i++ {
+	for attempt := 0; ; attempt++ {
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		err = retry.connection.Make(request, passedResponse, proxyReader)
+		err = retryRequest.connection.Make(request, passedResponse, proxyReader)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		delay, ok := retryRequest.policy.NextDelay(attempt, request, passedResponse.HTTPResponse)
+		if !ok {
 			break
 		}
+		retryRequest.policy.Wait(delay)
 	}
 	return err
 }
 
 // Wrap sets the connection in the RetryRequest and returns itself.
-func (retry *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connection {
-	retry.connection = innerconnection
-	return retry
-}
-
-// skipRetry if the request method is POST, or not one of the following http
-// status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+func (retryRequest *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
 }
@@ -0,0 +1,165 @@
+// Package retry decides when and how long to wait before a failed API request
+// is retried. It is shared by the connection wrappers of all API clients.
+package retry
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// IdempotencyKeyHeader marks a POST request that the server deduplicates,
+// which makes it safe to retry.
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+type idempotentKey struct{}
+
+// Policy describes how failed requests are retried. Requests are retried
+// after a 429 response or, if they are idempotent, after a 500, 502, 503 or
+// 504 response or a connection error. The delay between attempts grows
+// exponentially from BaseDelay up to MaxDelay with random jitter, unless the
+// server asks for a specific delay with the Retry-After or X-RateLimit-Reset
+// headers.
+type Policy struct {
+	// MaxRetries is the number of times a request is retried.
+	MaxRetries int
+	// BaseDelay is the delay before the first retry.
+	BaseDelay time.Duration
+	// MaxDelay caps the delay between retries. When the server asks to wait
+	// longer than MaxDelay the request is not retried.
+	MaxDelay time.Duration
+
+	// Sleep waits between attempts. Defaults to time.Sleep.
+	Sleep func(time.Duration)
+	// Now returns the current time. Defaults to time.Now.
+	Now func() time.Time
+	// Jitter returns a random number in [0.0,1.0). Defaults to rand.Float64.
+	Jitter func() float64
+}
+
+// MarkIdempotent returns a copy of request that is retried like a GET even if
+// it is a POST. Use it for POSTs that do not change server state when they
+// are repeated, such as polling for a token.
+func MarkIdempotent(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), idempotentKey{}, true))
+}
+
+// IsIdempotent returns true if repeating request has the same effect as
+// sending it once.
+func IsIdempotent(request *http.Request) bool {
+	if request.Method != http.MethodPost {
+		return true
+	}
+	if request.Header.Get(IdempotencyKeyHeader) != "" {
+		return true
+	}
+	marked, _ := request.Context().Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// NextDelay returns how long to wait before retrying request, which has
+// failed attempt+1 times with response. It returns false if the request
+// should not be retried. response is nil when the request failed before a
+// response was received.
+func (policy Policy) NextDelay(attempt int, request *http.Request, response *http.Response) (time.Duration, bool) {
+	if attempt >= policy.MaxRetries {
+		return 0, false
+	}
+
+	if response != nil && response.StatusCode == http.StatusTooManyRequests {
+		// The server rejected the request without processing it, so any
+		// method can be retried.
+		if delay, ok := policy.requestedDelay(response); ok {
+			return delay, delay <= policy.MaxDelay
+		}
+		return policy.backoff(attempt), true
+	}
+
+	if !IsIdempotent(request) || !isRetryableStatus(response) {
+		return 0, false
+	}
+
+	if delay, ok := policy.requestedDelay(response); ok {
+		return delay, delay <= policy.MaxDelay
+	}
+	return policy.backoff(attempt), true
+}
+
+// Wait sleeps for delay.
+func (policy Policy) Wait(delay time.Duration) {
+	if policy.Sleep != nil {
+		policy.Sleep(delay)
+		return
+	}
+	time.Sleep(delay)
+}
+
+// backoff returns the exponential delay for attempt with jitter applied to
+// the upper half of the range.
+func (policy Policy) backoff(attempt int) time.Duration {
+	delay := float64(policy.BaseDelay) * math.Pow(2, float64(attempt))
+	if delay > float64(policy.MaxDelay) {
+		delay = float64(policy.MaxDelay)
+	}
+
+	jitter := rand.Float64
+	if policy.Jitter != nil {
+		jitter = policy.Jitter
+	}
+	return time.Duration(delay/2 + jitter()*delay/2)
+}
+
+// requestedDelay returns the delay asked for by the Retry-After or
+// X-RateLimit-Reset headers of response.
+func (policy Policy) requestedDelay(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	now := time.Now
+	if policy.Now != nil {
+		now = policy.Now
+	}
+
+	if retryAfter := response.Header.Get("Retry-After"); retryAfter != "" {
+		if seconds, err := strconv.Atoi(retryAfter); err == nil {
+			return nonNegative(time.Duration(seconds) * time.Second), true
+		}
+		if date, err := http.ParseTime(retryAfter); err == nil {
+			return nonNegative(date.Sub(now())), true
+		}
+	}
+
+	if reset := response.Header.Get("X-RateLimit-Reset"); reset != "" {
+		if epoch, err := strconv.ParseInt(reset, 10, 64); err == nil {
+			return nonNegative(time.Unix(epoch, 0).Sub(now())), true
+		}
+	}
+
+	return 0, false
+}
+
+func isRetryableStatus(response *http.Response) bool {
+	if response == nil {
+		return true
+	}
+
+	switch response.StatusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+func nonNegative(delay time.Duration) time.Duration {
+	if delay < 0 {
+		return 0
+	}
+	return delay
+}
@@ -0,0 +1,159 @@
+package retry_test
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	var (
+		policy   Policy
+		request  *http.Request
+		response *http.Response
+		now      time.Time
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2019, time.March, 1, 12, 0, 0, 0, time.UTC)
+		policy = Policy{
+			MaxRetries: 3,
+			BaseDelay:  time.Second,
+			MaxDelay:   10 * time.Second,
+			Now:        func() time.Time { return now },
+			Jitter:     func() float64 { return 1 },
+		}
+
+		var err error
+		request, err = http.NewRequest(http.MethodGet, "https://api.example.com/v3/apps", nil)
+		Expect(err).ToNot(HaveOccurred())
+		response = &http.Response{StatusCode: http.StatusServiceUnavailable, Header: http.Header{}}
+	})
+
+	Describe("NextDelay", func() {
+		It("backs off exponentially up to MaxDelay", func() {
+			var delays []time.Duration
+			for attempt := 0; attempt < 3; attempt++ {
+				delay, ok := policy.NextDelay(attempt, request, response)
+				Expect(ok).To(BeTrue())
+				delays = append(delays, delay)
+			}
+			Expect(delays).To(Equal([]time.Duration{time.Second, 2 * time.Second, 4 * time.Second}))
+
+			policy.MaxRetries = 10
+			delay, _ := policy.NextDelay(8, request, response)
+			Expect(delay).To(Equal(10 * time.Second))
+		})
+
+		It("applies jitter to the upper half of the delay", func() {
+			policy.Jitter = func() float64 { return 0 }
+			delay, ok := policy.NextDelay(1, request, response)
+			Expect(ok).To(BeTrue())
+			Expect(delay).To(Equal(time.Second))
+		})
+
+		It("stops after MaxRetries", func() {
+			_, ok := policy.NextDelay(3, request, response)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("retries connection errors", func() {
+			_, ok := policy.NextDelay(0, request, nil)
+			Expect(ok).To(BeTrue())
+		})
+
+		DescribeTable("status codes",
+			func(method string, statusCode int, retried bool) {
+				request.Method = method
+				response.StatusCode = statusCode
+				_, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(Equal(retried))
+			},
+
+			Entry("GET 500", http.MethodGet, http.StatusInternalServerError, true),
+			Entry("GET 502", http.MethodGet, http.StatusBadGateway, true),
+			Entry("GET 504", http.MethodGet, http.StatusGatewayTimeout, true),
+			Entry("PUT 503", http.MethodPut, http.StatusServiceUnavailable, true),
+			Entry("GET 404", http.MethodGet, http.StatusNotFound, false),
+			Entry("POST 503", http.MethodPost, http.StatusServiceUnavailable, false),
+			Entry("POST 429", http.MethodPost, http.StatusTooManyRequests, true),
+		)
+
+		When("the POST is safe to retry", func() {
+			BeforeEach(func() {
+				request.Method = http.MethodPost
+			})
+
+			It("retries a POST marked idempotent", func() {
+				_, ok := policy.NextDelay(0, MarkIdempotent(request), response)
+				Expect(ok).To(BeTrue())
+			})
+
+			It("retries a POST with an Idempotency-Key", func() {
+				request.Header.Set(IdempotencyKeyHeader, "some-key")
+				_, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeTrue())
+			})
+		})
+
+		When("the server is rate limiting", func() {
+			BeforeEach(func() {
+				response.StatusCode = http.StatusTooManyRequests
+			})
+
+			It("waits the number of seconds in Retry-After", func() {
+				response.Header.Set("Retry-After", "7")
+				delay, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(Equal(7 * time.Second))
+			})
+
+			It("waits until the date in Retry-After", func() {
+				response.Header.Set("Retry-After", now.Add(5*time.Second).Format(http.TimeFormat))
+				delay, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(Equal(5 * time.Second))
+			})
+
+			It("waits until X-RateLimit-Reset", func() {
+				response.Header.Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(8*time.Second).Unix(), 10))
+				delay, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(Equal(8 * time.Second))
+			})
+
+			It("does not wait for a reset in the past", func() {
+				response.Header.Set("X-RateLimit-Reset", strconv.FormatInt(now.Add(-time.Minute).Unix(), 10))
+				delay, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(BeZero())
+			})
+
+			It("gives up when asked to wait longer than MaxDelay", func() {
+				response.Header.Set("Retry-After", "60")
+				_, ok := policy.NextDelay(0, request, response)
+				Expect(ok).To(BeFalse())
+			})
+
+			It("backs off when no delay is requested", func() {
+				delay, ok := policy.NextDelay(1, request, response)
+				Expect(ok).To(BeTrue())
+				Expect(delay).To(Equal(2 * time.Second))
+			})
+		})
+	})
+
+	Describe("Wait", func() {
+		It("sleeps for the delay", func() {
+			var slept time.Duration
+			policy.Sleep = func(delay time.Duration) { slept = delay }
+			policy.Wait(3 * time.Second)
+			Expect(slept).To(Equal(3 * time.Second))
+		})
+	})
+})
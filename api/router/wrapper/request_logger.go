@@ -19,10 +19,12 @@ type RequestLoggerOutput interface {
 	DisplayHost(name string) error
 	DisplayJSONBody(body []byte) error
 	DisplayMessage(msg string) error
+	DisplayRequestError(err error) error
 	DisplayRequestHeader(method string, uri string, httpProtocol string) error
 	DisplayResponseHeader(httpProtocol string, status string) error
 	DisplayType(name string, requestDate time.Time) error
 	HandleInternalError(err error)
+	SetRequest(request *http.Request)
 	Start() error
 	Stop() error
 }
@@ -51,7 +53,12 @@ func (logger *RequestLogger) Make(request *router.Request, passedResponse *route
 	err = logger.connection.Make(request, passedResponse)
 
 	if passedResponse.HTTPResponse != nil {
-		displayErr := logger.displayResponse(passedResponse)
+		displayErr := logger.displayResponse(request, passedResponse)
+		if displayErr != nil {
+			logger.output.HandleInternalError(displayErr)
+		}
+	} else if err != nil {
+		displayErr := logger.displayRequestError(request, err)
 		if displayErr != nil {
 			logger.output.HandleInternalError(displayErr)
 		}
@@ -72,6 +79,7 @@ func (logger *RequestLogger) displayRequest(request *router.Request) error {
 		return err
 	}
 	defer logger.output.Stop()
+	logger.output.SetRequest(request.Request)
 
 	err = logger.output.DisplayType("REQUEST", time.Now())
 	if err != nil {
@@ -118,12 +126,13 @@ func (logger *RequestLogger) displayRequest(request *router.Request) error {
 	return nil
 }
 
-func (logger *RequestLogger) displayResponse(passedResponse *router.Response) error {
+func (logger *RequestLogger) displayResponse(request *router.Request, passedResponse *router.Response) error {
 	err := logger.output.Start()
 	if err != nil {
 		return err
 	}
 	defer logger.output.Stop()
+	logger.output.SetRequest(request.Request)
 
 	err = logger.output.DisplayType("RESPONSE", time.Now())
 	if err != nil {
@@ -140,6 +149,21 @@ func (logger *RequestLogger) displayResponse(passedResponse *router.Response) er
 	return logger.output.DisplayJSONBody(passedResponse.RawResponse)
 }
 
+func (logger *RequestLogger) displayRequestError(request *router.Request, requestErr error) error {
+	err := logger.output.Start()
+	if err != nil {
+		return err
+	}
+	defer logger.output.Stop()
+	logger.output.SetRequest(request.Request)
+
+	err = logger.output.DisplayType("RESPONSE", time.Now())
+	if err != nil {
+		return err
+	}
+	return logger.output.DisplayRequestError(requestErr)
+}
+
 func (logger *RequestLogger) displaySortedHeaders(headers http.Header) error {
 	keys := []string{}
 	for key, _ := range headers {
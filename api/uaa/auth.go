@@ -44,8 +44,9 @@ func (client Client) Authenticate(ID string, secret string, origin string, grant
 		Header: http.Header{
 			"Content-Type": {"application/x-www-form-urlencoded"},
 		},
-		Body:  strings.NewReader(requestBody.Encode()),
-		Query: query,
+		Body:       strings.NewReader(requestBody.Encode()),
+		Query:      query,
+		Idempotent: true,
 	})
 
 	if err != nil {
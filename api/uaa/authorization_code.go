@@ -0,0 +1,83 @@
+package uaa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// PKCEChallenge is a Proof Key for Code Exchange (RFC 7636) verifier and its
+// S256 challenge.
+type PKCEChallenge struct {
+	Verifier  string
+	Challenge string
+}
+
+// NewPKCEChallenge generates a random code verifier and the matching S256
+// code challenge.
+func NewPKCEChallenge() (PKCEChallenge, error) {
+	verifier, err := randomURLSafeString(32)
+	if err != nil {
+		return PKCEChallenge{}, err
+	}
+
+	sum := sha256.Sum256([]byte(verifier))
+	return PKCEChallenge{
+		Verifier:  verifier,
+		Challenge: base64.RawURLEncoding.EncodeToString(sum[:]),
+	}, nil
+}
+
+// NewAuthorizationState returns a random value used to tie an authorization
+// response to the request that initiated it.
+func NewAuthorizationState() (string, error) {
+	return randomURLSafeString(16)
+}
+
+// AuthorizationCodeURL returns the URL the user must open in a browser to
+// start the authorization code grant with PKCE.
+func (client *Client) AuthorizationCodeURL(redirectURI string, challenge PKCEChallenge, state string) (string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.GetOAuthAuthorizeRequest,
+		Query: url.Values{
+			"response_type":         {"code"},
+			"client_id":             {client.config.UAAOAuthClient()},
+			"redirect_uri":          {redirectURI},
+			"code_challenge":        {challenge.Challenge},
+			"code_challenge_method": {"S256"},
+			"state":                 {state},
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL.String(), nil
+}
+
+// AuthenticateWithAuthorizationCode exchanges an authorization code and its
+// PKCE verifier for an access token and a refresh token.
+func (client *Client) AuthenticateWithAuthorizationCode(code string, codeVerifier string, redirectURI string) (string, string, error) {
+	requestBody := url.Values{
+		"grant_type":    {string(constant.GrantTypeAuthorizationCode)},
+		"code":          {code},
+		"code_verifier": {codeVerifier},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {client.config.UAAOAuthClient()},
+	}
+
+	// An authorization code can only be redeemed once.
+	return client.requestToken(requestBody, false)
+}
+
+func randomURLSafeString(size int) (string, error) {
+	raw := make([]byte, size)
+	if _, err := rand.Read(raw); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
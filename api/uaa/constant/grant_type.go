@@ -4,9 +4,15 @@ package constant
 type GrantType string
 
 const (
+	// GrantTypeAuthorizationCode is used for browser based authentication,
+	// where the authorization code is returned to a local redirect URI.
+	GrantTypeAuthorizationCode GrantType = "authorization_code"
 	// GrantTypeClientCredentials is used for a preconfigured client ID/secret
 	// authentication.
 	GrantTypeClientCredentials GrantType = "client_credentials"
+	// GrantTypeDeviceCode is used for the OAuth2 device authorization grant,
+	// where the user approves the login on a separate device.
+	GrantTypeDeviceCode GrantType = "urn:ietf:params:oauth:grant-type:device_code"
 	// GrantTypePassword is used for user's username/password authentication.
 	GrantTypePassword     GrantType = "password"
 	GrantTypeRefreshToken GrantType = "refresh_token"
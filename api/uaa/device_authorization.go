@@ -0,0 +1,97 @@
+package uaa
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/uaa/constant"
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// DeviceAuthorization represents the UAA response to a device authorization
+// request. The user visits VerificationURI and enters UserCode while the CLI
+// polls for a token with DeviceCode.
+type DeviceAuthorization struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	// ExpiresIn is the lifetime of the device code in seconds.
+	ExpiresIn int `json:"expires_in"`
+	// Interval is the minimum number of seconds to wait between polls.
+	Interval int `json:"interval"`
+}
+
+// RequestDeviceAuthorization starts the OAuth2 device authorization grant for
+// the configured UAA OAuth client.
+func (client *Client) RequestDeviceAuthorization() (DeviceAuthorization, error) {
+	requestBody := url.Values{
+		"client_id": {client.config.UAAOAuthClient()},
+	}
+
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostDeviceAuthorizationRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body: strings.NewReader(requestBody.Encode()),
+	})
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	var deviceAuthorization DeviceAuthorization
+	response := Response{
+		Result: &deviceAuthorization,
+	}
+
+	err = client.connection.Make(request, &response)
+	if err != nil {
+		return DeviceAuthorization{}, err
+	}
+
+	return deviceAuthorization, nil
+}
+
+// AuthenticateWithDeviceCode exchanges a device code for an access token and
+// a refresh token. Until the user approves the login, an
+// AuthorizationPendingError or SlowDownError is returned.
+func (client *Client) AuthenticateWithDeviceCode(deviceCode string) (string, string, error) {
+	requestBody := url.Values{
+		"grant_type":  {string(constant.GrantTypeDeviceCode)},
+		"device_code": {deviceCode},
+		"client_id":   {client.config.UAAOAuthClient()},
+	}
+
+	return client.requestToken(requestBody, true)
+}
+
+// requestToken posts the provided form to the token endpoint, authenticating
+// as the configured UAA OAuth client. Only idempotent requests are retried
+// after a server error.
+func (client *Client) requestToken(requestBody url.Values, idempotent bool) (string, string, error) {
+	request, err := client.newRequest(requestOptions{
+		RequestName: internal.PostOAuthTokenRequest,
+		Header: http.Header{
+			"Content-Type": {"application/x-www-form-urlencoded"},
+		},
+		Body:       strings.NewReader(requestBody.Encode()),
+		Idempotent: idempotent,
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	request.SetBasicAuth(client.config.UAAOAuthClient(), client.config.UAAOAuthClientSecret())
+
+	responseBody := AuthResponse{}
+	response := Response{
+		Result: &responseBody,
+	}
+
+	err = client.connection.Make(request, &response)
+	return responseBody.AccessToken, responseBody.RefreshToken, err
+}
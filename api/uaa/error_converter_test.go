@@ -83,6 +83,54 @@ var _ = Describe("Error Wrapper", func() {
 						Expect(makeErr).To(MatchError(InvalidSCIMResourceError{Message: "A username must be provided"}))
 					})
 				})
+				Context("authorization pending", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{
+  "error": "authorization_pending",
+  "error_description": "The user has not yet approved the login"
+}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AuthorizationPendingError", func() {
+						Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+						Expect(makeErr).To(MatchError(AuthorizationPendingError{Message: "The user has not yet approved the login"}))
+					})
+				})
+
+				Context("slow down", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error": "slow_down", "error_description": "Slow down"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns a SlowDownError", func() {
+						Expect(makeErr).To(MatchError(SlowDownError{Message: "Slow down"}))
+					})
+				})
+
+				Context("expired token", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error": "expired_token", "error_description": "The device code has expired"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an ExpiredDeviceCodeError", func() {
+						Expect(makeErr).To(MatchError(ExpiredDeviceCodeError{Message: "The device code has expired"}))
+					})
+				})
+
+				Context("access denied", func() {
+					BeforeEach(func() {
+						fakeConnectionErr.RawResponse = []byte(`{"error": "access_denied", "error_description": "The user denied the request"}`)
+						fakeConnection.MakeReturns(fakeConnectionErr)
+					})
+
+					It("returns an AccessDeniedError", func() {
+						Expect(makeErr).To(MatchError(AccessDeniedError{Message: "The user denied the request"}))
+					})
+				})
 			})
 
 			Context("(401) Unauthorized", func() {
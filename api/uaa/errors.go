@@ -88,3 +88,51 @@ type InvalidSCIMResourceError struct {
 func (e InvalidSCIMResourceError) Error() string {
 	return e.Message
 }
+
+// AuthorizationPendingError is returned when polling for a device code token
+// before the user has approved the login.
+type AuthorizationPendingError struct {
+	Message string
+}
+
+func (e AuthorizationPendingError) Error() string {
+	return e.Message
+}
+
+// SlowDownError is returned when polling for a device code token more often
+// than the UAA allows.
+type SlowDownError struct {
+	Message string
+}
+
+func (e SlowDownError) Error() string {
+	return e.Message
+}
+
+// ExpiredDeviceCodeError is returned when the device code has expired before
+// the user approved the login.
+type ExpiredDeviceCodeError struct {
+	Message string
+}
+
+func (e ExpiredDeviceCodeError) Error() string {
+	return e.Message
+}
+
+// AccessDeniedError is returned when the user denies the authorization
+// request.
+type AccessDeniedError struct {
+	Message string
+}
+
+func (e AccessDeniedError) Error() string {
+	return e.Message
+}
+
+// AuthorizationCodeTimeoutError is returned when no authorization code is
+// received before the timeout.
+type AuthorizationCodeTimeoutError struct{}
+
+func (AuthorizationCodeTimeoutError) Error() string {
+	return "timed out waiting for the authorization code"
+}
@@ -0,0 +1,106 @@
+package uaa
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+const redirectCallbackPath = "/callback"
+
+// RedirectListener is a loopback HTTP server that receives the authorization
+// code redirect at the end of a browser based login.
+type RedirectListener struct {
+	listener net.Listener
+	server   *http.Server
+	state    string
+	results  chan redirectResult
+}
+
+type redirectResult struct {
+	code string
+	err  error
+}
+
+// NewRedirectListener starts listening on a random port on the loopback
+// interface. Only redirects carrying the given state are accepted.
+func NewRedirectListener(state string) (*RedirectListener, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+
+	redirectListener := &RedirectListener{
+		listener: listener,
+		state:    state,
+		results:  make(chan redirectResult, 1),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(redirectCallbackPath, redirectListener.handleCallback)
+	redirectListener.server = &http.Server{Handler: mux}
+
+	go redirectListener.server.Serve(listener)
+
+	return redirectListener, nil
+}
+
+// RedirectURI is the URI that UAA should redirect the browser to.
+func (l *RedirectListener) RedirectURI() string {
+	return fmt.Sprintf("http://%s%s", l.listener.Addr().String(), redirectCallbackPath)
+}
+
+// WaitForCode blocks until the browser is redirected back with an
+// authorization code, or until timeout elapses.
+func (l *RedirectListener) WaitForCode(timeout time.Duration) (string, error) {
+	select {
+	case result := <-l.results:
+		return result.code, result.err
+	case <-time.After(timeout):
+		return "", AuthorizationCodeTimeoutError{}
+	}
+}
+
+// Close stops the listener.
+func (l *RedirectListener) Close() error {
+	return l.server.Close()
+}
+
+func (l *RedirectListener) handleCallback(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query()
+
+	// Redirects that were not caused by this login, for example ones forged
+	// by another page open in the browser, are rejected without ending it.
+	if query.Get("state") != l.state {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, "Login failed. You may close this window and return to the terminal.")
+		return
+	}
+
+	var result redirectResult
+	switch errorType := query.Get("error"); errorType {
+	case "":
+		result = redirectResult{code: query.Get("code")}
+	case "access_denied":
+		result = redirectResult{err: AccessDeniedError{Message: query.Get("error_description")}}
+	default:
+		result = redirectResult{err: UAAErrorResponse{Type: errorType, Description: query.Get("error_description")}}
+	}
+
+	if result.err == nil && result.code == "" {
+		result.err = UAAErrorResponse{Type: "invalid_request", Description: "no authorization code was provided"}
+	}
+
+	if result.err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintln(w, "Login failed. You may close this window and return to the terminal.")
+	} else {
+		fmt.Fprintln(w, "Login successful. You may close this window and return to the terminal.")
+	}
+
+	select {
+	case l.results <- result:
+	default:
+	}
+}
@@ -0,0 +1,120 @@
+package uaa_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RedirectListener", func() {
+	var (
+		listener *RedirectListener
+
+		code       string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		listener, err = NewRedirectListener("some-state")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(listener.Close()).To(Succeed())
+	})
+
+	It("listens on the loopback interface", func() {
+		Expect(listener.RedirectURI()).To(MatchRegexp(`^http://127\.0\.0\.1:\d+/callback$`))
+	})
+
+	When("the browser is redirected with a code", func() {
+		var (
+			state    string
+			response *http.Response
+			body     []byte
+		)
+
+		BeforeEach(func() {
+			state = "some-state"
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(listener.RedirectURI() + "?code=some-code&state=" + state)
+			Expect(err).NotTo(HaveOccurred())
+			defer response.Body.Close()
+			body, err = ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		When("the state matches", func() {
+			It("returns the code", func() {
+				code, executeErr = listener.WaitForCode(time.Second)
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(code).To(Equal("some-code"))
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(string(body)).To(ContainSubstring("Login successful."))
+			})
+		})
+
+		When("the state does not match", func() {
+			BeforeEach(func() {
+				state = "other-state"
+			})
+
+			It("rejects the redirect and keeps waiting for a valid one", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(string(body)).To(ContainSubstring("Login failed."))
+
+				_, executeErr = listener.WaitForCode(time.Millisecond)
+				Expect(executeErr).To(MatchError(AuthorizationCodeTimeoutError{}))
+
+				validResponse, err := http.Get(listener.RedirectURI() + "?code=valid-code&state=some-state")
+				Expect(err).NotTo(HaveOccurred())
+				validResponse.Body.Close()
+
+				code, executeErr = listener.WaitForCode(time.Second)
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(code).To(Equal("valid-code"))
+			})
+		})
+	})
+
+	When("the browser is redirected without a code", func() {
+		It("returns an error", func() {
+			response, err := http.Get(listener.RedirectURI() + "?state=some-state")
+			Expect(err).NotTo(HaveOccurred())
+			response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+			_, executeErr = listener.WaitForCode(time.Second)
+			Expect(executeErr).To(MatchError(UAAErrorResponse{Type: "invalid_request", Description: "no authorization code was provided"}))
+		})
+	})
+
+	When("the user denies the authorization", func() {
+		BeforeEach(func() {
+			response, err := http.Get(listener.RedirectURI() + "?error=access_denied&error_description=User+denied+access&state=some-state")
+			Expect(err).NotTo(HaveOccurred())
+			response.Body.Close()
+			Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+		})
+
+		It("returns an AccessDeniedError", func() {
+			_, executeErr = listener.WaitForCode(time.Second)
+			Expect(executeErr).To(MatchError(AccessDeniedError{Message: "User denied access"}))
+		})
+	})
+
+	When("no redirect is received", func() {
+		It("returns an AuthorizationCodeTimeoutError", func() {
+			_, executeErr = listener.WaitForCode(time.Millisecond)
+			Expect(executeErr).To(MatchError(AuthorizationCodeTimeoutError{}))
+		})
+	})
+})
@@ -43,6 +43,7 @@ func (client *Client) RefreshAccessToken(refreshToken string) (RefreshedTokens,
 		RequestName: internal.PostOAuthTokenRequest,
 		Header:      http.Header{"Content-Type": {"application/x-www-form-urlencoded"}},
 		Body:        body,
+		Idempotent:  true,
 	})
 	if err != nil {
 		return RefreshedTokens{}, err
@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"net/url"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa/internal"
 )
 
@@ -28,6 +29,9 @@ type requestOptions struct {
 	URL string
 	// Body is the request body
 	Body io.Reader
+
+	// Idempotent marks a POST that is safe to retry.
+	Idempotent bool
 }
 
 // newRequest returns a constructed http.Request with some defaults. The
@@ -67,5 +71,9 @@ func (client *Client) newRequest(passedRequest requestOptions) (*http.Request, e
 	request.Header.Set("Connection", "close")
 	request.Header.Set("User-Agent", client.userAgent)
 
+	if passedRequest.Idempotent {
+		request = retry.MarkIdempotent(request)
+	}
+
 	return request, nil
 }
@@ -5,25 +5,27 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"code.cloudfoundry.org/cli/api/retry"
 	"code.cloudfoundry.org/cli/api/uaa"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests according to a
+// retry.Policy.
 type RetryRequest struct {
-	maxRetries int
+	policy     retry.Policy
 	connection uaa.Connection
 }
 
 // NewRetryRequest returns a pointer to a RetryRequest wrapper.
-func NewRetryRequest(maxRetries int) *RetryRequest {
+func NewRetryRequest(policy retry.Policy) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
-// Make retries the request if it comes back with a 5XX status code.
-func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
+// Make retries the request if it comes back with a retryable status code or
+// connection error, waiting between attempts as the policy dictates.
+func (retryRequest *RetryRequest) Make(request *http.Request, passedResponse *uaa.Response) error {
 	var err error
 	var rawRequestBody []byte
 
@@ -35,35 +37,26 @@ func (retry *RetryRequest) Make(request *http.Request, passedResponse *uaa.Respo
 		}
 	}
 
-	for i := 0; i < retry.maxRetries+1; i++ {
+	for attempt := 0; ; attempt++ {
 		if rawRequestBody != nil {
 			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 		}
-		err = retry.connection.Make(request, passedResponse)
+		err = retryRequest.connection.Make(request, passedResponse)
 		if err == nil {
 			return nil
 		}
 
-		if retry.skipRetry(request.Method, passedResponse.HTTPResponse) {
+		delay, ok := retryRequest.policy.NextDelay(attempt, request, passedResponse.HTTPResponse)
+		if !ok {
 			break
 		}
+		retryRequest.policy.Wait(delay)
 	}
 	return err
 }
 
 // Wrap sets the connection in the RetryRequest and returns itself.
-func (retry *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
-	retry.connection = innerconnection
-	return retry
-}
-
-// skipRetry will skip retry if the request method is POST or contains a status
-// code that is not one of following http status codes: 500, 502, 503, 504.
-func (*RetryRequest) skipRetry(httpMethod string, response *http.Response) bool {
-	return httpMethod == http.MethodPost ||
-		response != nil &&
-			response.StatusCode != http.StatusInternalServerError &&
-			response.StatusCode != http.StatusBadGateway &&
-			response.StatusCode != http.StatusServiceUnavailable &&
-			response.StatusCode != http.StatusGatewayTimeout
+func (retryRequest *RetryRequest) Wrap(innerconnection uaa.Connection) uaa.Connection {
+	retryRequest.connection = innerconnection
+	return retryRequest
 }
@@ -4,9 +4,11 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/api/uaa/constant"
 )
 
 //go:generate counterfeiter . UAAClient
@@ -100,14 +102,21 @@ func (t *UAAAuthentication) Wrap(innerconnection uaa.Connection) uaa.Connection
 	return t
 }
 
-// The authentication header is not added to token refresh requests or login
-// requests.
+// The authentication header is not added to token refresh requests, login
+// requests or device authorization requests.
 func skipAuthenticationHeader(request *http.Request, body []byte) bool {
 	stringBody := string(body)
 
+	if strings.Contains(request.URL.String(), "/oauth/device_authorize") &&
+		request.Method == http.MethodPost {
+		return true
+	}
+
 	return strings.Contains(request.URL.String(), "/oauth/token") &&
 		request.Method == http.MethodPost &&
 		(strings.Contains(stringBody, "grant_type=refresh_token") ||
 			strings.Contains(stringBody, "grant_type=password") ||
-			strings.Contains(stringBody, "grant_type=client_credentials"))
+			strings.Contains(stringBody, "grant_type=client_credentials") ||
+			strings.Contains(stringBody, "grant_type=authorization_code") ||
+			strings.Contains(stringBody, "grant_type="+url.QueryEscape(string(constant.GrantTypeDeviceCode))))
 }
@@ -28,12 +28,13 @@ func (cmd *ConfigCommands) MetaData() commandregistry.CommandMetadata {
 	fs["trace"] = &flags.StringFlag{Name: "trace", Usage: T("Trace HTTP requests")}
 	fs["color"] = &flags.StringFlag{Name: "color", Usage: T("Enable or disable color")}
 	fs["locale"] = &flags.StringFlag{Name: "locale", Usage: T("Set default locale. If LOCALE is 'CLEAR', previous locale is deleted.")}
+	fs["credential-store"] = &flags.StringFlag{Name: "credential-store", Usage: T("Store credentials in 'file' (config.json), 'encrypted-file', or the credential helper cf-credential-NAME")}
 
 	return commandregistry.CommandMetadata{
 		Name:        "config",
 		Description: T("Write default values to the config"),
 		Usage: []string{
-			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"),
+			T("CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (file | encrypted-file | NAME)]"),
 		},
 		Flags: fs,
 	}
@@ -51,7 +52,7 @@ func (cmd *ConfigCommands) SetDependency(deps commandregistry.Dependency, plugin
 }
 
 func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
-	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") {
+	if !context.IsSet("trace") && !context.IsSet("async-timeout") && !context.IsSet("color") && !context.IsSet("locale") && !context.IsSet("credential-store") {
 		return errors.New(T("Incorrect Usage") + "\n\n" + commandregistry.Commands.CommandUsage("config"))
 	}
 
@@ -80,6 +81,10 @@ func (cmd *ConfigCommands) Execute(context flags.FlagContext) error {
 		}
 	}
 
+	if context.IsSet("credential-store") {
+		cmd.config.SetCredentialStore(context.String("credential-store"))
+	}
+
 	if context.IsSet("locale") {
 		locale := context.String("locale")
 
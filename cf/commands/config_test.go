@@ -125,4 +125,11 @@ var _ = Describe("config command", func() {
 			})
 		})
 	})
+
+	Context("--credential-store flag", func() {
+		It("stores the credential store when --credential-store [name] is provided", func() {
+			runCommand("--credential-store", "encrypted-file")
+			Expect(configRepo.CredentialStore()).Should(Equal("encrypted-file"))
+		})
+	})
 })
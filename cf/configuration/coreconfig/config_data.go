@@ -2,8 +2,10 @@ package coreconfig
 
 import (
 	"encoding/json"
+	"os"
 
 	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/util/configv3"
 )
 
 type AuthPromptType string
@@ -25,6 +27,9 @@ type Data struct {
 	AuthorizationEndpoint    string
 	ColorEnabled             string
 	ConfigVersion            int
+	Contexts                 json.RawMessage `json:",omitempty"`
+	CredentialStore          string          `json:",omitempty"`
+	CurrentContext           string          `json:",omitempty"`
 	DopplerEndPoint          string
 	Locale                   string
 	MinCLIVersion            string
@@ -32,6 +37,7 @@ type Data struct {
 	OrganizationFields       models.OrganizationFields
 	PluginRepos              []models.PluginRepo
 	RefreshToken             string
+	RequestRetry             json.RawMessage `json:",omitempty"`
 	RoutingAPIEndpoint       string
 	SpaceFields              models.SpaceFields
 	SSHOAuthClient           string
@@ -55,7 +61,31 @@ func NewData() *Data {
 
 func (d *Data) JSONMarshalV3() ([]byte, error) {
 	d.ConfigVersion = 3
-	return json.MarshalIndent(d, "", "  ")
+
+	data := *d
+	if store := d.credentialStore(); store != nil {
+		credentials := configv3.Credentials{
+			AccessToken:          d.AccessToken,
+			RefreshToken:         d.RefreshToken,
+			UAAOAuthClientSecret: d.UAAOAuthClientSecret,
+		}
+
+		var err error
+		if credentials.IsEmpty() {
+			err = store.Erase(d.credentialKey())
+		} else {
+			err = store.Store(d.credentialKey(), credentials)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		data.AccessToken = ""
+		data.RefreshToken = ""
+		data.UAAOAuthClientSecret = ""
+	}
+
+	return json.MarshalIndent(data, "", "  ")
 }
 
 func (d *Data) JSONUnmarshalV3(input []byte) error {
@@ -69,5 +99,30 @@ func (d *Data) JSONUnmarshalV3(input []byte) error {
 		return nil
 	}
 
+	if store := d.credentialStore(); store != nil {
+		credentials, err := store.Get(d.credentialKey())
+		if err != nil {
+			return err
+		}
+
+		if !credentials.IsEmpty() {
+			d.AccessToken = credentials.AccessToken
+			d.RefreshToken = credentials.RefreshToken
+			d.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+		}
+	}
+
 	return nil
 }
+
+// credentialStore returns the store shared with the refactored commands, or
+// nil when credentials are kept in config.json.
+func (d *Data) credentialStore() configv3.CredentialStore {
+	return configv3.NewCredentialStore(d.CredentialStore, os.Getenv("CF_CREDENTIAL_PASSPHRASE"))
+}
+
+// credentialKey returns the key of the current context's credentials in the
+// credential store.
+func (d *Data) credentialKey() string {
+	return configv3.CredentialKey(d.CurrentContext, d.Target)
+}
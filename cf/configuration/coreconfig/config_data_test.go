@@ -1,6 +1,9 @@
 package coreconfig_test
 
 import (
+	"io/ioutil"
+	"os"
+
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/models"
 
@@ -217,4 +220,61 @@ var _ = Describe("V3 Config files", func() {
 			Expect(*actualData).To(Equal(coreconfig.Data{}))
 		})
 	})
+
+	It("keeps the contexts of the refactored commands", func() {
+		input := []byte(`{
+			"ConfigVersion": 3,
+			"Target": "api.one.com",
+			"CurrentContext": "one",
+			"Contexts": {"two": {"Target": "api.two.com"}}
+		}`)
+
+		data := coreconfig.NewData()
+		err := data.JSONUnmarshalV3(input)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data.CurrentContext).To(Equal("one"))
+
+		jsonData, err := data.JSONMarshalV3()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(jsonData)).To(ContainSubstring(`"CurrentContext": "one"`))
+		Expect(string(jsonData)).To(ContainSubstring("api.two.com"))
+	})
+
+	Describe("credential store", func() {
+		var homeDir string
+
+		BeforeEach(func() {
+			var err error
+			homeDir, err = ioutil.TempDir("", "coreconfig-credentials")
+			Expect(err).NotTo(HaveOccurred())
+			os.Setenv("CF_HOME", homeDir)
+			os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")
+		})
+
+		AfterEach(func() {
+			os.Unsetenv("CF_HOME")
+			os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")
+			os.RemoveAll(homeDir)
+		})
+
+		It("keeps the credentials out of the JSON and reads them back from the store", func() {
+			data := coreconfig.NewData()
+			data.Target = "api.example.com"
+			data.CredentialStore = "encrypted-file"
+			data.AccessToken = "the-access-token"
+			data.RefreshToken = "the-refresh-token"
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(jsonData)).NotTo(ContainSubstring("the-access-token"))
+			Expect(string(jsonData)).NotTo(ContainSubstring("the-refresh-token"))
+			Expect(data.AccessToken).To(Equal("the-access-token"))
+
+			actualData := coreconfig.NewData()
+			err = actualData.JSONUnmarshalV3(jsonData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(actualData.AccessToken).To(Equal("the-access-token"))
+			Expect(actualData.RefreshToken).To(Equal("the-refresh-token"))
+		})
+	})
 })
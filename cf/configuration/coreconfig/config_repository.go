@@ -94,6 +94,8 @@ type Reader interface {
 
 	Locale() string
 
+	CredentialStore() string
+
 	PluginRepos() []models.PluginRepo
 }
 
@@ -109,6 +111,7 @@ type ReadWriter interface {
 	SetAuthenticationEndpoint(string)
 	SetCLIVersion(string)
 	SetColorEnabled(string)
+	SetCredentialStore(string)
 	SetDopplerEndpoint(string)
 	SetLocale(string)
 	SetMinCLIVersion(string)
@@ -420,6 +423,13 @@ func (c *ConfigRepository) Locale() (locale string) {
 	return
 }
 
+func (c *ConfigRepository) CredentialStore() (name string) {
+	c.read(func() {
+		name = c.data.CredentialStore
+	})
+	return
+}
+
 func (c *ConfigRepository) PluginRepos() (repos []models.PluginRepo) {
 	c.read(func() {
 		repos = c.data.PluginRepos
@@ -558,6 +568,12 @@ func (c *ConfigRepository) SetLocale(locale string) {
 	})
 }
 
+func (c *ConfigRepository) SetCredentialStore(name string) {
+	c.write(func() {
+		c.data.CredentialStore = name
+	})
+}
+
 func (c *ConfigRepository) SetPluginRepo(repo models.PluginRepo) {
 	c.write(func() {
 		c.data.PluginRepos = append(c.data.PluginRepos, repo)
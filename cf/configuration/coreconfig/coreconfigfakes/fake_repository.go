@@ -284,6 +284,15 @@ type FakeRepository struct {
 	localeReturnsOnCall map[int]struct {
 		result1 string
 	}
+	CredentialStoreStub        func() string
+	credentialStoreMutex       sync.RWMutex
+	credentialStoreArgsForCall []struct{}
+	credentialStoreReturns     struct {
+		result1 string
+	}
+	credentialStoreReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PluginReposStub        func() []models.PluginRepo
 	pluginReposMutex       sync.RWMutex
 	pluginReposArgsForCall []struct{}
@@ -396,6 +405,11 @@ type FakeRepository struct {
 	setLocaleArgsForCall []struct {
 		arg1 string
 	}
+	SetCredentialStoreStub        func(string)
+	setCredentialStoreMutex       sync.RWMutex
+	setCredentialStoreArgsForCall []struct {
+		arg1 string
+	}
 	SetPluginRepoStub        func(models.PluginRepo)
 	setPluginRepoMutex       sync.RWMutex
 	setPluginRepoArgsForCall []struct {
@@ -1648,6 +1662,46 @@ func (fake *FakeRepository) LocaleReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeRepository) CredentialStore() string {
+	fake.credentialStoreMutex.Lock()
+	ret, specificReturn := fake.credentialStoreReturnsOnCall[len(fake.credentialStoreArgsForCall)]
+	fake.credentialStoreArgsForCall = append(fake.credentialStoreArgsForCall, struct{}{})
+	fake.recordInvocation("CredentialStore", []interface{}{})
+	fake.credentialStoreMutex.Unlock()
+	if fake.CredentialStoreStub != nil {
+		return fake.CredentialStoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.credentialStoreReturns.result1
+}
+
+func (fake *FakeRepository) CredentialStoreCallCount() int {
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
+	return len(fake.credentialStoreArgsForCall)
+}
+
+func (fake *FakeRepository) CredentialStoreReturns(result1 string) {
+	fake.CredentialStoreStub = nil
+	fake.credentialStoreReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeRepository) CredentialStoreReturnsOnCall(i int, result1 string) {
+	fake.CredentialStoreStub = nil
+	if fake.credentialStoreReturnsOnCall == nil {
+		fake.credentialStoreReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.credentialStoreReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeRepository) PluginRepos() []models.PluginRepo {
 	fake.pluginReposMutex.Lock()
 	ret, specificReturn := fake.pluginReposReturnsOnCall[len(fake.pluginReposArgsForCall)]
@@ -2184,6 +2238,30 @@ func (fake *FakeRepository) SetLocaleArgsForCall(i int) string {
 	return fake.setLocaleArgsForCall[i].arg1
 }
 
+func (fake *FakeRepository) SetCredentialStore(arg1 string) {
+	fake.setCredentialStoreMutex.Lock()
+	fake.setCredentialStoreArgsForCall = append(fake.setCredentialStoreArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("SetCredentialStore", []interface{}{arg1})
+	fake.setCredentialStoreMutex.Unlock()
+	if fake.SetCredentialStoreStub != nil {
+		fake.SetCredentialStoreStub(arg1)
+	}
+}
+
+func (fake *FakeRepository) SetCredentialStoreCallCount() int {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return len(fake.setCredentialStoreArgsForCall)
+}
+
+func (fake *FakeRepository) SetCredentialStoreArgsForCall(i int) string {
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
+	return fake.setCredentialStoreArgsForCall[i].arg1
+}
+
 func (fake *FakeRepository) SetPluginRepo(arg1 models.PluginRepo) {
 	fake.setPluginRepoMutex.Lock()
 	fake.setPluginRepoArgsForCall = append(fake.setPluginRepoArgsForCall, struct {
@@ -2399,6 +2477,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.colorEnabledMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
+	fake.credentialStoreMutex.RLock()
+	defer fake.credentialStoreMutex.RUnlock()
 	fake.pluginReposMutex.RLock()
 	defer fake.pluginReposMutex.RUnlock()
 	fake.clearSessionMutex.RLock()
@@ -2443,6 +2523,8 @@ func (fake *FakeRepository) Invocations() map[string][][]interface{} {
 	defer fake.setColorEnabledMutex.RUnlock()
 	fake.setLocaleMutex.RLock()
 	defer fake.setLocaleMutex.RUnlock()
+	fake.setCredentialStoreMutex.RLock()
+	defer fake.setCredentialStoreMutex.RUnlock()
 	fake.setPluginRepoMutex.RLock()
 	defer fake.setPluginRepoMutex.RUnlock()
 	fake.unSetPluginRepoMutex.RLock()
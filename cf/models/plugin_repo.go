@@ -3,4 +3,6 @@ package models
 type PluginRepo struct {
 	Name string
 	URL  string
+
+	TrustedKeys []string `json:",omitempty"`
 }
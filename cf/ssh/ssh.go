@@ -23,6 +23,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/ssh/options"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/util/clissh/socks"
 	"github.com/moby/moby/pkg/term"
 )
 
@@ -38,6 +39,8 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
 	Wait() error
 	Close() error
 }
@@ -54,6 +57,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -90,7 +94,8 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	localListeners  []net.Listener
+	remoteListeners []net.Listener
 }
 
 func NewSecureShell(
@@ -144,6 +149,9 @@ func (c *secureShell) Close() error {
 	for _, listener := range c.localListeners {
 		_ = listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		_ = listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -161,7 +169,44 @@ func (c *secureShell) LocalPortForward() error {
 	return nil
 }
 
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+func (c *secureShell) DynamicPortForward() error {
+	for _, address := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
 func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func acceptLoop(listener net.Listener, handle func(conn net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,7 +219,7 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -188,6 +233,36 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
+	copyBothWays(conn, target)
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, localAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", localAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", localAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
+func (c *secureShell) handleDynamicForwardConnection(conn net.Conn) {
+	defer conn.Close()
+
+	target, err := socks.Connect(conn, c.secureClient.Dial)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
+func copyBothWays(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -473,6 +548,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -1,62 +1,157 @@
-// This file was generated by counterfeiter
+// Code generated by counterfeiter. DO NOT EDIT.
 package sshfakes
 
 import (
-	"sync"
+	sync "sync"
 
-	"code.cloudfoundry.org/cli/cf/ssh"
-	"code.cloudfoundry.org/cli/cf/ssh/options"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	options "code.cloudfoundry.org/cli/cf/ssh/options"
 )
 
 type FakeSecureShell struct {
-	ConnectStub        func(opts *options.SSHOptions) error
+	CloseStub        func() error
+	closeMutex       sync.RWMutex
+	closeArgsForCall []struct {
+	}
+	closeReturns struct {
+		result1 error
+	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	ConnectStub        func(*options.SSHOptions) error
 	connectMutex       sync.RWMutex
 	connectArgsForCall []struct {
-		opts *options.SSHOptions
+		arg1 *options.SSHOptions
 	}
 	connectReturns struct {
 		result1 error
 	}
+	connectReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func() error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct {
+	}
+	dynamicPortForwardReturns struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func() error
 	interactiveSessionMutex       sync.RWMutex
-	interactiveSessionArgsForCall []struct{}
-	interactiveSessionReturns     struct {
+	interactiveSessionArgsForCall []struct {
+	}
+	interactiveSessionReturns struct {
+		result1 error
+	}
+	interactiveSessionReturnsOnCall map[int]struct {
 		result1 error
 	}
 	LocalPortForwardStub        func() error
 	localPortForwardMutex       sync.RWMutex
-	localPortForwardArgsForCall []struct{}
-	localPortForwardReturns     struct {
+	localPortForwardArgsForCall []struct {
+	}
+	localPortForwardReturns struct {
+		result1 error
+	}
+	localPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct {
+	}
+	remotePortForwardReturns struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
 		result1 error
 	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
-	waitArgsForCall []struct{}
-	waitReturns     struct {
+	waitArgsForCall []struct {
+	}
+	waitReturns struct {
 		result1 error
 	}
-	CloseStub        func() error
-	closeMutex       sync.RWMutex
-	closeArgsForCall []struct{}
-	closeReturns     struct {
+	waitReturnsOnCall map[int]struct {
 		result1 error
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeSecureShell) Connect(opts *options.SSHOptions) error {
+func (fake *FakeSecureShell) Close() error {
+	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
+	fake.closeArgsForCall = append(fake.closeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Close", []interface{}{})
+	fake.closeMutex.Unlock()
+	if fake.CloseStub != nil {
+		return fake.CloseStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.closeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShell) CloseCallCount() int {
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
+	return len(fake.closeArgsForCall)
+}
+
+func (fake *FakeSecureShell) CloseCalls(stub func() error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = stub
+}
+
+func (fake *FakeSecureShell) CloseReturns(result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	fake.closeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) CloseReturnsOnCall(i int, result1 error) {
+	fake.closeMutex.Lock()
+	defer fake.closeMutex.Unlock()
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) Connect(arg1 *options.SSHOptions) error {
 	fake.connectMutex.Lock()
+	ret, specificReturn := fake.connectReturnsOnCall[len(fake.connectArgsForCall)]
 	fake.connectArgsForCall = append(fake.connectArgsForCall, struct {
-		opts *options.SSHOptions
-	}{opts})
-	fake.recordInvocation("Connect", []interface{}{opts})
+		arg1 *options.SSHOptions
+	}{arg1})
+	fake.recordInvocation("Connect", []interface{}{arg1})
 	fake.connectMutex.Unlock()
 	if fake.ConnectStub != nil {
-		return fake.ConnectStub(opts)
-	} else {
-		return fake.connectReturns.result1
+		return fake.ConnectStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
 	}
+	fakeReturns := fake.connectReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeSecureShell) ConnectCallCount() int {
@@ -65,29 +160,109 @@ func (fake *FakeSecureShell) ConnectCallCount() int {
 	return len(fake.connectArgsForCall)
 }
 
+func (fake *FakeSecureShell) ConnectCalls(stub func(*options.SSHOptions) error) {
+	fake.connectMutex.Lock()
+	defer fake.connectMutex.Unlock()
+	fake.ConnectStub = stub
+}
+
 func (fake *FakeSecureShell) ConnectArgsForCall(i int) *options.SSHOptions {
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
-	return fake.connectArgsForCall[i].opts
+	argsForCall := fake.connectArgsForCall[i]
+	return argsForCall.arg1
 }
 
 func (fake *FakeSecureShell) ConnectReturns(result1 error) {
+	fake.connectMutex.Lock()
+	defer fake.connectMutex.Unlock()
 	fake.ConnectStub = nil
 	fake.connectReturns = struct {
 		result1 error
 	}{result1}
 }
 
+func (fake *FakeSecureShell) ConnectReturnsOnCall(i int, result1 error) {
+	fake.connectMutex.Lock()
+	defer fake.connectMutex.Unlock()
+	fake.ConnectStub = nil
+	if fake.connectReturnsOnCall == nil {
+		fake.connectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.connectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForward() error {
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DynamicPortForward", []interface{}{})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.dynamicPortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCalls(stub func() error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = stub
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturns(result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.dynamicPortForwardMutex.Lock()
+	defer fake.dynamicPortForwardMutex.Unlock()
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) InteractiveSession() error {
 	fake.interactiveSessionMutex.Lock()
-	fake.interactiveSessionArgsForCall = append(fake.interactiveSessionArgsForCall, struct{}{})
+	ret, specificReturn := fake.interactiveSessionReturnsOnCall[len(fake.interactiveSessionArgsForCall)]
+	fake.interactiveSessionArgsForCall = append(fake.interactiveSessionArgsForCall, struct {
+	}{})
 	fake.recordInvocation("InteractiveSession", []interface{}{})
 	fake.interactiveSessionMutex.Unlock()
 	if fake.InteractiveSessionStub != nil {
 		return fake.InteractiveSessionStub()
-	} else {
-		return fake.interactiveSessionReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.interactiveSessionReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeSecureShell) InteractiveSessionCallCount() int {
@@ -96,23 +271,50 @@ func (fake *FakeSecureShell) InteractiveSessionCallCount() int {
 	return len(fake.interactiveSessionArgsForCall)
 }
 
+func (fake *FakeSecureShell) InteractiveSessionCalls(stub func() error) {
+	fake.interactiveSessionMutex.Lock()
+	defer fake.interactiveSessionMutex.Unlock()
+	fake.InteractiveSessionStub = stub
+}
+
 func (fake *FakeSecureShell) InteractiveSessionReturns(result1 error) {
+	fake.interactiveSessionMutex.Lock()
+	defer fake.interactiveSessionMutex.Unlock()
 	fake.InteractiveSessionStub = nil
 	fake.interactiveSessionReturns = struct {
 		result1 error
 	}{result1}
 }
 
+func (fake *FakeSecureShell) InteractiveSessionReturnsOnCall(i int, result1 error) {
+	fake.interactiveSessionMutex.Lock()
+	defer fake.interactiveSessionMutex.Unlock()
+	fake.InteractiveSessionStub = nil
+	if fake.interactiveSessionReturnsOnCall == nil {
+		fake.interactiveSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.interactiveSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) LocalPortForward() error {
 	fake.localPortForwardMutex.Lock()
-	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct{}{})
+	ret, specificReturn := fake.localPortForwardReturnsOnCall[len(fake.localPortForwardArgsForCall)]
+	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct {
+	}{})
 	fake.recordInvocation("LocalPortForward", []interface{}{})
 	fake.localPortForwardMutex.Unlock()
 	if fake.LocalPortForwardStub != nil {
 		return fake.LocalPortForwardStub()
-	} else {
-		return fake.localPortForwardReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.localPortForwardReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeSecureShell) LocalPortForwardCallCount() int {
@@ -121,23 +323,102 @@ func (fake *FakeSecureShell) LocalPortForwardCallCount() int {
 	return len(fake.localPortForwardArgsForCall)
 }
 
+func (fake *FakeSecureShell) LocalPortForwardCalls(stub func() error) {
+	fake.localPortForwardMutex.Lock()
+	defer fake.localPortForwardMutex.Unlock()
+	fake.LocalPortForwardStub = stub
+}
+
 func (fake *FakeSecureShell) LocalPortForwardReturns(result1 error) {
+	fake.localPortForwardMutex.Lock()
+	defer fake.localPortForwardMutex.Unlock()
 	fake.LocalPortForwardStub = nil
 	fake.localPortForwardReturns = struct {
 		result1 error
 	}{result1}
 }
 
+func (fake *FakeSecureShell) LocalPortForwardReturnsOnCall(i int, result1 error) {
+	fake.localPortForwardMutex.Lock()
+	defer fake.localPortForwardMutex.Unlock()
+	fake.LocalPortForwardStub = nil
+	if fake.localPortForwardReturnsOnCall == nil {
+		fake.localPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.localPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.remotePortForwardReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCalls(stub func() error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = stub
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.remotePortForwardMutex.Lock()
+	defer fake.remotePortForwardMutex.Unlock()
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Wait() error {
 	fake.waitMutex.Lock()
-	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
+	fake.waitArgsForCall = append(fake.waitArgsForCall, struct {
+	}{})
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
 		return fake.WaitStub()
-	} else {
-		return fake.waitReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.waitReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeSecureShell) WaitCallCount() int {
@@ -146,34 +427,31 @@ func (fake *FakeSecureShell) WaitCallCount() int {
 	return len(fake.waitArgsForCall)
 }
 
+func (fake *FakeSecureShell) WaitCalls(stub func() error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
+	fake.WaitStub = stub
+}
+
 func (fake *FakeSecureShell) WaitReturns(result1 error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
 	fake.WaitStub = nil
 	fake.waitReturns = struct {
 		result1 error
 	}{result1}
 }
 
-func (fake *FakeSecureShell) Close() error {
-	fake.closeMutex.Lock()
-	fake.closeArgsForCall = append(fake.closeArgsForCall, struct{}{})
-	fake.recordInvocation("Close", []interface{}{})
-	fake.closeMutex.Unlock()
-	if fake.CloseStub != nil {
-		return fake.CloseStub()
-	} else {
-		return fake.closeReturns.result1
+func (fake *FakeSecureShell) WaitReturnsOnCall(i int, result1 error) {
+	fake.waitMutex.Lock()
+	defer fake.waitMutex.Unlock()
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
 	}
-}
-
-func (fake *FakeSecureShell) CloseCallCount() int {
-	fake.closeMutex.RLock()
-	defer fake.closeMutex.RUnlock()
-	return len(fake.closeArgsForCall)
-}
-
-func (fake *FakeSecureShell) CloseReturns(result1 error) {
-	fake.CloseStub = nil
-	fake.closeReturns = struct {
+	fake.waitReturnsOnCall[i] = struct {
 		result1 error
 	}{result1}
 }
@@ -181,17 +459,25 @@ func (fake *FakeSecureShell) CloseReturns(result1 error) {
 func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.closeMutex.RLock()
+	defer fake.closeMutex.RUnlock()
 	fake.connectMutex.RLock()
 	defer fake.connectMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
 	fake.interactiveSessionMutex.RLock()
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
-	fake.closeMutex.RLock()
-	defer fake.closeMutex.RUnlock()
-	return fake.invocations
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
 }
 
 func (fake *FakeSecureShell) recordInvocation(key string, args []interface{}) {
@@ -5,6 +5,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	. "code.cloudfoundry.org/cli/cf/i18n"
 )
@@ -20,6 +21,12 @@ func NewLogger(writer io.Writer, verbose bool, boolsOrPaths ...string) Printer {
 		b, err := strconv.ParseBool(path)
 		LoggingToStdout = LoggingToStdout || b
 
+		// HAR files are only written by the request loggers in util/ui; appending
+		// plain text to them would leave an invalid archive behind.
+		if strings.EqualFold(filepath.Ext(path), ".har") {
+			continue
+		}
+
 		if path != "" && err != nil {
 			var file *os.File
 			err = os.MkdirAll(filepath.Dir(path), os.ModeDir|os.ModePerm)
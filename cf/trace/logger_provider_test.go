@@ -171,6 +171,20 @@ var _ = Describe("NewLogger", func() {
 		})
 	})
 
+	It("returns a logger that does not write to CF_TRACE when it is a HAR file", func() {
+		tmpDir, err := ioutil.TempDir("", "trace_test")
+		Expect(err).ToNot(HaveOccurred())
+		defer os.RemoveAll(tmpDir)
+
+		harPath := path.Join(tmpDir, "trace.HAR")
+		logger := NewLogger(buffer, false, harPath, "")
+
+		logger.Print("Hello World")
+
+		Expect(buffer).NotTo(gbytes.Say("Hello World"))
+		Expect(harPath).ToNot(BeAnExistingFile())
+	})
+
 	It("returns a logger that writes to STDOUT when CF_TRACE is a path that cannot be opened", func() {
 		if runtime.GOOS != "windows" {
 			logger := NewLogger(buffer, false, "/dev/null/whoops", "")
@@ -35,11 +35,18 @@ type FakeConfig struct {
 	addPluginArgsForCall []struct {
 		arg1 configv3.Plugin
 	}
-	AddPluginRepositoryStub        func(string, string)
+	AddPluginRepositoryStub        func(string, string, []string)
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 []string
+	}
+	AddPluginRepositoryTrustedKeysStub        func(string, []string)
+	addPluginRepositoryTrustedKeysMutex       sync.RWMutex
+	addPluginRepositoryTrustedKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
 	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
@@ -91,6 +98,36 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextNamesStub        func() []string
+	contextNamesMutex       sync.RWMutex
+	contextNamesArgsForCall []struct {
+	}
+	contextNamesReturns struct {
+		result1 []string
+	}
+	contextNamesReturnsOnCall map[int]struct {
+		result1 []string
+	}
+	ContextsStub        func() map[string]configv3.TargetContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct {
+	}
+	contextsReturns struct {
+		result1 map[string]configv3.TargetContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 map[string]configv3.TargetContext
+	}
+	CurrentContextNameStub        func() string
+	currentContextNameMutex       sync.RWMutex
+	currentContextNameArgsForCall []struct {
+	}
+	currentContextNameReturns struct {
+		result1 string
+	}
+	currentContextNameReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct {
@@ -191,6 +228,16 @@ type FakeConfig struct {
 	hasTargetedSpaceReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	HonorGitIgnoreStub        func() bool
+	honorGitIgnoreMutex       sync.RWMutex
+	honorGitIgnoreArgsForCall []struct {
+	}
+	honorGitIgnoreReturns struct {
+		result1 bool
+	}
+	honorGitIgnoreReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	LocaleStub        func() string
 	localeMutex       sync.RWMutex
 	localeArgsForCall []struct {
@@ -221,6 +268,16 @@ type FakeConfig struct {
 	nOAARequestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	OutputFormatStub        func() configv3.OutputFormat
+	outputFormatMutex       sync.RWMutex
+	outputFormatArgsForCall []struct {
+	}
+	outputFormatReturns struct {
+		result1 configv3.OutputFormat
+	}
+	outputFormatReturnsOnCall map[int]struct {
+		result1 configv3.OutputFormat
+	}
 	OverallPollingTimeoutStub        func() time.Duration
 	overallPollingTimeoutMutex       sync.RWMutex
 	overallPollingTimeoutArgsForCall []struct {
@@ -286,6 +343,28 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	RenameContextStub        func(string, string) error
+	renameContextMutex       sync.RWMutex
+	renameContextArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	renameContextReturns struct {
+		result1 error
+	}
+	renameContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RequestRetryBaseDelayStub        func() time.Duration
+	requestRetryBaseDelayMutex       sync.RWMutex
+	requestRetryBaseDelayArgsForCall []struct {
+	}
+	requestRetryBaseDelayReturns struct {
+		result1 time.Duration
+	}
+	requestRetryBaseDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
 	RequestRetryCountStub        func() int
 	requestRetryCountMutex       sync.RWMutex
 	requestRetryCountArgsForCall []struct {
@@ -296,6 +375,26 @@ type FakeConfig struct {
 	requestRetryCountReturnsOnCall map[int]struct {
 		result1 int
 	}
+	RequestRetryMaxDelayStub        func() time.Duration
+	requestRetryMaxDelayMutex       sync.RWMutex
+	requestRetryMaxDelayArgsForCall []struct {
+	}
+	requestRetryMaxDelayReturns struct {
+		result1 time.Duration
+	}
+	requestRetryMaxDelayReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	ResourceCacheFilePathStub        func() string
+	resourceCacheFilePathMutex       sync.RWMutex
+	resourceCacheFilePathArgsForCall []struct {
+	}
+	resourceCacheFilePathReturns struct {
+		result1 string
+	}
+	resourceCacheFilePathReturnsOnCall map[int]struct {
+		result1 string
+	}
 	RoutingEndpointStub        func() string
 	routingEndpointMutex       sync.RWMutex
 	routingEndpointArgsForCall []struct {
@@ -495,6 +594,19 @@ type FakeConfig struct {
 	unsetUserInformationMutex       sync.RWMutex
 	unsetUserInformationArgsForCall []struct {
 	}
+	UseContextStub        func(string) (bool, error)
+	useContextMutex       sync.RWMutex
+	useContextArgsForCall []struct {
+		arg1 string
+	}
+	useContextReturns struct {
+		result1 bool
+		result2 error
+	}
+	useContextReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	VerboseStub        func() (bool, []string)
 	verboseMutex       sync.RWMutex
 	verboseArgsForCall []struct {
@@ -656,16 +768,22 @@ func (fake *FakeConfig) AddPluginArgsForCall(i int) configv3.Plugin {
 	return argsForCall.arg1
 }
 
-func (fake *FakeConfig) AddPluginRepository(arg1 string, arg2 string) {
+func (fake *FakeConfig) AddPluginRepository(arg1 string, arg2 string, arg3 []string) {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
 	fake.addPluginRepositoryMutex.Lock()
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2})
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2, arg3Copy})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		fake.AddPluginRepositoryStub(arg1, arg2)
+		fake.AddPluginRepositoryStub(arg1, arg2, arg3)
 	}
 }
 
@@ -675,16 +793,53 @@ func (fake *FakeConfig) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeConfig) AddPluginRepositoryCalls(stub func(string, string)) {
+func (fake *FakeConfig) AddPluginRepositoryCalls(stub func(string, string, []string)) {
 	fake.addPluginRepositoryMutex.Lock()
 	defer fake.addPluginRepositoryMutex.Unlock()
 	fake.AddPluginRepositoryStub = stub
 }
 
-func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeConfig) AddPluginRepositoryArgsForCall(i int) (string, string, []string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	argsForCall := fake.addPluginRepositoryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeys(arg1 string, arg2 []string) {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	fake.addPluginRepositoryTrustedKeysArgsForCall = append(fake.addPluginRepositoryTrustedKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryTrustedKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	if fake.AddPluginRepositoryTrustedKeysStub != nil {
+		fake.AddPluginRepositoryTrustedKeysStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysCallCount() int {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryTrustedKeysArgsForCall)
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysCalls(stub func(string, []string)) {
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	fake.AddPluginRepositoryTrustedKeysStub = stub
+}
+
+func (fake *FakeConfig) AddPluginRepositoryTrustedKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryTrustedKeysArgsForCall[i]
 	return argsForCall.arg1, argsForCall.arg2
 }
 
@@ -948,6 +1103,162 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) ContextNames() []string {
+	fake.contextNamesMutex.Lock()
+	ret, specificReturn := fake.contextNamesReturnsOnCall[len(fake.contextNamesArgsForCall)]
+	fake.contextNamesArgsForCall = append(fake.contextNamesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ContextNames", []interface{}{})
+	fake.contextNamesMutex.Unlock()
+	if fake.ContextNamesStub != nil {
+		return fake.ContextNamesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.contextNamesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ContextNamesCallCount() int {
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
+	return len(fake.contextNamesArgsForCall)
+}
+
+func (fake *FakeConfig) ContextNamesCalls(stub func() []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = stub
+}
+
+func (fake *FakeConfig) ContextNamesReturns(result1 []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = nil
+	fake.contextNamesReturns = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextNamesReturnsOnCall(i int, result1 []string) {
+	fake.contextNamesMutex.Lock()
+	defer fake.contextNamesMutex.Unlock()
+	fake.ContextNamesStub = nil
+	if fake.contextNamesReturnsOnCall == nil {
+		fake.contextNamesReturnsOnCall = make(map[int]struct {
+			result1 []string
+		})
+	}
+	fake.contextNamesReturnsOnCall[i] = struct {
+		result1 []string
+	}{result1}
+}
+
+func (fake *FakeConfig) Contexts() map[string]configv3.TargetContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if fake.ContextsStub != nil {
+		return fake.ContextsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.contextsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsCalls(stub func() map[string]configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = stub
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 map[string]configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 map[string]configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 map[string]configv3.TargetContext) {
+	fake.contextsMutex.Lock()
+	defer fake.contextsMutex.Unlock()
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 map[string]configv3.TargetContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 map[string]configv3.TargetContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextName() string {
+	fake.currentContextNameMutex.Lock()
+	ret, specificReturn := fake.currentContextNameReturnsOnCall[len(fake.currentContextNameArgsForCall)]
+	fake.currentContextNameArgsForCall = append(fake.currentContextNameArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentContextName", []interface{}{})
+	fake.currentContextNameMutex.Unlock()
+	if fake.CurrentContextNameStub != nil {
+		return fake.CurrentContextNameStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.currentContextNameReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextNameCallCount() int {
+	fake.currentContextNameMutex.RLock()
+	defer fake.currentContextNameMutex.RUnlock()
+	return len(fake.currentContextNameArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextNameCalls(stub func() string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = stub
+}
+
+func (fake *FakeConfig) CurrentContextNameReturns(result1 string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = nil
+	fake.currentContextNameReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextNameReturnsOnCall(i int, result1 string) {
+	fake.currentContextNameMutex.Lock()
+	defer fake.currentContextNameMutex.Unlock()
+	fake.CurrentContextNameStub = nil
+	if fake.currentContextNameReturnsOnCall == nil {
+		fake.currentContextNameReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextNameReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1444,6 +1755,58 @@ func (fake *FakeConfig) HasTargetedSpaceReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeConfig) HonorGitIgnore() bool {
+	fake.honorGitIgnoreMutex.Lock()
+	ret, specificReturn := fake.honorGitIgnoreReturnsOnCall[len(fake.honorGitIgnoreArgsForCall)]
+	fake.honorGitIgnoreArgsForCall = append(fake.honorGitIgnoreArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HonorGitIgnore", []interface{}{})
+	fake.honorGitIgnoreMutex.Unlock()
+	if fake.HonorGitIgnoreStub != nil {
+		return fake.HonorGitIgnoreStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.honorGitIgnoreReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) HonorGitIgnoreCallCount() int {
+	fake.honorGitIgnoreMutex.RLock()
+	defer fake.honorGitIgnoreMutex.RUnlock()
+	return len(fake.honorGitIgnoreArgsForCall)
+}
+
+func (fake *FakeConfig) HonorGitIgnoreCalls(stub func() bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = stub
+}
+
+func (fake *FakeConfig) HonorGitIgnoreReturns(result1 bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = nil
+	fake.honorGitIgnoreReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeConfig) HonorGitIgnoreReturnsOnCall(i int, result1 bool) {
+	fake.honorGitIgnoreMutex.Lock()
+	defer fake.honorGitIgnoreMutex.Unlock()
+	fake.HonorGitIgnoreStub = nil
+	if fake.honorGitIgnoreReturnsOnCall == nil {
+		fake.honorGitIgnoreReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.honorGitIgnoreReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeConfig) Locale() string {
 	fake.localeMutex.Lock()
 	ret, specificReturn := fake.localeReturnsOnCall[len(fake.localeArgsForCall)]
@@ -1600,6 +1963,58 @@ func (fake *FakeConfig) NOAARequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) OutputFormat() configv3.OutputFormat {
+	fake.outputFormatMutex.Lock()
+	ret, specificReturn := fake.outputFormatReturnsOnCall[len(fake.outputFormatArgsForCall)]
+	fake.outputFormatArgsForCall = append(fake.outputFormatArgsForCall, struct {
+	}{})
+	fake.recordInvocation("OutputFormat", []interface{}{})
+	fake.outputFormatMutex.Unlock()
+	if fake.OutputFormatStub != nil {
+		return fake.OutputFormatStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.outputFormatReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) OutputFormatCallCount() int {
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
+	return len(fake.outputFormatArgsForCall)
+}
+
+func (fake *FakeConfig) OutputFormatCalls(stub func() configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = stub
+}
+
+func (fake *FakeConfig) OutputFormatReturns(result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	fake.outputFormatReturns = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
+func (fake *FakeConfig) OutputFormatReturnsOnCall(i int, result1 configv3.OutputFormat) {
+	fake.outputFormatMutex.Lock()
+	defer fake.outputFormatMutex.Unlock()
+	fake.OutputFormatStub = nil
+	if fake.outputFormatReturnsOnCall == nil {
+		fake.outputFormatReturnsOnCall = make(map[int]struct {
+			result1 configv3.OutputFormat
+		})
+	}
+	fake.outputFormatReturnsOnCall[i] = struct {
+		result1 configv3.OutputFormat
+	}{result1}
+}
+
 func (fake *FakeConfig) OverallPollingTimeout() time.Duration {
 	fake.overallPollingTimeoutMutex.Lock()
 	ret, specificReturn := fake.overallPollingTimeoutReturnsOnCall[len(fake.overallPollingTimeoutArgsForCall)]
@@ -1943,6 +2358,119 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
+func (fake *FakeConfig) RenameContext(arg1 string, arg2 string) error {
+	fake.renameContextMutex.Lock()
+	ret, specificReturn := fake.renameContextReturnsOnCall[len(fake.renameContextArgsForCall)]
+	fake.renameContextArgsForCall = append(fake.renameContextArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("RenameContext", []interface{}{arg1, arg2})
+	fake.renameContextMutex.Unlock()
+	if fake.RenameContextStub != nil {
+		return fake.RenameContextStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.renameContextReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RenameContextCallCount() int {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	return len(fake.renameContextArgsForCall)
+}
+
+func (fake *FakeConfig) RenameContextCalls(stub func(string, string) error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = stub
+}
+
+func (fake *FakeConfig) RenameContextArgsForCall(i int) (string, string) {
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	argsForCall := fake.renameContextArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeConfig) RenameContextReturns(result1 error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = nil
+	fake.renameContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RenameContextReturnsOnCall(i int, result1 error) {
+	fake.renameContextMutex.Lock()
+	defer fake.renameContextMutex.Unlock()
+	fake.RenameContextStub = nil
+	if fake.renameContextReturnsOnCall == nil {
+		fake.renameContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.renameContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelay() time.Duration {
+	fake.requestRetryBaseDelayMutex.Lock()
+	ret, specificReturn := fake.requestRetryBaseDelayReturnsOnCall[len(fake.requestRetryBaseDelayArgsForCall)]
+	fake.requestRetryBaseDelayArgsForCall = append(fake.requestRetryBaseDelayArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryBaseDelay", []interface{}{})
+	fake.requestRetryBaseDelayMutex.Unlock()
+	if fake.RequestRetryBaseDelayStub != nil {
+		return fake.RequestRetryBaseDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryBaseDelayReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayCallCount() int {
+	fake.requestRetryBaseDelayMutex.RLock()
+	defer fake.requestRetryBaseDelayMutex.RUnlock()
+	return len(fake.requestRetryBaseDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayCalls(stub func() time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayReturns(result1 time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = nil
+	fake.requestRetryBaseDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryBaseDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.requestRetryBaseDelayMutex.Lock()
+	defer fake.requestRetryBaseDelayMutex.Unlock()
+	fake.RequestRetryBaseDelayStub = nil
+	if fake.requestRetryBaseDelayReturnsOnCall == nil {
+		fake.requestRetryBaseDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.requestRetryBaseDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
 func (fake *FakeConfig) RequestRetryCount() int {
 	fake.requestRetryCountMutex.Lock()
 	ret, specificReturn := fake.requestRetryCountReturnsOnCall[len(fake.requestRetryCountArgsForCall)]
@@ -1995,6 +2523,110 @@ func (fake *FakeConfig) RequestRetryCountReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeConfig) RequestRetryMaxDelay() time.Duration {
+	fake.requestRetryMaxDelayMutex.Lock()
+	ret, specificReturn := fake.requestRetryMaxDelayReturnsOnCall[len(fake.requestRetryMaxDelayArgsForCall)]
+	fake.requestRetryMaxDelayArgsForCall = append(fake.requestRetryMaxDelayArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RequestRetryMaxDelay", []interface{}{})
+	fake.requestRetryMaxDelayMutex.Unlock()
+	if fake.RequestRetryMaxDelayStub != nil {
+		return fake.RequestRetryMaxDelayStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.requestRetryMaxDelayReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayCallCount() int {
+	fake.requestRetryMaxDelayMutex.RLock()
+	defer fake.requestRetryMaxDelayMutex.RUnlock()
+	return len(fake.requestRetryMaxDelayArgsForCall)
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayCalls(stub func() time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = stub
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayReturns(result1 time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = nil
+	fake.requestRetryMaxDelayReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) RequestRetryMaxDelayReturnsOnCall(i int, result1 time.Duration) {
+	fake.requestRetryMaxDelayMutex.Lock()
+	defer fake.requestRetryMaxDelayMutex.Unlock()
+	fake.RequestRetryMaxDelayStub = nil
+	if fake.requestRetryMaxDelayReturnsOnCall == nil {
+		fake.requestRetryMaxDelayReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.requestRetryMaxDelayReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePath() string {
+	fake.resourceCacheFilePathMutex.Lock()
+	ret, specificReturn := fake.resourceCacheFilePathReturnsOnCall[len(fake.resourceCacheFilePathArgsForCall)]
+	fake.resourceCacheFilePathArgsForCall = append(fake.resourceCacheFilePathArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ResourceCacheFilePath", []interface{}{})
+	fake.resourceCacheFilePathMutex.Unlock()
+	if fake.ResourceCacheFilePathStub != nil {
+		return fake.ResourceCacheFilePathStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourceCacheFilePathReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCallCount() int {
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
+	return len(fake.resourceCacheFilePathArgsForCall)
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathCalls(stub func() string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = stub
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturns(result1 string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = nil
+	fake.resourceCacheFilePathReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResourceCacheFilePathReturnsOnCall(i int, result1 string) {
+	fake.resourceCacheFilePathMutex.Lock()
+	defer fake.resourceCacheFilePathMutex.Unlock()
+	fake.ResourceCacheFilePathStub = nil
+	if fake.resourceCacheFilePathReturnsOnCall == nil {
+		fake.resourceCacheFilePathReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.resourceCacheFilePathReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) RoutingEndpoint() string {
 	fake.routingEndpointMutex.Lock()
 	ret, specificReturn := fake.routingEndpointReturnsOnCall[len(fake.routingEndpointArgsForCall)]
@@ -3031,6 +3663,69 @@ func (fake *FakeConfig) UnsetUserInformationCalls(stub func()) {
 	fake.UnsetUserInformationStub = stub
 }
 
+func (fake *FakeConfig) UseContext(arg1 string) (bool, error) {
+	fake.useContextMutex.Lock()
+	ret, specificReturn := fake.useContextReturnsOnCall[len(fake.useContextArgsForCall)]
+	fake.useContextArgsForCall = append(fake.useContextArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UseContext", []interface{}{arg1})
+	fake.useContextMutex.Unlock()
+	if fake.UseContextStub != nil {
+		return fake.UseContextStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.useContextReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeConfig) UseContextCallCount() int {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return len(fake.useContextArgsForCall)
+}
+
+func (fake *FakeConfig) UseContextCalls(stub func(string) (bool, error)) {
+	fake.useContextMutex.Lock()
+	defer fake.useContextMutex.Unlock()
+	fake.UseContextStub = stub
+}
+
+func (fake *FakeConfig) UseContextArgsForCall(i int) string {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	argsForCall := fake.useContextArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeConfig) UseContextReturns(result1 bool, result2 error) {
+	fake.useContextMutex.Lock()
+	defer fake.useContextMutex.Unlock()
+	fake.UseContextStub = nil
+	fake.useContextReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeConfig) UseContextReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.useContextMutex.Lock()
+	defer fake.useContextMutex.Unlock()
+	fake.UseContextStub = nil
+	if fake.useContextReturnsOnCall == nil {
+		fake.useContextReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.useContextReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -3149,6 +3844,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
@@ -3159,6 +3856,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.cFUsernameMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextNamesMutex.RLock()
+	defer fake.contextNamesMutex.RUnlock()
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	fake.currentContextNameMutex.RLock()
+	defer fake.currentContextNameMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.currentUserNameMutex.RLock()
@@ -3177,12 +3880,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.hasTargetedOrganizationMutex.RUnlock()
 	fake.hasTargetedSpaceMutex.RLock()
 	defer fake.hasTargetedSpaceMutex.RUnlock()
+	fake.honorGitIgnoreMutex.RLock()
+	defer fake.honorGitIgnoreMutex.RUnlock()
 	fake.localeMutex.RLock()
 	defer fake.localeMutex.RUnlock()
 	fake.minCLIVersionMutex.RLock()
 	defer fake.minCLIVersionMutex.RUnlock()
 	fake.nOAARequestRetryCountMutex.RLock()
 	defer fake.nOAARequestRetryCountMutex.RUnlock()
+	fake.outputFormatMutex.RLock()
+	defer fake.outputFormatMutex.RUnlock()
 	fake.overallPollingTimeoutMutex.RLock()
 	defer fake.overallPollingTimeoutMutex.RUnlock()
 	fake.pluginHomeMutex.RLock()
@@ -3197,8 +3904,16 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.renameContextMutex.RLock()
+	defer fake.renameContextMutex.RUnlock()
+	fake.requestRetryBaseDelayMutex.RLock()
+	defer fake.requestRetryBaseDelayMutex.RUnlock()
 	fake.requestRetryCountMutex.RLock()
 	defer fake.requestRetryCountMutex.RUnlock()
+	fake.requestRetryMaxDelayMutex.RLock()
+	defer fake.requestRetryMaxDelayMutex.RUnlock()
+	fake.resourceCacheFilePathMutex.RLock()
+	defer fake.resourceCacheFilePathMutex.RUnlock()
 	fake.routingEndpointMutex.RLock()
 	defer fake.routingEndpointMutex.RUnlock()
 	fake.sSHOAuthClientMutex.RLock()
@@ -3249,6 +3964,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetSpaceInformationMutex.RUnlock()
 	fake.unsetUserInformationMutex.RLock()
 	defer fake.unsetUserInformationMutex.RUnlock()
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
@@ -17,7 +17,9 @@ type V2CommandList struct {
 }
 
 type commandList struct {
-	VerboseOrVersion bool `short:"v" long:"version" description:"verbose and version flag"`
+	VerboseOrVersion bool   `short:"v" long:"version" description:"verbose and version flag"`
+	Output           string `long:"output" description:"output format for command results" choice:"table" choice:"json" choice:"yaml"`
+	Context          string `long:"context" description:"run the command against a named context without switching to it"`
 
 	App                                v6.V3AppCommand                              `command:"app" description:"Display health and status for an app"`
 	V3Apps                             v6.V3AppsCommand                             `command:"v3-apps" description:"List all apps in the target space"`
@@ -58,6 +60,7 @@ type commandList struct {
 	Buildpacks                         v6.BuildpacksCommand                         `command:"buildpacks" description:"List all buildpacks"`
 	CheckRoute                         v6.CheckRouteCommand                         `command:"check-route" description:"Perform a simple check to determine whether a route currently exists or not"`
 	Config                             v6.ConfigCommand                             `command:"config" description:"Write default values to the config"`
+	Contexts                           v6.ContextsCommand                           `command:"contexts" description:"List all contexts"`
 	CopySource                         v6.CopySourceCommand                         `command:"copy-source" description:"Copies the source code of an application to another existing application (and restarts that application)"`
 	CreateAppManifest                  v6.CreateAppManifestCommand                  `command:"create-app-manifest" description:"Create an app manifest for an app that has been pushed successfully"`
 	CreateBuildpack                    v6.CreateBuildpackCommand                    `command:"create-buildpack" description:"Create a buildpack"`
@@ -106,12 +109,14 @@ type commandList struct {
 	EnableSSH                          v6.EnableSSHCommand                          `command:"enable-ssh" description:"Enable ssh for the application"`
 	Env                                v6.EnvCommand                                `command:"env" alias:"e" description:"Show all env variables for an app"`
 	Events                             v6.EventsCommand                             `command:"events" description:"Show recent app events"`
+	ExportSpace                        v6.ExportSpaceCommand                        `command:"export-space" description:"Create a manifest for every app in the targeted space, along with a list of its services and network policies"`
 	FeatureFlags                       v6.FeatureFlagsCommand                       `command:"feature-flags" description:"Retrieve list of feature flags with status"`
 	FeatureFlag                        v6.FeatureFlagCommand                        `command:"feature-flag" description:"Retrieve an individual feature flag with status"`
 	Files                              v6.FilesCommand                              `command:"files" alias:"f" description:"Print out a list of files in a directory or the contents of a specific file of an app running on the DEA backend"`
 	GetHealthCheck                     v6.GetHealthCheckCommand                     `command:"get-health-check" description:"Show the type of health check performed on an app"`
 	Help                               HelpCommand                                  `command:"help" alias:"h" description:"Show help"`
 	InstallPlugin                      InstallPluginCommand                         `command:"install-plugin" description:"Install CLI plugin"`
+	IgnoreCheck                        v6.IgnoreCheckCommand                        `command:"ignore-check" description:"Show which files in an app directory would be included in or excluded from a push, and why"`
 	IsolationSegments                  v6.IsolationSegmentsCommand                  `command:"isolation-segments" description:"List all isolation segments"`
 	NetworkPolicies                    v6.NetworkPoliciesCommand                    `command:"network-policies" description:"List direct network traffic policies"`
 	ListPluginRepos                    plugin.ListPluginReposCommand                `command:"list-plugin-repos" description:"List all the added plugin repositories"`
@@ -135,6 +140,7 @@ type commandList struct {
 	RemoveNetworkPolicy                v6.RemoveNetworkPolicyCommand                `command:"remove-network-policy" description:"Remove network traffic policy of an app"`
 	RemovePluginRepo                   plugin.RemovePluginRepoCommand               `command:"remove-plugin-repo" description:"Remove a plugin repository"`
 	RenameBuildpack                    v6.RenameBuildpackCommand                    `command:"rename-buildpack" description:"Rename a buildpack"`
+	RenameContext                      v6.RenameContextCommand                      `command:"rename-context" description:"Rename a context"`
 	RenameOrg                          v6.RenameOrgCommand                          `command:"rename-org" description:"Rename an org"`
 	RenameServiceBroker                v6.RenameServiceBrokerCommand                `command:"rename-service-broker" description:"Rename a service broker"`
 	RenameService                      v6.RenameServiceCommand                      `command:"rename-service" description:"Rename a service instance"`
@@ -205,6 +211,7 @@ type commandList struct {
 	UnsharePrivateDomain               v6.UnsharePrivateDomainCommand               `command:"unshare-private-domain" description:"Unshare a private domain with an org"`
 	UnshareService                     v6.UnshareServiceCommand                     `command:"unshare-service" description:"Unshare a shared service instance from a space"`
 	UpdateBuildpack                    v6.UpdateBuildpackCommand                    `command:"update-buildpack" description:"Update a buildpack"`
+	UpdatePlugin                       UpdatePluginCommand                          `command:"update-plugin" description:"Update installed CLI plugins to their latest versions"`
 	UpdateQuota                        v6.UpdateQuotaCommand                        `command:"update-quota" description:"Update an existing resource quota"`
 	UpdateSecurityGroup                v6.UpdateSecurityGroupCommand                `command:"update-security-group" description:"Update a security group"`
 	UpdateServiceAuthToken             v6.UpdateServiceAuthTokenCommand             `command:"update-service-auth-token" description:"Update a service auth token"`
@@ -212,6 +219,7 @@ type commandList struct {
 	UpdateService                      v6.UpdateServiceCommand                      `command:"update-service" description:"Update a service instance"`
 	UpdateSpaceQuota                   v6.UpdateSpaceQuotaCommand                   `command:"update-space-quota" description:"Update an existing space quota"`
 	UpdateUserProvidedService          v6.UpdateUserProvidedServiceCommand          `command:"update-user-provided-service" alias:"uups" description:"Update user-provided service instance"`
+	UseContext                         v6.UseContextCommand                         `command:"use-context" description:"Switch to a named context, creating it if needed"`
 	Version                            VersionCommand                               `command:"version" description:"Print the version"`
 }
 
@@ -144,6 +144,19 @@ type FakeInstallPluginActor struct {
 	validateFileChecksumReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	ValidateFileSignatureStub        func(string, string, configv3.PluginRepository) error
+	validateFileSignatureMutex       sync.RWMutex
+	validateFileSignatureArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 configv3.PluginRepository
+	}
+	validateFileSignatureReturns struct {
+		result1 error
+	}
+	validateFileSignatureReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -782,6 +795,68 @@ func (fake *FakeInstallPluginActor) ValidateFileChecksumReturnsOnCall(i int, res
 	}{result1}
 }
 
+func (fake *FakeInstallPluginActor) ValidateFileSignature(arg1 string, arg2 string, arg3 configv3.PluginRepository) error {
+	fake.validateFileSignatureMutex.Lock()
+	ret, specificReturn := fake.validateFileSignatureReturnsOnCall[len(fake.validateFileSignatureArgsForCall)]
+	fake.validateFileSignatureArgsForCall = append(fake.validateFileSignatureArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 configv3.PluginRepository
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("ValidateFileSignature", []interface{}{arg1, arg2, arg3})
+	fake.validateFileSignatureMutex.Unlock()
+	if fake.ValidateFileSignatureStub != nil {
+		return fake.ValidateFileSignatureStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.validateFileSignatureReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureCallCount() int {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	return len(fake.validateFileSignatureArgsForCall)
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureCalls(stub func(string, string, configv3.PluginRepository) error) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = stub
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureArgsForCall(i int) (string, string, configv3.PluginRepository) {
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
+	argsForCall := fake.validateFileSignatureArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturns(result1 error) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	fake.validateFileSignatureReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeInstallPluginActor) ValidateFileSignatureReturnsOnCall(i int, result1 error) {
+	fake.validateFileSignatureMutex.Lock()
+	defer fake.validateFileSignatureMutex.Unlock()
+	fake.ValidateFileSignatureStub = nil
+	if fake.validateFileSignatureReturnsOnCall == nil {
+		fake.validateFileSignatureReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.validateFileSignatureReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -805,6 +880,8 @@ func (fake *FakeInstallPluginActor) Invocations() map[string][][]interface{} {
 	defer fake.uninstallPluginMutex.RUnlock()
 	fake.validateFileChecksumMutex.RLock()
 	defer fake.validateFileChecksumMutex.RUnlock()
+	fake.validateFileSignatureMutex.RLock()
+	defer fake.validateFileSignatureMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
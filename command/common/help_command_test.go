@@ -422,6 +422,7 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say(`  --help, -h\s+Show help`))
 			Expect(testUI.Out).To(Say(`  -v\s+Print API request diagnostics to stdout`))
+			Expect(testUI.Out).To(Say(`  --output\s+Display command results as table, json or yaml`))
 
 			Expect(testUI.Out).To(Say(`Use 'cf help -a' to see all commands\.`))
 		})
@@ -612,12 +613,14 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("   CF_PLUGIN_HOME=path/to/dir/        Override path to default plugin config directory"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=true                      Print API request diagnostics to stdout"))
 				Expect(testUI.Out).To(Say("   CF_TRACE=path/to/trace.log         Append API request diagnostics to a log file"))
+				Expect(testUI.Out).To(Say(`   CF_TRACE=path/to/trace.har         Record API requests of newer commands in an HTTP Archive \(HAR\) file`))
 				Expect(testUI.Out).To(Say("   all_proxy=proxy.example.com:8080   Specify a proxy server to enable proxying for all requests"))
 				Expect(testUI.Out).To(Say("   https_proxy=proxy.example.com:8080 Enable proxying for HTTP requests"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --output                           Display command results as table, json or yaml"))
 				Expect(testUI.Out).To(Say(""))
 				Expect(testUI.Out).To(Say(`APPS \(experimental\):`))
 				Expect(testUI.Out).To(Say(`   v3-apps\s+List all apps in the target space`))
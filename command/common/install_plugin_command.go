@@ -32,6 +32,7 @@ type InstallPluginActor interface {
 	InstallPluginFromPath(path string, plugin configv3.Plugin) error
 	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
 	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, repository configv3.PluginRepository) error
 }
 
 const installConfirmationPrompt = "Do you want to install the plugin {{.Path}}?"
@@ -52,16 +53,17 @@ const (
 )
 
 type InstallPluginCommand struct {
-	OptionalArgs         flag.InstallPluginArgs `positional-args:"yes"`
-	SkipSSLValidation    bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
-	Force                bool                   `short:"f" description:"Force install of plugin without confirmation"`
-	RegisteredRepository string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
-	usage                interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
-	relatedCommands      interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
-	UI                   command.UI
-	Config               command.Config
-	Actor                InstallPluginActor
-	ProgressBar          plugin.ProxyReader
+	OptionalArgs            flag.InstallPluginArgs `positional-args:"yes"`
+	SkipSSLValidation       bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	Force                   bool                   `short:"f" description:"Force install of plugin without confirmation"`
+	RegisteredRepository    string                 `short:"r" description:"Restrict search for plugin to this registered repository"`
+	SkipSignatureValidation bool                   `long:"skip-signature-validation" description:"Install a plugin from a repository without verifying its signature. Not recommended!"`
+	usage                   interface{}            `usage:"CF_NAME install-plugin PLUGIN_NAME [-r REPO_NAME] [-f] [--skip-signature-validation]\n   CF_NAME install-plugin LOCAL-PATH/TO/PLUGIN | URL [-f]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME install-plugin ~/Downloads/plugin-foobar\n   CF_NAME install-plugin https://example.com/plugin-foobar_linux_amd64\n   CF_NAME install-plugin -r My-Repo plugin-echo"`
+	relatedCommands         interface{}            `related_commands:"add-plugin-repo, list-plugin-repos, plugins"`
+	UI                      command.UI
+	Config                  command.Config
+	Actor                   InstallPluginActor
+	ProgressBar             plugin.ProxyReader
 }
 
 func (cmd *InstallPluginCommand) Setup(config command.Config, ui command.UI) error {
@@ -341,20 +343,59 @@ func (cmd InstallPluginCommand) getPluginFromRepositories(pluginName string, rep
 		return "", 0, err
 	}
 
-	cmd.UI.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
-		"RepositoryName": repoList[0],
+	tempPath, err := downloadPluginFromRepository(cmd.Actor, cmd.UI, cmd.ProgressBar, pluginInfo, findRepository(repos, repoList[0]), tempPluginDir, cmd.SkipSignatureValidation)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return tempPath, PluginFromRepository, nil
+}
+
+// repositoryPluginDownloader downloads plugin binaries from plugin
+// repositories and verifies them.
+type repositoryPluginDownloader interface {
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, repository configv3.PluginRepository) error
+}
+
+// downloadPluginFromRepository downloads the plugin binary described by
+// pluginInfo from repository and verifies its checksum and signature.
+func downloadPluginFromRepository(actor repositoryPluginDownloader, ui command.UI, progressBar plugin.ProxyReader, pluginInfo pluginaction.PluginInfo, repository configv3.PluginRepository, tempPluginDir string, skipSignatureValidation bool) (string, error) {
+	ui.DisplayText("Starting download of plugin binary from repository {{.RepositoryName}}...", map[string]interface{}{
+		"RepositoryName": repository.Name,
 	})
 
-	tempPath, err := cmd.Actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, cmd.ProgressBar)
+	tempPath, err := actor.DownloadExecutableBinaryFromURL(pluginInfo.URL, tempPluginDir, progressBar)
 	if err != nil {
-		return "", 0, err
+		return "", err
 	}
 
-	if !cmd.Actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
-		return "", 0, translatableerror.InvalidChecksumError{}
+	if !actor.ValidateFileChecksum(tempPath, pluginInfo.Checksum) {
+		return "", translatableerror.InvalidChecksumError{}
 	}
 
-	return tempPath, PluginFromRepository, err
+	if skipSignatureValidation {
+		ui.DisplayWarning("Skipping signature validation of the plugin binary.")
+		return tempPath, nil
+	}
+
+	err = actor.ValidateFileSignature(tempPath, pluginInfo.Signature, repository)
+	if err != nil {
+		return "", err
+	}
+
+	return tempPath, nil
+}
+
+// findRepository returns the repository named repoName from repos.
+func findRepository(repos []configv3.PluginRepository, repoName string) configv3.PluginRepository {
+	for _, repo := range repos {
+		if repo.Name == repoName {
+			return repo
+		}
+	}
+	return configv3.PluginRepository{Name: repoName}
 }
 
 func (cmd InstallPluginCommand) installPluginPrompt(template string, templateValues ...map[string]interface{}) error {
@@ -462,6 +462,62 @@ var _ = Describe("install-plugin command", func() {
 									fakeActor.ValidateFileChecksumReturns(true)
 								})
 
+								When("the signature is invalid", func() {
+									BeforeEach(func() {
+										fakeActor.GetPluginRepositoryReturns(configv3.PluginRepository{Name: repoName, URL: repoURL, TrustedKeys: []string{"some-key"}}, nil)
+										fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{Name: pluginName, Version: downloadedVersionString, URL: pluginURL, Checksum: checksum, Signature: "some-signature"}, []string{repoName}, nil)
+										fakeActor.ValidateFileSignatureReturns(actionerror.InvalidPluginSignatureError{RepositoryName: repoName})
+									})
+
+									It("validates the signature against the repository's trusted keys and returns the error", func() {
+										Expect(executeErr).To(MatchError(actionerror.InvalidPluginSignatureError{RepositoryName: repoName}))
+
+										Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+										pathArg, signatureArg, repositoryArg := fakeActor.ValidateFileSignatureArgsForCall(0)
+										Expect(pathArg).To(Equal("some-path"))
+										Expect(signatureArg).To(Equal("some-signature"))
+										Expect(repositoryArg).To(Equal(configv3.PluginRepository{Name: repoName, URL: repoURL, TrustedKeys: []string{"some-key"}}))
+
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+										Expect(testUI.Out).ToNot(Say("Installing plugin"))
+									})
+
+									When("the --skip-signature-validation flag is given", func() {
+										BeforeEach(func() {
+											cmd.SkipSignatureValidation = true
+											fakeActor.CreateExecutableCopyReturns("copy-path", nil)
+											fakeActor.GetAndValidatePluginReturns(configv3.Plugin{
+												Name:    pluginName,
+												Version: configv3.PluginVersion{Major: 1, Minor: 2, Build: 3},
+											}, nil)
+										})
+
+										It("warns and installs the plugin without validating the signature", func() {
+											Expect(executeErr).ToNot(HaveOccurred())
+
+											Expect(testUI.Err).To(Say(`Skipping signature validation of the plugin binary\.`))
+											Expect(testUI.Out).To(Say(`Installing plugin %s\.\.\.`, pluginName))
+
+											Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(0))
+											Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+										})
+									})
+								})
+
+								When("the repository has no trusted keys", func() {
+									BeforeEach(func() {
+										fakeActor.ValidateFileSignatureReturns(actionerror.PluginRepositoryHasNoTrustedKeysError{RepositoryName: repoName})
+									})
+
+									It("returns a PluginRepositoryHasNoTrustedKeysError", func() {
+										Expect(executeErr).To(MatchError(actionerror.PluginRepositoryHasNoTrustedKeysError{RepositoryName: repoName}))
+
+										Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(1))
+										Expect(fakeActor.CreateExecutableCopyCallCount()).To(Equal(0))
+										Expect(testUI.Out).ToNot(Say("Installing plugin"))
+									})
+								})
+
 								When("creating an executable copy errors", func() {
 									BeforeEach(func() {
 										fakeActor.CreateExecutableCopyReturns("", errors.New("some-error"))
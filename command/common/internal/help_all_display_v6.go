@@ -8,6 +8,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "use-context", "rename-context"},
 		},
 	},
 	{
@@ -20,7 +21,7 @@ var HelpCategoryList = []HelpCategory{
 			{"events", "files", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
+			{"copy-source", "create-app-manifest", "ignore-check"},
 			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
 		},
 	},
@@ -46,7 +47,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
+			{"spaces", "space", "export-space"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
@@ -150,7 +151,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "update-plugin", "uninstall-plugin"},
 		},
 	},
 }
@@ -8,6 +8,7 @@ var HelpCategoryList = []HelpCategory{
 		CommandList: [][]string{
 			{"help", "version", "login", "logout", "passwd", "target"},
 			{"api", "auth"},
+			{"contexts", "use-context", "rename-context"},
 		},
 	},
 	{
@@ -16,12 +17,13 @@ var HelpCategoryList = []HelpCategory{
 			{"apps", "app"},
 			{"push", "scale", "delete", "rename"},
 			{"start", "stop", "restart", "restage", "restart-app-instance"},
+			{"revisions", "rollback"},
 			{"run-task", "tasks", "terminate-task"},
 			{"events", "logs"},
 			{"env", "set-env", "unset-env"},
 			{"stacks", "stack"},
-			{"copy-source", "create-app-manifest"},
-			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh"},
+			{"copy-source", "create-app-manifest", "ignore-check"},
+			{"get-health-check", "set-health-check", "enable-ssh", "disable-ssh", "ssh-enabled", "ssh", "scp"},
 		},
 	},
 	{
@@ -46,7 +48,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "SPACES:",
 		CommandList: [][]string{
-			{"spaces", "space"},
+			{"spaces", "space", "export-space"},
 			{"create-space", "delete-space", "rename-space"},
 			{"allow-space-ssh", "disallow-space-ssh", "space-ssh-allowed"},
 		},
@@ -70,6 +72,12 @@ var HelpCategoryList = []HelpCategory{
 			{"network-policies", "add-network-policy", "remove-network-policy"},
 		},
 	},
+	{
+		CategoryName: "METADATA:",
+		CommandList: [][]string{
+			{"labels", "set-label", "delete-label"},
+		},
+	},
 	{
 		CategoryName: "BUILDPACKS:",
 		CommandList: [][]string{
@@ -149,7 +157,7 @@ var HelpCategoryList = []HelpCategory{
 	{
 		CategoryName: "ADD/REMOVE PLUGIN:",
 		CommandList: [][]string{
-			{"plugins", "install-plugin", "uninstall-plugin"},
+			{"plugins", "install-plugin", "update-plugin", "uninstall-plugin"},
 		},
 	},
 }
@@ -0,0 +1,281 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"runtime"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/plugin/shared"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	log "github.com/sirupsen/logrus"
+)
+
+//go:generate counterfeiter . UpdatePluginActor
+
+type UpdatePluginActor interface {
+	BackupPluginBinary(plugin configv3.Plugin) (string, error)
+	CreateExecutableCopy(path string, tempPluginDir string) (string, error)
+	DownloadExecutableBinaryFromURL(url string, tempPluginDir string, proxyReader plugin.ProxyReader) (string, error)
+	GetAndValidatePlugin(metadata pluginaction.PluginMetadata, commands pluginaction.CommandList, path string) (configv3.Plugin, error)
+	GetOutdatedPlugins() ([]pluginaction.OutdatedPlugin, error)
+	GetPlatformString(runtimeGOOS string, runtimeGOARCH string) string
+	GetPluginInfoFromRepositoriesForPlatform(pluginName string, pluginRepos []configv3.PluginRepository, platform string) (pluginaction.PluginInfo, []string, error)
+	InstallPluginFromPath(path string, plugin configv3.Plugin) error
+	UninstallPlugin(uninstaller pluginaction.PluginUninstaller, name string) error
+	ValidateFileChecksum(path string, checksum string) bool
+	ValidateFileSignature(path string, signature string, repository configv3.PluginRepository) error
+}
+
+type UpdatePluginCommand struct {
+	OptionalArgs            flag.UpdatePluginArgs `positional-args:"yes"`
+	All                     bool                  `long:"all" description:"Update every installed plugin that has a newer version in the registered repositories"`
+	Force                   bool                  `short:"f" description:"Force update of plugins without confirmation"`
+	SkipSignatureValidation bool                  `long:"skip-signature-validation" description:"Update plugins without verifying their signatures. Not recommended!"`
+	SkipSSLValidation       bool                  `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	usage                   interface{}           `usage:"CF_NAME update-plugin PLUGIN_NAME [-f] [--skip-signature-validation]\n   CF_NAME update-plugin --all [-f] [--skip-signature-validation]\n\nWARNING:\n   Plugins are binaries written by potentially untrusted authors.\n   Install and use plugins at your own risk.\n\nEXAMPLES:\n   CF_NAME update-plugin my-plugin\n   CF_NAME update-plugin --all -f"`
+	relatedCommands         interface{}           `related_commands:"install-plugin, plugins, uninstall-plugin"`
+	UI                      command.UI
+	Config                  command.Config
+	Actor                   UpdatePluginActor
+	ProgressBar             plugin.ProxyReader
+}
+
+func (cmd *UpdatePluginCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Actor = pluginaction.NewActor(config, shared.NewClient(config, ui, cmd.SkipSSLValidation))
+
+	cmd.ProgressBar = shared.NewProgressBarProxyReader(cmd.UI.Writer())
+
+	return nil
+}
+
+func (cmd UpdatePluginCommand) Execute([]string) error {
+	pluginName := cmd.OptionalArgs.PluginName
+	switch {
+	case cmd.All && pluginName != "":
+		return translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}
+	case !cmd.All && pluginName == "":
+		return translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}
+	}
+
+	if pluginName != "" {
+		installedPlugin, installed := cmd.Config.GetPluginCaseInsensitive(pluginName)
+		if !installed {
+			return translatableerror.PluginNotFoundError{PluginName: pluginName}
+		}
+		pluginName = installedPlugin.Name
+	}
+
+	repos := cmd.Config.PluginRepositories()
+	if len(repos) == 0 {
+		return translatableerror.NoPluginRepositoriesError{}
+	}
+
+	outdatedPlugins, err := cmd.findOutdatedPlugins(repos, pluginName)
+	if err != nil {
+		return err
+	}
+
+	if len(outdatedPlugins) == 0 {
+		if pluginName != "" {
+			installedPlugin, _ := cmd.Config.GetPlugin(pluginName)
+			cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} is already up to date.", map[string]interface{}{
+				"PluginName":    installedPlugin.Name,
+				"PluginVersion": installedPlugin.Version.String(),
+			})
+		} else {
+			cmd.UI.DisplayText("All installed plugins are up to date.")
+		}
+		return nil
+	}
+
+	err = cmd.updatePluginsPrompt(outdatedPlugins)
+	if _, ok := err.(cancelInstall); ok {
+		cmd.UI.DisplayText("Plugin update cancelled.")
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	tempPluginDir, err := ioutil.TempDir(cmd.Config.PluginHome(), "temp")
+	log.WithField("tempPluginDir", tempPluginDir).Debug("making tempPluginDir dir")
+	defer os.RemoveAll(tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	rpcService, err := shared.NewRPCService(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+	log.Info("started RPC server")
+
+	// With --all, a plugin that fails to update does not stop the others from
+	// being updated; the failures are reported at the end.
+	var failedPluginNames []string
+	for _, outdatedPlugin := range outdatedPlugins {
+		err = cmd.updatePlugin(outdatedPlugin, repos, tempPluginDir, rpcService)
+		if err != nil {
+			if !cmd.All {
+				return err
+			}
+			cmd.UI.DisplayError(translatableerror.ConvertToTranslatableError(err))
+			cmd.UI.DisplayNewline()
+			failedPluginNames = append(failedPluginNames, outdatedPlugin.Name)
+		}
+	}
+
+	if len(failedPluginNames) > 0 {
+		return translatableerror.PluginsUpdateFailedError{PluginNames: failedPluginNames}
+	}
+
+	return nil
+}
+
+// findOutdatedPlugins returns the installed plugins with newer versions in
+// repos, restricted to pluginName when it is set.
+func (cmd UpdatePluginCommand) findOutdatedPlugins(repos []configv3.PluginRepository, pluginName string) ([]pluginaction.OutdatedPlugin, error) {
+	repoNames := make([]string, len(repos))
+	for i := range repos {
+		repoNames[i] = repos[i].Name
+	}
+	cmd.UI.DisplayTextWithFlavor("Searching {{.RepoNames}} for newer versions of installed plugins...",
+		map[string]interface{}{
+			"RepoNames": strings.Join(repoNames, ", "),
+		})
+
+	outdatedPlugins, err := cmd.Actor.GetOutdatedPlugins()
+	if err != nil {
+		return nil, err
+	}
+
+	if pluginName == "" {
+		return outdatedPlugins, nil
+	}
+
+	for _, outdatedPlugin := range outdatedPlugins {
+		if outdatedPlugin.Name == pluginName {
+			return []pluginaction.OutdatedPlugin{outdatedPlugin}, nil
+		}
+	}
+	return nil, nil
+}
+
+func (cmd UpdatePluginCommand) updatePluginsPrompt(outdatedPlugins []pluginaction.OutdatedPlugin) error {
+	table := [][]string{{"plugin", "version", "latest version"}}
+	for _, outdatedPlugin := range outdatedPlugins {
+		table = append(table, []string{outdatedPlugin.Name, outdatedPlugin.CurrentVersion, outdatedPlugin.LatestVersion})
+	}
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+
+	cmd.UI.DisplayHeader("Attention: Plugins are binaries written by potentially untrusted authors.")
+	cmd.UI.DisplayHeader("Install and use plugins at your own risk.")
+
+	if cmd.Force {
+		return nil
+	}
+
+	really, err := cmd.UI.DisplayBoolPrompt(false, "Do you want to update these plugins?")
+	if err != nil {
+		return err
+	}
+
+	if !really {
+		log.Debug("plugin update confirmation - 'no' inputed")
+		return cancelInstall{}
+	}
+
+	return nil
+}
+
+// updatePlugin replaces an installed plugin with the newest version found in
+// repos. The previous binary is kept in the plugin home so that the update can
+// be rolled back, and is reinstalled if installing the new version fails.
+func (cmd UpdatePluginCommand) updatePlugin(outdatedPlugin pluginaction.OutdatedPlugin, repos []configv3.PluginRepository, tempPluginDir string, rpcService *shared.RPCService) error {
+	installedPlugin, _ := cmd.Config.GetPlugin(outdatedPlugin.Name)
+
+	currentPlatform := cmd.Actor.GetPlatformString(runtime.GOOS, runtime.GOARCH)
+	pluginInfo, repoList, err := cmd.Actor.GetPluginInfoFromRepositoriesForPlatform(outdatedPlugin.Name, repos, currentPlatform)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Updating plugin {{.PluginName}} from {{.CurrentVersion}} to {{.LatestVersion}}...", map[string]interface{}{
+		"PluginName":     outdatedPlugin.Name,
+		"CurrentVersion": installedPlugin.Version.String(),
+		"LatestVersion":  pluginInfo.Version,
+	})
+
+	tempPath, err := downloadPluginFromRepository(cmd.Actor, cmd.UI, cmd.ProgressBar, pluginInfo, findRepository(repos, repoList[0]), tempPluginDir, cmd.SkipSignatureValidation)
+	if err != nil {
+		return err
+	}
+
+	executablePath, err := cmd.Actor.CreateExecutableCopy(tempPath, tempPluginDir)
+	if err != nil {
+		return err
+	}
+
+	newPlugin, err := cmd.Actor.GetAndValidatePlugin(rpcService, Commands, executablePath)
+	if err != nil {
+		return err
+	}
+	log.Info("validated plugin")
+
+	backupPath, err := cmd.Actor.BackupPluginBinary(installedPlugin)
+	if err != nil {
+		return err
+	}
+	log.WithField("backupPath", backupPath).Debug("backed up plugin binary")
+
+	err = cmd.Actor.UninstallPlugin(rpcService, installedPlugin.Name)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.Actor.InstallPluginFromPath(executablePath, newPlugin)
+	if err != nil {
+		restoreErr := cmd.Actor.InstallPluginFromPath(backupPath, installedPlugin)
+		if restoreErr != nil {
+			log.WithField("restoreErr", restoreErr).Error("restoring plugin from backup")
+			cmd.UI.DisplayWarning("Could not restore plugin {{.PluginName}} {{.PluginVersion}}: {{.Error}}", map[string]interface{}{
+				"PluginName":    installedPlugin.Name,
+				"PluginVersion": installedPlugin.Version.String(),
+				"Error":         restoreErr.Error(),
+			})
+			cmd.UI.DisplayWarning("The previous version was saved to {{.BackupPath}}. To reinstall it, run '{{.BinaryName}} install-plugin {{.BackupPath}} -f'.", map[string]interface{}{
+				"BackupPath": backupPath,
+				"BinaryName": cmd.Config.BinaryName(),
+			})
+		} else {
+			cmd.UI.DisplayWarning("Restored plugin {{.PluginName}} {{.PluginVersion}}.", map[string]interface{}{
+				"PluginName":    installedPlugin.Name,
+				"PluginVersion": installedPlugin.Version.String(),
+			})
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Plugin {{.PluginName}} {{.PluginVersion}} successfully updated.", map[string]interface{}{
+		"PluginName":    newPlugin.Name,
+		"PluginVersion": newPlugin.Version.String(),
+	})
+	cmd.UI.DisplayText("The previous version was saved to {{.BackupPath}}. To roll back, run '{{.BinaryName}} install-plugin {{.BackupPath}} -f'.", map[string]interface{}{
+		"BackupPath": backupPath,
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+	cmd.UI.DisplayNewline()
+
+	return nil
+}
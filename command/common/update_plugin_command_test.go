@@ -0,0 +1,385 @@
+package common_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/pluginaction"
+	"code.cloudfoundry.org/cli/api/plugin/pluginfakes"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/common"
+	"code.cloudfoundry.org/cli/command/common/commonfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("update-plugin command", func() {
+	var (
+		cmd             UpdatePluginCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeActor       *commonfakes.FakeUpdatePluginActor
+		fakeProgressBar *pluginfakes.FakeProxyReader
+		executeErr      error
+		pluginHome      string
+		installedPlugin configv3.Plugin
+		repository      configv3.PluginRepository
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeActor = new(commonfakes.FakeUpdatePluginActor)
+		fakeProgressBar = new(pluginfakes.FakeProxyReader)
+
+		cmd = UpdatePluginCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		var err error
+		pluginHome, err = ioutil.TempDir("", "some-pluginhome")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.PluginHomeReturns(pluginHome)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		installedPlugin = configv3.Plugin{
+			Name:     "some-plugin",
+			Version:  configv3.PluginVersion{Major: 1, Minor: 0, Build: 0},
+			Location: "some-location",
+		}
+		fakeConfig.GetPluginCaseInsensitiveReturns(installedPlugin, true)
+		fakeConfig.GetPluginReturns(installedPlugin, true)
+
+		repository = configv3.PluginRepository{Name: "some-repo", URL: "some-url", TrustedKeys: []string{"some-key"}}
+		fakeConfig.PluginRepositoriesReturns([]configv3.PluginRepository{repository})
+
+		fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+			{Name: "some-plugin", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+		}, nil)
+		fakeActor.GetPluginInfoFromRepositoriesForPlatformReturns(pluginaction.PluginInfo{
+			Name:      "some-plugin",
+			Version:   "2.0.0",
+			URL:       "some-plugin-url",
+			Checksum:  "some-checksum",
+			Signature: "some-signature",
+		}, []string{"some-repo"}, nil)
+		fakeActor.DownloadExecutableBinaryFromURLReturns("some-download-path", nil)
+		fakeActor.ValidateFileChecksumReturns(true)
+		fakeActor.CreateExecutableCopyReturns("some-executable-path", nil)
+		fakeActor.GetAndValidatePluginReturns(configv3.Plugin{
+			Name:    "some-plugin",
+			Version: configv3.PluginVersion{Major: 2, Minor: 0, Build: 0},
+		}, nil)
+		fakeActor.BackupPluginBinaryReturns("some-backup-path", nil)
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(pluginHome)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither a plugin name nor --all is provided", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{ArgumentName: "PLUGIN_NAME"}))
+		})
+	})
+
+	When("both a plugin name and --all are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "some-plugin"
+			cmd.All = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"PLUGIN_NAME", "--all"}}))
+		})
+	})
+
+	When("the plugin is not installed", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "some-other-plugin"
+			fakeConfig.GetPluginCaseInsensitiveReturns(configv3.Plugin{}, false)
+		})
+
+		It("returns a PluginNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.PluginNotFoundError{PluginName: "some-other-plugin"}))
+			Expect(fakeActor.GetOutdatedPluginsCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no plugin repositories", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			fakeConfig.PluginRepositoriesReturns(nil)
+		})
+
+		It("returns a NoPluginRepositoriesError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.NoPluginRepositoriesError{}))
+		})
+	})
+
+	When("getting the outdated plugins fails", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			fakeActor.GetOutdatedPluginsReturns(nil, actionerror.GettingPluginRepositoryError{Name: "some-repo", Message: "some-error"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.GettingPluginRepositoryError{Name: "some-repo", Message: "some-error"}))
+		})
+	})
+
+	When("the plugin is up to date", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "SOME-PLUGIN"
+			fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+				{Name: "some-other-plugin", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+			}, nil)
+		})
+
+		It("displays that the plugin is up to date", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Searching some-repo for newer versions of installed plugins\.\.\.`))
+			Expect(testUI.Out).To(Say(`Plugin some-plugin 1\.0\.0 is already up to date\.`))
+			Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+		})
+	})
+
+	When("--all is provided and every plugin is up to date", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			fakeActor.GetOutdatedPluginsReturns(nil, nil)
+		})
+
+		It("displays that all plugins are up to date", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`All installed plugins are up to date\.`))
+		})
+	})
+
+	When("the plugin is outdated", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.PluginName = "some-plugin"
+		})
+
+		When("the user declines the update", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the update", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`plugin\s+version\s+latest version`))
+				Expect(testUI.Out).To(Say(`some-plugin\s+1\.0\.0\s+2\.0\.0`))
+				Expect(testUI.Out).To(Say(`Do you want to update these plugins\? \[yN\]`))
+				Expect(testUI.Out).To(Say(`Plugin update cancelled\.`))
+				Expect(fakeActor.DownloadExecutableBinaryFromURLCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the user confirms the update", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("downloads, verifies and installs the new version, keeping a backup of the previous one", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`Updating plugin some-plugin from 1\.0\.0 to 2\.0\.0\.\.\.`))
+				Expect(testUI.Out).To(Say(`Starting download of plugin binary from repository some-repo\.\.\.`))
+				Expect(testUI.Out).To(Say("OK"))
+				Expect(testUI.Out).To(Say(`Plugin some-plugin 2\.0\.0 successfully updated\.`))
+				Expect(testUI.Out).To(Say(`The previous version was saved to some-backup-path\. To roll back, run 'faceman install-plugin some-backup-path -f'\.`))
+
+				pluginName, repos, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+				Expect(pluginName).To(Equal("some-plugin"))
+				Expect(repos).To(Equal([]configv3.PluginRepository{repository}))
+
+				url, _, proxyReader := fakeActor.DownloadExecutableBinaryFromURLArgsForCall(0)
+				Expect(url).To(Equal("some-plugin-url"))
+				Expect(proxyReader).To(Equal(fakeProgressBar))
+
+				path, checksum := fakeActor.ValidateFileChecksumArgsForCall(0)
+				Expect(path).To(Equal("some-download-path"))
+				Expect(checksum).To(Equal("some-checksum"))
+
+				path, signature, signatureRepository := fakeActor.ValidateFileSignatureArgsForCall(0)
+				Expect(path).To(Equal("some-download-path"))
+				Expect(signature).To(Equal("some-signature"))
+				Expect(signatureRepository).To(Equal(repository))
+
+				_, commandList, path := fakeActor.GetAndValidatePluginArgsForCall(0)
+				Expect(commandList).To(Equal(Commands))
+				Expect(path).To(Equal("some-executable-path"))
+
+				Expect(fakeActor.BackupPluginBinaryArgsForCall(0)).To(Equal(installedPlugin))
+
+				_, uninstalledName := fakeActor.UninstallPluginArgsForCall(0)
+				Expect(uninstalledName).To(Equal("some-plugin"))
+
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+				installPath, newPlugin := fakeActor.InstallPluginFromPathArgsForCall(0)
+				Expect(installPath).To(Equal("some-executable-path"))
+				Expect(newPlugin.Version).To(Equal(configv3.PluginVersion{Major: 2, Minor: 0, Build: 0}))
+			})
+		})
+
+		When("-f is provided", func() {
+			BeforeEach(func() {
+				cmd.Force = true
+			})
+
+			It("does not prompt", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Do you want to update these plugins"))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+
+			When("the checksum does not match", func() {
+				BeforeEach(func() {
+					fakeActor.ValidateFileChecksumReturns(false)
+				})
+
+				It("returns an InvalidChecksumError and keeps the installed plugin", func() {
+					Expect(executeErr).To(MatchError(translatableerror.InvalidChecksumError{}))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+			})
+
+			When("the signature is invalid", func() {
+				BeforeEach(func() {
+					fakeActor.ValidateFileSignatureReturns(actionerror.InvalidPluginSignatureError{RepositoryName: "some-repo"})
+				})
+
+				It("returns the error and keeps the installed plugin", func() {
+					Expect(executeErr).To(MatchError(actionerror.InvalidPluginSignatureError{RepositoryName: "some-repo"}))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+
+				When("--skip-signature-validation is provided", func() {
+					BeforeEach(func() {
+						cmd.SkipSignatureValidation = true
+					})
+
+					It("warns and updates the plugin", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Err).To(Say(`Skipping signature validation of the plugin binary\.`))
+						Expect(fakeActor.ValidateFileSignatureCallCount()).To(Equal(0))
+						Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			When("the new version's commands conflict", func() {
+				BeforeEach(func() {
+					fakeActor.GetAndValidatePluginReturns(configv3.Plugin{}, actionerror.PluginCommandsConflictError{
+						PluginName:    "some-plugin",
+						PluginVersion: "2.0.0",
+						CommandNames:  []string{"push"},
+					})
+				})
+
+				It("returns the error and keeps the installed plugin", func() {
+					Expect(executeErr).To(MatchError(actionerror.PluginCommandsConflictError{
+						PluginName:    "some-plugin",
+						PluginVersion: "2.0.0",
+						CommandNames:  []string{"push"},
+					}))
+					Expect(fakeActor.BackupPluginBinaryCallCount()).To(Equal(0))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+			})
+
+			When("backing up the installed plugin fails", func() {
+				BeforeEach(func() {
+					fakeActor.BackupPluginBinaryReturns("", errors.New("some-backup-error"))
+				})
+
+				It("returns the error and keeps the installed plugin", func() {
+					Expect(executeErr).To(MatchError("some-backup-error"))
+					Expect(fakeActor.UninstallPluginCallCount()).To(Equal(0))
+				})
+			})
+
+			When("installing the new version fails", func() {
+				BeforeEach(func() {
+					fakeActor.InstallPluginFromPathReturnsOnCall(0, errors.New("some-install-error"))
+				})
+
+				It("reinstalls the previous version and returns the error", func() {
+					Expect(executeErr).To(MatchError("some-install-error"))
+
+					Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+					restorePath, restoredPlugin := fakeActor.InstallPluginFromPathArgsForCall(1)
+					Expect(restorePath).To(Equal("some-backup-path"))
+					Expect(restoredPlugin).To(Equal(installedPlugin))
+					Expect(testUI.Err).To(Say(`Restored plugin some-plugin 1\.0\.0\.`))
+				})
+
+				When("reinstalling the previous version fails as well", func() {
+					BeforeEach(func() {
+						fakeActor.InstallPluginFromPathReturnsOnCall(1, errors.New("some-restore-error"))
+					})
+
+					It("tells the user how to reinstall the previous version and returns the error", func() {
+						Expect(executeErr).To(MatchError("some-install-error"))
+
+						Expect(testUI.Err).To(Say(`Could not restore plugin some-plugin 1\.0\.0: some-restore-error`))
+						Expect(testUI.Err).To(Say(`The previous version was saved to some-backup-path\. To reinstall it, run 'faceman install-plugin some-backup-path -f'\.`))
+						Expect(testUI.Err).ToNot(Say("Restored plugin"))
+					})
+				})
+			})
+		})
+	})
+
+	When("--all is provided and several plugins are outdated", func() {
+		BeforeEach(func() {
+			cmd.All = true
+			cmd.Force = true
+			fakeActor.GetOutdatedPluginsReturns([]pluginaction.OutdatedPlugin{
+				{Name: "some-plugin", CurrentVersion: "1.0.0", LatestVersion: "2.0.0"},
+				{Name: "some-other-plugin", CurrentVersion: "0.1.0", LatestVersion: "0.2.0"},
+			}, nil)
+		})
+
+		It("updates each of them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+			firstName, _, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(0)
+			secondName, _, _ := fakeActor.GetPluginInfoFromRepositoriesForPlatformArgsForCall(1)
+			Expect([]string{firstName, secondName}).To(Equal([]string{"some-plugin", "some-other-plugin"}))
+			Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(2))
+		})
+
+		When("updating one of them fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetPluginInfoFromRepositoriesForPlatformReturnsOnCall(0, pluginaction.PluginInfo{}, nil, actionerror.NoCompatibleBinaryError{})
+			})
+
+			It("updates the others and reports the failure at the end", func() {
+				Expect(executeErr).To(MatchError(translatableerror.PluginsUpdateFailedError{PluginNames: []string{"some-plugin"}}))
+
+				Expect(testUI.Err).To(Say(`Plugin requested has no binary available for your platform\.`))
+				Expect(fakeActor.GetPluginInfoFromRepositoriesForPlatformCallCount()).To(Equal(2))
+				Expect(fakeActor.InstallPluginFromPathCallCount()).To(Equal(1))
+			})
+		})
+	})
+})
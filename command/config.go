@@ -12,13 +12,17 @@ import (
 type Config interface {
 	AccessToken() string
 	AddPlugin(configv3.Plugin)
-	AddPluginRepository(name string, url string)
+	AddPluginRepository(name string, url string, trustedKeys []string)
+	AddPluginRepositoryTrustedKeys(name string, trustedKeys []string)
 	APIVersion() string
 	BinaryName() string
 	BinaryVersion() string
 	CFPassword() string
 	CFUsername() string
 	ColorEnabled() configv3.ColorSetting
+	ContextNames() []string
+	Contexts() map[string]configv3.TargetContext
+	CurrentContextName() string
 	CurrentUser() (configv3.User, error)
 	CurrentUserName() (string, error)
 	DialTimeout() time.Duration
@@ -28,9 +32,11 @@ type Config interface {
 	GetPluginCaseInsensitive(pluginName string) (configv3.Plugin, bool)
 	HasTargetedOrganization() bool
 	HasTargetedSpace() bool
+	HonorGitIgnore() bool
 	Locale() string
 	MinCLIVersion() string
 	NOAARequestRetryCount() int
+	OutputFormat() configv3.OutputFormat
 	OverallPollingTimeout() time.Duration
 	PluginHome() string
 	PluginRepositories() []configv3.PluginRepository
@@ -38,7 +44,11 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	RenameContext(oldName string, newName string) error
+	RequestRetryBaseDelay() time.Duration
 	RequestRetryCount() int
+	RequestRetryMaxDelay() time.Duration
+	ResourceCacheFilePath() string
 	RoutingEndpoint() string
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
@@ -64,6 +74,7 @@ type Config interface {
 	UnsetOrganizationAndSpaceInformation()
 	UnsetSpaceInformation()
 	UnsetUserInformation()
+	UseContext(name string) (bool, error)
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 }
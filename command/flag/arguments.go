@@ -4,6 +4,10 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names"`
+}
+
 type OptionalAppName struct {
 	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
 }
@@ -146,6 +150,10 @@ type FilesArgs struct {
 	Path    string `positional-arg-name:"PATH" description:"The file path"`
 }
 
+type AppDirectory struct {
+	Path PathWithExistenceCheck `positional-arg-name:"PATH" required:"true" description:"The path to the app directory"`
+}
+
 type EnvironmentArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
@@ -228,6 +236,15 @@ type RenameBuildpackArgs struct {
 	NewBuildpackName string `positional-arg-name:"NEW_BUILDPACK_NAME" required:"true" description:"The new buildpack name"`
 }
 
+type RenameContextArgs struct {
+	OldContextName string `positional-arg-name:"CONTEXT" required:"true" description:"The old context name"`
+	NewContextName string `positional-arg-name:"NEW_CONTEXT" required:"true" description:"The new context name"`
+}
+
+type ContextName struct {
+	Context string `positional-arg-name:"CONTEXT" required:"true" description:"The context name"`
+}
+
 type SetOrgRoleArgs struct {
 	Username     string  `positional-arg-name:"USERNAME" required:"true" description:"The user"`
 	Organization string  `positional-arg-name:"ORG" required:"true" description:"The organization"`
@@ -286,6 +303,10 @@ type InstallPluginArgs struct {
 	PluginNameOrLocation Path `positional-arg-name:"PLUGIN_NAME_OR_LOCATION" required:"true" description:"The local path to the plugin, if the plugin exists locally; the URL to the plugin, if the plugin exists online; or the plugin name, if a repo is specified"`
 }
 
+type UpdatePluginArgs struct {
+	PluginName string `positional-arg-name:"PLUGIN_NAME" description:"The plugin name"`
+}
+
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
@@ -325,3 +346,25 @@ type AddNetworkPolicyArgs struct {
 type RemoveNetworkPolicyArgs struct {
 	SourceApp string
 }
+
+type LabelsArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource (app, org or space)"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+}
+
+type SetLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource (app, org or space)"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	Labels       []string      `positional-arg-name:"KEY=VALUE" required:"1" description:"A space-separated list of labels to set on the resource"`
+}
+
+type DeleteLabelArgs struct {
+	ResourceType LabelResource `positional-arg-name:"RESOURCE" required:"true" description:"The type of resource (app, org or space)"`
+	ResourceName string        `positional-arg-name:"RESOURCE_NAME" required:"true" description:"The name of the resource"`
+	LabelKeys    []string      `positional-arg-name:"KEY" required:"1" description:"A space-separated list of label keys to remove from the resource"`
+}
+
+type SecureCopyArgs struct {
+	Source      string `positional-arg-name:"SOURCE" required:"true" description:"The file or directory to copy, as APP_NAME:PATH when it is on an app instance"`
+	Destination string `positional-arg-name:"DESTINATION" required:"true" description:"Where to copy to, as APP_NAME:PATH when it is on an app instance"`
+}
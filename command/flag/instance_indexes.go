@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"strconv"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndexes is a comma separated list of app instance indexes.
+type InstanceIndexes struct {
+	Indexes []uint
+}
+
+func (i *InstanceIndexes) UnmarshalFlag(rawValue string) error {
+	var indexes []uint
+	for _, rawIndex := range strings.Split(rawValue, ",") {
+		index, err := strconv.ParseUint(strings.TrimSpace(rawIndex), 10, 32)
+		if err != nil {
+			return &flags.Error{
+				Type:    flags.ErrMarshal,
+				Message: `Value must be a comma separated list of instance indexes, e.g. 0,2,3.`,
+			}
+		}
+		indexes = append(indexes, uint(index))
+	}
+
+	i.Indexes = indexes
+	return nil
+}
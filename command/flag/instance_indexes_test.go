@@ -0,0 +1,37 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("InstanceIndexes", func() {
+	var instanceIndexes InstanceIndexes
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			instanceIndexes = InstanceIndexes{}
+		})
+
+		When("passed a comma separated list of indexes", func() {
+			It("sets the indexes", func() {
+				err := instanceIndexes.UnmarshalFlag("0, 2,5")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(instanceIndexes.Indexes).To(Equal([]uint{0, 2, 5}))
+			})
+		})
+
+		When("passed something that is not an index", func() {
+			It("returns an error", func() {
+				err := instanceIndexes.UnmarshalFlag("0,-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be a comma separated list of instance indexes, e.g. 0,2,3.`,
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LabelResource struct {
+	Type string
+}
+
+func (LabelResource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"app", "org", "space"}, prefix, false)
+}
+
+func (l *LabelResource) UnmarshalFlag(val string) error {
+	switch strings.ToLower(val) {
+	case "app", "org", "space":
+		l.Type = strings.ToLower(val)
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `RESOURCE must be "app", "org" or "space"`,
+		}
+	}
+
+	return nil
+}
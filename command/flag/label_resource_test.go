@@ -0,0 +1,56 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LabelResource", func() {
+	var labelResource LabelResource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := labelResource.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("completes to 'app' when passed 'a'", "a",
+				[]flags.Completion{{Item: "app"}}),
+			Entry("completes to 'space' when passed 'S'", "S",
+				[]flags.Completion{{Item: "space"}}),
+			Entry("returns all resources when passed nothing", "",
+				[]flags.Completion{{Item: "app"}, {Item: "org"}, {Item: "space"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			labelResource = LabelResource{}
+		})
+
+		DescribeTable("accepts the supported resources regardless of case",
+			func(val string, expectedType string) {
+				err := labelResource.UnmarshalFlag(val)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(labelResource).To(Equal(LabelResource{Type: expectedType}))
+			},
+			Entry("app", "App", "app"),
+			Entry("org", "org", "org"),
+			Entry("space", "SPACE", "space"),
+		)
+
+		It("errors on anything else", func() {
+			err := labelResource.UnmarshalFlag("stack")
+			Expect(err).To(MatchError(&flags.Error{
+				Type:    flags.ErrRequired,
+				Message: `RESOURCE must be "app", "org" or "space"`,
+			}))
+			Expect(labelResource.Type).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,31 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// LogTime is a point in time given either as an RFC3339 timestamp or as a
+// duration before now, such as 30m or 2h.
+type LogTime struct {
+	time.Time
+}
+
+func (logTime *LogTime) UnmarshalFlag(rawValue string) error {
+	if duration, err := time.ParseDuration(rawValue); err == nil && duration >= 0 {
+		logTime.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	parsedTime, err := time.Parse(time.RFC3339, rawValue)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be a duration such as 30m or 2h, or an RFC3339 time such as 2006-01-02T15:04:05Z.`,
+		}
+	}
+
+	logTime.Time = parsedTime
+	return nil
+}
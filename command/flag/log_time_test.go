@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("LogTime", func() {
+	var logTime LogTime
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			logTime = LogTime{}
+		})
+
+		When("passed a duration", func() {
+			It("sets the time that long before now", func() {
+				err := logTime.UnmarshalFlag("2h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(BeTemporally("~", time.Now().Add(-2*time.Hour), time.Minute))
+			})
+		})
+
+		When("passed an RFC3339 time", func() {
+			It("sets the time", func() {
+				err := logTime.UnmarshalFlag("2019-06-01T10:30:00Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(logTime.Time).To(Equal(time.Date(2019, 6, 1, 10, 30, 0, 0, time.UTC)))
+			})
+		})
+
+		When("passed anything else", func() {
+			It("returns an error", func() {
+				err := logTime.UnmarshalFlag("yesterday")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be a duration such as 30m or 2h, or an RFC3339 time such as 2006-01-02T15:04:05Z.`,
+				}))
+			})
+		})
+	})
+})
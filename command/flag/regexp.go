@@ -0,0 +1,25 @@
+package flag
+
+import (
+	"regexp"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Regexp is a compiled regular expression.
+type Regexp struct {
+	*regexp.Regexp
+}
+
+func (re *Regexp) UnmarshalFlag(rawValue string) error {
+	compiled, err := regexp.Compile(rawValue)
+	if err != nil {
+		return &flags.Error{
+			Type:    flags.ErrMarshal,
+			Message: `Value must be a valid regular expression.`,
+		}
+	}
+
+	re.Regexp = compiled
+	return nil
+}
@@ -0,0 +1,37 @@
+package flag_test
+
+import (
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+)
+
+var _ = Describe("Regexp", func() {
+	var re Regexp
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			re = Regexp{}
+		})
+
+		When("passed a valid regular expression", func() {
+			It("compiles it", func() {
+				err := re.UnmarshalFlag(`GET /\w+`)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(re.MatchString("GET /healthz")).To(BeTrue())
+			})
+		})
+
+		When("passed an invalid regular expression", func() {
+			It("returns an error", func() {
+				err := re.UnmarshalFlag("(unclosed")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrMarshal,
+					Message: `Value must be a valid regular expression.`,
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,34 @@
+package flag
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// SSHDynamicPortForwarding is a [BIND_ADDRESS:]PORT specification for a local
+// SOCKS proxy.
+type SSHDynamicPortForwarding struct {
+	LocalAddress string
+}
+
+func (s *SSHDynamicPortForwarding) UnmarshalFlag(val string) error {
+	splitHosts := strings.Split(val, ":")
+
+	re := regexp.MustCompile(`^\d+$`)
+	switch {
+	case len(splitHosts) == 1 && re.MatchString(splitHosts[0]):
+		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
+	case len(splitHosts) == 2 && len(splitHosts[0]) > 0 && re.MatchString(splitHosts[1]):
+		s.LocalAddress = val
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", val),
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package flag_test
+
+import (
+	"fmt"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSHDynamicPortForwarding", func() {
+	var forward SSHDynamicPortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHDynamicPortForwarding{}
+		})
+
+		When("passed a port", func() {
+			It("listens on localhost", func() {
+				err := forward.UnmarshalFlag("1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "localhost:1080"}))
+			})
+		})
+
+		When("passed bind_address:port", func() {
+			It("listens on the bind address", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:1080")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHDynamicPortForwarding{LocalAddress: "0.0.0.0:1080"}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad dynamic forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("not a port", "socks"),
+			Entry("empty bind address", ":1080"),
+			Entry("too many colons", "localhost:1080:8080"),
+		)
+	})
+})
@@ -16,12 +16,25 @@ type SSHPortForwarding struct {
 }
 
 func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
+	localAddress, remoteAddress, err := parseForwardingSpecification(val, "local")
+	if err != nil {
+		return err
+	}
+
+	s.LocalAddress = localAddress
+	s.RemoteAddress = remoteAddress
+	return nil
+}
+
+// parseForwardingSpecification parses a [BIND_ADDRESS:]PORT:HOST:HOST_PORT
+// specification into the address to listen on and the address to connect to.
+func parseForwardingSpecification(val string, kind string) (string, string, error) {
 	splitHosts := strings.Split(val, ":")
 	for _, piece := range splitHosts {
 		if len(piece) == 0 {
-			return &flags.Error{
+			return "", "", &flags.Error{
 				Type:    flags.ErrRequired,
-				Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+				Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
 			}
 		}
 	}
@@ -29,17 +42,13 @@ func (s *SSHPortForwarding) UnmarshalFlag(val string) error {
 	re := regexp.MustCompile(`^\d+$`)
 	switch {
 	case len(splitHosts) == 3 && re.MatchString(splitHosts[0]) && re.MatchString(splitHosts[2]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2])
+		return fmt.Sprintf("%s:%s", DefaultLocalAddress, splitHosts[0]), fmt.Sprintf("%s:%s", splitHosts[1], splitHosts[2]), nil
 	case len(splitHosts) == 4 && re.MatchString(splitHosts[1]) && re.MatchString(splitHosts[3]):
-		s.LocalAddress = fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1])
-		s.RemoteAddress = fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3])
+		return fmt.Sprintf("%s:%s", splitHosts[0], splitHosts[1]), fmt.Sprintf("%s:%s", splitHosts[2], splitHosts[3]), nil
 	default:
-		return &flags.Error{
+		return "", "", &flags.Error{
 			Type:    flags.ErrRequired,
-			Message: fmt.Sprintf("Bad local forwarding specification '%s'", val),
+			Message: fmt.Sprintf("Bad %s forwarding specification '%s'", kind, val),
 		}
 	}
-
-	return nil
 }
@@ -0,0 +1,20 @@
+package flag
+
+// SSHRemotePortForwarding is a [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT
+// specification. The app instance listens on RemoteAddress and connections to
+// it are forwarded to LocalAddress.
+type SSHRemotePortForwarding struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
+func (s *SSHRemotePortForwarding) UnmarshalFlag(val string) error {
+	remoteAddress, localAddress, err := parseForwardingSpecification(val, "remote")
+	if err != nil {
+		return err
+	}
+
+	s.RemoteAddress = remoteAddress
+	s.LocalAddress = localAddress
+	return nil
+}
@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	"fmt"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SSHRemotePortForwarding", func() {
+	var forward SSHRemotePortForwarding
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			forward = SSHRemotePortForwarding{}
+		})
+
+		When("passed remote_port:local:local_port", func() {
+			It("listens on localhost on the remote side", func() {
+				err := forward.UnmarshalFlag("9229:localhost:9230")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "localhost:9229",
+					LocalAddress:  "localhost:9230",
+				}))
+			})
+		})
+
+		When("passed remote:remote_port:local:local_port", func() {
+			It("extracts the remote and local addresses", func() {
+				err := forward.UnmarshalFlag("0.0.0.0:9229:debugger:9230")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(forward).To(Equal(SSHRemotePortForwarding{
+					RemoteAddress: "0.0.0.0:9229",
+					LocalAddress:  "debugger:9230",
+				}))
+			})
+		})
+
+		DescribeTable("error cases",
+			func(input string) {
+				err := forward.UnmarshalFlag(input)
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: fmt.Sprintf("Bad remote forwarding specification '%s'", input),
+				}))
+			},
+
+			Entry("no colons", "9229"),
+			Entry("empty values in between colons", "9229::9230"),
+			Entry("incorrect port numbers", "9229:localhost:debugger"),
+		)
+	})
+})
@@ -11,14 +11,16 @@ import (
 //go:generate counterfeiter . AddPluginRepoActor
 
 type AddPluginRepoActor interface {
-	AddPluginRepository(repoName string, repoURL string) error
+	AddPluginRepository(repoName string, repoURL string, trustedKeys []string) error
+	AddPluginRepositoryTrustedKeys(repoName string, trustedKeys []string) error
 }
 
 type AddPluginRepoCommand struct {
 	RequiredArgs      flag.AddPluginRepoArgs `positional-args:"yes"`
-	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo"`
+	usage             interface{}            `usage:"CF_NAME add-plugin-repo REPO_NAME URL [--trusted-key KEY]...\n\nTIP:\n   Plugins can only be installed from repositories with trusted keys, unless --skip-signature-validation is used. Run add-plugin-repo again with the same name and URL to add trusted keys to a registered repository.\n\nEXAMPLES:\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo\n   CF_NAME add-plugin-repo ExampleRepo https://example.com/repo --trusted-key 11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo="`
 	relatedCommands   interface{}            `related_commands:"install-plugin, list-plugin-repos"`
 	SkipSSLValidation bool                   `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
+	TrustedKeys       []string               `long:"trusted-key" description:"Base64 encoded ed25519 public key that plugins installed from this repository must be signed with (can be specified multiple times)"`
 	UI                command.UI
 	Config            command.Config
 	Actor             AddPluginRepoActor
@@ -32,9 +34,22 @@ func (cmd *AddPluginRepoCommand) Setup(config command.Config, ui command.UI) err
 }
 
 func (cmd AddPluginRepoCommand) Execute(args []string) error {
-	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL)
+	err := cmd.Actor.AddPluginRepository(cmd.RequiredArgs.PluginRepoName, cmd.RequiredArgs.PluginRepoURL, cmd.TrustedKeys)
 	switch e := err.(type) {
 	case actionerror.RepositoryAlreadyExistsError:
+		if len(cmd.TrustedKeys) > 0 {
+			err = cmd.Actor.AddPluginRepositoryTrustedKeys(e.Name, cmd.TrustedKeys)
+			if err != nil {
+				return err
+			}
+
+			cmd.UI.DisplayTextWithFlavor("Trusted keys added to {{.RepositoryName}}",
+				map[string]interface{}{
+					"RepositoryName": e.Name,
+				})
+			return nil
+		}
+
 		cmd.UI.DisplayTextWithFlavor("{{.RepositoryURL}} already registered as {{.RepositoryName}}",
 			map[string]interface{}{
 				"RepositoryName": e.Name,
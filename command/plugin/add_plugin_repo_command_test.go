@@ -56,9 +56,38 @@ var _ = Describe("add-plugin-repo command", func() {
 			Expect(testUI.Out).To(Say("https://some-repo-URL already registered as some-repo"))
 
 			Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
-			repoName, repoURL := fakeActor.AddPluginRepositoryArgsForCall(0)
+			repoName, repoURL, _ := fakeActor.AddPluginRepositoryArgsForCall(0)
 			Expect(repoName).To(Equal("some-repo"))
 			Expect(repoURL).To(Equal("some-repo-URL"))
+
+			Expect(fakeActor.AddPluginRepositoryTrustedKeysCallCount()).To(Equal(0))
+		})
+
+		When("trusted keys are provided", func() {
+			BeforeEach(func() {
+				cmd.TrustedKeys = []string{"some-key"}
+			})
+
+			It("adds the trusted keys to the registered repo", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Trusted keys added to some-repo"))
+
+				Expect(fakeActor.AddPluginRepositoryTrustedKeysCallCount()).To(Equal(1))
+				repoName, trustedKeys := fakeActor.AddPluginRepositoryTrustedKeysArgsForCall(0)
+				Expect(repoName).To(Equal("some-repo"))
+				Expect(trustedKeys).To(Equal([]string{"some-key"}))
+			})
+
+			When("adding the trusted keys fails", func() {
+				BeforeEach(func() {
+					fakeActor.AddPluginRepositoryTrustedKeysReturns(actionerror.AddPluginRepositoryError{Name: "some-repo", URL: "https://some-repo-URL", Message: "bad key"})
+				})
+
+				It("returns the error", func() {
+					Expect(executeErr).To(MatchError(actionerror.AddPluginRepositoryError{Name: "some-repo", URL: "https://some-repo-URL", Message: "bad key"}))
+				})
+			})
 		})
 	})
 
@@ -78,6 +107,7 @@ var _ = Describe("add-plugin-repo command", func() {
 		BeforeEach(func() {
 			cmd.RequiredArgs.PluginRepoName = "some-repo"
 			cmd.RequiredArgs.PluginRepoURL = "https://some-repo-URL"
+			cmd.TrustedKeys = []string{"some-key", "another-key"}
 			fakeActor.AddPluginRepositoryReturns(nil)
 		})
 
@@ -87,9 +117,10 @@ var _ = Describe("add-plugin-repo command", func() {
 			Expect(testUI.Out).To(Say("https://some-repo-URL added as some-repo"))
 
 			Expect(fakeActor.AddPluginRepositoryCallCount()).To(Equal(1))
-			repoName, repoURL := fakeActor.AddPluginRepositoryArgsForCall(0)
+			repoName, repoURL, trustedKeys := fakeActor.AddPluginRepositoryArgsForCall(0)
 			Expect(repoName).To(Equal("some-repo"))
 			Expect(repoURL).To(Equal("https://some-repo-URL"))
+			Expect(trustedKeys).To(Equal([]string{"some-key", "another-key"}))
 		})
 	})
 })
@@ -8,11 +8,12 @@ import (
 )
 
 type FakeAddPluginRepoActor struct {
-	AddPluginRepositoryStub        func(string, string) error
+	AddPluginRepositoryStub        func(string, string, []string) error
 	addPluginRepositoryMutex       sync.RWMutex
 	addPluginRepositoryArgsForCall []struct {
 		arg1 string
 		arg2 string
+		arg3 []string
 	}
 	addPluginRepositoryReturns struct {
 		result1 error
@@ -20,21 +21,39 @@ type FakeAddPluginRepoActor struct {
 	addPluginRepositoryReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AddPluginRepositoryTrustedKeysStub        func(string, []string) error
+	addPluginRepositoryTrustedKeysMutex       sync.RWMutex
+	addPluginRepositoryTrustedKeysArgsForCall []struct {
+		arg1 string
+		arg2 []string
+	}
+	addPluginRepositoryTrustedKeysReturns struct {
+		result1 error
+	}
+	addPluginRepositoryTrustedKeysReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAddPluginRepoActor) AddPluginRepository(arg1 string, arg2 string) error {
+func (fake *FakeAddPluginRepoActor) AddPluginRepository(arg1 string, arg2 string, arg3 []string) error {
+	var arg3Copy []string
+	if arg3 != nil {
+		arg3Copy = make([]string, len(arg3))
+		copy(arg3Copy, arg3)
+	}
 	fake.addPluginRepositoryMutex.Lock()
 	ret, specificReturn := fake.addPluginRepositoryReturnsOnCall[len(fake.addPluginRepositoryArgsForCall)]
 	fake.addPluginRepositoryArgsForCall = append(fake.addPluginRepositoryArgsForCall, struct {
 		arg1 string
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2})
+		arg3 []string
+	}{arg1, arg2, arg3Copy})
+	fake.recordInvocation("AddPluginRepository", []interface{}{arg1, arg2, arg3Copy})
 	fake.addPluginRepositoryMutex.Unlock()
 	if fake.AddPluginRepositoryStub != nil {
-		return fake.AddPluginRepositoryStub(arg1, arg2)
+		return fake.AddPluginRepositoryStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -49,17 +68,17 @@ func (fake *FakeAddPluginRepoActor) AddPluginRepositoryCallCount() int {
 	return len(fake.addPluginRepositoryArgsForCall)
 }
 
-func (fake *FakeAddPluginRepoActor) AddPluginRepositoryCalls(stub func(string, string) error) {
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryCalls(stub func(string, string, []string) error) {
 	fake.addPluginRepositoryMutex.Lock()
 	defer fake.addPluginRepositoryMutex.Unlock()
 	fake.AddPluginRepositoryStub = stub
 }
 
-func (fake *FakeAddPluginRepoActor) AddPluginRepositoryArgsForCall(i int) (string, string) {
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryArgsForCall(i int) (string, string, []string) {
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	argsForCall := fake.addPluginRepositoryArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeAddPluginRepoActor) AddPluginRepositoryReturns(result1 error) {
@@ -85,11 +104,79 @@ func (fake *FakeAddPluginRepoActor) AddPluginRepositoryReturnsOnCall(i int, resu
 	}{result1}
 }
 
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeys(arg1 string, arg2 []string) error {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	ret, specificReturn := fake.addPluginRepositoryTrustedKeysReturnsOnCall[len(fake.addPluginRepositoryTrustedKeysArgsForCall)]
+	fake.addPluginRepositoryTrustedKeysArgsForCall = append(fake.addPluginRepositoryTrustedKeysArgsForCall, struct {
+		arg1 string
+		arg2 []string
+	}{arg1, arg2Copy})
+	fake.recordInvocation("AddPluginRepositoryTrustedKeys", []interface{}{arg1, arg2Copy})
+	fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	if fake.AddPluginRepositoryTrustedKeysStub != nil {
+		return fake.AddPluginRepositoryTrustedKeysStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.addPluginRepositoryTrustedKeysReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeysCallCount() int {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	return len(fake.addPluginRepositoryTrustedKeysArgsForCall)
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeysCalls(stub func(string, []string) error) {
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	fake.AddPluginRepositoryTrustedKeysStub = stub
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeysArgsForCall(i int) (string, []string) {
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
+	argsForCall := fake.addPluginRepositoryTrustedKeysArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeysReturns(result1 error) {
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	fake.AddPluginRepositoryTrustedKeysStub = nil
+	fake.addPluginRepositoryTrustedKeysReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAddPluginRepoActor) AddPluginRepositoryTrustedKeysReturnsOnCall(i int, result1 error) {
+	fake.addPluginRepositoryTrustedKeysMutex.Lock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.Unlock()
+	fake.AddPluginRepositoryTrustedKeysStub = nil
+	if fake.addPluginRepositoryTrustedKeysReturnsOnCall == nil {
+		fake.addPluginRepositoryTrustedKeysReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.addPluginRepositoryTrustedKeysReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAddPluginRepoActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.addPluginRepositoryMutex.RLock()
 	defer fake.addPluginRepositoryMutex.RUnlock()
+	fake.addPluginRepositoryTrustedKeysMutex.RLock()
+	defer fake.addPluginRepositoryTrustedKeysMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
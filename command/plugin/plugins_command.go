@@ -21,7 +21,7 @@ type PluginsCommand struct {
 	Checksum          bool        `long:"checksum" description:"Compute and show the sha1 value of the plugin binary file"`
 	Outdated          bool        `long:"outdated" description:"Search the plugin repositories for new versions of installed plugins"`
 	usage             interface{} `usage:"CF_NAME plugins [--checksum | --outdated]"`
-	relatedCommands   interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin"`
+	relatedCommands   interface{} `related_commands:"install-plugin, repo-plugins, uninstall-plugin, update-plugin"`
 	SkipSSLValidation bool        `short:"k" hidden:"true" description:"Skip SSL certificate validation"`
 	UI                command.UI
 	Config            command.Config
@@ -88,7 +88,7 @@ func (cmd PluginsCommand) displayOutdatedPlugins() error {
 	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 
 	cmd.UI.DisplayNewline()
-	cmd.UI.DisplayText("Use '{{.BinaryName}} install-plugin' to update a plugin to the latest version.", map[string]interface{}{
+	cmd.UI.DisplayText("Use '{{.BinaryName}} update-plugin' to update a plugin to the latest version.", map[string]interface{}{
 		"BinaryName": cmd.Config.BinaryName(),
 	})
 
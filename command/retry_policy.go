@@ -0,0 +1,12 @@
+package command
+
+import "code.cloudfoundry.org/cli/api/retry"
+
+// NewRetryPolicy returns the policy used to retry failed API requests.
+func NewRetryPolicy(config Config) retry.Policy {
+	return retry.Policy{
+		MaxRetries: config.RequestRetryCount(),
+		BaseDelay:  config.RequestRetryBaseDelay(),
+		MaxDelay:   config.RequestRetryMaxDelay(),
+	}
+}
@@ -0,0 +1,9 @@
+package command
+
+// StructuredOutputCommander is implemented by commands that display their
+// results with UI.DisplayRecord, and can therefore be run with the global
+// '--output' flag set to a structured output format.
+type StructuredOutputCommander interface {
+	ExtendedCommander
+	SupportsStructuredOutput()
+}
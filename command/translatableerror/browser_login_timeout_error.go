@@ -0,0 +1,12 @@
+package translatableerror
+
+type BrowserLoginTimeoutError struct {
+}
+
+func (BrowserLoginTimeoutError) Error() string {
+	return "Timed out waiting for the browser login to complete. Please try again."
+}
+
+func (e BrowserLoginTimeoutError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,16 @@
+package translatableerror
+
+// ContextFlagNotSupportedError is returned when the global '--context' flag is
+// used with a command that reads the config without it.
+type ContextFlagNotSupportedError struct {
+}
+
+func (ContextFlagNotSupportedError) Error() string {
+	return "This command does not support '--context'. Use '{{.Command}}' to switch contexts first."
+}
+
+func (e ContextFlagNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Command": "cf use-context",
+	})
+}
@@ -62,6 +62,8 @@ func ConvertToTranslatableError(err error) error {
 		return InvalidRouteError(e)
 	case actionerror.InvalidTCPRouteSettings:
 		return HostAndPathNotAllowedWithTCPDomainError(e)
+	case actionerror.InvalidPluginSignatureError:
+		return InvalidPluginSignatureError(e)
 	case actionerror.IsolationSegmentNotFoundError:
 		return IsolationSegmentNotFoundError(e)
 	case actionerror.MissingNameError:
@@ -80,6 +82,8 @@ func ConvertToTranslatableError(err error) error {
 		return FileNotFoundError(e)
 	case actionerror.NoOrganizationTargetedError:
 		return NoOrganizationTargetedError(e)
+	case actionerror.NoRunningProcessInstancesError:
+		return NoRunningProcessInstancesError(e)
 	case actionerror.NoSpaceTargetedError:
 		return NoSpaceTargetedError(e)
 	case actionerror.NotLoggedInError:
@@ -96,6 +100,10 @@ func ConvertToTranslatableError(err error) error {
 		return PluginInvalidError(e)
 	case actionerror.PluginNotFoundError:
 		return PluginNotFoundError(e)
+	case actionerror.PluginRepositoryHasNoTrustedKeysError:
+		return PluginRepositoryHasNoTrustedKeysError(e)
+	case actionerror.PluginSignatureMissingError:
+		return PluginSignatureMissingError(e)
 	case actionerror.ProcessInstanceNotFoundError:
 		return ProcessInstanceNotFoundError(e)
 	case actionerror.ProcessInstanceNotRunningError:
@@ -108,6 +116,8 @@ func ConvertToTranslatableError(err error) error {
 		return RepositoryNameTakenError(e)
 	case actionerror.RepositoryNotRegisteredError:
 		return RepositoryNotRegisteredError(e)
+	case actionerror.RevisionNotFoundError:
+		return RevisionNotFoundError(e)
 	case actionerror.RouteInDifferentSpaceError:
 		return RouteInDifferentSpaceError(e)
 	case actionerror.RoutePathWithTCPDomainError:
@@ -198,6 +208,12 @@ func ConvertToTranslatableError(err error) error {
 		return SSHUnableToAuthenticateError{}
 
 	// UAA Errors
+	case uaa.AccessDeniedError:
+		return LoginDeniedError{}
+	case uaa.AuthorizationCodeTimeoutError:
+		return BrowserLoginTimeoutError{}
+	case uaa.ExpiredDeviceCodeError:
+		return DeviceCodeExpiredError{}
 	case uaa.UnauthorizedError:
 		return UnauthorizedError(e)
 	case uaa.InsufficientScopeError:
@@ -108,6 +108,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.InvalidHTTPRouteSettings{Domain: "some-domain"},
 			PortNotAllowedWithHTTPDomainError{Domain: "some-domain"}),
 
+		Entry("actionerror.InvalidPluginSignatureError -> InvalidPluginSignatureError",
+			actionerror.InvalidPluginSignatureError{RepositoryName: "some-repo"},
+			InvalidPluginSignatureError{RepositoryName: "some-repo"}),
+
 		Entry("actionerror.InvalidRouteError -> InvalidRouteError",
 			actionerror.InvalidRouteError{Route: "some-invalid-route"},
 			InvalidRouteError{Route: "some-invalid-route"}),
@@ -148,6 +152,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.NoOrganizationTargetedError{BinaryName: "faceman"},
 			NoOrganizationTargetedError{BinaryName: "faceman"}),
 
+		Entry("actionerror.NoRunningProcessInstancesError -> NoRunningProcessInstancesError",
+			actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"},
+			NoRunningProcessInstancesError{ProcessType: "some-process-type"}),
+
 		Entry("actionerror.NoSpaceTargetedError -> NoSpaceTargetedError",
 			actionerror.NoSpaceTargetedError{BinaryName: "faceman"},
 			NoSpaceTargetedError{BinaryName: "faceman"}),
@@ -194,6 +202,14 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.PluginNotFoundError{PluginName: "some-plugin"},
 			PluginNotFoundError{PluginName: "some-plugin"}),
 
+		Entry("actionerror.PluginRepositoryHasNoTrustedKeysError -> PluginRepositoryHasNoTrustedKeysError",
+			actionerror.PluginRepositoryHasNoTrustedKeysError{RepositoryName: "some-repo"},
+			PluginRepositoryHasNoTrustedKeysError{RepositoryName: "some-repo"}),
+
+		Entry("actionerror.PluginSignatureMissingError -> PluginSignatureMissingError",
+			actionerror.PluginSignatureMissingError{RepositoryName: "some-repo"},
+			PluginSignatureMissingError{RepositoryName: "some-repo"}),
+
 		Entry("actionerror.ProcessInstanceNotFoundError -> ProcessInstanceNotFoundError",
 			actionerror.ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42},
 			ProcessInstanceNotFoundError{ProcessType: "some-process-type", InstanceIndex: 42}),
@@ -218,6 +234,10 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			actionerror.RepositoryNotRegisteredError{Name: "some-repo"},
 			RepositoryNotRegisteredError{Name: "some-repo"}),
 
+		Entry("actionerror.RevisionNotFoundError -> RevisionNotFoundError",
+			actionerror.RevisionNotFoundError{Version: 3},
+			RevisionNotFoundError{Version: 3}),
+
 		Entry("actionerror.RouteInDifferentSpaceError -> RouteInDifferentSpaceError",
 			actionerror.RouteInDifferentSpaceError{Route: "some-route"},
 			RouteInDifferentSpaceError{Route: "some-route"}),
@@ -398,6 +418,18 @@ var _ = Describe("ConvertToTranslatableError", func() {
 			SSHUnableToAuthenticateError{}),
 
 		// UAA Errors
+		Entry("uaa.AccessDeniedError -> LoginDeniedError",
+			uaa.AccessDeniedError{},
+			LoginDeniedError{}),
+
+		Entry("uaa.AuthorizationCodeTimeoutError -> BrowserLoginTimeoutError",
+			uaa.AuthorizationCodeTimeoutError{},
+			BrowserLoginTimeoutError{}),
+
+		Entry("uaa.ExpiredDeviceCodeError -> DeviceCodeExpiredError",
+			uaa.ExpiredDeviceCodeError{},
+			DeviceCodeExpiredError{}),
+
 		Entry("uaa.BadCredentialsError -> BadCredentialsError",
 			uaa.UnauthorizedError{},
 			UnauthorizedError{}),
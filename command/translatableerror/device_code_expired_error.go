@@ -0,0 +1,12 @@
+package translatableerror
+
+type DeviceCodeExpiredError struct {
+}
+
+func (DeviceCodeExpiredError) Error() string {
+	return "The login code expired before it was approved. Please try again."
+}
+
+func (e DeviceCodeExpiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
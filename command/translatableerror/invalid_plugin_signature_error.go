@@ -0,0 +1,15 @@
+package translatableerror
+
+// InvalidPluginSignatureError is returned when a plugin binary's signature
+// does not match any of its repository's trusted keys.
+type InvalidPluginSignatureError struct {
+	RepositoryName string
+}
+
+func (InvalidPluginSignatureError) Error() string {
+	return "Downloaded plugin binary's signature does not match any trusted key of repository {{.RepositoryName}}.\nPlease try again or contact the plugin author."
+}
+
+func (e InvalidPluginSignatureError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.RepositoryName})
+}
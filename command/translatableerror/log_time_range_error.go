@@ -0,0 +1,13 @@
+package translatableerror
+
+// LogTimeRangeError is returned when the start of the requested log window is
+// not before its end.
+type LogTimeRangeError struct{}
+
+func (LogTimeRangeError) Error() string {
+	return "Incorrect Usage: --since must be earlier than --until."
+}
+
+func (e LogTimeRangeError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// NoRunningProcessInstancesError is returned when trying to perform an action
+// on the running instances of a process and there are none.
+type NoRunningProcessInstancesError struct {
+	ProcessType string
+}
+
+func (NoRunningProcessInstancesError) Error() string {
+	return "No instances of process {{.ProcessType}} are running"
+}
+
+func (e NoRunningProcessInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"ProcessType": e.ProcessType,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// PluginRepositoryHasNoTrustedKeysError is returned when a plugin's signature
+// cannot be verified because its repository has no trusted keys.
+type PluginRepositoryHasNoTrustedKeysError struct {
+	RepositoryName string
+}
+
+func (PluginRepositoryHasNoTrustedKeysError) Error() string {
+	return "Repository {{.RepositoryName}} has no trusted keys to verify the plugin binary's signature with.\nRe-add the repository with add-plugin-repo --trusted-key, or use --skip-signature-validation to install without verifying the signature."
+}
+
+func (e PluginRepositoryHasNoTrustedKeysError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.RepositoryName})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// PluginSignatureMissingError is returned when a repository does not list a
+// signature for a plugin binary.
+type PluginSignatureMissingError struct {
+	RepositoryName string
+}
+
+func (PluginSignatureMissingError) Error() string {
+	return "Repository {{.RepositoryName}} does not provide a signature for the plugin binary.\nUse --skip-signature-validation to install it without verifying its signature."
+}
+
+func (e PluginSignatureMissingError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{"RepositoryName": e.RepositoryName})
+}
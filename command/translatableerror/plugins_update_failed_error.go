@@ -0,0 +1,19 @@
+package translatableerror
+
+import "strings"
+
+// PluginsUpdateFailedError is returned when update-plugin --all could not
+// update some of the plugins.
+type PluginsUpdateFailedError struct {
+	PluginNames []string
+}
+
+func (e PluginsUpdateFailedError) Error() string {
+	return "Failed to update plugins: {{.PluginNames}}."
+}
+
+func (e PluginsUpdateFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PluginNames": strings.Join(e.PluginNames, ", "),
+	})
+}
@@ -0,0 +1,28 @@
+package translatableerror
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PushFailedError is returned when one or more apps of a parallel push fail.
+type PushFailedError struct {
+	// AppErrors are the errors of the failed apps, formatted as
+	// "APP_NAME: ERROR".
+	AppErrors []string
+}
+
+func (PushFailedError) Error() string {
+	return "Failed to push {{.Count}} app(s):\n{{.Errors}}"
+}
+
+func (e PushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	var formattedErrs []string
+	for _, err := range e.AppErrors {
+		formattedErrs = append(formattedErrs, fmt.Sprintf("- %s", err))
+	}
+	return translate(e.Error(), map[string]interface{}{
+		"Count":  len(e.AppErrors),
+		"Errors": strings.Join(formattedErrs, "\n"),
+	})
+}
@@ -0,0 +1,17 @@
+package translatableerror
+
+// RevisionNotFoundError is returned when a requested application revision
+// can't be found.
+type RevisionNotFoundError struct {
+	Version int
+}
+
+func (RevisionNotFoundError) Error() string {
+	return "Revision {{.Version}} not found"
+}
+
+func (e RevisionNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Version": e.Version,
+	})
+}
@@ -0,0 +1,15 @@
+package translatableerror
+
+// SecureCopyLocationError is returned when scp is not given exactly one path
+// on an app instance.
+type SecureCopyLocationError struct{}
+
+func (SecureCopyLocationError) DisplayUsage() {}
+
+func (SecureCopyLocationError) Error() string {
+	return "Incorrect Usage: Exactly one of SOURCE and DESTINATION must be an APP_NAME:PATH on an app instance"
+}
+
+func (e SecureCopyLocationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
@@ -0,0 +1,27 @@
+package translatableerror
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SSHCommandFailedOnInstancesError is returned when a command run over SSH on
+// several app instances fails on some of them.
+type SSHCommandFailedOnInstancesError struct {
+	Indexes []uint
+}
+
+func (SSHCommandFailedOnInstancesError) Error() string {
+	return "Command failed on instances: {{.Indexes}}"
+}
+
+func (e SSHCommandFailedOnInstancesError) Translate(translate func(string, ...interface{}) string) string {
+	var indexes []string
+	for _, index := range e.Indexes {
+		indexes = append(indexes, strconv.FormatUint(uint64(index), 10))
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Indexes": strings.Join(indexes, ", "),
+	})
+}
@@ -0,0 +1,18 @@
+package translatableerror
+
+// StructuredOutputNotSupportedError is returned when the global '--output'
+// flag requests a structured output format from a command that only displays
+// text and tables.
+type StructuredOutputNotSupportedError struct {
+	Format string
+}
+
+func (StructuredOutputNotSupportedError) Error() string {
+	return "This command does not support '--output {{.Format}}'."
+}
+
+func (e StructuredOutputNotSupportedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Format": e.Format,
+	})
+}
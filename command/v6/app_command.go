@@ -41,6 +41,8 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (AppCommand) SupportsStructuredOutput() {}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -62,7 +64,7 @@ func (cmd AppCommand) displayAppGUID() error {
 	}
 
 	cmd.UI.DisplayText(app.GUID)
-	return nil
+	return cmd.UI.DisplayRecord(map[string]string{"guid": app.GUID})
 }
 
 func (cmd AppCommand) displayAppSummary() error {
@@ -89,5 +91,5 @@ func (cmd AppCommand) displayAppSummary() error {
 
 	shared.DisplayAppSummary(cmd.UI, appSummary, false)
 
-	return nil
+	return cmd.UI.DisplayRecord(shared.NewApplicationRecord(appSummary, false))
 }
@@ -1,19 +1,113 @@
 package v6
 
 import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetApplicationRoutesBySpace(spaceGUID string) (map[string]v2action.Routes, v2action.Warnings, error)
+}
+
+type appsRecord struct {
+	Apps []appRecord `json:"apps" yaml:"apps"`
+}
+
+type appRecord struct {
+	Name           string   `json:"name" yaml:"name"`
+	GUID           string   `json:"guid" yaml:"guid"`
+	RequestedState string   `json:"requested_state" yaml:"requested_state"`
+	Instances      int      `json:"instances" yaml:"instances"`
+	MemoryInMB     uint64   `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB       uint64   `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Routes         []string `json:"routes" yaml:"routes"`
+}
+
 type AppsCommand struct {
 	usage           interface{} `usage:"CF_NAME apps"`
 	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
 }
 
-func (AppsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	// The table output is still displayed by the legacy command, so the clients
+	// are only needed when a structured output format is requested.
+	if !config.OutputFormat().IsStructured() {
+		return nil
+	}
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (AppsCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (AppsCommand) SupportsStructuredOutput() {}
+
+func (cmd AppsCommand) Execute(args []string) error {
+	if !cmd.Config.OutputFormat().IsStructured() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	apps, warnings, err := cmd.Actor.GetApplicationsBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := appsRecord{Apps: []appRecord{}}
+	if len(apps) == 0 {
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	appRoutes, warnings, err := cmd.Actor.GetApplicationRoutesBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, app := range apps {
+		routes := []string{}
+		for _, route := range appRoutes[app.GUID] {
+			routes = append(routes, route.String())
+		}
+
+		record.Apps = append(record.Apps, appRecord{
+			Name:           app.Name,
+			GUID:           app.GUID,
+			RequestedState: strings.ToLower(string(app.State)),
+			Instances:      app.Instances.Value,
+			MemoryInMB:     app.Memory.Value,
+			DiskInMB:       app.DiskQuota.Value,
+			Routes:         routes,
+		})
+	}
+
+	return cmd.UI.DisplayRecord(record)
 }
@@ -0,0 +1,176 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeAppsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the output format is table", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatTable)
+		})
+
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		When("checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("the space has apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{
+						{
+							Name:      "app-1",
+							GUID:      "app-guid-1",
+							State:     constant.ApplicationStarted,
+							Instances: types.NullInt{IsSet: true, Value: 2},
+							Memory:    types.NullByteSizeInMb{IsSet: true, Value: 256},
+							DiskQuota: types.NullByteSizeInMb{IsSet: true, Value: 1024},
+						},
+						{
+							Name:  "app-2",
+							GUID:  "app-guid-2",
+							State: constant.ApplicationStopped,
+						},
+					},
+					v2action.Warnings{"get-apps-warning"},
+					nil,
+				)
+				fakeActor.GetApplicationRoutesBySpaceReturns(
+					map[string]v2action.Routes{
+						"app-guid-1": {
+							{Host: "app-1", Domain: v2action.Domain{Name: "example.com"}},
+						},
+					},
+					v2action.Warnings{"get-routes-warning"},
+					nil,
+				)
+			})
+
+			It("displays the apps as a json document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`"apps": \[`))
+				Expect(testUI.Out).To(Say(`"name": "app-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "app-guid-1"`))
+				Expect(testUI.Out).To(Say(`"requested_state": "started"`))
+				Expect(testUI.Out).To(Say(`"instances": 2`))
+				Expect(testUI.Out).To(Say(`"memory_in_mb": 256`))
+				Expect(testUI.Out).To(Say(`"disk_in_mb": 1024`))
+				Expect(testUI.Out).To(Say(`"app-1\.example\.com"`))
+				Expect(testUI.Out).To(Say(`"name": "app-2"`))
+				Expect(testUI.Out).To(Say(`"routes": \[\]`))
+
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetApplicationRoutesBySpaceCallCount()).To(Equal(1))
+				Expect(fakeActor.GetApplicationRoutesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+
+			When("getting the routes fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationRoutesBySpaceReturns(nil, v2action.Warnings{"get-routes-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(testUI.Err).To(Say("get-routes-warning"))
+				})
+			})
+		})
+
+		When("the space has no apps", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"get-apps-warning"}, nil)
+			})
+
+			It("displays an empty list of apps", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"apps": \[\]`))
+				Expect(fakeActor.GetApplicationRoutesBySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the apps fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"get-apps-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+			})
+		})
+	})
+})
@@ -2,6 +2,7 @@ package v6
 
 import (
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
@@ -16,14 +17,24 @@ import (
 
 type AuthActor interface {
 	Authenticate(ID string, secret string, origin string, grantType constant.GrantType) error
+	AuthenticateWithBrowserLogin(login v2action.BrowserLogin, timeout time.Duration) error
+	AuthenticateWithDeviceCode(deviceAuthorization v2action.DeviceAuthorization) error
+	GetDeviceAuthorization() (v2action.DeviceAuthorization, error)
+	StartBrowserLogin() (v2action.BrowserLogin, error)
 	UAAAPIVersion() string
 }
 
+// browserLoginTimeout is how long to wait for the browser to be redirected
+// back to the CLI after starting a browser login.
+const browserLoginTimeout = 5 * time.Minute
+
 type AuthCommand struct {
 	RequiredArgs      flag.Authentication `positional-args:"yes"`
 	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
 	Origin            string              `long:"origin" description:"Indicates the identity provider to be used for authentication"`
-	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
+	DeviceCode        bool                `long:"device-code" description:"Authenticate by approving a one-time code in a browser on any device"`
+	Browser           bool                `long:"browser" description:"Authenticate in a browser on this machine"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth USERNAME PASSWORD --origin ORIGIN\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth --device-code\n   CF_NAME auth --browser\n\nENVIRONMENT VARIABLES:\n   CF_USERNAME=user          Authenticating user. Overridden if USERNAME argument is provided.\n   CF_PASSWORD=password      Password associated with user. Overriden if PASSWORD argument is provided.\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n   Consider using the CF_PASSWORD environment variable instead\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
 	relatedCommands   interface{}         `related_commands:"api, login, target"`
 
 	UI     command.UI
@@ -58,6 +69,10 @@ func (cmd AuthCommand) Execute(args []string) error {
 		}
 	}
 
+	if cmd.DeviceCode || cmd.Browser {
+		return cmd.interactiveAuthenticate()
+	}
+
 	username, password, err := cmd.checkEnvVariables()
 	if err != nil {
 		return err
@@ -95,6 +110,53 @@ func (cmd AuthCommand) Execute(args []string) error {
 	return nil
 }
 
+func (cmd AuthCommand) interactiveAuthenticate() error {
+	var conflictingFlags []string
+	if cmd.ClientCredentials {
+		conflictingFlags = append(conflictingFlags, "--client-credentials")
+	}
+	if cmd.Origin != "" {
+		conflictingFlags = append(conflictingFlags, "--origin")
+	}
+	if cmd.RequiredArgs.Username != "" || cmd.RequiredArgs.Password != "" {
+		conflictingFlags = append(conflictingFlags, "USERNAME", "PASSWORD")
+	}
+
+	err := checkInteractiveLoginFlags(cmd.DeviceCode, cmd.Browser, conflictingFlags)
+	if err != nil {
+		return err
+	}
+
+	err = command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"API endpoint: {{.Endpoint}}",
+		map[string]interface{}{
+			"Endpoint": cmd.Config.Target(),
+		})
+
+	if cmd.DeviceCode {
+		err = authenticateWithDeviceCode(cmd.Actor, cmd.UI)
+	} else {
+		err = authenticateWithBrowser(cmd.Actor, cmd.UI)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayTextWithFlavor(
+		"Use '{{.Command}}' to view or set your target org and space.",
+		map[string]interface{}{
+			"Command": fmt.Sprintf("%s target", cmd.Config.BinaryName()),
+		})
+
+	return nil
+}
+
 func (cmd AuthCommand) checkEnvVariables() (string, string, error) {
 	var (
 		userMissing     bool
@@ -128,3 +190,60 @@ func (cmd AuthCommand) checkEnvVariables() (string, string, error) {
 
 	return username, password, nil
 }
+
+// checkInteractiveLoginFlags validates that only one of --device-code and
+// --browser is used, and that it is not combined with any of conflictingFlags.
+func checkInteractiveLoginFlags(deviceCode bool, browser bool, conflictingFlags []string) error {
+	if deviceCode && browser {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--device-code", "--browser"},
+		}
+	}
+
+	if len(conflictingFlags) > 0 {
+		loginFlag := "--browser"
+		if deviceCode {
+			loginFlag = "--device-code"
+		}
+		return translatableerror.ArgumentCombinationError{
+			Args: append([]string{loginFlag}, conflictingFlags...),
+		}
+	}
+
+	return nil
+}
+
+func authenticateWithDeviceCode(actor AuthActor, ui command.UI) error {
+	deviceAuthorization, err := actor.GetDeviceAuthorization()
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayText("To log in, visit {{.URL}} and enter the code {{.Code}}", map[string]interface{}{
+		"URL":  deviceAuthorization.VerificationURI,
+		"Code": deviceAuthorization.UserCode,
+	})
+	if deviceAuthorization.VerificationURIComplete != "" {
+		ui.DisplayText("Or open {{.URL}} to enter the code automatically.", map[string]interface{}{
+			"URL": deviceAuthorization.VerificationURIComplete,
+		})
+	}
+	ui.DisplayNewline()
+	ui.DisplayText("Waiting for the login to be approved...")
+
+	return actor.AuthenticateWithDeviceCode(deviceAuthorization)
+}
+
+func authenticateWithBrowser(actor AuthActor, ui command.UI) error {
+	login, err := actor.StartBrowserLogin()
+	if err != nil {
+		return err
+	}
+
+	ui.DisplayText("Open the following URL in a browser on this machine to log in:")
+	ui.DisplayText(login.AuthorizationURL)
+	ui.DisplayNewline()
+	ui.DisplayText("Waiting for the browser login to complete...")
+
+	return actor.AuthenticateWithBrowserLogin(login, browserLoginTimeout)
+}
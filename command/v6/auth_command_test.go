@@ -3,6 +3,7 @@ package v6_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/api/uaa/constant"
 	"code.cloudfoundry.org/cli/api/uaa/uaaversion"
@@ -318,4 +319,105 @@ var _ = Describe("auth Command", func() {
 			})
 		})
 	})
+
+	When("--device-code is set", func() {
+		BeforeEach(func() {
+			cmd.DeviceCode = true
+			fakeConfig.TargetReturns("some-api-target")
+			fakeActor.GetDeviceAuthorizationReturns(v2action.DeviceAuthorization{
+				DeviceCode:              "some-device-code",
+				UserCode:                "ABCD-EFGH",
+				VerificationURI:         "https://login.example.com/device",
+				VerificationURIComplete: "https://login.example.com/device?user_code=ABCD-EFGH",
+			}, nil)
+		})
+
+		It("displays the code and waits for the login to be approved", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+			Expect(testUI.Out).To(Say(`To log in, visit https://login\.example\.com/device and enter the code ABCD-EFGH`))
+			Expect(testUI.Out).To(Say(`Or open https://login\.example\.com/device\?user_code=ABCD-EFGH to enter the code automatically\.`))
+			Expect(testUI.Out).To(Say(`Waiting for the login to be approved\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use '%s target' to view or set your target org and space", binaryName))
+
+			Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+			Expect(fakeActor.AuthenticateWithDeviceCodeArgsForCall(0).DeviceCode).To(Equal("some-device-code"))
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+		})
+
+		When("the login is not approved in time", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithDeviceCodeReturns(uaa.ExpiredDeviceCodeError{})
+			})
+
+			It("returns the error", func() {
+				Expect(err).To(MatchError(uaa.ExpiredDeviceCodeError{}))
+			})
+		})
+
+		When("a username and password are also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "some-user"
+				cmd.RequiredArgs.Password = "some-password"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-code", "USERNAME", "PASSWORD"},
+				}))
+				Expect(fakeActor.GetDeviceAuthorizationCallCount()).To(Equal(0))
+			})
+		})
+
+		When("--browser is also set", func() {
+			BeforeEach(func() {
+				cmd.Browser = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-code", "--browser"},
+				}))
+			})
+		})
+	})
+
+	When("--browser is set", func() {
+		BeforeEach(func() {
+			cmd.Browser = true
+			fakeConfig.TargetReturns("some-api-target")
+			fakeActor.StartBrowserLoginReturns(v2action.BrowserLogin{
+				AuthorizationURL: "https://login.example.com/oauth/authorize?some-query",
+			}, nil)
+		})
+
+		It("displays the authorization URL and waits for the browser login", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+			Expect(testUI.Out).To(Say("Open the following URL in a browser on this machine to log in:"))
+			Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize\?some-query`))
+			Expect(testUI.Out).To(Say(`Waiting for the browser login to complete\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateWithBrowserLoginCallCount()).To(Equal(1))
+			login, timeout := fakeActor.AuthenticateWithBrowserLoginArgsForCall(0)
+			Expect(login.AuthorizationURL).To(Equal("https://login.example.com/oauth/authorize?some-query"))
+			Expect(timeout).To(BeNumerically(">", 0))
+		})
+
+		When("--client-credentials is also set", func() {
+			BeforeEach(func() {
+				cmd.ClientCredentials = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(err).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--browser", "--client-credentials"},
+				}))
+			})
+		})
+	})
 })
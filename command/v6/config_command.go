@@ -7,11 +7,12 @@ import (
 )
 
 type ConfigCommand struct {
-	AsyncTimeout int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
-	Color        flag.Color        `long:"color" description:"Enable or disable color"`
-	Locale       flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
-	Trace        flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
-	usage        interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)]"`
+	AsyncTimeout    int               `long:"async-timeout" description:"Timeout for async HTTP requests"`
+	Color           flag.Color        `long:"color" description:"Enable or disable color"`
+	CredentialStore string            `long:"credential-store" description:"Store credentials in 'file' (config.json), 'encrypted-file', or the credential helper cf-credential-NAME"`
+	Locale          flag.Locale       `long:"locale" description:"Set default locale. If LOCALE is 'CLEAR', previous locale is deleted."`
+	Trace           flag.PathWithBool `long:"trace" description:"Trace HTTP requests"`
+	usage           interface{}       `usage:"CF_NAME config [--async-timeout TIMEOUT_IN_MINUTES] [--trace (true | false | path/to/file)] [--color (true | false)] [--locale (LOCALE | CLEAR)] [--credential-store (file | encrypted-file | NAME)]"`
 }
 
 func (ConfigCommand) Setup(config command.Config, ui command.UI) error {
@@ -0,0 +1,83 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+type contextsRecord struct {
+	Contexts []contextRecord `json:"contexts" yaml:"contexts"`
+}
+
+type contextRecord struct {
+	Name         string `json:"name" yaml:"name"`
+	Current      bool   `json:"current" yaml:"current"`
+	API          string `json:"api" yaml:"api"`
+	Organization string `json:"org" yaml:"org"`
+	Space        string `json:"space" yaml:"space"`
+}
+
+type ContextsCommand struct {
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"rename-context, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (ContextsCommand) SupportsStructuredOutput() {}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting contexts...")
+	cmd.UI.DisplayNewline()
+
+	record := contextsRecord{Contexts: []contextRecord{}}
+	names := cmd.Config.ContextNames()
+	if len(names) == 0 {
+		cmd.UI.DisplayText("No contexts found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	contexts := cmd.Config.Contexts()
+	current := cmd.Config.CurrentContextName()
+
+	table := [][]string{
+		{
+			"",
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("api endpoint"),
+			cmd.UI.TranslateText("org"),
+			cmd.UI.TranslateText("space"),
+		},
+	}
+	for _, name := range names {
+		context := contexts[name]
+		marker := ""
+		if name == current {
+			marker = "*"
+		}
+		table = append(table, []string{
+			marker,
+			name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+		record.Contexts = append(record.Contexts, contextRecord{
+			Name:         name,
+			Current:      name == current,
+			API:          context.Target,
+			Organization: context.TargetedOrganization.Name,
+			Space:        context.TargetedSpace.Name,
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
@@ -0,0 +1,67 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("there are no contexts", func() {
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found."))
+		})
+	})
+
+	When("there are contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextNamesReturns([]string{"one", "two"})
+			fakeConfig.ContextsReturns(map[string]configv3.TargetContext{
+				"one": {
+					Target:               "https://api.one.com",
+					TargetedOrganization: configv3.Organization{Name: "one-org"},
+					TargetedSpace:        configv3.Space{Name: "one-space"},
+				},
+				"two": {
+					Target: "https://api.two.com",
+				},
+			})
+			fakeConfig.CurrentContextNameReturns("two")
+		})
+
+		It("displays the contexts and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Getting contexts..."))
+			Expect(testUI.Out).To(Say(`name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`one\s+https://api.one.com\s+one-org\s+one-space`))
+			Expect(testUI.Out).To(Say(`\*\s+two\s+https://api.two.com`))
+		})
+	})
+})
@@ -0,0 +1,241 @@
+package v6
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
+	yaml "gopkg.in/yaml.v2"
+)
+
+//go:generate counterfeiter . ExportSpaceActor
+
+type ExportSpaceActor interface {
+	CreateApplicationManifestsBySpace(spaceGUID string) ([]manifest.Application, v2v3action.Warnings, error)
+	WriteApplicationsManifest(manifestApps []manifest.Application, manifestPath string) error
+}
+
+// spaceServicesRecord is the companion document to an exported space
+// manifest. It lists what has to exist in a space before the manifest can be
+// pushed to it.
+type spaceServicesRecord struct {
+	ServiceInstances     []exportedServiceInstanceRecord     `yaml:"service_instances"`
+	UserProvidedServices []exportedUserProvidedServiceRecord `yaml:"user_provided_services"`
+	NetworkPolicies      []exportedNetworkPolicyRecord       `yaml:"network_policies"`
+}
+
+type exportedServiceInstanceRecord struct {
+	Name       string   `yaml:"name"`
+	Service    string   `yaml:"service"`
+	Plan       string   `yaml:"plan"`
+	Tags       []string `yaml:"tags,omitempty"`
+	SharedFrom string   `yaml:"shared_from,omitempty"`
+	BoundApps  []string `yaml:"bound_apps,omitempty"`
+}
+
+type exportedUserProvidedServiceRecord struct {
+	Name      string   `yaml:"name"`
+	Tags      []string `yaml:"tags,omitempty"`
+	BoundApps []string `yaml:"bound_apps,omitempty"`
+}
+
+type exportedNetworkPolicyRecord struct {
+	Source      string `yaml:"source"`
+	Destination string `yaml:"destination"`
+	Protocol    string `yaml:"protocol"`
+	Ports       string `yaml:"ports"`
+}
+
+type ExportSpaceCommand struct {
+	ManifestPath    flag.Path   `short:"p" description:"Path of the manifest file to create. Defaults to <space-name>_manifest.yml in the current working directory."`
+	ServicesPath    flag.Path   `long:"services-path" description:"Path of the file listing the space's services and network policies. Defaults to <space-name>_services.yml in the current working directory."`
+	usage           interface{} `usage:"CF_NAME export-space [-p /path/to/<space-name>_manifest.yml] [--services-path /path/to/<space-name>_services.yml]\n\nNOTE: Credentials of service instances and user-provided services are not exported."`
+	relatedCommands interface{} `related_commands:"create-app-manifest, network-policies, push, services"`
+
+	UI              command.UI
+	Config          command.Config
+	SharedActor     command.SharedActor
+	Actor           ExportSpaceActor
+	ServiceActor    ServiceInstancesActor
+	NetworkingActor NetworkPoliciesActor
+}
+
+func (cmd *ExportSpaceCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+
+	ccClientV3, uaaClientV3, err := shared.NewV3BasedClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	ccClientV2, uaaClientV2, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
+	v3Actor := v3action.NewActor(ccClientV3, config, sharedActor, uaaClientV3)
+	cmd.Actor = v2v3action.NewActor(v2Actor, v3Actor)
+	cmd.ServiceActor = v2Actor
+
+	networkingClient, err := shared.NewNetworkingClient(ccClientV3.NetworkPolicyV1(), config, uaaClientV3, ui)
+	if err != nil {
+		return err
+	}
+	cmd.NetworkingActor = cfnetworkingaction.NewActor(networkingClient, v3Actor)
+
+	return nil
+}
+
+func (cmd ExportSpaceCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	spaceName := cmd.Config.TargetedSpace().Name
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+
+	cmd.UI.DisplayTextWithFlavor("Exporting space {{.SpaceName}} in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"SpaceName": spaceName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"Username":  user.Name,
+	})
+
+	manifestPath := cmd.ManifestPath.String()
+	if manifestPath == "" {
+		manifestPath = fmt.Sprintf(".%s%s_manifest.yml", string(os.PathSeparator), spaceName)
+	}
+	servicesPath := cmd.ServicesPath.String()
+	if servicesPath == "" {
+		servicesPath = fmt.Sprintf(".%s%s_services.yml", string(os.PathSeparator), spaceName)
+	}
+
+	manifestApps, warnings, err := cmd.Actor.CreateApplicationManifestsBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := spaceServicesRecord{
+		ServiceInstances:     []exportedServiceInstanceRecord{},
+		UserProvidedServices: []exportedUserProvidedServiceRecord{},
+		NetworkPolicies:      []exportedNetworkPolicyRecord{},
+	}
+
+	instanceSummaries, serviceWarnings, err := cmd.ServiceActor.GetServiceInstancesSummaryBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(serviceWarnings)
+	if err != nil {
+		return err
+	}
+	cmd.addServiceInstances(&record, instanceSummaries)
+
+	policies, policyWarnings, err := cmd.NetworkingActor.NetworkPoliciesBySpace(spaceGUID)
+	cmd.UI.DisplayWarnings(policyWarnings)
+	if err != nil {
+		return err
+	}
+	cmd.addNetworkPolicies(&record, policies)
+
+	err = cmd.Actor.WriteApplicationsManifest(manifestApps, manifestPath)
+	if err != nil {
+		return err
+	}
+
+	err = cmd.writeServicesFile(record, servicesPath)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayText("Manifest file for {{.AppCount}} app(s) created successfully at {{.FilePath}}", map[string]interface{}{
+		"AppCount": len(manifestApps),
+		"FilePath": manifestPath,
+	})
+	cmd.UI.DisplayText("Services file created successfully at {{.FilePath}}", map[string]interface{}{
+		"FilePath": servicesPath,
+	})
+
+	return nil
+}
+
+func (ExportSpaceCommand) addServiceInstances(record *spaceServicesRecord, instanceSummaries []v2action.ServiceInstanceSummary) {
+	sort.Slice(instanceSummaries, func(i, j int) bool { return instanceSummaries[i].Name < instanceSummaries[j].Name })
+
+	for _, summary := range instanceSummaries {
+		var boundAppNames []string
+		for _, boundApplication := range summary.BoundApplications {
+			boundAppNames = append(boundAppNames, boundApplication.AppName)
+		}
+		sort.Strings(boundAppNames)
+
+		if summary.IsUserProvided() {
+			record.UserProvidedServices = append(record.UserProvidedServices, exportedUserProvidedServiceRecord{
+				Name:      summary.Name,
+				Tags:      summary.Tags,
+				BoundApps: boundAppNames,
+			})
+			continue
+		}
+
+		instance := exportedServiceInstanceRecord{
+			Name:      summary.Name,
+			Service:   summary.Service.Label,
+			Plan:      summary.ServicePlan.Name,
+			Tags:      summary.Tags,
+			BoundApps: boundAppNames,
+		}
+		if summary.IsSharedFrom() {
+			instance.SharedFrom = fmt.Sprintf("%s/%s", summary.ServiceInstanceSharedFrom.OrganizationName, summary.ServiceInstanceSharedFrom.SpaceName)
+		}
+		record.ServiceInstances = append(record.ServiceInstances, instance)
+	}
+}
+
+func (ExportSpaceCommand) addNetworkPolicies(record *spaceServicesRecord, policies []cfnetworkingaction.Policy) {
+	for _, policy := range policies {
+		ports := strconv.Itoa(policy.StartPort)
+		if policy.StartPort != policy.EndPort {
+			ports = fmt.Sprintf("%d-%d", policy.StartPort, policy.EndPort)
+		}
+
+		record.NetworkPolicies = append(record.NetworkPolicies, exportedNetworkPolicyRecord{
+			Source:      policy.SourceName,
+			Destination: policy.DestinationName,
+			Protocol:    policy.Protocol,
+			Ports:       ports,
+		})
+	}
+}
+
+func (ExportSpaceCommand) writeServicesFile(record spaceServicesRecord, filePath string) error {
+	recordBytes, err := yaml.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	pathToFile, err := filepath.Abs(filePath)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(pathToFile, recordBytes, 0644)
+}
@@ -0,0 +1,273 @@
+package v6_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/cfnetworkingaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2v3action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("export-space Command", func() {
+	var (
+		cmd                 ExportSpaceCommand
+		testUI              *ui.UI
+		fakeConfig          *commandfakes.FakeConfig
+		fakeSharedActor     *commandfakes.FakeSharedActor
+		fakeActor           *v6fakes.FakeExportSpaceActor
+		fakeServiceActor    *v6fakes.FakeServiceInstancesActor
+		fakeNetworkingActor *v6fakes.FakeNetworkPoliciesActor
+		tmpDir              string
+		servicesPath        string
+		executeErr          error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeExportSpaceActor)
+		fakeServiceActor = new(v6fakes.FakeServiceInstancesActor)
+		fakeNetworkingActor = new(v6fakes.FakeNetworkPoliciesActor)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "export-space-test")
+		Expect(err).ToNot(HaveOccurred())
+		servicesPath = filepath.Join(tmpDir, "services.yml")
+
+		cmd = ExportSpaceCommand{
+			ManifestPath:    flag.Path("some-manifest-path"),
+			ServicesPath:    flag.Path(servicesPath),
+			UI:              testUI,
+			Config:          fakeConfig,
+			SharedActor:     fakeSharedActor,
+			Actor:           fakeActor,
+			ServiceActor:    fakeServiceActor,
+			NetworkingActor: fakeNetworkingActor,
+		}
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("exporting the space succeeds", func() {
+		var manifestApps []manifest.Application
+
+		BeforeEach(func() {
+			manifestApps = []manifest.Application{{Name: "app-1"}, {Name: "app-2"}}
+			fakeActor.CreateApplicationManifestsBySpaceReturns(manifestApps, v2v3action.Warnings{"manifest-warning"}, nil)
+
+			fakeServiceActor.GetServiceInstancesSummaryBySpaceReturns([]v2action.ServiceInstanceSummary{
+				{
+					ServiceInstance: v2action.ServiceInstance{
+						Name: "some-ups",
+						Type: constant.ServiceInstanceTypeUserProvidedService,
+						Tags: []string{"ups-tag"},
+					},
+					BoundApplications: []v2action.BoundApplication{{AppName: "app-2"}},
+				},
+				{
+					ServiceInstance: v2action.ServiceInstance{
+						Name: "some-db",
+						Type: constant.ServiceInstanceTypeManagedService,
+						Tags: []string{"db-tag"},
+					},
+					Service:     v2action.Service{Label: "some-service"},
+					ServicePlan: v2action.ServicePlan{Name: "some-plan"},
+					BoundApplications: []v2action.BoundApplication{
+						{AppName: "app-2"},
+						{AppName: "app-1"},
+					},
+				},
+				{
+					ServiceInstance: v2action.ServiceInstance{
+						Name: "some-shared-cache",
+						Type: constant.ServiceInstanceTypeManagedService,
+					},
+					Service:                  v2action.Service{Label: "some-cache"},
+					ServicePlan:              v2action.ServicePlan{Name: "some-cache-plan"},
+					ServiceInstanceShareType: v2action.ServiceInstanceIsSharedFrom,
+					ServiceInstanceSharedFrom: v2action.ServiceInstanceSharedFrom{
+						OrganizationName: "other-org",
+						SpaceName:        "other-space",
+					},
+				},
+			}, v2action.Warnings{"service-warning"}, nil)
+
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns([]cfnetworkingaction.Policy{
+				{SourceName: "app-1", DestinationName: "app-2", Protocol: "tcp", StartPort: 8080, EndPort: 8080},
+				{SourceName: "app-2", DestinationName: "app-1", Protocol: "udp", StartPort: 9000, EndPort: 9010},
+			}, cfnetworkingaction.Warnings{"policy-warning"}, nil)
+		})
+
+		It("writes the manifest for every app in the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Exporting space some-space in org some-org as some-user\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Manifest file for 2 app\\(s\\) created successfully at some-manifest-path"))
+			Expect(testUI.Out).To(Say("Services file created successfully at %s", servicesPath))
+			Expect(testUI.Err).To(Say("manifest-warning"))
+			Expect(testUI.Err).To(Say("service-warning"))
+			Expect(testUI.Err).To(Say("policy-warning"))
+
+			Expect(fakeActor.CreateApplicationManifestsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeServiceActor.GetServiceInstancesSummaryBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeNetworkingActor.NetworkPoliciesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.WriteApplicationsManifestCallCount()).To(Equal(1))
+			apps, manifestPath := fakeActor.WriteApplicationsManifestArgsForCall(0)
+			Expect(apps).To(Equal(manifestApps))
+			Expect(manifestPath).To(Equal("some-manifest-path"))
+		})
+
+		It("writes the services and network policies to the services file", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			servicesBytes, err := ioutil.ReadFile(servicesPath)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(servicesBytes)).To(Equal(`service_instances:
+- name: some-db
+  service: some-service
+  plan: some-plan
+  tags:
+  - db-tag
+  bound_apps:
+  - app-1
+  - app-2
+- name: some-shared-cache
+  service: some-cache
+  plan: some-cache-plan
+  shared_from: other-org/other-space
+user_provided_services:
+- name: some-ups
+  tags:
+  - ups-tag
+  bound_apps:
+  - app-2
+network_policies:
+- source: app-1
+  destination: app-2
+  protocol: tcp
+  ports: "8080"
+- source: app-2
+  destination: app-1
+  protocol: udp
+  ports: 9000-9010
+`))
+		})
+
+		When("no paths are provided", func() {
+			var pwd string
+
+			BeforeEach(func() {
+				var err error
+				pwd, err = os.Getwd()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(os.Chdir(tmpDir)).To(Succeed())
+
+				cmd.ManifestPath = ""
+				cmd.ServicesPath = ""
+			})
+
+			AfterEach(func() {
+				Expect(os.Chdir(pwd)).To(Succeed())
+			})
+
+			It("writes the files to the current directory, named after the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, manifestPath := fakeActor.WriteApplicationsManifestArgsForCall(0)
+				Expect(manifestPath).To(Equal(fmt.Sprintf(".%ssome-space_manifest.yml", string(os.PathSeparator))))
+				Expect(filepath.Join(tmpDir, "some-space_services.yml")).To(BeAnExistingFile())
+			})
+		})
+
+		When("writing the manifest fails", func() {
+			BeforeEach(func() {
+				fakeActor.WriteApplicationsManifestReturns(errors.New("write-error"))
+			})
+
+			It("returns the error without writing the services file", func() {
+				Expect(executeErr).To(MatchError("write-error"))
+				Expect(servicesPath).ToNot(BeAnExistingFile())
+			})
+		})
+	})
+
+	When("creating the manifests fails", func() {
+		BeforeEach(func() {
+			fakeActor.CreateApplicationManifestsBySpaceReturns(nil, v2v3action.Warnings{"manifest-warning"}, errors.New("manifest-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("manifest-error"))
+			Expect(testUI.Err).To(Say("manifest-warning"))
+			Expect(fakeActor.WriteApplicationsManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the services fails", func() {
+		BeforeEach(func() {
+			fakeServiceActor.GetServiceInstancesSummaryBySpaceReturns(nil, v2action.Warnings{"service-warning"}, errors.New("service-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("service-error"))
+			Expect(testUI.Err).To(Say("service-warning"))
+			Expect(fakeActor.WriteApplicationsManifestCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the network policies fails", func() {
+		BeforeEach(func() {
+			fakeNetworkingActor.NetworkPoliciesBySpaceReturns(nil, cfnetworkingaction.Warnings{"policy-warning"}, errors.New("policy-error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("policy-error"))
+			Expect(testUI.Err).To(Say("policy-warning"))
+			Expect(fakeActor.WriteApplicationsManifestCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,101 @@
+package v6
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . IgnoreCheckActor
+
+type IgnoreCheckActor interface {
+	CheckIgnoredFiles(sourceDir string) ([]sharedaction.IgnoreDecision, error)
+}
+
+type IgnoreCheckCommand struct {
+	RequiredArgs        flag.AppDirectory `positional-args:"yes"`
+	usage               interface{}       `usage:"CF_NAME ignore-check PATH"`
+	relatedCommands     interface{}       `related_commands:"push"`
+	envCFHonorGitIgnore interface{}       `environmentName:"CF_HONOR_GITIGNORE" environmentDescription:"Also exclude files matched by .gitignore files from the app bits" environmentDefault:"false"`
+
+	UI    command.UI
+	Actor IgnoreCheckActor
+}
+
+func (cmd *IgnoreCheckCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Actor = sharedaction.NewActor(config)
+	return nil
+}
+
+func (cmd IgnoreCheckCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Checking ignore rules for {{.Path}}...", map[string]interface{}{
+		"Path": cmd.RequiredArgs.Path,
+	})
+	cmd.UI.DisplayNewline()
+
+	decisions, err := cmd.Actor.CheckIgnoredFiles(string(cmd.RequiredArgs.Path))
+	if err != nil {
+		return err
+	}
+
+	if len(decisions) == 0 {
+		cmd.UI.DisplayText("No files found.")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("decision"),
+			cmd.UI.TranslateText("file"),
+			cmd.UI.TranslateText("rule"),
+		},
+	}
+
+	var excludedCount int
+	for _, decision := range decisions {
+		status := cmd.UI.TranslateText("included")
+		if decision.Ignored {
+			status = cmd.UI.TranslateText("excluded")
+			excludedCount++
+		}
+
+		filename := decision.Filename
+		if decision.IsDir {
+			filename += "/"
+		}
+
+		table = append(table, []string{
+			status,
+			filename,
+			cmd.ruleDescription(decision),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("{{.IncludedCount}} included, {{.ExcludedCount}} excluded", map[string]interface{}{
+		"IncludedCount": len(decisions) - excludedCount,
+		"ExcludedCount": excludedCount,
+	})
+
+	return nil
+}
+
+// ruleDescription formats the rule that made a decision the same way
+// 'git check-ignore --verbose' does: SOURCE:LINE:PATTERN.
+func (cmd IgnoreCheckCommand) ruleDescription(decision sharedaction.IgnoreDecision) string {
+	switch {
+	case !decision.Matched:
+		return ""
+	case decision.Rule.Source == "":
+		return cmd.UI.TranslateText("built-in: {{.Pattern}}", map[string]interface{}{
+			"Pattern": decision.Rule.Pattern,
+		})
+	default:
+		return fmt.Sprintf("%s:%d:%s", decision.Rule.Source, decision.Rule.Line, decision.Rule.Pattern)
+	}
+}
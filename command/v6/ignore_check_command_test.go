@@ -0,0 +1,87 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ignore-check Command", func() {
+	var (
+		cmd        IgnoreCheckCommand
+		testUI     *ui.UI
+		fakeActor  *v6fakes.FakeIgnoreCheckActor
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v6fakes.FakeIgnoreCheckActor)
+
+		cmd = IgnoreCheckCommand{
+			RequiredArgs: flag.AppDirectory{Path: "some-app-dir"},
+			UI:           testUI,
+			Actor:        fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking the files succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.CheckIgnoredFilesReturns([]sharedaction.IgnoreDecision{
+				{Filename: ".cfignore", Ignored: true, Matched: true, Rule: sharedaction.IgnoreRule{Pattern: ".cfignore"}},
+				{Filename: "app.js"},
+				{Filename: "node_modules", IsDir: true, Ignored: true, Matched: true, Rule: sharedaction.IgnoreRule{Source: ".cfignore", Line: 1, Pattern: "node_modules/"}},
+				{Filename: "sub", IsDir: true},
+				{Filename: "sub/keep.log", Matched: true, Rule: sharedaction.IgnoreRule{Source: "sub/.cfignore", Line: 3, Pattern: "!keep.log"}},
+			}, nil)
+		})
+
+		It("displays each decision and the rule that caused it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CheckIgnoredFilesCallCount()).To(Equal(1))
+			Expect(fakeActor.CheckIgnoredFilesArgsForCall(0)).To(Equal("some-app-dir"))
+
+			Expect(testUI.Out).To(Say(`Checking ignore rules for some-app-dir\.\.\.`))
+			Expect(testUI.Out).To(Say(`decision\s+file\s+rule`))
+			Expect(testUI.Out).To(Say(`excluded\s+\.cfignore\s+built-in: \.cfignore`))
+			Expect(testUI.Out).To(Say(`included\s+app\.js\s*\n`))
+			Expect(testUI.Out).To(Say(`excluded\s+node_modules/\s+\.cfignore:1:node_modules/`))
+			Expect(testUI.Out).To(Say(`included\s+sub/\s*\n`))
+			Expect(testUI.Out).To(Say(`included\s+sub/keep\.log\s+sub/\.cfignore:3:!keep\.log`))
+			Expect(testUI.Out).To(Say(`3 included, 2 excluded`))
+		})
+	})
+
+	When("the directory is empty", func() {
+		BeforeEach(func() {
+			fakeActor.CheckIgnoredFilesReturns(nil, nil)
+		})
+
+		It("displays that no files were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No files found."))
+		})
+	})
+
+	When("checking the files fails", func() {
+		BeforeEach(func() {
+			fakeActor.CheckIgnoredFilesReturns(nil, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
@@ -1,12 +1,18 @@
 package v6
 
 import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
 type LoginCommand struct {
 	APIEndpoint       string      `short:"a" description:"API endpoint (e.g. https://api.example.com)"`
+	Browser           bool        `long:"browser" description:"Log in through a browser on this machine"`
+	DeviceCode        bool        `long:"device-code" description:"Log in by approving a one-time code in a browser on any device"`
 	Organization      string      `short:"o" description:"Org"`
 	Password          string      `short:"p" description:"Password"`
 	Space             string      `short:"s" description:"Space"`
@@ -14,14 +20,91 @@ type LoginCommand struct {
 	SSO               bool        `long:"sso" description:"Prompt for a one-time passcode to login"`
 	SSOPasscode       string      `long:"sso-passcode" description:"One-time passcode"`
 	Username          string      `short:"u" description:"Username"`
-	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)"`
+	usage             interface{} `usage:"CF_NAME login [-a API_URL] [-u USERNAME] [-p PASSWORD] [-o ORG] [-s SPACE] [--sso | --sso-passcode PASSCODE]\n   CF_NAME login [--device-code | --browser]\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME login (omit username and password to login interactively -- CF_NAME will prompt for both)\n   CF_NAME login -u name@example.com -p pa55woRD (specify username and password as arguments)\n   CF_NAME login -u name@example.com -p \"my password\" (use quotes for passwords with a space)\n   CF_NAME login -u name@example.com -p \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME login --sso (CF_NAME will provide a url to obtain a one-time passcode to login)\n   CF_NAME login --device-code (CF_NAME will provide a url and a code to approve the login from any device)\n   CF_NAME login --browser (CF_NAME will provide a url to log in with a browser on this machine)"`
 	relatedCommands   interface{} `related_commands:"api, auth, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  AuthActor
 }
 
-func (LoginCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *LoginCommand) Setup(config command.Config, ui command.UI) error {
+	if !cmd.DeviceCode && !cmd.Browser {
+		return nil
+	}
+
+	cmd.UI = ui
+	cmd.Config = config
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (LoginCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (cmd LoginCommand) Execute(args []string) error {
+	if !cmd.DeviceCode && !cmd.Browser {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	var conflictingFlags []string
+	if cmd.APIEndpoint != "" {
+		conflictingFlags = append(conflictingFlags, "-a")
+	}
+	if cmd.Username != "" {
+		conflictingFlags = append(conflictingFlags, "-u")
+	}
+	if cmd.Password != "" {
+		conflictingFlags = append(conflictingFlags, "-p")
+	}
+	if cmd.Organization != "" {
+		conflictingFlags = append(conflictingFlags, "-o")
+	}
+	if cmd.Space != "" {
+		conflictingFlags = append(conflictingFlags, "-s")
+	}
+	if cmd.SSO {
+		conflictingFlags = append(conflictingFlags, "--sso")
+	}
+	if cmd.SSOPasscode != "" {
+		conflictingFlags = append(conflictingFlags, "--sso-passcode")
+	}
+
+	err := checkInteractiveLoginFlags(cmd.DeviceCode, cmd.Browser, conflictingFlags)
+	if err != nil {
+		return err
+	}
+
+	err = command.WarnIfCLIVersionBelowAPIDefinedMinimum(cmd.Config, cmd.UI)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor(
+		"API endpoint: {{.Endpoint}}",
+		map[string]interface{}{
+			"Endpoint": cmd.Config.Target(),
+		})
+	cmd.UI.DisplayNewline()
+
+	if cmd.DeviceCode {
+		err = authenticateWithDeviceCode(cmd.Actor, cmd.UI)
+	} else {
+		err = authenticateWithBrowser(cmd.Actor, cmd.UI)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayTextWithFlavor(
+		"Use '{{.Command}}' to view or set your target org and space.",
+		map[string]interface{}{
+			"Command": fmt.Sprintf("%s target", cmd.Config.BinaryName()),
+		})
+
+	return nil
 }
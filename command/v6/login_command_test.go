@@ -0,0 +1,128 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("login Command", func() {
+	var (
+		cmd        LoginCommand
+		testUI     *ui.UI
+		fakeActor  *v6fakes.FakeAuthActor
+		fakeConfig *commandfakes.FakeConfig
+		binaryName string
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeActor = new(v6fakes.FakeAuthActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = LoginCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetReturns("some-api-target")
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("neither --device-code nor --browser is set", func() {
+		It("falls back to the legacy login", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+		})
+	})
+
+	When("--device-code is set", func() {
+		BeforeEach(func() {
+			cmd.DeviceCode = true
+			fakeActor.GetDeviceAuthorizationReturns(v2action.DeviceAuthorization{
+				DeviceCode:      "some-device-code",
+				UserCode:        "ABCD-EFGH",
+				VerificationURI: "https://login.example.com/device",
+			}, nil)
+		})
+
+		It("logs in with the device code", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("API endpoint: some-api-target"))
+			Expect(testUI.Out).To(Say(`To log in, visit https://login\.example\.com/device and enter the code ABCD-EFGH`))
+			Expect(testUI.Out).ToNot(Say("Or open"))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use '%s target' to view or set your target org and space", binaryName))
+
+			Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(1))
+		})
+
+		When("getting the device authorization fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetDeviceAuthorizationReturns(v2action.DeviceAuthorization{}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(fakeActor.AuthenticateWithDeviceCodeCallCount()).To(Equal(0))
+			})
+		})
+
+		When("other login flags are provided", func() {
+			BeforeEach(func() {
+				cmd.Username = "some-user"
+				cmd.SSO = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+					Args: []string{"--device-code", "-u", "--sso"},
+				}))
+			})
+		})
+	})
+
+	When("--browser is set", func() {
+		BeforeEach(func() {
+			cmd.Browser = true
+			fakeActor.StartBrowserLoginReturns(v2action.BrowserLogin{
+				AuthorizationURL: "https://login.example.com/oauth/authorize?some-query",
+			}, nil)
+		})
+
+		It("logs in through the browser", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`https://login\.example\.com/oauth/authorize\?some-query`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(fakeActor.AuthenticateWithBrowserLoginCallCount()).To(Equal(1))
+		})
+
+		When("the browser login fails", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateWithBrowserLoginReturns(errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
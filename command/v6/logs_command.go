@@ -1,33 +1,51 @@
 package v6
 
 import (
+	"sort"
+	"strings"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
-	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetLogCacheLogsForApplicationByNameAndSpace(appName string, spaceGUID string, query v2action.LogQuery, client v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, query v2action.LogQuery, client v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplicationsByNameAndSpace(appNames []string, spaceGUID string, client v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
-
-	UI          command.UI
-	Config      command.Config
-	SharedActor command.SharedActor
-	Actor       LogsActor
-	NOAAClient  *consumer.Consumer
+	RequiredArgs    flag.AppNames        `positional-args:"yes"`
+	AllApps         bool                 `long:"all-apps" description:"Show logs of every app in the targeted space"`
+	Format          string               `long:"format" choice:"json" description:"Display each log message as a JSON object on its own line"`
+	Grep            flag.Regexp          `long:"grep" description:"Only show log messages matching the regular expression"`
+	Instance        []string             `long:"instance" description:"Only show logs from this instance index (can be repeated)"`
+	Lines           flag.PositiveInteger `long:"lines" description:"Dump at most this many of the most recent log lines (implies --recent)"`
+	Recent          bool                 `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.LogTime         `long:"since" description:"Dump logs newer than a duration such as 30m or an RFC3339 time (implies --recent)"`
+	SourceType      []string             `long:"source-type" description:"Only show logs from this source type, such as APP, RTR, STG, API or CELL (can be repeated)"`
+	Stderr          bool                 `long:"stderr" description:"Only show logs written to stderr"`
+	Stdout          bool                 `long:"stdout" description:"Only show logs written to stdout"`
+	Until           flag.LogTime         `long:"until" description:"Dump logs older than a duration such as 30m or an RFC3339 time (implies --recent)"`
+	usage           interface{}          `usage:"CF_NAME logs (APP_NAME... | --all-apps) [--recent] [--since TIME] [--until TIME] [--lines NUMBER]\n   [--source-type TYPE]... [--instance INDEX]... [--stdout | --stderr] [--grep REGEX] [--format json]\n\nEXAMPLES:\n   CF_NAME logs my-app --recent\n   CF_NAME logs my-app my-worker\n   CF_NAME logs --all-apps --source-type APP\n   CF_NAME logs my-app --since 2h --until 90m\n   CF_NAME logs my-app --since 2019-06-01T10:00:00Z --lines 500\n   CF_NAME logs my-app --source-type RTR --grep ' 5[0-9][0-9] ' --format json"`
+	relatedCommands interface{}          `related_commands:"app, apps, ssh"`
+
+	UI             command.UI
+	Config         command.Config
+	SharedActor    command.SharedActor
+	Actor          LogsActor
+	NOAAClient     *consumer.Consumer
+	LogCacheClient v2action.LogCacheClient
 }
 
 func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,10 +61,41 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 
 	cmd.NOAAClient = shared.NewNOAAClient(ccClient.DopplerEndpoint(), config, uaaClient, ui)
 
+	if cmd.recent() {
+		// Recent logs fall back to NOAA when the Log Cache endpoint cannot be
+		// discovered.
+		logCacheURL, err := shared.LogCacheEndpoint(config, ui)
+		if err == nil && logCacheURL != "" {
+			cmd.LogCacheClient = shared.NewLogCacheClient(logCacheURL, config, uaaClient, ui)
+		}
+	}
+
 	return nil
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	if len(cmd.RequiredArgs.AppNames) == 0 && !cmd.AllApps {
+		return translatableerror.RequiredArgumentError{
+			ArgumentName: "APP_NAME",
+		}
+	}
+
+	if len(cmd.RequiredArgs.AppNames) > 0 && cmd.AllApps {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"APP_NAME", "--all-apps"},
+		}
+	}
+
+	if cmd.Stdout && cmd.Stderr {
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--stdout", "--stderr"},
+		}
+	}
+
+	if !cmd.Since.IsZero() && !cmd.Until.IsZero() && !cmd.Since.Before(cmd.Until.Time) {
+		return translatableerror.LogTimeRangeError{}
+	}
+
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
 		return err
@@ -57,49 +106,187 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	appNames := cmd.RequiredArgs.AppNames
+	if cmd.AllApps {
+		apps, warnings, err := cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if len(apps) == 0 {
+			cmd.UI.DisplayText("No apps found")
+			return nil
+		}
 
-	if cmd.Recent {
-		return cmd.displayRecentLogs()
+		appNames = nil
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+	}
+
+	// JSON output is meant to be piped, so it only contains log messages.
+	if cmd.Format != "json" {
+		if len(appNames) == 1 {
+			cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+				map[string]interface{}{
+					"AppName":   appNames[0],
+					"OrgName":   cmd.Config.TargetedOrganization().Name,
+					"SpaceName": cmd.Config.TargetedSpace().Name,
+					"Username":  user.Name,
+				})
+		} else {
+			cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+				map[string]interface{}{
+					"AppNames":  strings.Join(appNames, ", "),
+					"OrgName":   cmd.Config.TargetedOrganization().Name,
+					"SpaceName": cmd.Config.TargetedSpace().Name,
+					"Username":  user.Name,
+				})
+		}
+		cmd.UI.DisplayNewline()
 	}
 
-	return cmd.streamLogs()
+	if cmd.recent() {
+		return cmd.displayRecentLogs(appNames)
+	}
+
+	return cmd.streamLogs(appNames)
 }
 
-func (cmd LogsCommand) displayRecentLogs() error {
-	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
-		cmd.Config.TargetedSpace().GUID,
-		cmd.NOAAClient,
+// filter returns the client side filter selected by the flags.
+func (cmd LogsCommand) filter() v2action.LogFilter {
+	filter := v2action.LogFilter{
+		SourceTypes:     cmd.SourceType,
+		SourceInstances: cmd.Instance,
+		Pattern:         cmd.Grep.Regexp,
+	}
+	if cmd.Stdout {
+		filter.Types = []string{"OUT"}
+	}
+	if cmd.Stderr {
+		filter.Types = []string{"ERR"}
+	}
+	return filter
+}
+
+// displayLogMessage displays the message in the selected format. The
+// application name is displayed in front of the message when the logs of
+// several applications are shown.
+func (cmd LogsCommand) displayLogMessage(message v2action.LogMessage, showAppName bool) error {
+	if cmd.Format == "json" {
+		return cmd.UI.DisplayLogMessageJSON(message.ApplicationName(), message)
+	}
+
+	if showAppName {
+		cmd.UI.DisplayLogMessageWithAppName(message.ApplicationName(), message, true)
+		return nil
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
+
+// recent returns true if the logs should be dumped instead of tailed.
+func (cmd LogsCommand) recent() bool {
+	return cmd.Recent || cmd.Lines.Value > 0 || !cmd.Since.IsZero() || !cmd.Until.IsZero()
+}
+
+func (cmd LogsCommand) displayRecentLogs(appNames []string) error {
+	query := v2action.LogQuery{
+		Since:  cmd.Since.Time,
+		Until:  cmd.Until.Time,
+		Lines:  cmd.Lines.Value,
+		Filter: cmd.filter(),
+	}
+
+	var (
+		messages []v2action.LogMessage
+		warnings v2action.Warnings
+		err      error
 	)
+	for _, appName := range appNames {
+		var (
+			appMessages []v2action.LogMessage
+			appWarnings v2action.Warnings
+		)
+		appMessages, appWarnings, err = cmd.getRecentLogs(appName, query)
+		messages = append(messages, appMessages...)
+		warnings = append(warnings, appWarnings...)
+		if err != nil {
+			break
+		}
+	}
+
+	if len(appNames) > 1 {
+		// Interleave the logs of all applications and keep the most recent
+		// lines across all of them.
+		sort.SliceStable(messages, func(i int, j int) bool {
+			return messages[i].Timestamp().Before(messages[j].Timestamp())
+		})
+		if query.Lines > 0 && len(messages) > query.Lines {
+			messages = messages[len(messages)-query.Lines:]
+		}
+	}
 
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		displayErr := cmd.displayLogMessage(message, len(appNames) > 1)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	return err
 }
 
-func (cmd LogsCommand) streamLogs() error {
-	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+// getRecentLogs reads the recent logs of a single application from Log Cache
+// when it is available, and from NOAA otherwise.
+func (cmd LogsCommand) getRecentLogs(appName string, query v2action.LogQuery) ([]v2action.LogMessage, v2action.Warnings, error) {
+	if cmd.LogCacheClient != nil {
+		return cmd.Actor.GetLogCacheLogsForApplicationByNameAndSpace(
+			appName,
+			cmd.Config.TargetedSpace().GUID,
+			query,
+			cmd.LogCacheClient,
+		)
+	}
+
+	return cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
+		appName,
 		cmd.Config.TargetedSpace().GUID,
+		query,
 		cmd.NOAAClient,
 	)
+}
+
+func (cmd LogsCommand) streamLogs(appNames []string) error {
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+		warnings v2action.Warnings
+		err      error
+	)
+	if len(appNames) == 1 {
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
+			appNames[0],
+			cmd.Config.TargetedSpace().GUID,
+			cmd.NOAAClient,
+		)
+	} else {
+		messages, logErrs, warnings, err = cmd.Actor.GetStreamingLogsForApplicationsByNameAndSpace(
+			appNames,
+			cmd.Config.TargetedSpace().GUID,
+			cmd.NOAAClient,
+		)
+	}
 
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
+	filter := cmd.filter()
 	var messagesClosed, errLogsClosed bool
 	for {
 		select {
@@ -109,7 +296,15 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			if !filter.Matches(*message) {
+				break
+			}
+
+			err = cmd.displayLogMessage(*message, len(appNames) > 1)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -2,11 +2,15 @@ package v6_test
 
 import (
 	"errors"
+	"regexp"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
 	. "code.cloudfoundry.org/cli/command/v6"
 	"code.cloudfoundry.org/cli/command/v6/v6fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -46,7 +50,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -54,6 +58,46 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	When("no app name is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.RequiredArgumentError{
+				ArgumentName: "APP_NAME",
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both app names and --all-apps are provided", func() {
+		BeforeEach(func() {
+			cmd.AllApps = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"APP_NAME", "--all-apps"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	When("both --stdout and --stderr are provided", func() {
+		BeforeEach(func() {
+			cmd.Stdout = true
+			cmd.Stderr = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{
+				Args: []string{"--stdout", "--stderr"},
+			}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	When("the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -138,12 +182,92 @@ var _ = Describe("logs command", func() {
 					Expect(testUI.Out).To(Say("i am message 2"))
 
 					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
-					appName, spaceGUID, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					appName, spaceGUID, query, client := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
 
 					Expect(appName).To(Equal("some-app"))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(query).To(Equal(v2action.LogQuery{}))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				When("filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Instance = []string{"1"}
+						cmd.Grep = flag.Regexp{Regexp: regexp.MustCompile("message [0-9]")}
+					})
+
+					It("passes the filters to the actor so that they apply before the lines are counted", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+						_, _, query, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+						Expect(query.Filter.SourceInstances).To(Equal([]string{"1"}))
+						Expect(query.Filter.Pattern.String()).To(Equal("message [0-9]"))
+					})
+				})
+			})
+
+			When("Log Cache is available", func() {
+				var fakeLogCacheClient *v2actionfakes.FakeLogCacheClient
+
+				BeforeEach(func() {
+					fakeLogCacheClient = new(v2actionfakes.FakeLogCacheClient)
+					cmd.LogCacheClient = fakeLogCacheClient
+
+					fakeActor.GetLogCacheLogsForApplicationByNameAndSpaceReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am a cached message", 1, time.Unix(0, 0), "app", "1"),
+						},
+						v2action.Warnings{"some-warning"},
+						nil)
+				})
+
+				It("reads the logs from Log Cache", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("some-warning"))
+					Expect(testUI.Out).To(Say("i am a cached message"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.GetLogCacheLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					appName, spaceGUID, query, client := fakeActor.GetLogCacheLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("some-app"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(query).To(Equal(v2action.LogQuery{}))
+					Expect(client).To(Equal(fakeLogCacheClient))
+				})
+			})
+		})
+
+		When("a time window or number of lines is provided", func() {
+			var since, until time.Time
+
+			BeforeEach(func() {
+				since = time.Date(2019, 6, 1, 10, 0, 0, 0, time.UTC)
+				until = since.Add(time.Hour)
+
+				cmd.Since = flag.LogTime{Time: since}
+				cmd.Until = flag.LogTime{Time: until}
+				cmd.Lines = flag.PositiveInteger{Value: 50}
+			})
+
+			It("dumps the recent logs matching the query instead of tailing", func() {
+				Expect(executeErr).NotTo(HaveOccurred())
+				Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+
+				_, _, query, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+				Expect(query).To(Equal(v2action.LogQuery{Since: since, Until: until, Lines: 50}))
+			})
+
+			When("--since is not earlier than --until", func() {
+				BeforeEach(func() {
+					cmd.Until = flag.LogTime{Time: since}
+				})
+
+				It("returns a LogTimeRangeError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.LogTimeRangeError{}))
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
 			})
 		})
 
@@ -244,6 +368,199 @@ var _ = Describe("logs command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(client).To(Equal(noaaClient))
 				})
+
+				When("filters are provided", func() {
+					BeforeEach(func() {
+						cmd.SourceType = []string{"ANOTHER-APP"}
+						cmd.Stdout = true
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("i am message 1"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+
+				When("the --format json flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Format = "json"
+					})
+
+					It("only displays each log message as a JSON object on its own line", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`^\{"timestamp":"[^"]+","source_type":"app","source_instance":"1","type":"OUT","message":"i am message 1"\}\n`))
+						Expect(testUI.Out).To(Say(`^\{"timestamp":"[^"]+","source_type":"another-app","source_instance":"2","type":"OUT","message":"i am message 2"\}\n`))
+					})
+				})
+			})
+		})
+
+		When("several app names are provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = []string{"app-1", "app-2"}
+			})
+
+			When("the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+
+					fakeActor.GetRecentLogsForApplicationByNameAndSpaceStub = func(appName string, _ string, _ v2action.LogQuery, _ v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error) {
+						offset := int64(0)
+						if appName == "app-2" {
+							offset = 1
+						}
+						return []v2action.LogMessage{
+							*v2action.NewApplicationLogMessage(appName, "first message of "+appName, 1, time.Unix(offset, 0), "APP/PROC/WEB", "0"),
+							*v2action.NewApplicationLogMessage(appName, "second message of "+appName, 1, time.Unix(offset+2, 0), "APP/PROC/WEB", "0"),
+						}, v2action.Warnings{appName + "-warning"}, nil
+					}
+				})
+
+				It("displays flavor text", func() {
+					Expect(testUI.Out).To(Say("Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user..."))
+				})
+
+				It("displays the recent logs of all apps in order, prefixed with the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("app-1-warning"))
+					Expect(testUI.Err).To(Say("app-2-warning"))
+
+					Expect(testUI.Out).To(Say("app-1 .* first message of app-1"))
+					Expect(testUI.Out).To(Say("app-2 .* first message of app-2"))
+					Expect(testUI.Out).To(Say("app-1 .* second message of app-1"))
+					Expect(testUI.Out).To(Say("app-2 .* second message of app-2"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(2))
+				})
+
+				When("the number of lines is limited", func() {
+					BeforeEach(func() {
+						cmd.Lines = flag.PositiveInteger{Value: 3}
+					})
+
+					It("only displays the most recent lines across all apps", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).NotTo(Say("first message of app-1"))
+						Expect(testUI.Out).To(Say("first message of app-2"))
+						Expect(testUI.Out).To(Say("second message of app-1"))
+						Expect(testUI.Out).To(Say("second message of app-2"))
+					})
+				})
+
+				When("getting the logs of an app fails", func() {
+					var expectedErr error
+
+					BeforeEach(func() {
+						expectedErr = actionerror.ApplicationNotFoundError{Name: "app-1"}
+						fakeActor.GetRecentLogsForApplicationByNameAndSpaceReturnsOnCall(0, nil, v2action.Warnings{"app-1-warning"}, expectedErr)
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError(expectedErr))
+						Expect(testUI.Err).To(Say("app-1-warning"))
+						Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			When("the --recent flag is not provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceStub = func(_ []string, _ string, _ v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewApplicationLogMessage("app-1", "i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+							messages <- v2action.NewApplicationLogMessage("app-2", "i am message 2", 1, time.Unix(1, 0), "APP/PROC/WEB", "0")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs, v2action.Warnings{"some-warning"}, nil
+					}
+				})
+
+				It("tails the logs of all apps, prefixed with the app name", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("some-warning"))
+
+					Expect(testUI.Out).To(Say("app-1 .* i am message 1"))
+					Expect(testUI.Out).To(Say("app-2 .* i am message 2"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceCallCount()).To(Equal(1))
+					appNames, spaceGUID, client := fakeActor.GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(0)
+					Expect(appNames).To(Equal([]string{"app-1", "app-2"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+					Expect(client).To(Equal(noaaClient))
+				})
+
+				When("the --format json flag is provided", func() {
+					BeforeEach(func() {
+						cmd.Format = "json"
+					})
+
+					It("includes the app name in each JSON object", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say(`^\{"app":"app-1","timestamp":"[^"]+",.*"message":"i am message 1"\}\n`))
+						Expect(testUI.Out).To(Say(`^\{"app":"app-2","timestamp":"[^"]+",.*"message":"i am message 2"\}\n`))
+					})
+				})
+			})
+		})
+
+		When("the --all-apps flag is provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.AppNames = nil
+				cmd.AllApps = true
+				cmd.Recent = true
+			})
+
+			When("the space has apps", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns(
+						[]v2action.Application{{Name: "app-1"}, {Name: "app-2"}},
+						v2action.Warnings{"apps-warning"},
+						nil)
+				})
+
+				It("displays the logs of every app in the space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("apps-warning"))
+					Expect(testUI.Out).To(Say("Retrieving logs for apps app-1, app-2 in org some-org-name / space some-space-name as some-user..."))
+
+					Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(1))
+					Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(2))
+					appName, _, _, _ := fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(0)
+					Expect(appName).To(Equal("app-1"))
+					appName, _, _, _ = fakeActor.GetRecentLogsForApplicationByNameAndSpaceArgsForCall(1)
+					Expect(appName).To(Equal("app-2"))
+				})
+			})
+
+			When("the space has no apps", func() {
+				It("displays that no apps were found", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("No apps found"))
+					Expect(fakeActor.GetRecentLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("getting the apps fails", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("some-error")
+					fakeActor.GetApplicationsBySpaceReturns(nil, v2action.Warnings{"apps-warning"}, expectedErr)
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(testUI.Err).To(Say("apps-warning"))
+				})
 			})
 		})
 	})
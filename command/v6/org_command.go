@@ -30,6 +30,16 @@ type OrgActorV3 interface {
 	CloudControllerAPIVersion() string
 }
 
+type orgRecord struct {
+	Name                    string   `json:"name" yaml:"name"`
+	GUID                    string   `json:"guid" yaml:"guid"`
+	Domains                 []string `json:"domains" yaml:"domains"`
+	Quota                   string   `json:"quota" yaml:"quota"`
+	Spaces                  []string `json:"spaces" yaml:"spaces"`
+	IsolationSegments       []string `json:"isolation_segments,omitempty" yaml:"isolation_segments,omitempty"`
+	DefaultIsolationSegment string   `json:"default_isolation_segment,omitempty" yaml:"default_isolation_segment,omitempty"`
+}
+
 type OrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	GUID            bool              `long:"guid" description:"Retrieve and display the given org's guid.  All other output for the org is suppressed."`
@@ -66,6 +76,8 @@ func (cmd *OrgCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (OrgCommand) SupportsStructuredOutput() {}
+
 func (cmd OrgCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -88,7 +100,7 @@ func (cmd OrgCommand) displayOrgGUID() error {
 
 	cmd.UI.DisplayText(org.GUID)
 
-	return nil
+	return cmd.UI.DisplayRecord(map[string]string{"guid": org.GUID})
 }
 
 func (cmd OrgCommand) displayOrgSummary() error {
@@ -111,6 +123,14 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		return err
 	}
 
+	record := orgRecord{
+		Name:    orgSummary.Name,
+		GUID:    orgSummary.GUID,
+		Domains: orgSummary.DomainNames,
+		Quota:   orgSummary.QuotaName,
+		Spaces:  orgSummary.SpaceNames,
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), orgSummary.Name},
 		{cmd.UI.TranslateText("domains:"), strings.Join(orgSummary.DomainNames, ", ")},
@@ -129,12 +149,15 @@ func (cmd OrgCommand) displayOrgSummary() error {
 
 			isolationSegmentNames := []string{}
 			for _, iso := range isolationSegments {
+				record.IsolationSegments = append(record.IsolationSegments, iso.Name)
 				if iso.GUID == orgSummary.DefaultIsolationSegmentGUID {
+					record.DefaultIsolationSegment = iso.Name
 					isolationSegmentNames = append(isolationSegmentNames, fmt.Sprintf("%s (%s)", iso.Name, cmd.UI.TranslateText("default")))
 				} else {
 					isolationSegmentNames = append(isolationSegmentNames, iso.Name)
 				}
 			}
+			sort.Strings(record.IsolationSegments)
 
 			sort.Strings(isolationSegmentNames)
 			table = append(table, []string{cmd.UI.TranslateText("isolation segments:"), strings.Join(isolationSegmentNames, ", ")})
@@ -143,5 +166,5 @@ func (cmd OrgCommand) displayOrgSummary() error {
 
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
-	return nil
+	return cmd.UI.DisplayRecord(record)
 }
@@ -14,6 +14,15 @@ type OrgsActor interface {
 	GetOrganizations() ([]v2action.Organization, v2action.Warnings, error)
 }
 
+type orgsRecord struct {
+	Organizations []organizationRecord `json:"organizations" yaml:"organizations"`
+}
+
+type organizationRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 type OrgsCommand struct {
 	usage interface{} `usage:"CF_NAME orgs"`
 
@@ -37,6 +46,8 @@ func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (OrgsCommand) SupportsStructuredOutput() {}
+
 func (cmd OrgsCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(false, false)
 	if err != nil {
@@ -65,7 +76,11 @@ func (cmd OrgsCommand) Execute(args []string) error {
 		cmd.displayOrgs(orgs)
 	}
 
-	return nil
+	record := orgsRecord{Organizations: []organizationRecord{}}
+	for _, org := range orgs {
+		record.Organizations = append(record.Organizations, organizationRecord{Name: org.Name, GUID: org.GUID})
+	}
+	return cmd.UI.DisplayRecord(record)
 }
 
 func (cmd OrgsCommand) displayOrgs(orgs []v2action.Organization) {
@@ -125,6 +125,23 @@ var _ = Describe("orgs Command", func() {
 
 					Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
 				})
+
+				When("the output format is json", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = configv3.OutputFormatJSON
+					})
+
+					It("displays the orgs as a json document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).ToNot(Say("Getting orgs"))
+						Expect(testUI.Out).To(Say(`"organizations": \[`))
+						Expect(testUI.Out).To(Say(`"name": "org-1"`))
+						Expect(testUI.Out).To(Say(`"name": "org-2"`))
+
+						Expect(testUI.Err).To(Say("get-orgs-warning"))
+					})
+				})
 			})
 
 			When("a translatable error is encountered getting orgs", func() {
@@ -4,6 +4,7 @@ import (
 	"os"
 	"path/filepath"
 
+	"code.cloudfoundry.org/bytefmt"
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
@@ -18,6 +19,7 @@ import (
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
+	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bosh-cli/director/template"
 	"github.com/cloudfoundry/noaa/consumer"
 	log "github.com/sirupsen/logrus"
@@ -40,6 +42,7 @@ type V2PushActor interface {
 	ConvertToApplicationConfigs(orgGUID string, spaceGUID string, noStart bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
 	ReadManifest(pathToManifest string, pathsToVarsFiles []string, vars []template.VarKV) ([]manifest.Application, pushaction.Warnings, error)
+	SetMatchedResources(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings)
 }
 
 type PushCommand struct {
@@ -63,6 +66,7 @@ type PushCommand struct {
 	AppPath             flag.PathWithExistenceCheck             `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	RandomRoute         bool                                    `long:"random-route" description:"Create a random route for this app"`
 	RoutePath           flag.RoutePath                          `long:"route-path" description:"Path for the route"`
+	ShowUploadPlan      bool                                    `long:"show-upload-plan" description:"List which app files would be resource-matched and which would be uploaded, then exit without pushing"`
 	StackName           string                                  `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
 	VarsFilePaths       []flag.PathWithExistenceCheck           `long:"vars-file" description:"Path to a variable substitution file for manifest; can specify multiple times"`
 	Vars                []template.VarKV                        `long:"var" description:"Variable key value pair for variable substitution, (e.g., name=app1); can specify multiple times"`
@@ -70,8 +74,9 @@ type PushCommand struct {
 	envCFStagingTimeout interface{}                             `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}                             `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	dockerPassword      interface{}                             `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
+	envCFHonorGitIgnore interface{}                             `environmentName:"CF_HONOR_GITIGNORE" environmentDescription:"Also exclude files matched by .gitignore files from the app bits" environmentDefault:"false"`
 
-	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
+	usage           interface{} `usage:"CF_NAME push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-p PATH] [-s STACK] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n   [--show-upload-plan]\n\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME]\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push APP_NAME --droplet DROPLET_PATH\n   [-c COMMAND] [-f MANIFEST_PATH | --no-manifest] [--no-start]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [-t HEALTH_TIMEOUT] [-u (process | port | http)]\n   [--no-route | --random-route | --hostname HOST | --no-hostname] [-d DOMAIN] [--route-path ROUTE_PATH] [--var KEY=VALUE]... [--vars-file VARS_FILE_PATH]...\n\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start]"`
 	relatedCommands interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
 
 	UI                      command.UI
@@ -192,6 +197,11 @@ func (cmd PushCommand) Execute(args []string) error {
 		cmd.UI.DisplayNewline()
 	}
 
+	if cmd.ShowUploadPlan {
+		cmd.displayUploadPlans(appConfigs)
+		return nil
+	}
+
 	for appNumber, appConfig := range appConfigs {
 		if appConfig.CreatingApplication() {
 			cmd.UI.DisplayTextWithFlavor("Creating app {{.AppName}}...", map[string]interface{}{
@@ -481,6 +491,69 @@ func (cmd PushCommand) processEvent(user configv3.User, appConfig pushaction.App
 	return false
 }
 
+// displayUploadPlans resource matches the files of each app and lists which
+// of them the Cloud Controller already has and which would be uploaded.
+func (cmd PushCommand) displayUploadPlans(appConfigs []pushaction.ApplicationConfig) {
+	for _, appConfig := range appConfigs {
+		cmd.UI.DisplayTextWithFlavor("Upload plan for app {{.AppName}}:", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+
+		if len(appConfig.AllResources) == 0 {
+			cmd.UI.DisplayText("No app files to upload.")
+			cmd.UI.DisplayNewline()
+			continue
+		}
+
+		matchedConfig, warnings := cmd.Actor.SetMatchedResources(appConfig)
+		cmd.UI.DisplayWarnings(warnings)
+
+		matched := map[string]bool{}
+		for _, resource := range matchedConfig.MatchedResources {
+			matched[resource.Filename] = true
+		}
+
+		table := [][]string{
+			{
+				cmd.UI.TranslateText("status"),
+				cmd.UI.TranslateText("size"),
+				cmd.UI.TranslateText("file"),
+			},
+		}
+
+		var matchedCount, uploadCount int
+		for _, resource := range matchedConfig.AllResources {
+			// Directories are recreated from the archive paths and are never
+			// matched or uploaded on their own.
+			if resource.SHA1 == "" && resource.Mode&os.ModeSymlink == 0 {
+				continue
+			}
+
+			status := cmd.UI.TranslateText("upload")
+			if matched[resource.Filename] {
+				status = cmd.UI.TranslateText("matched")
+				matchedCount++
+			} else {
+				uploadCount++
+			}
+
+			table = append(table, []string{
+				status,
+				bytefmt.ByteSize(uint64(resource.Size)),
+				resource.Filename,
+			})
+		}
+
+		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+		cmd.UI.DisplayNewline()
+		cmd.UI.DisplayText("{{.MatchedCount}} file(s) resource-matched, {{.UploadCount}} file(s) to upload", map[string]interface{}{
+			"MatchedCount": matchedCount,
+			"UploadCount":  uploadCount,
+		})
+		cmd.UI.DisplayNewline()
+	}
+}
+
 func (cmd PushCommand) validateArgs() error {
 	switch {
 	case cmd.DropletPath != "" && cmd.AppPath != "":
@@ -550,6 +623,14 @@ func (cmd PushCommand) validateArgs() error {
 		return translatableerror.ArgumentCombinationError{
 			Args: []string{"--route-path", "--no-route"},
 		}
+	case cmd.ShowUploadPlan && cmd.DockerImage.Path != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--show-upload-plan", "--docker-image, -o"},
+		}
+	case cmd.ShowUploadPlan && cmd.DropletPath != "":
+		return translatableerror.ArgumentCombinationError{
+			Args: []string{"--show-upload-plan", "--droplet"},
+		}
 	}
 
 	return nil
@@ -922,6 +922,61 @@ var _ = Describe("push Command", func() {
 						})
 					})
 
+					When("--show-upload-plan is provided", func() {
+						BeforeEach(func() {
+							cmd.ShowUploadPlan = true
+
+							appConfigs[0].AllResources = []v2action.Resource{
+								{Filename: "level1", Mode: 0755},
+								{Filename: "level1/matched-file", Mode: 0644, SHA1: "some-sha-1", Size: 2048},
+								{Filename: "level1/symlink", Mode: os.ModeSymlink | 0777},
+								{Filename: "new-file", Mode: 0644, SHA1: "some-sha-2", Size: 10},
+							}
+							fakeActor.ConvertToApplicationConfigsReturns(appConfigs, pushaction.Warnings{"some-config-warnings"}, nil)
+
+							fakeActor.SetMatchedResourcesStub = func(config pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings) {
+								config.MatchedResources = []v2action.Resource{config.AllResources[1]}
+								config.UnmatchedResources = []v2action.Resource{config.AllResources[0], config.AllResources[2], config.AllResources[3]}
+								return config, pushaction.Warnings{"resource-match-warning"}
+							}
+						})
+
+						It("lists the matched and uploaded files without pushing", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.SetMatchedResourcesCallCount()).To(Equal(1))
+							Expect(fakeActor.SetMatchedResourcesArgsForCall(0)).To(Equal(appConfigs[0]))
+
+							Expect(testUI.Out).To(Say(`Upload plan for app %s:`, appName))
+							Expect(testUI.Out).To(Say(`status\s+size\s+file`))
+							Expect(testUI.Out).To(Say(`matched\s+2K\s+level1/matched-file`))
+							Expect(testUI.Out).To(Say(`upload\s+0B?\s+level1/symlink`))
+							Expect(testUI.Out).To(Say(`upload\s+10B\s+new-file`))
+							Expect(testUI.Out).To(Say(`1 file\(s\) resource-matched, 2 file\(s\) to upload`))
+							Expect(testUI.Out).ToNot(Say(`level1\n`))
+							Expect(testUI.Err).To(Say("resource-match-warning"))
+
+							Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							Expect(fakeRestartActor.RestartApplicationCallCount()).To(Equal(0))
+						})
+
+						When("the app has no files to upload", func() {
+							BeforeEach(func() {
+								appConfigs[0].AllResources = nil
+								fakeActor.ConvertToApplicationConfigsReturns(appConfigs, nil, nil)
+							})
+
+							It("says so and does not resource match", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								Expect(testUI.Out).To(Say(`Upload plan for app %s:`, appName))
+								Expect(testUI.Out).To(Say("No app files to upload."))
+								Expect(fakeActor.SetMatchedResourcesCallCount()).To(Equal(0))
+								Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+							})
+						})
+					})
+
 					When("the apply errors", func() {
 						var expectedErr error
 
@@ -1267,6 +1322,20 @@ var _ = Describe("push Command", func() {
 					cmd.NoRoute = true
 				},
 				translatableerror.ArgumentCombinationError{Args: []string{"--route-path", "--no-route"}}),
+
+			Entry("--show-upload-plan and --docker-image",
+				func() {
+					cmd.ShowUploadPlan = true
+					cmd.DockerImage.Path = "some-docker"
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--show-upload-plan", "--docker-image, -o"}}),
+
+			Entry("--show-upload-plan and --droplet",
+				func() {
+					cmd.ShowUploadPlan = true
+					cmd.DropletPath = "some-droplet-path"
+				},
+				translatableerror.ArgumentCombinationError{Args: []string{"--show-upload-plan", "--droplet"}}),
 		)
 	})
 })
@@ -0,0 +1,48 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+)
+
+type RenameContextCommand struct {
+	RequiredArgs    flag.RenameContextArgs `positional-args:"yes"`
+	usage           interface{}            `usage:"CF_NAME rename-context CONTEXT NEW_CONTEXT"`
+	relatedCommands interface{}            `related_commands:"contexts, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *RenameContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd RenameContextCommand) Execute(args []string) error {
+	oldName := cmd.RequiredArgs.OldContextName
+	newName := cmd.RequiredArgs.NewContextName
+
+	cmd.UI.DisplayText("Renaming context {{.OldName}} to {{.NewName}}...", map[string]interface{}{
+		"OldName": oldName,
+		"NewName": newName,
+	})
+
+	contexts := cmd.Config.Contexts()
+	if _, exists := contexts[oldName]; !exists {
+		return translatableerror.ContextNotFoundError{Name: oldName}
+	}
+	if _, exists := contexts[newName]; exists {
+		return translatableerror.ContextAlreadyExistsError{Name: newName}
+	}
+
+	err := cmd.Config.RenameContext(oldName, newName)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
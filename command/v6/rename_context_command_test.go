@@ -0,0 +1,74 @@
+package v6_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rename-context Command", func() {
+	var (
+		cmd        RenameContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.ContextsReturns(map[string]configv3.TargetContext{
+			"one": {},
+			"two": {},
+		})
+
+		cmd = RenameContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.OldContextName = "one"
+		cmd.RequiredArgs.NewContextName = "first"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("renames the context", func() {
+		Expect(executeErr).ToNot(HaveOccurred())
+		Expect(testUI.Out).To(Say("Renaming context one to first..."))
+		Expect(testUI.Out).To(Say("OK"))
+
+		Expect(fakeConfig.RenameContextCallCount()).To(Equal(1))
+		oldName, newName := fakeConfig.RenameContextArgsForCall(0)
+		Expect(oldName).To(Equal("one"))
+		Expect(newName).To(Equal("first"))
+	})
+
+	When("the context does not exist", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.OldContextName = "three"
+		})
+
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextNotFoundError{Name: "three"}))
+			Expect(fakeConfig.RenameContextCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the new name is already used", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.NewContextName = "two"
+		})
+
+		It("returns a ContextAlreadyExistsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.ContextAlreadyExistsError{Name: "two"}))
+			Expect(fakeConfig.RenameContextCallCount()).To(Equal(0))
+		})
+	})
+})
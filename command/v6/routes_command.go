@@ -1,20 +1,147 @@
 package v6
 
 import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v6/shared"
 )
 
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
+	GetSpaceRoutes(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetRouteApplicationGUIDs(routes []v2action.Route) (map[string][]string, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+}
+
+type routesRecord struct {
+	Routes []routeRecord `json:"routes" yaml:"routes"`
+}
+
+type routeRecord struct {
+	GUID   string   `json:"guid" yaml:"guid"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host" yaml:"host"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Port   int      `json:"port,omitempty" yaml:"port,omitempty"`
+	Path   string   `json:"path" yaml:"path"`
+	Apps   []string `json:"apps" yaml:"apps"`
+}
+
 type RoutesCommand struct {
 	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
 	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
 	relatedCommands interface{} `related_commands:"check-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
 }
 
-func (RoutesCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.Config = config
+	cmd.UI = ui
+
+	// The table output is still displayed by the legacy command, so the clients
+	// are only needed when a structured output format is requested.
+	if !config.OutputFormat().IsStructured() {
+		return nil
+	}
+
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient, config)
+
 	return nil
 }
 
-func (RoutesCommand) Execute(args []string) error {
-	return translatableerror.UnrefactoredCommandError{}
+func (RoutesCommand) SupportsStructuredOutput() {}
+
+func (cmd RoutesCommand) Execute(args []string) error {
+	if !cmd.Config.OutputFormat().IsStructured() {
+		return translatableerror.UnrefactoredCommandError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, !cmd.OrgLevel)
+	if err != nil {
+		return err
+	}
+
+	spaces := []v2action.Space{{
+		GUID: cmd.Config.TargetedSpace().GUID,
+		Name: cmd.Config.TargetedSpace().Name,
+	}}
+	if cmd.OrgLevel {
+		var warnings v2action.Warnings
+		spaces, warnings, err = cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	record := routesRecord{Routes: []routeRecord{}}
+	for _, space := range spaces {
+		spaceRecords, err := cmd.spaceRouteRecords(space)
+		if err != nil {
+			return err
+		}
+		record.Routes = append(record.Routes, spaceRecords...)
+	}
+
+	return cmd.UI.DisplayRecord(record)
+}
+
+func (cmd RoutesCommand) spaceRouteRecords(space v2action.Space) ([]routeRecord, error) {
+	routes, warnings, err := cmd.Actor.GetSpaceRoutes(space.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil || len(routes) == 0 {
+		return nil, err
+	}
+
+	routeApps, warnings, err := cmd.Actor.GetRouteApplicationGUIDs(routes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return nil, err
+	}
+
+	appNames := map[string]string{}
+	if len(routeApps) > 0 {
+		apps, warnings, err := cmd.Actor.GetApplicationsBySpace(space.GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range apps {
+			appNames[app.GUID] = app.Name
+		}
+	}
+
+	var records []routeRecord
+	for _, route := range routes {
+		apps := []string{}
+		for _, appGUID := range routeApps[route.GUID] {
+			apps = append(apps, appNames[appGUID])
+		}
+
+		records = append(records, routeRecord{
+			GUID:   route.GUID,
+			Space:  space.Name,
+			Host:   route.Host,
+			Domain: route.Domain.Name,
+			Port:   route.Port.Value,
+			Path:   route.Path,
+			Apps:   apps,
+		})
+	}
+
+	return records, nil
 }
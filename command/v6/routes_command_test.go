@@ -0,0 +1,232 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/command/v6/v6fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v6fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v6fakes.FakeRoutesActor)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the output format is table", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatTable)
+		})
+
+		It("falls back to the legacy command", func() {
+			Expect(executeErr).To(MatchError(translatableerror.UnrefactoredCommandError{}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+			Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			testUI.OutputFormat = configv3.OutputFormatJSON
+		})
+
+		When("checking the target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NotLoggedInError{BinaryName: binaryName})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NotLoggedInError{BinaryName: binaryName}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("the space has routes", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns(
+					[]v2action.Route{
+						{GUID: "route-guid-1", Host: "host-1", Domain: v2action.Domain{Name: "example.com"}, Path: "/some-path"},
+						{GUID: "route-guid-2", Domain: v2action.Domain{Name: "tcp.example.com"}, Port: types.NullInt{IsSet: true, Value: 1024}},
+					},
+					v2action.Warnings{"get-routes-warning"},
+					nil,
+				)
+				fakeActor.GetRouteApplicationGUIDsReturns(
+					map[string][]string{"route-guid-1": {"app-guid-1", "app-guid-2"}},
+					v2action.Warnings{"get-route-mappings-warning"},
+					nil,
+				)
+				fakeActor.GetApplicationsBySpaceReturns(
+					[]v2action.Application{
+						{Name: "app-1", GUID: "app-guid-1"},
+						{Name: "app-2", GUID: "app-guid-2"},
+					},
+					v2action.Warnings{"get-apps-warning"},
+					nil,
+				)
+			})
+
+			It("displays the routes as a json document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`"routes": \[`))
+				Expect(testUI.Out).To(Say(`"guid": "route-guid-1"`))
+				Expect(testUI.Out).To(Say(`"space": "some-space"`))
+				Expect(testUI.Out).To(Say(`"host": "host-1"`))
+				Expect(testUI.Out).To(Say(`"domain": "example.com"`))
+				Expect(testUI.Out).To(Say(`"path": "/some-path"`))
+				Expect(testUI.Out).To(Say(`"app-1",\s+"app-2"`))
+				Expect(testUI.Out).To(Say(`"guid": "route-guid-2"`))
+				Expect(testUI.Out).To(Say(`"domain": "tcp.example.com"`))
+				Expect(testUI.Out).To(Say(`"port": 1024`))
+				Expect(testUI.Out).To(Say(`"apps": \[\]`))
+
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+				Expect(testUI.Err).To(Say("get-route-mappings-warning"))
+				Expect(testUI.Err).To(Say("get-apps-warning"))
+
+				Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(0))
+				Expect(fakeActor.GetSpaceRoutesArgsForCall(0)).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetRouteApplicationGUIDsArgsForCall(0)).To(HaveLen(2))
+				Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			})
+
+			When("no routes are mapped to apps", func() {
+				BeforeEach(func() {
+					fakeActor.GetRouteApplicationGUIDsReturns(map[string][]string{}, nil, nil)
+				})
+
+				It("does not look up the apps", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeActor.GetApplicationsBySpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			When("getting the route mappings fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetRouteApplicationGUIDsReturns(nil, v2action.Warnings{"get-route-mappings-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(testUI.Err).To(Say("get-route-mappings-warning"))
+				})
+			})
+		})
+
+		When("the space has no routes", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns(nil, v2action.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("displays an empty list of routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"routes": \[\]`))
+				Expect(fakeActor.GetRouteApplicationGUIDsCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceRoutesReturns(nil, v2action.Warnings{"get-routes-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("get-routes-warning"))
+			})
+		})
+
+		When("the --orglevel flag is provided", func() {
+			BeforeEach(func() {
+				cmd.OrgLevel = true
+				fakeActor.GetOrganizationSpacesReturns(
+					[]v2action.Space{
+						{Name: "space-1", GUID: "space-guid-1"},
+						{Name: "space-2", GUID: "space-guid-2"},
+					},
+					v2action.Warnings{"get-spaces-warning"},
+					nil,
+				)
+				fakeActor.GetSpaceRoutesStub = func(spaceGUID string) ([]v2action.Route, v2action.Warnings, error) {
+					return []v2action.Route{
+						{GUID: spaceGUID + "-route", Host: "host", Domain: v2action.Domain{Name: "example.com"}},
+					}, nil, nil
+				}
+			})
+
+			It("only checks that an org is targeted", func() {
+				_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedSpace).To(BeFalse())
+			})
+
+			It("displays the routes of every space in the org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Err).To(Say("get-spaces-warning"))
+				Expect(testUI.Out).To(Say(`"guid": "space-guid-1-route"`))
+				Expect(testUI.Out).To(Say(`"space": "space-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "space-guid-2-route"`))
+				Expect(testUI.Out).To(Say(`"space": "space-2"`))
+
+				Expect(fakeActor.GetOrganizationSpacesArgsForCall(0)).To(Equal("some-org-guid"))
+				Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(2))
+			})
+
+			When("getting the spaces fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationSpacesReturns(nil, v2action.Warnings{"get-spaces-warning"}, errors.New("some-error"))
+				})
+
+				It("returns the error and displays warnings", func() {
+					Expect(executeErr).To(MatchError("some-error"))
+					Expect(testUI.Err).To(Say("get-spaces-warning"))
+					Expect(fakeActor.GetSpaceRoutesCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -17,6 +17,24 @@ type ServiceInstancesActor interface {
 	GetServiceInstancesSummaryBySpace(spaceGUID string) ([]v2action.ServiceInstanceSummary, v2action.Warnings, error)
 }
 
+type servicesRecord struct {
+	Services []serviceInstanceRecord `json:"services" yaml:"services"`
+}
+
+type serviceInstanceRecord struct {
+	Name          string              `json:"name" yaml:"name"`
+	GUID          string              `json:"guid" yaml:"guid"`
+	Service       string              `json:"service" yaml:"service"`
+	Plan          string              `json:"plan,omitempty" yaml:"plan,omitempty"`
+	BoundApps     []string            `json:"bound_apps" yaml:"bound_apps"`
+	LastOperation lastOperationRecord `json:"last_operation" yaml:"last_operation"`
+}
+
+type lastOperationRecord struct {
+	Type  string `json:"type" yaml:"type"`
+	State string `json:"state" yaml:"state"`
+}
+
 type ServicesCommand struct {
 	usage           interface{} `usage:"CF_NAME services"`
 	relatedCommands interface{} `related_commands:"create-service, marketplace"`
@@ -40,6 +58,8 @@ func (cmd *ServicesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (ServicesCommand) SupportsStructuredOutput() {}
+
 func (cmd ServicesCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -65,9 +85,11 @@ func (cmd ServicesCommand) Execute(args []string) error {
 		return err
 	}
 
+	record := servicesRecord{Services: []serviceInstanceRecord{}}
+
 	if len(instanceSummaries) == 0 {
 		cmd.UI.DisplayText("No services found")
-		return nil
+		return cmd.UI.DisplayRecord(record)
 	}
 
 	table := [][]string{{
@@ -98,8 +120,19 @@ func (cmd ServicesCommand) Execute(args []string) error {
 			strings.Join(boundAppNames, ", "),
 			fmt.Sprintf("%s %s", summary.LastOperation.Type, summary.LastOperation.State)},
 		)
+		record.Services = append(record.Services, serviceInstanceRecord{
+			Name:      summary.Name,
+			GUID:      summary.GUID,
+			Service:   serviceLabel,
+			Plan:      summary.ServicePlan.Name,
+			BoundApps: boundAppNames,
+			LastOperation: lastOperationRecord{
+				Type:  summary.LastOperation.Type,
+				State: string(summary.LastOperation.State),
+			},
+		})
 	}
 	cmd.UI.DisplayTableWithHeader("", table, 3)
 
-	return nil
+	return cmd.UI.DisplayRecord(record)
 }
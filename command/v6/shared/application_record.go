@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+)
+
+// ApplicationRecord is the structured representation of an application
+// summary displayed by UI.DisplayRecord.
+type ApplicationRecord struct {
+	Name             string                      `json:"name" yaml:"name"`
+	GUID             string                      `json:"guid" yaml:"guid"`
+	RequestedState   string                      `json:"requested_state" yaml:"requested_state"`
+	IsolationSegment string                      `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	RunningInstances int                         `json:"running_instances" yaml:"running_instances"`
+	Instances        int                         `json:"instances" yaml:"instances"`
+	MemoryInMB       uint64                      `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                      `json:"disk_in_mb" yaml:"disk_in_mb"`
+	Routes           []string                    `json:"routes" yaml:"routes"`
+	LastUploaded     string                      `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	Stack            string                      `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpack        string                      `json:"buildpack,omitempty" yaml:"buildpack,omitempty"`
+	DockerImage      string                      `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	StartCommand     string                      `json:"start_command,omitempty" yaml:"start_command,omitempty"`
+	InstanceDetails  []ApplicationInstanceRecord `json:"instance_details" yaml:"instance_details"`
+}
+
+// ApplicationInstanceRecord is the structured representation of a single
+// application instance.
+type ApplicationInstanceRecord struct {
+	Index       int     `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Since       string  `json:"since" yaml:"since"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage int     `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota int     `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   int     `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   int     `json:"disk_quota" yaml:"disk_quota"`
+	Details     string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+// NewApplicationRecord converts the application summary into an
+// ApplicationRecord. The start command is only included when
+// displayStartCommand is true.
+func NewApplicationRecord(appSummary v2action.ApplicationSummary, displayStartCommand bool) ApplicationRecord {
+	record := ApplicationRecord{
+		Name:             appSummary.Name,
+		GUID:             appSummary.GUID,
+		RequestedState:   strings.ToLower(string(appSummary.State)),
+		IsolationSegment: appSummary.IsolationSegment,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		Instances:        appSummary.Instances.Value,
+		MemoryInMB:       appSummary.Memory.Value,
+		DiskInMB:         appSummary.DiskQuota.Value,
+		Routes:           []string{},
+		Stack:            appSummary.Stack.Name,
+		DockerImage:      appSummary.DockerImage,
+		InstanceDetails:  []ApplicationInstanceRecord{},
+	}
+
+	if !appSummary.PackageUpdatedAt.IsZero() {
+		record.LastUploaded = zuluDate(appSummary.PackageUpdatedAt)
+	}
+
+	if appSummary.DockerImage == "" {
+		record.Buildpack = appSummary.Application.CalculatedBuildpack()
+	}
+
+	if displayStartCommand {
+		record.StartCommand = appSummary.Application.CalculatedCommand()
+	}
+
+	for _, route := range appSummary.Routes {
+		record.Routes = append(record.Routes, route.String())
+	}
+
+	for _, instance := range appSummary.RunningInstances {
+		record.InstanceDetails = append(record.InstanceDetails, ApplicationInstanceRecord{
+			Index:       instance.ID,
+			State:       strings.ToLower(string(instance.State)),
+			Since:       zuluDate(instance.TimeSinceCreation()),
+			CPU:         instance.CPU,
+			MemoryUsage: instance.Memory,
+			MemoryQuota: instance.MemoryQuota,
+			DiskUsage:   instance.Disk,
+			DiskQuota:   instance.DiskQuota,
+			Details:     instance.Details,
+		})
+	}
+
+	return record
+}
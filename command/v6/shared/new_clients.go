@@ -28,7 +28,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(command.NewRetryPolicy(config)))
 
 	ccClient := ccv2.NewClient(ccv2.Config{
 		AppName:            config.BinaryName(),
@@ -76,7 +76,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv2.Clie
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(nil, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(command.NewRetryPolicy(config)))
 
 	err = uaaClient.SetupResources(ccClient.AuthorizationEndpoint())
 	if err != nil {
@@ -115,7 +115,9 @@ func NewRouterClient(config command.Config, ui command.UI, uaaClient *uaa.Client
 	authWrapper := routerWrapper.NewUAAAuthentication(uaaClient, config)
 	errorWrapper := routerWrapper.NewErrorWrapper()
 
-	routerWrappers = append(routerWrappers, authWrapper, errorWrapper)
+	retryWrapper := routerWrapper.NewRetryRequest(command.NewRetryPolicy(config))
+
+	routerWrappers = append(routerWrappers, authWrapper, errorWrapper, retryWrapper)
 	routerConfig.Wrappers = routerWrappers
 
 	routerClient := router.NewClient(routerConfig)
@@ -0,0 +1,59 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/logcache"
+	logcacheWrapper "code.cloudfoundry.org/cli/api/logcache/wrapper"
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+)
+
+// LogCacheEndpoint returns the Log Cache endpoint advertised in the root info
+// of the targeted Cloud Controller, or an empty string if it advertises none.
+func LogCacheEndpoint(config command.Config, ui command.UI) (string, error) {
+	ccClient, _, err := NewV3BasedClients(config, ui, false, "")
+	if err != nil {
+		return "", err
+	}
+
+	_, err = ccClient.TargetCF(ccv3.TargetSettings{
+		URL:               config.Target(),
+		SkipSSLValidation: config.SkipSSLValidation(),
+		DialTimeout:       config.DialTimeout(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return ccClient.LogCache(), nil
+}
+
+// NewLogCacheClient creates a new Log Cache client.
+func NewLogCacheClient(logCacheURL string, config command.Config, uaaClient *uaa.Client, ui command.UI) *logcache.Client {
+	wrappers := []logcache.ConnectionWrapper{}
+
+	verbose, location := config.Verbose()
+	if verbose {
+		wrappers = append(wrappers, logcacheWrapper.NewRequestLogger(ui.RequestLoggerTerminalDisplay()))
+	}
+	if location != nil {
+		wrappers = append(wrappers, logcacheWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
+	}
+
+	wrappers = append(wrappers,
+		logcacheWrapper.NewErrorWrapper(),
+		logcacheWrapper.NewUAAAuthentication(uaaClient, config),
+		logcacheWrapper.NewRetryRequest(command.NewRetryPolicy(config)),
+	)
+
+	return logcache.NewClient(logcache.Config{
+		AppName:    config.BinaryName(),
+		AppVersion: config.BinaryVersion(),
+		ConnectionConfig: logcache.ConnectionConfig{
+			DialTimeout:       config.DialTimeout(),
+			SkipSSLValidation: config.SkipSSLValidation(),
+		},
+		LogCacheEndpoint: logCacheURL,
+		Wrappers:         wrappers,
+	})
+}
@@ -0,0 +1,32 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("NewLogCacheClient", func() {
+	var (
+		fakeConfig    *commandfakes.FakeConfig
+		testUI        *ui.UI
+		fakeUAAClient *uaa.Client
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		testUI = ui.NewTestUI(NewBuffer(), NewBuffer(), NewBuffer())
+	})
+
+	It("returns a Log Cache client", func() {
+		client := NewLogCacheClient("some-url", fakeConfig, fakeUAAClient, testUI)
+		Expect(client).NotTo(BeNil())
+	})
+})
@@ -32,6 +32,28 @@ type SpaceActorV3 interface {
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
 }
 
+type spaceSummaryRecord struct {
+	Name                  string                    `json:"name" yaml:"name"`
+	GUID                  string                    `json:"guid" yaml:"guid"`
+	Org                   string                    `json:"org" yaml:"org"`
+	Apps                  []string                  `json:"apps" yaml:"apps"`
+	Services              []string                  `json:"services" yaml:"services"`
+	IsolationSegment      string                    `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	SpaceQuota            string                    `json:"space_quota" yaml:"space_quota"`
+	RunningSecurityGroups []string                  `json:"running_security_groups" yaml:"running_security_groups"`
+	StagingSecurityGroups []string                  `json:"staging_security_groups" yaml:"staging_security_groups"`
+	SecurityGroupRules    []securityGroupRuleRecord `json:"security_group_rules,omitempty" yaml:"security_group_rules,omitempty"`
+}
+
+type securityGroupRuleRecord struct {
+	SecurityGroup string `json:"security_group" yaml:"security_group"`
+	Destination   string `json:"destination" yaml:"destination"`
+	Ports         string `json:"ports,omitempty" yaml:"ports,omitempty"`
+	Protocol      string `json:"protocol" yaml:"protocol"`
+	Lifecycle     string `json:"lifecycle" yaml:"lifecycle"`
+	Description   string `json:"description,omitempty" yaml:"description,omitempty"`
+}
+
 type SpaceCommand struct {
 	RequiredArgs       flag.Space  `positional-args:"yes"`
 	GUID               bool        `long:"guid" description:"Retrieve and display the given space's guid.  All other output for the space is suppressed."`
@@ -69,6 +91,8 @@ func (cmd *SpaceCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (SpaceCommand) SupportsStructuredOutput() {}
+
 func (cmd SpaceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 
@@ -92,7 +116,7 @@ func (cmd SpaceCommand) displaySpaceGUID() error {
 
 	cmd.UI.DisplayText(org.GUID)
 
-	return nil
+	return cmd.UI.DisplayRecord(map[string]string{"guid": org.GUID})
 }
 
 func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) error {
@@ -121,12 +145,24 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 		{cmd.UI.TranslateText("services:"), strings.Join(spaceSummary.ServiceInstanceNames, ", ")},
 	}
 
+	record := spaceSummaryRecord{
+		Name:                  spaceSummary.Name,
+		GUID:                  spaceSummary.GUID,
+		Org:                   spaceSummary.OrgName,
+		Apps:                  spaceSummary.AppNames,
+		Services:              spaceSummary.ServiceInstanceNames,
+		SpaceQuota:            spaceSummary.SpaceQuotaName,
+		RunningSecurityGroups: spaceSummary.RunningSecurityGroupNames,
+		StagingSecurityGroups: spaceSummary.StagingSecurityGroupNames,
+	}
+
 	isolationSegmentRow, err := cmd.isolationSegmentRow(spaceSummary)
 	if err != nil {
 		return err
 	}
 	if isolationSegmentRow != nil {
 		table = append(table, isolationSegmentRow)
+		record.IsolationSegment = isolationSegmentRow[1]
 	}
 
 	table = append(table,
@@ -171,13 +207,21 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 				string(securityGroupRule.Lifecycle),
 				securityGroupRule.Description,
 			})
+			record.SecurityGroupRules = append(record.SecurityGroupRules, securityGroupRuleRecord{
+				SecurityGroup: securityGroupRule.Name,
+				Destination:   securityGroupRule.Destination,
+				Ports:         securityGroupRule.Ports,
+				Protocol:      securityGroupRule.Protocol,
+				Lifecycle:     string(securityGroupRule.Lifecycle),
+				Description:   securityGroupRule.Description,
+			})
 		}
 
 		cmd.UI.DisplayNewline()
 		cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
 	}
 
-	return nil
+	return cmd.UI.DisplayRecord(record)
 }
 
 func (cmd SpaceCommand) isolationSegmentRow(spaceSummary v2action.SpaceSummary) ([]string, error) {
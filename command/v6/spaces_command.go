@@ -14,6 +14,15 @@ type SpacesActor interface {
 	GetOrganizationSpaces(orgGUID string) ([]v2action.Space, v2action.Warnings, error)
 }
 
+type spacesRecord struct {
+	Spaces []spaceRecord `json:"spaces" yaml:"spaces"`
+}
+
+type spaceRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
 type SpacesCommand struct {
 	usage           interface{} `usage:"CF_NAME spaces"`
 	relatedCommands interface{} `related_commands:"target"`
@@ -38,6 +47,8 @@ func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (SpacesCommand) SupportsStructuredOutput() {}
+
 func (cmd SpacesCommand) Execute([]string) error {
 	err := cmd.SharedActor.CheckTarget(true, false)
 	if err != nil {
@@ -67,7 +78,11 @@ func (cmd SpacesCommand) Execute([]string) error {
 		cmd.displaySpaces(spaces)
 	}
 
-	return nil
+	record := spacesRecord{Spaces: []spaceRecord{}}
+	for _, space := range spaces {
+		record.Spaces = append(record.Spaces, spaceRecord{Name: space.Name, GUID: space.GUID})
+	}
+	return cmd.UI.DisplayRecord(record)
 }
 
 func (cmd SpacesCommand) displaySpaces(spaces []v2action.Space) {
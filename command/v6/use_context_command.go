@@ -0,0 +1,48 @@
+package v6
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type UseContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME use-context CONTEXT\n\nTIP:\n   Each context keeps its own api endpoint, login and targeted org and space. A context that does not exist is created.\n\n   Use '--context CONTEXT' with any command to run it against a context without switching to it."`
+	relatedCommands interface{}      `related_commands:"api, contexts, login, rename-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	name := cmd.RequiredArgs.Context
+
+	created, err := cmd.Config.UseContext(name)
+	if err != nil {
+		return err
+	}
+
+	if created {
+		cmd.UI.DisplayText("Created new context {{.Name}}.", map[string]interface{}{
+			"Name": name,
+		})
+	} else {
+		cmd.UI.DisplayText("Switched to context {{.Name}}.", map[string]interface{}{
+			"Name": name,
+		})
+	}
+
+	if cmd.Config.Target() == "" {
+		cmd.UI.DisplayText("No api endpoint set. Use '{{.APICommand}}' and '{{.LoginCommand}}' to set up this context.", map[string]interface{}{
+			"APICommand":   cmd.Config.BinaryName() + " api",
+			"LoginCommand": cmd.Config.BinaryName() + " login",
+		})
+	}
+	return nil
+}
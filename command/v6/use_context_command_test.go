@@ -0,0 +1,74 @@
+package v6_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v6"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Context = "some-context"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(false, nil)
+			fakeConfig.TargetReturns("https://api.example.com")
+		})
+
+		It("switches to the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(fakeConfig.UseContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseContextArgsForCall(0)).To(Equal("some-context"))
+			Expect(testUI.Out).To(Say("Switched to context some-context."))
+			Expect(testUI.Out).ToNot(Say("No api endpoint set"))
+		})
+	})
+
+	When("the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(true, nil)
+		})
+
+		It("creates the context and explains how to set it up", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Created new context some-context."))
+			Expect(testUI.Out).To(Say("No api endpoint set. Use 'faceman api' and 'faceman login' to set up this context."))
+		})
+	})
+
+	When("switching the context fails", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(false, errors.New("some-error"))
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+		})
+	})
+})
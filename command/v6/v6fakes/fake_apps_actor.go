@@ -0,0 +1,204 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeAppsActor struct {
+	GetApplicationRoutesBySpaceStub        func(string) (map[string]v2action.Routes, v2action.Warnings, error)
+	getApplicationRoutesBySpaceMutex       sync.RWMutex
+	getApplicationRoutesBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationRoutesBySpaceReturns struct {
+		result1 map[string]v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationRoutesBySpaceReturnsOnCall map[int]struct {
+		result1 map[string]v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpace(arg1 string) (map[string]v2action.Routes, v2action.Warnings, error) {
+	fake.getApplicationRoutesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesBySpaceReturnsOnCall[len(fake.getApplicationRoutesBySpaceArgsForCall)]
+	fake.getApplicationRoutesBySpaceArgsForCall = append(fake.getApplicationRoutesBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationRoutesBySpace", []interface{}{arg1})
+	fake.getApplicationRoutesBySpaceMutex.Unlock()
+	if fake.GetApplicationRoutesBySpaceStub != nil {
+		return fake.GetApplicationRoutesBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationRoutesBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpaceCallCount() int {
+	fake.getApplicationRoutesBySpaceMutex.RLock()
+	defer fake.getApplicationRoutesBySpaceMutex.RUnlock()
+	return len(fake.getApplicationRoutesBySpaceArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpaceCalls(stub func(string) (map[string]v2action.Routes, v2action.Warnings, error)) {
+	fake.getApplicationRoutesBySpaceMutex.Lock()
+	defer fake.getApplicationRoutesBySpaceMutex.Unlock()
+	fake.GetApplicationRoutesBySpaceStub = stub
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpaceArgsForCall(i int) string {
+	fake.getApplicationRoutesBySpaceMutex.RLock()
+	defer fake.getApplicationRoutesBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationRoutesBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpaceReturns(result1 map[string]v2action.Routes, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationRoutesBySpaceMutex.Lock()
+	defer fake.getApplicationRoutesBySpaceMutex.Unlock()
+	fake.GetApplicationRoutesBySpaceStub = nil
+	fake.getApplicationRoutesBySpaceReturns = struct {
+		result1 map[string]v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetApplicationRoutesBySpaceReturnsOnCall(i int, result1 map[string]v2action.Routes, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationRoutesBySpaceMutex.Lock()
+	defer fake.getApplicationRoutesBySpaceMutex.Unlock()
+	fake.GetApplicationRoutesBySpaceStub = nil
+	if fake.getApplicationRoutesBySpaceReturnsOnCall == nil {
+		fake.getApplicationRoutesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 map[string]v2action.Routes
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesBySpaceReturnsOnCall[i] = struct {
+		result1 map[string]v2action.Routes
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpace(arg1 string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpaceCalls(stub func(string) ([]v2action.Application, v2action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationRoutesBySpaceMutex.RLock()
+	defer fake.getApplicationRoutesBySpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAppsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.AppsActor = new(FakeAppsActor)
@@ -3,7 +3,9 @@ package v6fakes
 
 import (
 	sync "sync"
+	time "time"
 
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
 	constant "code.cloudfoundry.org/cli/api/uaa/constant"
 	v6 "code.cloudfoundry.org/cli/command/v6"
 )
@@ -23,6 +25,53 @@ type FakeAuthActor struct {
 	authenticateReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuthenticateWithBrowserLoginStub        func(v2action.BrowserLogin, time.Duration) error
+	authenticateWithBrowserLoginMutex       sync.RWMutex
+	authenticateWithBrowserLoginArgsForCall []struct {
+		arg1 v2action.BrowserLogin
+		arg2 time.Duration
+	}
+	authenticateWithBrowserLoginReturns struct {
+		result1 error
+	}
+	authenticateWithBrowserLoginReturnsOnCall map[int]struct {
+		result1 error
+	}
+	AuthenticateWithDeviceCodeStub        func(v2action.DeviceAuthorization) error
+	authenticateWithDeviceCodeMutex       sync.RWMutex
+	authenticateWithDeviceCodeArgsForCall []struct {
+		arg1 v2action.DeviceAuthorization
+	}
+	authenticateWithDeviceCodeReturns struct {
+		result1 error
+	}
+	authenticateWithDeviceCodeReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GetDeviceAuthorizationStub        func() (v2action.DeviceAuthorization, error)
+	getDeviceAuthorizationMutex       sync.RWMutex
+	getDeviceAuthorizationArgsForCall []struct {
+	}
+	getDeviceAuthorizationReturns struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}
+	getDeviceAuthorizationReturnsOnCall map[int]struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}
+	StartBrowserLoginStub        func() (v2action.BrowserLogin, error)
+	startBrowserLoginMutex       sync.RWMutex
+	startBrowserLoginArgsForCall []struct {
+	}
+	startBrowserLoginReturns struct {
+		result1 v2action.BrowserLogin
+		result2 error
+	}
+	startBrowserLoginReturnsOnCall map[int]struct {
+		result1 v2action.BrowserLogin
+		result2 error
+	}
 	UAAAPIVersionStub        func() string
 	uAAAPIVersionMutex       sync.RWMutex
 	uAAAPIVersionArgsForCall []struct {
@@ -100,6 +149,237 @@ func (fake *FakeAuthActor) AuthenticateReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAuthActor) AuthenticateWithBrowserLogin(arg1 v2action.BrowserLogin, arg2 time.Duration) error {
+	fake.authenticateWithBrowserLoginMutex.Lock()
+	ret, specificReturn := fake.authenticateWithBrowserLoginReturnsOnCall[len(fake.authenticateWithBrowserLoginArgsForCall)]
+	fake.authenticateWithBrowserLoginArgsForCall = append(fake.authenticateWithBrowserLoginArgsForCall, struct {
+		arg1 v2action.BrowserLogin
+		arg2 time.Duration
+	}{arg1, arg2})
+	fake.recordInvocation("AuthenticateWithBrowserLogin", []interface{}{arg1, arg2})
+	fake.authenticateWithBrowserLoginMutex.Unlock()
+	if fake.AuthenticateWithBrowserLoginStub != nil {
+		return fake.AuthenticateWithBrowserLoginStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithBrowserLoginReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuthActor) AuthenticateWithBrowserLoginCallCount() int {
+	fake.authenticateWithBrowserLoginMutex.RLock()
+	defer fake.authenticateWithBrowserLoginMutex.RUnlock()
+	return len(fake.authenticateWithBrowserLoginArgsForCall)
+}
+
+func (fake *FakeAuthActor) AuthenticateWithBrowserLoginCalls(stub func(v2action.BrowserLogin, time.Duration) error) {
+	fake.authenticateWithBrowserLoginMutex.Lock()
+	defer fake.authenticateWithBrowserLoginMutex.Unlock()
+	fake.AuthenticateWithBrowserLoginStub = stub
+}
+
+func (fake *FakeAuthActor) AuthenticateWithBrowserLoginArgsForCall(i int) (v2action.BrowserLogin, time.Duration) {
+	fake.authenticateWithBrowserLoginMutex.RLock()
+	defer fake.authenticateWithBrowserLoginMutex.RUnlock()
+	argsForCall := fake.authenticateWithBrowserLoginArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuthActor) AuthenticateWithBrowserLoginReturns(result1 error) {
+	fake.authenticateWithBrowserLoginMutex.Lock()
+	defer fake.authenticateWithBrowserLoginMutex.Unlock()
+	fake.AuthenticateWithBrowserLoginStub = nil
+	fake.authenticateWithBrowserLoginReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithBrowserLoginReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithBrowserLoginMutex.Lock()
+	defer fake.authenticateWithBrowserLoginMutex.Unlock()
+	fake.AuthenticateWithBrowserLoginStub = nil
+	if fake.authenticateWithBrowserLoginReturnsOnCall == nil {
+		fake.authenticateWithBrowserLoginReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithBrowserLoginReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCode(arg1 v2action.DeviceAuthorization) error {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	ret, specificReturn := fake.authenticateWithDeviceCodeReturnsOnCall[len(fake.authenticateWithDeviceCodeArgsForCall)]
+	fake.authenticateWithDeviceCodeArgsForCall = append(fake.authenticateWithDeviceCodeArgsForCall, struct {
+		arg1 v2action.DeviceAuthorization
+	}{arg1})
+	fake.recordInvocation("AuthenticateWithDeviceCode", []interface{}{arg1})
+	fake.authenticateWithDeviceCodeMutex.Unlock()
+	if fake.AuthenticateWithDeviceCodeStub != nil {
+		return fake.AuthenticateWithDeviceCodeStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.authenticateWithDeviceCodeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCodeCallCount() int {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	return len(fake.authenticateWithDeviceCodeArgsForCall)
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCodeCalls(stub func(v2action.DeviceAuthorization) error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = stub
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCodeArgsForCall(i int) v2action.DeviceAuthorization {
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	argsForCall := fake.authenticateWithDeviceCodeArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCodeReturns(result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	fake.authenticateWithDeviceCodeReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) AuthenticateWithDeviceCodeReturnsOnCall(i int, result1 error) {
+	fake.authenticateWithDeviceCodeMutex.Lock()
+	defer fake.authenticateWithDeviceCodeMutex.Unlock()
+	fake.AuthenticateWithDeviceCodeStub = nil
+	if fake.authenticateWithDeviceCodeReturnsOnCall == nil {
+		fake.authenticateWithDeviceCodeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.authenticateWithDeviceCodeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuthActor) GetDeviceAuthorization() (v2action.DeviceAuthorization, error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	ret, specificReturn := fake.getDeviceAuthorizationReturnsOnCall[len(fake.getDeviceAuthorizationArgsForCall)]
+	fake.getDeviceAuthorizationArgsForCall = append(fake.getDeviceAuthorizationArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GetDeviceAuthorization", []interface{}{})
+	fake.getDeviceAuthorizationMutex.Unlock()
+	if fake.GetDeviceAuthorizationStub != nil {
+		return fake.GetDeviceAuthorizationStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.getDeviceAuthorizationReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthActor) GetDeviceAuthorizationCallCount() int {
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
+	return len(fake.getDeviceAuthorizationArgsForCall)
+}
+
+func (fake *FakeAuthActor) GetDeviceAuthorizationCalls(stub func() (v2action.DeviceAuthorization, error)) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = stub
+}
+
+func (fake *FakeAuthActor) GetDeviceAuthorizationReturns(result1 v2action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	fake.getDeviceAuthorizationReturns = struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthActor) GetDeviceAuthorizationReturnsOnCall(i int, result1 v2action.DeviceAuthorization, result2 error) {
+	fake.getDeviceAuthorizationMutex.Lock()
+	defer fake.getDeviceAuthorizationMutex.Unlock()
+	fake.GetDeviceAuthorizationStub = nil
+	if fake.getDeviceAuthorizationReturnsOnCall == nil {
+		fake.getDeviceAuthorizationReturnsOnCall = make(map[int]struct {
+			result1 v2action.DeviceAuthorization
+			result2 error
+		})
+	}
+	fake.getDeviceAuthorizationReturnsOnCall[i] = struct {
+		result1 v2action.DeviceAuthorization
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthActor) StartBrowserLogin() (v2action.BrowserLogin, error) {
+	fake.startBrowserLoginMutex.Lock()
+	ret, specificReturn := fake.startBrowserLoginReturnsOnCall[len(fake.startBrowserLoginArgsForCall)]
+	fake.startBrowserLoginArgsForCall = append(fake.startBrowserLoginArgsForCall, struct {
+	}{})
+	fake.recordInvocation("StartBrowserLogin", []interface{}{})
+	fake.startBrowserLoginMutex.Unlock()
+	if fake.StartBrowserLoginStub != nil {
+		return fake.StartBrowserLoginStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startBrowserLoginReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuthActor) StartBrowserLoginCallCount() int {
+	fake.startBrowserLoginMutex.RLock()
+	defer fake.startBrowserLoginMutex.RUnlock()
+	return len(fake.startBrowserLoginArgsForCall)
+}
+
+func (fake *FakeAuthActor) StartBrowserLoginCalls(stub func() (v2action.BrowserLogin, error)) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = stub
+}
+
+func (fake *FakeAuthActor) StartBrowserLoginReturns(result1 v2action.BrowserLogin, result2 error) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = nil
+	fake.startBrowserLoginReturns = struct {
+		result1 v2action.BrowserLogin
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuthActor) StartBrowserLoginReturnsOnCall(i int, result1 v2action.BrowserLogin, result2 error) {
+	fake.startBrowserLoginMutex.Lock()
+	defer fake.startBrowserLoginMutex.Unlock()
+	fake.StartBrowserLoginStub = nil
+	if fake.startBrowserLoginReturnsOnCall == nil {
+		fake.startBrowserLoginReturnsOnCall = make(map[int]struct {
+			result1 v2action.BrowserLogin
+			result2 error
+		})
+	}
+	fake.startBrowserLoginReturnsOnCall[i] = struct {
+		result1 v2action.BrowserLogin
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeAuthActor) UAAAPIVersion() string {
 	fake.uAAAPIVersionMutex.Lock()
 	ret, specificReturn := fake.uAAAPIVersionReturnsOnCall[len(fake.uAAAPIVersionArgsForCall)]
@@ -157,6 +437,14 @@ func (fake *FakeAuthActor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.authenticateMutex.RLock()
 	defer fake.authenticateMutex.RUnlock()
+	fake.authenticateWithBrowserLoginMutex.RLock()
+	defer fake.authenticateWithBrowserLoginMutex.RUnlock()
+	fake.authenticateWithDeviceCodeMutex.RLock()
+	defer fake.authenticateWithDeviceCodeMutex.RUnlock()
+	fake.getDeviceAuthorizationMutex.RLock()
+	defer fake.getDeviceAuthorizationMutex.RUnlock()
+	fake.startBrowserLoginMutex.RLock()
+	defer fake.startBrowserLoginMutex.RUnlock()
 	fake.uAAAPIVersionMutex.RLock()
 	defer fake.uAAAPIVersionMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -9,12 +9,46 @@ import (
 )
 
 type FakeLogsActor struct {
-	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	GetApplicationsBySpaceStub        func(string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetLogCacheLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.LogQuery, v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)
+	getLogCacheLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
+	getLogCacheLogsForApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogQuery
+		arg4 v2action.LogCacheClient
+	}
+	getLogCacheLogsForApplicationByNameAndSpaceReturns struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	getLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentLogsForApplicationByNameAndSpaceStub        func(string, string, v2action.LogQuery, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
 		arg1 string
 		arg2 string
-		arg3 v2action.NOAAClient
+		arg3 v2action.LogQuery
+		arg4 v2action.NOAAClient
 	}
 	getRecentLogsForApplicationByNameAndSpaceReturns struct {
 		result1 []v2action.LogMessage
@@ -45,22 +79,177 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsForApplicationsByNameAndSpaceStub        func([]string, string, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	getStreamingLogsForApplicationsByNameAndSpaceMutex       sync.RWMutex
+	getStreamingLogsForApplicationsByNameAndSpaceArgsForCall []struct {
+		arg1 []string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
+	getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error) {
+func (fake *FakeLogsActor) GetApplicationsBySpace(arg1 string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceCalls(stub func(string) ([]v2action.Application, v2action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.LogQuery, arg4 v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error) {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getLogCacheLogsForApplicationByNameAndSpaceArgsForCall)]
+	fake.getLogCacheLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getLogCacheLogsForApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 v2action.LogQuery
+		arg4 v2action.LogCacheClient
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetLogCacheLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetLogCacheLogsForApplicationByNameAndSpaceStub != nil {
+		return fake.GetLogCacheLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getLogCacheLogsForApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpaceCallCount() int {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getLogCacheLogsForApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.LogQuery, v2action.LogCacheClient) ([]v2action.LogMessage, v2action.Warnings, error)) {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetLogCacheLogsForApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogQuery, v2action.LogCacheClient) {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getLogCacheLogsForApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetLogCacheLogsForApplicationByNameAndSpaceStub = nil
+	fake.getLogCacheLogsForApplicationByNameAndSpaceReturns = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall(i int, result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetLogCacheLogsForApplicationByNameAndSpaceStub = nil
+	if fake.getLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getLogCacheLogsForApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(arg1 string, arg2 string, arg3 v2action.LogQuery, arg4 v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
 	fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall = append(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall, struct {
 		arg1 string
 		arg2 string
-		arg3 v2action.NOAAClient
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3})
+		arg3 v2action.LogQuery
+		arg4 v2action.NOAAClient
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetRecentLogsForApplicationByNameAndSpace", []interface{}{arg1, arg2, arg3, arg4})
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	if fake.GetRecentLogsForApplicationByNameAndSpaceStub != nil {
-		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3)
+		return fake.GetRecentLogsForApplicationByNameAndSpaceStub(arg1, arg2, arg3, arg4)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -75,17 +264,17 @@ func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCallCount()
 	return len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceCalls(stub func(string, string, v2action.LogQuery, v2action.NOAAClient) ([]v2action.LogMessage, v2action.Warnings, error)) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.Unlock()
 	fake.GetRecentLogsForApplicationByNameAndSpaceStub = stub
 }
 
-func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.NOAAClient) {
+func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceArgsForCall(i int) (string, string, v2action.LogQuery, v2action.NOAAClient) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	argsForCall := fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
 func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpaceReturns(result1 []v2action.LogMessage, result2 v2action.Warnings, result3 error) {
@@ -188,13 +377,95 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpace(arg1 []string, arg2 string, arg3 v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)]
+	fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall = append(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall, struct {
+		arg1 []string
+		arg2 string
+		arg3 v2action.NOAAClient
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("GetStreamingLogsForApplicationsByNameAndSpace", []interface{}{arg1Copy, arg2, arg3})
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsByNameAndSpaceStub != nil {
+		return fake.GetStreamingLogsForApplicationsByNameAndSpaceStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	fakeReturns := fake.getStreamingLogsForApplicationsByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceCallCount() int {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceCalls(stub func([]string, string, v2action.NOAAClient) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = stub
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceArgsForCall(i int) ([]string, string, v2action.NOAAClient) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getStreamingLogsForApplicationsByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error, result3 v2action.Warnings, result4 error) {
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Lock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.Unlock()
+	fake.GetStreamingLogsForApplicationsByNameAndSpaceStub = nil
+	if fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.getStreamingLogsForApplicationsByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getLogCacheLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsByNameAndSpaceMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
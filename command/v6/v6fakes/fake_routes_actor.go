@@ -0,0 +1,375 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v6fakes
+
+import (
+	sync "sync"
+
+	v2action "code.cloudfoundry.org/cli/actor/v2action"
+	v6 "code.cloudfoundry.org/cli/command/v6"
+)
+
+type FakeRoutesActor struct {
+	GetApplicationsBySpaceStub        func(string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		arg1 string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetOrganizationSpacesStub        func(string) ([]v2action.Space, v2action.Warnings, error)
+	getOrganizationSpacesMutex       sync.RWMutex
+	getOrganizationSpacesArgsForCall []struct {
+		arg1 string
+	}
+	getOrganizationSpacesReturns struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrganizationSpacesReturnsOnCall map[int]struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRouteApplicationGUIDsStub        func([]v2action.Route) (map[string][]string, v2action.Warnings, error)
+	getRouteApplicationGUIDsMutex       sync.RWMutex
+	getRouteApplicationGUIDsArgsForCall []struct {
+		arg1 []v2action.Route
+	}
+	getRouteApplicationGUIDsReturns struct {
+		result1 map[string][]string
+		result2 v2action.Warnings
+		result3 error
+	}
+	getRouteApplicationGUIDsReturnsOnCall map[int]struct {
+		result1 map[string][]string
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceRoutesStub        func(string) ([]v2action.Route, v2action.Warnings, error)
+	getSpaceRoutesMutex       sync.RWMutex
+	getSpaceRoutesArgsForCall []struct {
+		arg1 string
+	}
+	getSpaceRoutesReturns struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRoutesReturnsOnCall map[int]struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpace(arg1 string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{arg1})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpaceCalls(stub func(string) ([]v2action.Application, v2action.Warnings, error)) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = stub
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsBySpaceArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	defer fake.getApplicationsBySpaceMutex.Unlock()
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpaces(arg1 string) ([]v2action.Space, v2action.Warnings, error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	ret, specificReturn := fake.getOrganizationSpacesReturnsOnCall[len(fake.getOrganizationSpacesArgsForCall)]
+	fake.getOrganizationSpacesArgsForCall = append(fake.getOrganizationSpacesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetOrganizationSpaces", []interface{}{arg1})
+	fake.getOrganizationSpacesMutex.Unlock()
+	if fake.GetOrganizationSpacesStub != nil {
+		return fake.GetOrganizationSpacesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getOrganizationSpacesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesCallCount() int {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	return len(fake.getOrganizationSpacesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesCalls(stub func(string) ([]v2action.Space, v2action.Warnings, error)) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = stub
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesArgsForCall(i int) string {
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	argsForCall := fake.getOrganizationSpacesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesReturns(result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	fake.getOrganizationSpacesReturns = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetOrganizationSpacesReturnsOnCall(i int, result1 []v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.getOrganizationSpacesMutex.Lock()
+	defer fake.getOrganizationSpacesMutex.Unlock()
+	fake.GetOrganizationSpacesStub = nil
+	if fake.getOrganizationSpacesReturnsOnCall == nil {
+		fake.getOrganizationSpacesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationSpacesReturnsOnCall[i] = struct {
+		result1 []v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDs(arg1 []v2action.Route) (map[string][]string, v2action.Warnings, error) {
+	var arg1Copy []v2action.Route
+	if arg1 != nil {
+		arg1Copy = make([]v2action.Route, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRouteApplicationGUIDsMutex.Lock()
+	ret, specificReturn := fake.getRouteApplicationGUIDsReturnsOnCall[len(fake.getRouteApplicationGUIDsArgsForCall)]
+	fake.getRouteApplicationGUIDsArgsForCall = append(fake.getRouteApplicationGUIDsArgsForCall, struct {
+		arg1 []v2action.Route
+	}{arg1Copy})
+	fake.recordInvocation("GetRouteApplicationGUIDs", []interface{}{arg1Copy})
+	fake.getRouteApplicationGUIDsMutex.Unlock()
+	if fake.GetRouteApplicationGUIDsStub != nil {
+		return fake.GetRouteApplicationGUIDsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteApplicationGUIDsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDsCallCount() int {
+	fake.getRouteApplicationGUIDsMutex.RLock()
+	defer fake.getRouteApplicationGUIDsMutex.RUnlock()
+	return len(fake.getRouteApplicationGUIDsArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDsCalls(stub func([]v2action.Route) (map[string][]string, v2action.Warnings, error)) {
+	fake.getRouteApplicationGUIDsMutex.Lock()
+	defer fake.getRouteApplicationGUIDsMutex.Unlock()
+	fake.GetRouteApplicationGUIDsStub = stub
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDsArgsForCall(i int) []v2action.Route {
+	fake.getRouteApplicationGUIDsMutex.RLock()
+	defer fake.getRouteApplicationGUIDsMutex.RUnlock()
+	argsForCall := fake.getRouteApplicationGUIDsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDsReturns(result1 map[string][]string, result2 v2action.Warnings, result3 error) {
+	fake.getRouteApplicationGUIDsMutex.Lock()
+	defer fake.getRouteApplicationGUIDsMutex.Unlock()
+	fake.GetRouteApplicationGUIDsStub = nil
+	fake.getRouteApplicationGUIDsReturns = struct {
+		result1 map[string][]string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetRouteApplicationGUIDsReturnsOnCall(i int, result1 map[string][]string, result2 v2action.Warnings, result3 error) {
+	fake.getRouteApplicationGUIDsMutex.Lock()
+	defer fake.getRouteApplicationGUIDsMutex.Unlock()
+	fake.GetRouteApplicationGUIDsStub = nil
+	if fake.getRouteApplicationGUIDsReturnsOnCall == nil {
+		fake.getRouteApplicationGUIDsReturnsOnCall = make(map[int]struct {
+			result1 map[string][]string
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteApplicationGUIDsReturnsOnCall[i] = struct {
+		result1 map[string][]string
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutes(arg1 string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getSpaceRoutesMutex.Lock()
+	ret, specificReturn := fake.getSpaceRoutesReturnsOnCall[len(fake.getSpaceRoutesArgsForCall)]
+	fake.getSpaceRoutesArgsForCall = append(fake.getSpaceRoutesArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetSpaceRoutes", []interface{}{arg1})
+	fake.getSpaceRoutesMutex.Unlock()
+	if fake.GetSpaceRoutesStub != nil {
+		return fake.GetSpaceRoutesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSpaceRoutesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesCallCount() int {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	return len(fake.getSpaceRoutesArgsForCall)
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesCalls(stub func(string) ([]v2action.Route, v2action.Warnings, error)) {
+	fake.getSpaceRoutesMutex.Lock()
+	defer fake.getSpaceRoutesMutex.Unlock()
+	fake.GetSpaceRoutesStub = stub
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesArgsForCall(i int) string {
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	argsForCall := fake.getSpaceRoutesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceRoutesMutex.Lock()
+	defer fake.getSpaceRoutesMutex.Unlock()
+	fake.GetSpaceRoutesStub = nil
+	fake.getSpaceRoutesReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) GetSpaceRoutesReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.getSpaceRoutesMutex.Lock()
+	defer fake.getSpaceRoutesMutex.Unlock()
+	fake.GetSpaceRoutesStub = nil
+	if fake.getSpaceRoutesReturnsOnCall == nil {
+		fake.getSpaceRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRoutesReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeRoutesActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getOrganizationSpacesMutex.RLock()
+	defer fake.getOrganizationSpacesMutex.RUnlock()
+	fake.getRouteApplicationGUIDsMutex.RLock()
+	defer fake.getRouteApplicationGUIDsMutex.RUnlock()
+	fake.getSpaceRoutesMutex.RLock()
+	defer fake.getSpaceRoutesMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeRoutesActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v6.RoutesActor = new(FakeRoutesActor)
@@ -98,6 +98,19 @@ type FakeV2PushActor struct {
 		result2 pushaction.Warnings
 		result3 error
 	}
+	SetMatchedResourcesStub        func(pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings)
+	setMatchedResourcesMutex       sync.RWMutex
+	setMatchedResourcesArgsForCall []struct {
+		arg1 pushaction.ApplicationConfig
+	}
+	setMatchedResourcesReturns struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}
+	setMatchedResourcesReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -497,6 +510,69 @@ func (fake *FakeV2PushActor) ReadManifestReturnsOnCall(i int, result1 []manifest
 	}{result1, result2, result3}
 }
 
+func (fake *FakeV2PushActor) SetMatchedResources(arg1 pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings) {
+	fake.setMatchedResourcesMutex.Lock()
+	ret, specificReturn := fake.setMatchedResourcesReturnsOnCall[len(fake.setMatchedResourcesArgsForCall)]
+	fake.setMatchedResourcesArgsForCall = append(fake.setMatchedResourcesArgsForCall, struct {
+		arg1 pushaction.ApplicationConfig
+	}{arg1})
+	fake.recordInvocation("SetMatchedResources", []interface{}{arg1})
+	fake.setMatchedResourcesMutex.Unlock()
+	if fake.SetMatchedResourcesStub != nil {
+		return fake.SetMatchedResourcesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.setMatchedResourcesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesCallCount() int {
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
+	return len(fake.setMatchedResourcesArgsForCall)
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesCalls(stub func(pushaction.ApplicationConfig) (pushaction.ApplicationConfig, pushaction.Warnings)) {
+	fake.setMatchedResourcesMutex.Lock()
+	defer fake.setMatchedResourcesMutex.Unlock()
+	fake.SetMatchedResourcesStub = stub
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
+	argsForCall := fake.setMatchedResourcesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesReturns(result1 pushaction.ApplicationConfig, result2 pushaction.Warnings) {
+	fake.setMatchedResourcesMutex.Lock()
+	defer fake.setMatchedResourcesMutex.Unlock()
+	fake.SetMatchedResourcesStub = nil
+	fake.setMatchedResourcesReturns = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
+func (fake *FakeV2PushActor) SetMatchedResourcesReturnsOnCall(i int, result1 pushaction.ApplicationConfig, result2 pushaction.Warnings) {
+	fake.setMatchedResourcesMutex.Lock()
+	defer fake.setMatchedResourcesMutex.Unlock()
+	fake.SetMatchedResourcesStub = nil
+	if fake.setMatchedResourcesReturnsOnCall == nil {
+		fake.setMatchedResourcesReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+		})
+	}
+	fake.setMatchedResourcesReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+	}{result1, result2}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -512,6 +588,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
 	fake.readManifestMutex.RLock()
 	defer fake.readManifestMutex.RUnlock()
+	fake.setMatchedResourcesMutex.RLock()
+	defer fake.setMatchedResourcesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -2,12 +2,10 @@ package v7
 
 import (
 	"code.cloudfoundry.org/cli/actor/sharedaction"
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccversion"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
-	sharedV2 "code.cloudfoundry.org/cli/command/v6/shared"
 	"code.cloudfoundry.org/cli/command/v7/shared"
 )
 
@@ -16,7 +14,7 @@ import (
 type AppActor interface {
 	CloudControllerAPIVersion() string
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
-	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool, routeActor v7action.RouteActor) (v7action.ApplicationSummary, v7action.Warnings, error)
+	GetApplicationSummaryByNameAndSpace(appName string, spaceGUID string, withObfuscatedValues bool) (v7action.ApplicationSummary, v7action.Warnings, error)
 }
 
 type AppCommand struct {
@@ -28,7 +26,6 @@ type AppCommand struct {
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
-	RouteActor  v7action.RouteActor
 	Actor       AppActor
 }
 
@@ -38,22 +35,18 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	sharedActor := sharedaction.NewActor(config)
 	cmd.SharedActor = sharedActor
 
-	ccClient, _, err := shared.NewClients(config, ui, true, ccversion.MinVersionApplicationFlowV3)
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, ccversion.MinVersionApplicationFlowV3)
 	if err != nil {
 		return err
 	}
 
-	ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
-	if err != nil {
-		return err
-	}
-
-	cmd.RouteActor = v2action.NewActor(ccClientV2, uaaClientV2, config)
-	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClientV2)
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
 
 	return nil
 }
 
+func (AppCommand) SupportsStructuredOutput() {}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := command.MinimumCCAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), ccversion.MinVersionApplicationFlowV3)
 	if err != nil {
@@ -83,14 +76,14 @@ func (cmd AppCommand) Execute(args []string) error {
 	cmd.UI.DisplayNewline()
 
 	appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
-	summary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false, cmd.RouteActor)
+	summary, warnings, err := cmd.Actor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, false)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return err
 	}
 
 	appSummaryDisplayer.AppDisplay(summary, false)
-	return nil
+	return cmd.UI.DisplayRecord(shared.NewAppSummaryRecord(summary, false))
 }
 
 func (cmd AppCommand) displayAppGUID() error {
@@ -101,5 +94,5 @@ func (cmd AppCommand) displayAppGUID() error {
 	}
 
 	cmd.UI.DisplayText(app.GUID)
-	return nil
+	return cmd.UI.DisplayRecord(map[string]string{"guid": app.GUID})
 }
@@ -246,11 +246,32 @@ var _ = Describe("app Command", func() {
 				Expect(testUI.Err).To(Say("warning-2"))
 
 				Expect(fakeActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
-				appName, spaceGUID, withObfuscatedValues, _ := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+				appName, spaceGUID, withObfuscatedValues := fakeActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
 				Expect(appName).To(Equal("some-app"))
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(withObfuscatedValues).To(BeFalse())
 			})
+
+			When("the output format is yaml", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatYAML
+				})
+
+				It("prints the application summary as a yaml document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).ToNot(Say("Showing health and status"))
+					Expect(testUI.Out).To(Say(`name: some-app`))
+					Expect(testUI.Out).To(Say(`requested_state: started`))
+					Expect(testUI.Out).To(Say(`stack: cflinuxfs2`))
+					Expect(testUI.Out).To(Say(`- type: web`))
+					Expect(testUI.Out).To(Say(`- type: console`))
+					Expect(testUI.Out).ToNot(Say("command:"))
+
+					Expect(testUI.Err).To(Say("warning-1"))
+					Expect(testUI.Err).To(Say("warning-2"))
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,152 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . AppsActor
+
+type AppsActor interface {
+	GetApplicationsWithProcessesBySpace(spaceGUID string, labelSelector string) ([]v7action.ApplicationWithProcessSummary, v7action.Warnings, error)
+	GetRoutesByApplications(appGUIDs []string) (map[string]v7action.Routes, v7action.Warnings, error)
+}
+
+type appsRecord struct {
+	Apps []appRecord `json:"apps" yaml:"apps"`
+}
+
+type appRecord struct {
+	Name           string             `json:"name" yaml:"name"`
+	GUID           string             `json:"guid" yaml:"guid"`
+	RequestedState string             `json:"requested_state" yaml:"requested_state"`
+	Processes      []appProcessRecord `json:"processes" yaml:"processes"`
+	Routes         []string           `json:"routes" yaml:"routes"`
+}
+
+type appProcessRecord struct {
+	Type             string `json:"type" yaml:"type"`
+	HealthyInstances int    `json:"healthy_instances" yaml:"healthy_instances"`
+	Instances        int    `json:"instances" yaml:"instances"`
+}
+
+type AppsCommand struct {
+	Labels          string      `long:"labels" description:"Selector to filter apps by labels"`
+	usage           interface{} `usage:"CF_NAME apps [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME apps\n   CF_NAME apps --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME apps --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{} `related_commands:"events, logs, map-route, push, scale, start, stop, restart"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       AppsActor
+}
+
+func (cmd *AppsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (AppsCommand) SupportsStructuredOutput() {}
+
+func (cmd AppsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting apps in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	summaries, warnings, err := cmd.Actor.GetApplicationsWithProcessesBySpace(cmd.Config.TargetedSpace().GUID, cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := appsRecord{Apps: []appRecord{}}
+	if len(summaries) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("requested state"),
+			cmd.UI.TranslateText("processes"),
+			cmd.UI.TranslateText("routes"),
+		},
+	}
+
+	var appGUIDs []string
+	for _, summary := range summaries {
+		appGUIDs = append(appGUIDs, summary.GUID)
+	}
+
+	appRoutes, warnings, err := cmd.Actor.GetRoutesByApplications(appGUIDs)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.Name,
+			cmd.UI.TranslateText(strings.ToLower(string(summary.State))),
+			summary.ProcessSummaries.String(),
+			appRoutes[summary.GUID].Summary(),
+		})
+		record.Apps = append(record.Apps, newAppRecord(summary, appRoutes[summary.GUID]))
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
+
+func newAppRecord(summary v7action.ApplicationWithProcessSummary, routes v7action.Routes) appRecord {
+	record := appRecord{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(string(summary.State)),
+		Processes:      []appProcessRecord{},
+		Routes:         []string{},
+	}
+
+	for _, process := range summary.ProcessSummaries {
+		record.Processes = append(record.Processes, appProcessRecord{
+			Type:             process.Type,
+			HealthyInstances: process.HealthyInstanceCount(),
+			Instances:        process.TotalInstanceCount(),
+		})
+	}
+
+	for _, route := range routes {
+		record.Routes = append(record.Routes, route.String())
+	}
+
+	return record
+}
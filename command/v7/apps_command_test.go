@@ -0,0 +1,191 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apps Command", func() {
+	var (
+		cmd             AppsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeAppsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeAppsActor)
+
+		cmd = AppsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the apps returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsWithProcessesBySpaceReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsWithProcessesBySpaceReturns(nil, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays that no apps were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting apps in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No apps found"))
+		})
+	})
+
+	When("there are apps", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationsWithProcessesBySpaceReturns(
+				[]v7action.ApplicationWithProcessSummary{
+					{
+						Application: v7action.Application{Name: "app-1", GUID: "app-1-guid", State: constant.ApplicationStarted},
+						ProcessSummaries: v7action.ProcessSummaries{
+							{
+								Process: v7action.Process{Type: constant.ProcessTypeWeb},
+								InstanceDetails: []v7action.ProcessInstance{
+									{State: constant.ProcessInstanceRunning},
+									{State: constant.ProcessInstanceDown},
+								},
+							},
+						},
+					},
+					{
+						Application: v7action.Application{Name: "app-2", GUID: "app-2-guid", State: constant.ApplicationStopped},
+					},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+			fakeActor.GetRoutesByApplicationsReturns(
+				map[string]v7action.Routes{
+					"app-1-guid": {
+						{Host: "app-1", DomainName: "example.com"},
+						{Host: "www", DomainName: "example.com", Path: "/foo"},
+					},
+				},
+				v7action.Warnings{"route-warning"},
+				nil,
+			)
+		})
+
+		It("displays the apps with their processes and routes", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetApplicationsWithProcessesBySpaceCallCount()).To(Equal(1))
+			spaceGUID, labelSelector := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labelSelector).To(BeEmpty())
+
+			Expect(fakeActor.GetRoutesByApplicationsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRoutesByApplicationsArgsForCall(0)).To(Equal([]string{"app-1-guid", "app-2-guid"}))
+
+			Expect(testUI.Out).To(Say(`name\s+requested state\s+processes\s+routes`))
+			Expect(testUI.Out).To(Say(`app-1\s+started\s+web:1/2\s+app-1\.example\.com, www\.example\.com/foo`))
+			Expect(testUI.Out).To(Say(`app-2\s+stopped`))
+			Expect(testUI.Err).To(Say("warning-1"))
+			Expect(testUI.Err).To(Say("route-warning"))
+		})
+
+		When("the --labels flag is provided", func() {
+			BeforeEach(func() {
+				cmd.Labels = "env=prod"
+			})
+
+			It("passes the label selector to the actor", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, labelSelector := fakeActor.GetApplicationsWithProcessesBySpaceArgsForCall(0)
+				Expect(labelSelector).To(Equal("env=prod"))
+			})
+		})
+
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the apps as a json document", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).ToNot(Say("Getting apps"))
+				Expect(testUI.Out).To(Say(`"apps": \[`))
+				Expect(testUI.Out).To(Say(`"name": "app-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "app-1-guid"`))
+				Expect(testUI.Out).To(Say(`"requested_state": "started"`))
+				Expect(testUI.Out).To(Say(`"type": "web"`))
+				Expect(testUI.Out).To(Say(`"healthy_instances": 1`))
+				Expect(testUI.Out).To(Say(`"instances": 2`))
+				Expect(testUI.Out).To(Say(`"app-1\.example\.com"`))
+				Expect(testUI.Out).To(Say(`"www\.example\.com/foo"`))
+				Expect(testUI.Out).To(Say(`"name": "app-2"`))
+				Expect(testUI.Out).To(Say(`"processes": \[\]`))
+				Expect(testUI.Out).To(Say(`"routes": \[\]`))
+
+				Expect(testUI.Err).To(Say("warning-1"))
+				Expect(testUI.Err).To(Say("route-warning"))
+			})
+		})
+
+		When("getting the routes returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetRoutesByApplicationsReturns(nil, v7action.Warnings{"route-warning"}, errors.New("route-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("route-error"))
+				Expect(testUI.Err).To(Say("route-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,89 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . CreateRouteActor
+
+type CreateRouteActor interface {
+	CreateRoute(spaceGUID string, domainName string, hostname string, path string) (v7action.Route, v7action.Warnings, error)
+}
+
+type CreateRouteCommand struct {
+	RequiredArgs    flag.Domain `positional-args:"yes"`
+	Hostname        string      `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            string      `long:"path" description:"Path for the HTTP route"`
+	usage           interface{} `usage:"CF_NAME create-route DOMAIN [--hostname HOSTNAME] [--path PATH]\n\nEXAMPLES:\n   CF_NAME create-route example.com                             # example.com\n   CF_NAME create-route example.com --hostname myapp            # myapp.example.com\n   CF_NAME create-route example.com --hostname myapp --path foo # myapp.example.com/foo"`
+	relatedCommands interface{} `related_commands:"domains, map-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       CreateRouteActor
+}
+
+func (cmd *CreateRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd CreateRouteCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	route := v7action.Route{
+		Host:       cmd.Hostname,
+		Path:       cmd.Path,
+		DomainName: cmd.RequiredArgs.Domain,
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Creating route {{.Route}} for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     route,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	_, warnings, err := cmd.Actor.CreateRoute(cmd.Config.TargetedSpace().GUID, cmd.RequiredArgs.Domain, cmd.Hostname, cmd.Path)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.RouteAlreadyExistsError); ok {
+			cmd.UI.DisplayWarning("Route {{.Route}} already exists.", map[string]interface{}{
+				"Route": route,
+			})
+			cmd.UI.DisplayOK()
+			return nil
+		}
+
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Route {{.Route}} has been created.", map[string]interface{}{
+		"Route": route,
+	})
+	cmd.UI.DisplayOK()
+
+	return nil
+}
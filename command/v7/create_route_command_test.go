@@ -0,0 +1,135 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("create-route Command", func() {
+	var (
+		cmd             CreateRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeCreateRouteActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeCreateRouteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = CreateRouteCommand{
+			RequiredArgs: flag.Domain{Domain: "example.com"},
+			Hostname:     "some-host",
+			Path:         "/some-path",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("creating the route succeeds", func() {
+		BeforeEach(func() {
+			fakeActor.CreateRouteReturns(v7action.Route{GUID: "route-guid"}, v7action.Warnings{"create-warning"}, nil)
+		})
+
+		It("creates the route in the targeted space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
+			spaceGUID, domainName, hostname, path := fakeActor.CreateRouteArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(domainName).To(Equal("example.com"))
+			Expect(hostname).To(Equal("some-host"))
+			Expect(path).To(Equal("/some-path"))
+
+			Expect(testUI.Out).To(Say(`Creating route some-host\.example\.com/some-path for org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Route some-host\.example\.com/some-path has been created\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+
+	When("the route already exists", func() {
+		BeforeEach(func() {
+			fakeActor.CreateRouteReturns(v7action.Route{}, v7action.Warnings{"create-warning"}, actionerror.RouteAlreadyExistsError{})
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say("create-warning"))
+			Expect(testUI.Err).To(Say(`Route some-host\.example\.com/some-path already exists\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("creating the route returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.CreateRouteReturns(v7action.Route{}, v7action.Warnings{"create-warning"}, actionerror.DomainNotFoundError{Name: "example.com"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+	})
+})
@@ -0,0 +1,83 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . DeleteLabelActor
+
+type DeleteLabelActor interface {
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+}
+
+type DeleteLabelCommand struct {
+	RequiredArgs    flag.DeleteLabelArgs `positional-args:"yes"`
+	usage           interface{}          `usage:"CF_NAME delete-label RESOURCE RESOURCE_NAME KEY...\n\nEXAMPLES:\n   CF_NAME delete-label app dora ci_signature_sha2\n   CF_NAME delete-label org business pci public-facing\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}          `related_commands:"set-label, labels"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteLabelActor
+}
+
+func (cmd *DeleteLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd DeleteLabelCommand) Execute(args []string) error {
+	labels := map[string]types.NullString{}
+	for _, key := range cmd.RequiredArgs.LabelKeys {
+		labels[key] = types.NullString{}
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err := checkLabelResourceTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	flavorText := labelResourceFlavorText(cmd.Config, resourceType, cmd.RequiredArgs.ResourceName, user.Name)
+	var warnings v7action.Warnings
+	switch resourceType {
+	case "app":
+		cmd.UI.DisplayTextWithFlavor("Deleting label(s) for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case "space":
+		cmd.UI.DisplayTextWithFlavor("Deleting label(s) for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Deleting label(s) for {{.ResourceType}} {{.ResourceName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
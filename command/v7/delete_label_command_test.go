@@ -0,0 +1,135 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-label Command", func() {
+	var (
+		cmd             DeleteLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeleteLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeleteLabelActor)
+
+		cmd = DeleteLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("deleting app labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.DeleteLabelArgs{
+				ResourceType: flag.LabelResource{Type: "app"},
+				ResourceName: "dora",
+				LabelKeys:    []string{"FOO", "ENV"},
+			}
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("unsets the provided label keys on the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"FOO": {},
+				"ENV": {},
+			}))
+
+			Expect(testUI.Out).To(Say(`Deleting label\(s\) for app dora in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v7action.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+
+	Describe("deleting org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.DeleteLabelArgs{
+				ResourceType: flag.LabelResource{Type: "org"},
+				ResourceName: "business",
+				LabelKeys:    []string{"pci"},
+			}
+		})
+
+		It("unsets the provided label keys on the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("business"))
+			Expect(labels).To(Equal(map[string]types.NullString{"pci": {}}))
+
+			Expect(testUI.Out).To(Say(`Deleting label\(s\) for org business as steve\.\.\.`))
+		})
+	})
+
+	Describe("deleting space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.DeleteLabelArgs{
+				ResourceType: flag.LabelResource{Type: "space"},
+				ResourceName: "business-space",
+				LabelKeys:    []string{"owner"},
+			}
+		})
+
+		It("unsets the provided label keys on the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			spaceName, orgGUID, labels := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("business-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{"owner": {}}))
+
+			Expect(testUI.Out).To(Say(`Deleting label\(s\) for space business-space in org some-org as steve\.\.\.`))
+		})
+	})
+})
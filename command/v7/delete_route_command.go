@@ -0,0 +1,97 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . DeleteRouteActor
+
+type DeleteRouteActor interface {
+	DeleteRoute(domainName string, hostname string, path string) (v7action.Warnings, error)
+}
+
+type DeleteRouteCommand struct {
+	RequiredArgs    flag.Domain `positional-args:"yes"`
+	Force           bool        `short:"f" description:"Force deletion without confirmation"`
+	Hostname        string      `long:"hostname" short:"n" description:"Hostname used to identify the HTTP route"`
+	Path            string      `long:"path" description:"Path used to identify the HTTP route"`
+	usage           interface{} `usage:"CF_NAME delete-route DOMAIN [--hostname HOSTNAME] [--path PATH] [-f]\n\nEXAMPLES:\n   CF_NAME delete-route example.com                              # example.com\n   CF_NAME delete-route example.com --hostname myhost            # myhost.example.com\n   CF_NAME delete-route example.com --hostname myhost --path foo # myhost.example.com/foo"`
+	relatedCommands interface{} `related_commands:"delete-orphaned-routes, routes, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DeleteRouteActor
+}
+
+func (cmd *DeleteRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd DeleteRouteCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	route := v7action.Route{
+		Host:       cmd.Hostname,
+		Path:       cmd.Path,
+		DomainName: cmd.RequiredArgs.Domain,
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete the route {{.Route}}?", map[string]interface{}{
+			"Route": route,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("Delete cancelled")
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Deleting route {{.Route}} as {{.Username}}...", map[string]interface{}{
+		"Route":    route,
+		"Username": user.Name,
+	})
+
+	warnings, err := cmd.Actor.DeleteRoute(cmd.RequiredArgs.Domain, cmd.Hostname, cmd.Path)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		if _, ok := err.(actionerror.RouteNotFoundError); !ok {
+			return err
+		}
+
+		cmd.UI.DisplayWarning("Unable to delete. Route {{.Route}} does not exist.", map[string]interface{}{
+			"Route": route,
+		})
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
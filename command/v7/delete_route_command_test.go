@@ -0,0 +1,149 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("delete-route Command", func() {
+	var (
+		cmd             DeleteRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDeleteRouteActor
+		input           *Buffer
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDeleteRouteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DeleteRouteCommand{
+			RequiredArgs: flag.Domain{Domain: "example.com"},
+			Hostname:     "some-host",
+			Force:        true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the -f flag is NOT provided", func() {
+		BeforeEach(func() {
+			cmd.Force = false
+		})
+
+		When("the user inputs yes", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("y\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("deletes the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Really delete the route some-host\.example\.com\?`))
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+			})
+		})
+
+		When("the user inputs no", func() {
+			BeforeEach(func() {
+				_, err := input.Write([]byte("n\n"))
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("cancels the delete", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Delete cancelled"))
+				Expect(fakeActor.DeleteRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	When("deleting the route succeeds", func() {
+		BeforeEach(func() {
+			cmd.Path = "/some-path"
+			fakeActor.DeleteRouteReturns(v7action.Warnings{"delete-warning"}, nil)
+		})
+
+		It("deletes the route and prints warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.DeleteRouteCallCount()).To(Equal(1))
+			domainName, hostname, path := fakeActor.DeleteRouteArgsForCall(0)
+			Expect(domainName).To(Equal("example.com"))
+			Expect(hostname).To(Equal("some-host"))
+			Expect(path).To(Equal("/some-path"))
+
+			Expect(testUI.Out).To(Say(`Deleting route some-host\.example\.com/some-path as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteRouteReturns(v7action.Warnings{"delete-warning"}, actionerror.RouteNotFoundError{})
+		})
+
+		It("displays a warning and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Err).To(Say(`Unable to delete\. Route some-host\.example\.com does not exist\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	When("deleting the route returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.DeleteRouteReturns(v7action.Warnings{"delete-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("delete-warning"))
+		})
+	})
+})
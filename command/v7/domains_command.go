@@ -0,0 +1,126 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . DomainsActor
+
+type DomainsActor interface {
+	GetOrganizationDomains(orgGUID string) ([]v7action.Domain, v7action.Warnings, error)
+}
+
+type domainsRecord struct {
+	Domains []domainRecord `json:"domains" yaml:"domains"`
+}
+
+type domainRecord struct {
+	Name         string `json:"name" yaml:"name"`
+	GUID         string `json:"guid" yaml:"guid"`
+	Availability string `json:"availability" yaml:"availability"`
+	Internal     bool   `json:"internal" yaml:"internal"`
+}
+
+type DomainsCommand struct {
+	usage           interface{} `usage:"CF_NAME domains"`
+	relatedCommands interface{} `related_commands:"create-route, routes"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       DomainsActor
+}
+
+func (cmd *DomainsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (DomainsCommand) SupportsStructuredOutput() {}
+
+func (cmd DomainsCommand) Execute(args []string) error {
+	if len(args) > 0 {
+		return translatableerror.TooManyArgumentsError{
+			ExtraArgument: args[0],
+		}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	org := cmd.Config.TargetedOrganization()
+	cmd.UI.DisplayTextWithFlavor("Getting domains in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  org.Name,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	domains, warnings, err := cmd.Actor.GetOrganizationDomains(org.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := domainsRecord{Domains: []domainRecord{}}
+	if len(domains) == 0 {
+		cmd.UI.DisplayText("No domains found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("availability"),
+			cmd.UI.TranslateText("internal"),
+		},
+	}
+
+	for _, domain := range domains {
+		availability := "private"
+		if domain.Shared() {
+			availability = "shared"
+		}
+
+		internal := ""
+		if domain.Internal.Value {
+			internal = "true"
+		}
+
+		table = append(table, []string{
+			domain.Name,
+			cmd.UI.TranslateText(availability),
+			internal,
+		})
+		record.Domains = append(record.Domains, domainRecord{
+			Name:         domain.Name,
+			GUID:         domain.GUID,
+			Availability: availability,
+			Internal:     domain.Internal.Value,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
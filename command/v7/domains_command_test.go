@@ -0,0 +1,157 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("domains Command", func() {
+	var (
+		cmd             DomainsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeDomainsActor
+		binaryName      string
+		args            []string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeDomainsActor)
+		args = nil
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = DomainsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(args)
+	})
+
+	When("too many arguments are provided", func() {
+		BeforeEach(func() {
+			args = []string{"extra"}
+		})
+
+		It("returns a TooManyArgumentsError", func() {
+			Expect(executeErr).To(MatchError(translatableerror.TooManyArgumentsError{ExtraArgument: "extra"}))
+		})
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("getting the domains returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationDomainsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no domains", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationDomainsReturns(nil, v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("displays that no domains were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting domains in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No domains found."))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are domains", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationDomainsReturns(
+				[]v7action.Domain{
+					{Name: "shared.com", GUID: "shared-guid"},
+					{Name: "private.com", GUID: "private-guid", OrganizationGUID: "some-org-guid"},
+					{Name: "apps.internal", GUID: "internal-guid", Internal: types.NullBool{IsSet: true, Value: true}},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the domains and their availability", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationDomainsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationDomainsArgsForCall(0)).To(Equal("some-org-guid"))
+
+			Expect(testUI.Out).To(Say(`name\s+availability\s+internal`))
+			Expect(testUI.Out).To(Say(`shared\.com\s+shared`))
+			Expect(testUI.Out).To(Say(`private\.com\s+private`))
+			Expect(testUI.Out).To(Say(`apps\.internal\s+shared\s+true`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the domains as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"name": "private.com"`))
+				Expect(testUI.Out).To(Say(`"availability": "private"`))
+			})
+		})
+	})
+})
@@ -0,0 +1,145 @@
+package v7
+
+import (
+	"sort"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . LabelsActor
+
+type LabelsActor interface {
+	GetApplicationLabels(appName string, spaceGUID string) (map[string]types.NullString, v7action.Warnings, error)
+	GetOrganizationLabels(orgName string) (map[string]types.NullString, v7action.Warnings, error)
+	GetSpaceLabels(spaceName string, orgGUID string) (map[string]types.NullString, v7action.Warnings, error)
+}
+
+type labelsRecord struct {
+	Labels map[string]string `json:"labels" yaml:"labels"`
+}
+
+type LabelsCommand struct {
+	RequiredArgs    flag.LabelsArgs `positional-args:"yes"`
+	usage           interface{}     `usage:"CF_NAME labels RESOURCE RESOURCE_NAME\n\nEXAMPLES:\n   CF_NAME labels app dora\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}     `related_commands:"set-label, delete-label"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       LabelsActor
+}
+
+func (cmd *LabelsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (LabelsCommand) SupportsStructuredOutput() {}
+
+func (cmd LabelsCommand) Execute(args []string) error {
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err := checkLabelResourceTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	flavorText := labelResourceFlavorText(cmd.Config, resourceType, cmd.RequiredArgs.ResourceName, user.Name)
+	switch resourceType {
+	case "app":
+		cmd.UI.DisplayTextWithFlavor("Getting labels for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", flavorText)
+	case "space":
+		cmd.UI.DisplayTextWithFlavor("Getting labels for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", flavorText)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting labels for {{.ResourceType}} {{.ResourceName}} as {{.Username}}...", flavorText)
+	}
+	cmd.UI.DisplayNewline()
+
+	var (
+		labels   map[string]types.NullString
+		warnings v7action.Warnings
+	)
+	switch resourceType {
+	case "app":
+		labels, warnings, err = cmd.Actor.GetApplicationLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID)
+	case "org":
+		labels, warnings, err = cmd.Actor.GetOrganizationLabels(cmd.RequiredArgs.ResourceName)
+	case "space":
+		labels, warnings, err = cmd.Actor.GetSpaceLabels(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := labelsRecord{Labels: map[string]string{}}
+	if len(labels) == 0 {
+		cmd.UI.DisplayText("No labels found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("key"),
+			cmd.UI.TranslateText("value"),
+		},
+	}
+	for _, key := range keys {
+		table = append(table, []string{key, labels[key].Value})
+		record.Labels[key] = labels[key].Value
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
+
+// checkLabelResourceTarget checks that the org and space that a resource of
+// the given type is looked up in are targeted.
+func checkLabelResourceTarget(sharedActor command.SharedActor, resourceType string) error {
+	switch resourceType {
+	case "app":
+		return sharedActor.CheckTarget(true, true)
+	case "space":
+		return sharedActor.CheckTarget(true, false)
+	default:
+		return sharedActor.CheckTarget(false, false)
+	}
+}
+
+// labelResourceFlavorText returns the keys used to display the resource that a
+// label command acts on.
+func labelResourceFlavorText(config command.Config, resourceType string, resourceName string, username string) map[string]interface{} {
+	return map[string]interface{}{
+		"ResourceType": resourceType,
+		"ResourceName": resourceName,
+		"OrgName":      config.TargetedOrganization().Name,
+		"SpaceName":    config.TargetedSpace().Name,
+		"Username":     username,
+	}
+}
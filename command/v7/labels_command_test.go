@@ -0,0 +1,205 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("labels Command", func() {
+	var (
+		cmd             LabelsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeLabelsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeLabelsActor)
+
+		cmd = LabelsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("listing app labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.LabelsArgs{
+				ResourceType: flag.LabelResource{Type: "app"},
+				ResourceName: "dora",
+			}
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+				Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+				checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeTrue())
+			})
+		})
+
+		When("the user is not logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError("some current user error"))
+			})
+		})
+
+		When("getting the labels returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		When("the app has no labels", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(nil, v7action.Warnings{"warning-1"}, nil)
+			})
+
+			It("displays that no labels were found", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`Getting labels for app dora in org some-org / space some-space as steve\.\.\.`))
+				Expect(testUI.Out).To(Say("No labels found."))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+
+		When("the app has labels", func() {
+			BeforeEach(func() {
+				fakeActor.GetApplicationLabelsReturns(
+					map[string]types.NullString{
+						"some-other-label": types.NewNullString("some-other-value"),
+						"some-label":       types.NewNullString("some-value"),
+					},
+					v7action.Warnings{"warning-1"},
+					nil,
+				)
+			})
+
+			It("displays the labels sorted by key", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.GetApplicationLabelsCallCount()).To(Equal(1))
+				appName, spaceGUID := fakeActor.GetApplicationLabelsArgsForCall(0)
+				Expect(appName).To(Equal("dora"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+
+				Expect(testUI.Out).To(Say(`key\s+value`))
+				Expect(testUI.Out).To(Say(`some-label\s+some-value`))
+				Expect(testUI.Out).To(Say(`some-other-label\s+some-other-value`))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+
+			When("the output format is JSON", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = configv3.OutputFormatJSON
+				})
+
+				It("displays the labels as JSON", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`"some-label": "some-value"`))
+				})
+			})
+		})
+	})
+
+	Describe("listing org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.LabelsArgs{
+				ResourceType: flag.LabelResource{Type: "org"},
+				ResourceName: "business",
+			}
+			fakeActor.GetOrganizationLabelsReturns(
+				map[string]types.NullString{"pci": types.NewNullString("true")},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("does not require a targeted org and displays the labels", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeFalse())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.GetOrganizationLabelsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationLabelsArgsForCall(0)).To(Equal("business"))
+
+			Expect(testUI.Out).To(Say(`Getting labels for org business as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`pci\s+true`))
+		})
+	})
+
+	Describe("listing space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.LabelsArgs{
+				ResourceType: flag.LabelResource{Type: "space"},
+				ResourceName: "business-space",
+			}
+			fakeActor.GetSpaceLabelsReturns(
+				map[string]types.NullString{"owner": types.NewNullString("jane")},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("requires a targeted org and displays the labels", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(fakeActor.GetSpaceLabelsCallCount()).To(Equal(1))
+			spaceName, orgGUID := fakeActor.GetSpaceLabelsArgsForCall(0)
+			Expect(spaceName).To(Equal("business-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+
+			Expect(testUI.Out).To(Say(`Getting labels for space business-space in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`owner\s+jane`))
+		})
+	})
+})
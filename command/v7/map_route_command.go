@@ -0,0 +1,153 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+// defaultRouteDestinationPort is the port the Cloud Controller sends route
+// traffic to when no port is given.
+const defaultRouteDestinationPort = 8080
+
+//go:generate counterfeiter . MapRouteActor
+
+type MapRouteActor interface {
+	CreateRoute(spaceGUID string, domainName string, hostname string, path string) (v7action.Route, v7action.Warnings, error)
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetDomainByName(domainName string) (v7action.Domain, v7action.Warnings, error)
+	GetRouteByAttributes(domainName string, domainGUID string, hostname string, path string) (v7action.Route, v7action.Warnings, error)
+	GetRouteDestinationsByAppGUID(routeGUID string, appGUID string) ([]v7action.RouteDestination, v7action.Warnings, error)
+	MapRoute(routeGUID string, appGUID string, processType string, port int) (v7action.Warnings, error)
+}
+
+type MapRouteCommand struct {
+	RequiredArgs    flag.AppDomain `positional-args:"yes"`
+	Hostname        string         `long:"hostname" short:"n" description:"Hostname for the HTTP route (required for shared domains)"`
+	Path            string         `long:"path" description:"Path for the HTTP route"`
+	Process         string         `long:"process" description:"Process type of the app that receives traffic from the route (Default: web)"`
+	Port            int            `long:"port" description:"Port on the app process that receives traffic from the route (Default: 8080)"`
+	usage           interface{}    `usage:"CF_NAME map-route APP_NAME DOMAIN [--hostname HOSTNAME] [--path PATH] [--process PROCESS_TYPE] [--port PORT]\n\nEXAMPLES:\n   CF_NAME map-route my-app example.com                              # example.com\n   CF_NAME map-route my-app example.com --hostname myhost            # myhost.example.com\n   CF_NAME map-route my-app example.com --hostname myhost --path foo # myhost.example.com/foo\n   CF_NAME map-route my-app example.com --hostname myhost --process worker --port 9090"`
+	relatedCommands interface{}    `related_commands:"create-route, routes, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       MapRouteActor
+}
+
+func (cmd *MapRouteCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd MapRouteCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	domain, warnings, err := cmd.Actor.GetDomainByName(cmd.RequiredArgs.Domain)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	spaceGUID := cmd.Config.TargetedSpace().GUID
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.App, spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	route, warnings, err := cmd.Actor.GetRouteByAttributes(domain.Name, domain.GUID, cmd.Hostname, cmd.Path)
+	cmd.UI.DisplayWarnings(warnings)
+	if _, ok := err.(actionerror.RouteNotFoundError); ok {
+		route = v7action.Route{Host: cmd.Hostname, Path: cmd.Path, DomainName: domain.Name}
+		cmd.UI.DisplayTextWithFlavor("Creating route {{.Route}} for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"Route":     route,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+
+		route, warnings, err = cmd.Actor.CreateRoute(spaceGUID, domain.Name, cmd.Hostname, cmd.Path)
+		cmd.UI.DisplayWarnings(warnings)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Mapping route {{.Route}} to app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Route":     route,
+		"AppName":   cmd.RequiredArgs.App,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	destinations, warnings, err := cmd.Actor.GetRouteDestinationsByAppGUID(route.GUID, app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err == nil && cmd.matchesAnyDestination(destinations) {
+		cmd.UI.DisplayText("App '{{.AppName}}' is already mapped to route '{{.Route}}'.", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.App,
+			"Route":   route,
+		})
+		cmd.UI.DisplayOK()
+		return nil
+	}
+	if _, ok := err.(actionerror.RouteDestinationNotFoundError); err != nil && !ok {
+		return err
+	}
+
+	warnings, err = cmd.Actor.MapRoute(route.GUID, app.GUID, cmd.Process, cmd.Port)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
+
+// matchesAnyDestination reports whether one of the existing destinations
+// already sends traffic to the requested process type and port. The Cloud
+// Controller defaults to the web process and port 8080, so those are compared
+// when no process type or port is requested.
+func (cmd MapRouteCommand) matchesAnyDestination(destinations []v7action.RouteDestination) bool {
+	processType := cmd.Process
+	if processType == "" {
+		processType = constant.ProcessTypeWeb
+	}
+
+	port := cmd.Port
+	if port == 0 {
+		port = defaultRouteDestinationPort
+	}
+
+	for _, destination := range destinations {
+		if destination.ProcessType == processType && destination.Port == port {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,310 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("map-route Command", func() {
+	var (
+		cmd             MapRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeMapRouteActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeMapRouteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = MapRouteCommand{
+			RequiredArgs: flag.AppDomain{App: "some-app", Domain: "example.com"},
+			Hostname:     "some-host",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetDomainByNameReturns(
+			v7action.Domain{Name: "example.com", GUID: "domain-guid"},
+			v7action.Warnings{"get-domain-warning"},
+			nil,
+		)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v7action.Application{Name: "some-app", GUID: "app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetRouteByAttributesReturns(
+			v7action.Route{GUID: "route-guid", Host: "some-host", DomainName: "example.com"},
+			v7action.Warnings{"get-route-warning"},
+			nil,
+		)
+		fakeActor.GetRouteDestinationsByAppGUIDReturns(
+			nil,
+			v7action.Warnings{"get-destination-warning"},
+			actionerror.RouteDestinationNotFoundError{},
+		)
+		fakeActor.MapRouteReturns(v7action.Warnings{"map-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("getting the domain returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetDomainByNameReturns(v7action.Domain{}, v7action.Warnings{"get-domain-warning"}, actionerror.DomainNotFoundError{Name: "example.com"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.DomainNotFoundError{Name: "example.com"}))
+			Expect(testUI.Err).To(Say("get-domain-warning"))
+			Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the app returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v7action.Application{}, v7action.Warnings{"get-app-warning"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	When("the route exists and is not mapped to the app", func() {
+		It("maps the route to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			domainName, domainGUID, hostname, path := fakeActor.GetRouteByAttributesArgsForCall(0)
+			Expect(domainName).To(Equal("example.com"))
+			Expect(domainGUID).To(Equal("domain-guid"))
+			Expect(hostname).To(Equal("some-host"))
+			Expect(path).To(BeEmpty())
+			Expect(fakeActor.CreateRouteCallCount()).To(Equal(0))
+
+			routeGUID, appGUID := fakeActor.GetRouteDestinationsByAppGUIDArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("app-guid"))
+
+			Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+			routeGUID, appGUID, processType, port := fakeActor.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(appGUID).To(Equal("app-guid"))
+			Expect(processType).To(BeEmpty())
+			Expect(port).To(BeZero())
+
+			Expect(testUI.Out).To(Say(`Mapping route some-host\.example\.com to app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-domain-warning"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+			Expect(testUI.Err).To(Say("get-destination-warning"))
+			Expect(testUI.Err).To(Say("map-warning"))
+		})
+
+		When("the process type and port are provided", func() {
+			BeforeEach(func() {
+				cmd.Process = "worker"
+				cmd.Port = 9090
+			})
+
+			It("maps the route to the given process type and port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+				_, _, processType, port := fakeActor.MapRouteArgsForCall(0)
+				Expect(processType).To(Equal("worker"))
+				Expect(port).To(Equal(9090))
+			})
+		})
+
+		When("mapping the route returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.MapRouteReturns(v7action.Warnings{"map-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("map-warning"))
+			})
+		})
+	})
+
+	When("the route is already mapped to the app", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteDestinationsByAppGUIDReturns(
+				[]v7action.RouteDestination{
+					{GUID: "worker-destination-guid", AppGUID: "app-guid", ProcessType: "worker", Port: 9090},
+					{GUID: "web-destination-guid", AppGUID: "app-guid", ProcessType: "web", Port: 8080},
+				},
+				v7action.Warnings{"get-destination-warning"},
+				nil,
+			)
+		})
+
+		It("does not map the route again", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`App 'some-app' is already mapped to route 'some-host\.example\.com'\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+		})
+
+		When("the process type and port of another destination are requested", func() {
+			BeforeEach(func() {
+				cmd.Process = "worker"
+				cmd.Port = 9090
+			})
+
+			It("does not map the route again", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`App 'some-app' is already mapped to route 'some-host\.example\.com'\.`))
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+			})
+		})
+
+		When("a process type without a destination is requested", func() {
+			BeforeEach(func() {
+				cmd.Process = "other"
+			})
+
+			It("maps the route to the requested process type", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("already mapped"))
+
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+				_, _, processType, _ := fakeActor.MapRouteArgsForCall(0)
+				Expect(processType).To(Equal("other"))
+			})
+		})
+
+		When("a port without a destination is requested", func() {
+			BeforeEach(func() {
+				cmd.Port = 9090
+			})
+
+			It("maps the route to the web process on the requested port", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("already mapped"))
+
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+				_, _, processType, port := fakeActor.MapRouteArgsForCall(0)
+				Expect(processType).To(BeEmpty())
+				Expect(port).To(Equal(9090))
+			})
+		})
+
+		When("only non-default destinations exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteDestinationsByAppGUIDReturns(
+					[]v7action.RouteDestination{
+						{GUID: "worker-destination-guid", AppGUID: "app-guid", ProcessType: "worker", Port: 9090},
+					},
+					v7action.Warnings{"get-destination-warning"},
+					nil,
+				)
+			})
+
+			It("maps the route to the web process on port 8080", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("already mapped"))
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByAttributesReturns(v7action.Route{}, v7action.Warnings{"get-route-warning"}, actionerror.RouteNotFoundError{})
+			fakeActor.CreateRouteReturns(
+				v7action.Route{GUID: "new-route-guid", Host: "some-host", DomainName: "example.com"},
+				v7action.Warnings{"create-warning"},
+				nil,
+			)
+		})
+
+		It("creates the route and maps it to the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.CreateRouteCallCount()).To(Equal(1))
+			spaceGUID, domainName, hostname, path := fakeActor.CreateRouteArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(domainName).To(Equal("example.com"))
+			Expect(hostname).To(Equal("some-host"))
+			Expect(path).To(BeEmpty())
+
+			routeGUID, _, _, _ := fakeActor.MapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("new-route-guid"))
+
+			Expect(testUI.Out).To(Say(`Creating route some-host\.example\.com for org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Mapping route some-host\.example\.com to app some-app`))
+			Expect(testUI.Err).To(Say("create-warning"))
+		})
+
+		When("creating the route returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.CreateRouteReturns(v7action.Route{}, v7action.Warnings{"create-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("create-warning"))
+				Expect(fakeActor.MapRouteCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,88 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . OrgsActor
+
+type OrgsActor interface {
+	GetOrganizations(labelSelector string) ([]v7action.Organization, v7action.Warnings, error)
+}
+
+type orgsRecord struct {
+	Organizations []organizationRecord `json:"organizations" yaml:"organizations"`
+}
+
+type organizationRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+type OrgsCommand struct {
+	Labels string      `long:"labels" description:"Selector to filter orgs by labels"`
+	usage  interface{} `usage:"CF_NAME orgs [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME orgs\n   CF_NAME orgs --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME orgs --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       OrgsActor
+}
+
+func (cmd *OrgsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (OrgsCommand) SupportsStructuredOutput() {}
+
+func (cmd OrgsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(false, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting orgs as {{.Username}}...", map[string]interface{}{
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	orgs, warnings, err := cmd.Actor.GetOrganizations(cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := orgsRecord{Organizations: []organizationRecord{}}
+	if len(orgs) == 0 {
+		cmd.UI.DisplayText("No orgs found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	for _, org := range orgs {
+		table = append(table, []string{org.Name})
+		record.Organizations = append(record.Organizations, organizationRecord{Name: org.Name, GUID: org.GUID})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
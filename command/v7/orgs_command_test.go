@@ -0,0 +1,119 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("orgs Command", func() {
+	var (
+		cmd             OrgsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeOrgsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeOrgsActor)
+
+		cmd = OrgsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	It("only checks that the user is logged in", func() {
+		checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+		Expect(checkTargetedOrg).To(BeFalse())
+		Expect(checkTargetedSpace).To(BeFalse())
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("getting the orgs returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationsReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no orgs", func() {
+		It("displays that no orgs were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting orgs as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No orgs found."))
+		})
+	})
+
+	When("there are orgs", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationsReturns(
+				[]v7action.Organization{
+					{Name: "org-1", GUID: "org-guid-1"},
+					{Name: "org-2", GUID: "org-guid-2"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+			cmd.Labels = "env=prod"
+		})
+
+		It("displays the orgs filtered by the label selector", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationsCallCount()).To(Equal(1))
+			Expect(fakeActor.GetOrganizationsArgsForCall(0)).To(Equal("env=prod"))
+
+			Expect(testUI.Out).To(Say("name"))
+			Expect(testUI.Out).To(Say("org-1"))
+			Expect(testUI.Out).To(Say("org-2"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the orgs as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"name": "org-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "org-guid-1"`))
+			})
+		})
+	})
+})
@@ -1,11 +1,13 @@
 package v7
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/actor/v7pushaction"
@@ -14,6 +16,7 @@ import (
 	"code.cloudfoundry.org/cli/command/translatableerror"
 	v6shared "code.cloudfoundry.org/cli/command/v6/shared"
 	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/progressbar"
 
 	log "github.com/sirupsen/logrus"
@@ -32,8 +35,15 @@ type ProgressBar interface {
 type PushActor interface {
 	// Actualize applies any necessary changes.
 	Actualize(state v7pushaction.PushState, progressBar v7pushaction.ProgressBar) (<-chan v7pushaction.PushState, <-chan v7pushaction.Event, <-chan v7pushaction.Warnings, <-chan error)
+	// ActualizeConcurrently applies any necessary changes to several apps at
+	// the same time.
+	ActualizeConcurrently(states []v7pushaction.PushState, parallelism int, newProgressBar func(appName string) v7pushaction.ProgressBar) <-chan v7pushaction.AppEvent
 	// Conceptualize figures out the state of the world.
-	Conceptualize(appName string, spaceGUID string, orgGUID string, currentDir string, flagOverrides v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)
+	Conceptualize(appName string, manifestApps []manifest.Application, spaceGUID string, orgGUID string, currentDir string, flagOverrides v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)
+	// ReadManifest returns the apps of a manifest file.
+	ReadManifest(pathToManifest string) ([]manifest.Application, error)
+	// Plan figures out the changes Actualize would apply, without applying them.
+	Plan(state v7pushaction.PushState) (v7pushaction.PushPlan, v7pushaction.Warnings, error)
 }
 
 //go:generate counterfeiter . V7ActorForPush
@@ -46,17 +56,22 @@ type V7ActorForPush interface {
 }
 
 type PushCommand struct {
-	RequiredArgs        flag.AppName                `positional-args:"yes"`
+	OptionalArgs        flag.OptionalAppName        `positional-args:"yes"`
 	Buildpacks          []string                    `long:"buildpack" short:"b" description:"Custom buildpack by name (e.g. my-buildpack) or Git URL (e.g. 'https://github.com/cloudfoundry/java-buildpack.git') or Git URL with a branch or tag (e.g. 'https://github.com/cloudfoundry/java-buildpack.git#v3.3.0' for 'v3.3.0' tag). To use built-in buildpacks only, specify 'default' or 'null'"`
 	DockerImage         flag.DockerImage            `long:"docker-image" short:"o" description:"Docker image to use (e.g. user/docker-image-name)"`
 	DockerUsername      string                      `long:"docker-username" description:"Repository username; used with password from environment variable CF_DOCKER_PASSWORD"`
+	DryRun              bool                        `long:"dry-run" description:"Display the changes push would make without applying them"`
+	PathToManifest      flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
 	HealthCheckType     flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type: 'port' (default), 'process', 'http' (implies endpoint '/')"`
 	Memory              flag.Megabytes              `long:"memory" short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	NoManifest          bool                        `long:"no-manifest" description:"Ignore manifest file"`
 	NoRoute             bool                        `long:"no-route" description:"Do not map a route to this app"`
 	NoStart             bool                        `long:"no-start" description:"Do not stage and start the app after pushing"`
+	Parallel            flag.PositiveInteger        `long:"parallel" description:"Push up to this many apps at the same time, prefixing the output with the app name"`
 	AppPath             flag.PathWithExistenceCheck `short:"p" description:"Path to app directory or to a zip file of the contents of the app directory"`
 	dockerPassword      interface{}                 `environmentName:"CF_DOCKER_PASSWORD" environmentDescription:"Password used for private docker repository"`
-	usage               interface{}                 `usage:"CF_NAME push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [--no-route] [--no-start]\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [--no-route] [--no-start]"`
+	envCFHonorGitIgnore interface{}                 `environmentName:"CF_HONOR_GITIGNORE" environmentDescription:"Also exclude files matched by .gitignore files from the app bits" environmentDefault:"false"`
+	usage               interface{}                 `usage:"CF_NAME push APP_NAME [-b BUILDPACK]... [-p APP_PATH] [-f MANIFEST_PATH | --no-manifest] [--no-route] [--no-start] [--dry-run]\n   CF_NAME push APP_NAME --docker-image [REGISTRY_HOST:PORT/]IMAGE[:TAG] [--docker-username USERNAME] [-f MANIFEST_PATH | --no-manifest] [--no-route] [--no-start] [--dry-run]\n   CF_NAME push -f MANIFEST_WITH_MULTIPLE_APPS_PATH [APP_NAME] [--no-start] [--dry-run] [--parallel NUMBER]"`
 	envCFStagingTimeout interface{}                 `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{}                 `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 
@@ -66,7 +81,6 @@ type PushCommand struct {
 	Actor        PushActor
 	VersionActor V7ActorForPush
 	SharedActor  command.SharedActor
-	RouteActor   v7action.RouteActor
 	ProgressBar  ProgressBar
 	PWD          string
 }
@@ -86,14 +100,7 @@ func (cmd *PushCommand) Setup(config command.Config, ui command.UI) error {
 
 	v7actor := v7action.NewActor(ccClient, config, sharedActor, uaaClient)
 	cmd.VersionActor = v7actor
-	ccClientV2, uaaClientV2, err := v6shared.NewClients(config, ui, true)
-	if err != nil {
-		return err
-	}
-
-	v2Actor := v2action.NewActor(ccClientV2, uaaClientV2, config)
-	cmd.RouteActor = v2Actor
-	cmd.Actor = v7pushaction.NewActor(v2Actor, v7actor, sharedActor)
+	cmd.Actor = v7pushaction.NewActor(v7actor, sharedActor)
 
 	cmd.NOAAClient = v6shared.NewNOAAClient(ccClient.Info.Logging(), config, uaaClient, ui)
 
@@ -121,18 +128,56 @@ func (cmd PushCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Pushing app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
-		"AppName":   cmd.RequiredArgs.AppName,
-		"OrgName":   cmd.Config.TargetedOrganization().Name,
-		"SpaceName": cmd.Config.TargetedSpace().Name,
-		"Username":  user.Name,
-	})
+	pathToManifest, err := cmd.findManifest()
+	if err != nil {
+		return err
+	}
+
+	var manifestApps []manifest.Application
+	if pathToManifest != "" {
+		log.WithField("pathToManifest", pathToManifest).Info("reading manifest")
+		manifestApps, err = cmd.Actor.ReadManifest(pathToManifest)
+		if err != nil {
+			return err
+		}
+	}
+
+	if cmd.OptionalArgs.AppName == "" && len(manifestApps) == 0 {
+		return translatableerror.RequiredNameForPushError{}
+	}
+
+	if pathToManifest == "" {
+		flavorText := "Pushing app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+		if cmd.DryRun {
+			flavorText = "Planning push of app {{.AppName}} to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+		}
+		cmd.UI.DisplayTextWithFlavor(flavorText, map[string]interface{}{
+			"AppName":   cmd.OptionalArgs.AppName,
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+	} else {
+		flavorText := "Pushing from manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+		if cmd.DryRun {
+			flavorText = "Planning push from manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}..."
+		}
+		cmd.UI.DisplayTextWithFlavor(flavorText, map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		cmd.UI.DisplayText("Using manifest file {{.Path}}", map[string]interface{}{
+			"Path": pathToManifest,
+		})
+	}
 
 	cmd.UI.DisplayText("Getting app info...")
 
 	log.Info("generating the app state")
 	pushState, warnings, err := cmd.Actor.Conceptualize(
-		cmd.RequiredArgs.AppName,
+		cmd.OptionalArgs.AppName,
+		manifestApps,
 		cmd.Config.TargetedSpace().GUID,
 		cmd.Config.TargetedOrganization().GUID,
 		cmd.PWD,
@@ -144,6 +189,14 @@ func (cmd PushCommand) Execute(args []string) error {
 	}
 	log.WithField("number of states", len(pushState)).Debug("completed generating state")
 
+	if cmd.DryRun {
+		return cmd.displayPlans(pushState)
+	}
+
+	if cmd.Parallel.Value > 0 {
+		return cmd.pushConcurrently(pushState)
+	}
+
 	for _, state := range pushState {
 		log.WithField("app_name", state.Application.Name).Info("actualizing")
 		stateStream, eventStream, warningsStream, errorStream := cmd.Actor.Actualize(state, cmd.ProgressBar)
@@ -180,7 +233,7 @@ func (cmd PushCommand) Execute(args []string) error {
 		if err != nil {
 			if _, ok := err.(actionerror.StartupTimeoutError); ok {
 				return translatableerror.StartupTimeoutError{
-					AppName:    cmd.RequiredArgs.AppName,
+					AppName:    state.Application.Name,
 					BinaryName: cmd.Config.BinaryName(),
 				}
 			}
@@ -189,20 +242,268 @@ func (cmd PushCommand) Execute(args []string) error {
 		}
 
 		log.Info("getting application summary info")
-		summary, warnings, err := cmd.VersionActor.GetApplicationSummaryByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID, true, cmd.RouteActor)
+		summary, warnings, err := cmd.VersionActor.GetApplicationSummaryByNameAndSpace(state.Application.Name, cmd.Config.TargetedSpace().GUID, true)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		cmd.UI.DisplayNewline()
+		appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
+		appSummaryDisplayer.AppDisplay(summary, true)
+	}
+
+	return nil
+}
+
+// findManifest returns the path to the manifest given with -f, or to the
+// manifest in the current directory. It returns an empty path when there is
+// no manifest or --no-manifest is provided.
+func (cmd PushCommand) findManifest() (string, error) {
+	switch {
+	case cmd.NoManifest:
+		log.Debug("skipping reading of manifest")
+		return "", nil
+	case cmd.PathToManifest != "":
+		log.WithField("file", cmd.PathToManifest).Debug("using specified manifest file")
+		pathToManifest := string(cmd.PathToManifest)
+
+		fileInfo, err := os.Stat(pathToManifest)
+		if err != nil {
+			return "", err
+		}
+		if !fileInfo.IsDir() {
+			return pathToManifest, nil
+		}
+
+		for _, manifestPath := range []string{
+			filepath.Join(pathToManifest, "manifest.yml"),
+			filepath.Join(pathToManifest, "manifest.yaml"),
+		} {
+			if _, err = os.Stat(manifestPath); err == nil {
+				return manifestPath, nil
+			}
+		}
+		return "", translatableerror.ManifestFileNotFoundInDirectoryError{
+			PathToManifest: pathToManifest,
+		}
+	default:
+		log.Debug("searching for manifest file")
+		for _, manifestPath := range []string{
+			filepath.Join(cmd.PWD, "manifest.yml"),
+			filepath.Join(cmd.PWD, "manifest.yaml"),
+		} {
+			if _, err := os.Stat(manifestPath); err == nil {
+				return manifestPath, nil
+			}
+			log.WithField("pathToManifest", manifestPath).Debug("could not find")
+		}
+		return "", nil
+	}
+}
+
+// displayPlans displays the changes push would make to each application
+// without applying any of them.
+func (cmd PushCommand) displayPlans(pushState []v7pushaction.PushState) error {
+	for _, state := range pushState {
+		log.WithField("app_name", state.Application.Name).Info("planning")
+		plan, warnings, err := cmd.Actor.Plan(state)
 		cmd.UI.DisplayWarnings(warnings)
 		if err != nil {
 			return err
 		}
 
+		cmd.UI.DisplayNewline()
+		if plan.CreatingApplication() {
+			cmd.UI.DisplayText("Push would create app with these attributes...")
+		} else {
+			cmd.UI.DisplayText("Push would update app with these attributes...")
+		}
+
+		err = cmd.UI.DisplayChangesForPush(shared.GetPushPlanChanges(plan))
+		if err != nil {
+			return err
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Dry run complete. No changes were made.")
+	return nil
+}
+
+// pushConcurrently pushes at most --parallel apps at the same time. Every line
+// of output is prefixed with the name of its app, and the failures of all apps
+// are reported together at the end.
+func (cmd PushCommand) pushConcurrently(pushState []v7pushaction.PushState) error {
+	failures := map[string]error{}
+	updatedStates := map[string]v7pushaction.PushState{}
+
+	appEvents := cmd.Actor.ActualizeConcurrently(pushState, cmd.Parallel.Value, cmd.newAppProgressBar)
+	for appEvent := range appEvents {
+		switch {
+		case appEvent.Err != nil:
+			failures[appEvent.AppName] = appEvent.Err
+			cmd.displayAppText(appEvent.AppName, "Push failed")
+		case appEvent.State != nil:
+			updatedStates[appEvent.AppName] = *appEvent.State
+		case len(appEvent.Warnings) > 0:
+			cmd.displayAppWarnings(appEvent.AppName, appEvent.Warnings)
+		default:
+			cmd.processAppEvent(appEvent.AppName, appEvent.Event)
+		}
+	}
+
+	var (
+		lock          sync.Mutex
+		wg            sync.WaitGroup
+		startFailures = map[string]error{}
+	)
+	semaphore := make(chan struct{}, cmd.Parallel.Value)
+	for _, state := range pushState {
+		appName := state.Application.Name
+		if _, failed := failures[appName]; failed {
+			continue
+		}
+
+		semaphore <- struct{}{}
+		wg.Add(1)
+		go func(appName string, appGUID string) {
+			defer wg.Done()
+			defer func() { <-semaphore }()
+
+			err := cmd.startApp(appName, appGUID)
+			if err != nil {
+				lock.Lock()
+				startFailures[appName] = err
+				lock.Unlock()
+				cmd.displayAppText(appName, "Push failed")
+			}
+		}(appName, updatedStates[appName].Application.GUID)
+	}
+	wg.Wait()
+
+	for appName, err := range startFailures {
+		failures[appName] = err
+	}
+
+	var appErrors []string
+	for _, state := range pushState {
+		appName := state.Application.Name
+		if err, failed := failures[appName]; failed {
+			appErrors = append(appErrors, fmt.Sprintf("%s: %s", appName, err))
+			continue
+		}
+
+		log.WithField("app_name", appName).Info("getting application summary info")
+		summary, warnings, err := cmd.VersionActor.GetApplicationSummaryByNameAndSpace(appName, cmd.Config.TargetedSpace().GUID, true)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			appErrors = append(appErrors, fmt.Sprintf("%s: %s", appName, err))
+			continue
+		}
+
 		cmd.UI.DisplayNewline()
 		appSummaryDisplayer := shared.NewAppSummaryDisplayer(cmd.UI)
 		appSummaryDisplayer.AppDisplay(summary, true)
 	}
 
+	if len(appErrors) > 0 {
+		return translatableerror.PushFailedError{AppErrors: appErrors}
+	}
+
 	return nil
 }
 
+// startApp restarts an app that was pushed concurrently and waits for it to
+// start.
+func (cmd PushCommand) startApp(appName string, appGUID string) error {
+	cmd.displayAppText(appName, "Waiting for app to start...")
+	warnings, err := cmd.VersionActor.RestartApplication(appGUID)
+	cmd.displayAppWarnings(appName, v7pushaction.Warnings(warnings))
+	if err != nil {
+		return err
+	}
+
+	pollWarnings := make(chan v7action.Warnings)
+	done := make(chan bool)
+	go func() {
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.displayAppWarnings(appName, v7pushaction.Warnings(message))
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.VersionActor.PollStart(appGUID, pollWarnings)
+	done <- true
+
+	if _, ok := err.(actionerror.StartupTimeoutError); ok {
+		return translatableerror.StartupTimeoutError{
+			AppName:    appName,
+			BinaryName: cmd.Config.BinaryName(),
+		}
+	}
+	return err
+}
+
+// processAppEvent displays an event of an app that is pushed concurrently.
+// Staging logs are not displayed because the logs of several apps would be
+// interleaved.
+func (cmd PushCommand) processAppEvent(appName string, event v7pushaction.Event) {
+	log.WithField("app_name", appName).Infoln("received apply event:", event)
+
+	switch event {
+	case v7pushaction.SkippingApplicationCreation:
+		cmd.displayAppText(appName, "Updating app...")
+	case v7pushaction.CreatedApplication:
+		cmd.displayAppText(appName, "Creating app...")
+	case v7pushaction.CreatingAndMappingRoutes:
+		cmd.displayAppText(appName, "Mapping routes...")
+	case v7pushaction.CreatingArchive:
+		cmd.displayAppText(appName, "Packaging files to upload...")
+	case v7pushaction.UploadingApplicationWithArchive:
+		cmd.displayAppText(appName, "Uploading files...")
+	case v7pushaction.RetryUpload:
+		cmd.displayAppText(appName, "Retrying upload due to an error...")
+	case v7pushaction.UploadWithArchiveComplete:
+		cmd.displayAppText(appName, "Waiting for API to complete processing files...")
+	case v7pushaction.StartingStaging:
+		cmd.displayAppText(appName, "Staging app...")
+	case v7pushaction.StagingComplete:
+		cmd.displayAppText(appName, "Staging complete")
+	default:
+		log.WithField("event", event).Debug("ignoring event")
+	}
+}
+
+// newAppProgressBar returns a progress bar that displays the upload progress
+// of an app as separate lines.
+func (cmd PushCommand) newAppProgressBar(appName string) v7pushaction.ProgressBar {
+	return progressbar.NewLineProgressBar(func(percent int) {
+		cmd.displayAppText(appName, "Uploaded {{.Percent}}%", map[string]interface{}{
+			"Percent": percent,
+		})
+	})
+}
+
+func (cmd PushCommand) displayAppText(appName string, template string, templateValues ...map[string]interface{}) {
+	cmd.UI.DisplayText("{{.AppName}}: {{.Message}}", map[string]interface{}{
+		"AppName": appName,
+		"Message": cmd.UI.TranslateText(template, templateValues...),
+	})
+}
+
+func (cmd PushCommand) displayAppWarnings(appName string, warnings v7pushaction.Warnings) {
+	var appWarnings []string
+	for _, warning := range warnings {
+		appWarnings = append(appWarnings, fmt.Sprintf("%s: %s", appName, warning))
+	}
+	cmd.UI.DisplayWarnings(appWarnings)
+}
+
 func (cmd PushCommand) processApplyStreams(
 	appName string,
 	stateStream <-chan v7pushaction.PushState,
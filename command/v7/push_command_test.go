@@ -2,6 +2,11 @@ package v7_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
@@ -17,6 +22,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v7/v7fakes"
 	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/manifest"
 	"code.cloudfoundry.org/cli/util/ui"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -129,7 +135,7 @@ var _ = Describe("push Command", func() {
 		fakeConfig.ExperimentalReturns(true) // TODO: Delete once we remove the experimental flag
 
 		cmd = PushCommand{
-			RequiredArgs: flag.AppName{AppName: "some-app"},
+			OptionalArgs: flag.OptionalAppName{AppName: "some-app"},
 			UI:           testUI,
 			Config:       fakeConfig,
 			Actor:        fakeActor,
@@ -250,7 +256,8 @@ var _ = Describe("push Command", func() {
 						Expect(testUI.Err).To(Say("some-warning-1"))
 
 						Expect(fakeActor.ConceptualizeCallCount()).To(Equal(1))
-						name, spaceGUID, orgGUID, currentDirectory, _ := fakeActor.ConceptualizeArgsForCall(0)
+						name, manifestApps, spaceGUID, orgGUID, currentDirectory, _ := fakeActor.ConceptualizeArgsForCall(0)
+						Expect(manifestApps).To(BeEmpty())
 						Expect(name).To(Equal(appName))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
 						Expect(orgGUID).To(Equal("some-org-guid"))
@@ -431,7 +438,7 @@ var _ = Describe("push Command", func() {
 								Expect(testUI.Err).To(Say("warning-2"))
 
 								Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
-								name, spaceGUID, withObfuscatedValues, _ := fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+								name, spaceGUID, withObfuscatedValues := fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
 								Expect(name).To(Equal("some-app"))
 								Expect(spaceGUID).To(Equal("some-space-guid"))
 								Expect(withObfuscatedValues).To(BeTrue())
@@ -495,6 +502,291 @@ var _ = Describe("push Command", func() {
 				})
 			})
 
+			When("the --parallel flag is provided", func() {
+				var newProgressBar func(string) v7pushaction.ProgressBar
+
+				BeforeEach(func() {
+					cmd.Parallel = flag.PositiveInteger{Value: 2}
+
+					fakeActor.ConceptualizeReturns(
+						[]v7pushaction.PushState{
+							{Application: v7action.Application{Name: "app-1"}},
+							{Application: v7action.Application{Name: "app-2"}},
+						},
+						nil, nil)
+
+					fakeActor.ActualizeConcurrentlyStub = func(_ []v7pushaction.PushState, _ int, progressBar func(string) v7pushaction.ProgressBar) <-chan v7pushaction.AppEvent {
+						newProgressBar = progressBar
+						appEvents := make(chan v7pushaction.AppEvent)
+						go func() {
+							defer close(appEvents)
+
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", Event: v7pushaction.CreatedApplication}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-2", Event: v7pushaction.CreatedApplication}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", Warnings: v7pushaction.Warnings{"some-warning"}}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", State: &v7pushaction.PushState{
+								Application: v7action.Application{Name: "app-1", GUID: "app-1-guid"},
+							}}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", Event: v7pushaction.UploadingApplicationWithArchive}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-2", Err: errors.New("app-2-error")}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", Event: v7pushaction.StartingStaging}
+							appEvents <- v7pushaction.AppEvent{AppName: "app-1", Event: v7pushaction.Complete}
+						}()
+						return appEvents
+					}
+
+					fakeVersionActor.RestartApplicationReturns(v7action.Warnings{"restart-warning"}, nil)
+				})
+
+				It("pushes the apps concurrently with output prefixed by the app name", func() {
+					Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+					Expect(fakeActor.ActualizeConcurrentlyCallCount()).To(Equal(1))
+					states, parallelism, _ := fakeActor.ActualizeConcurrentlyArgsForCall(0)
+					Expect(states).To(HaveLen(2))
+					Expect(parallelism).To(Equal(2))
+
+					Expect(testUI.Out).To(Say(`app-1: Creating app\.\.\.`))
+					Expect(testUI.Out).To(Say(`app-2: Creating app\.\.\.`))
+					Expect(testUI.Out).To(Say(`app-1: Uploading files\.\.\.`))
+					Expect(testUI.Out).To(Say(`app-2: Push failed`))
+					Expect(testUI.Out).To(Say(`app-1: Staging app\.\.\.`))
+					Expect(testUI.Out).To(Say(`app-1: Waiting for app to start\.\.\.`))
+					Expect(testUI.Err).To(Say("app-1: some-warning"))
+					Expect(testUI.Err).To(Say("app-1: restart-warning"))
+				})
+
+				It("displays the upload progress of each app on separate lines", func() {
+					reader := newProgressBar("app-1").NewProgressBarWrapper(strings.NewReader("some-bits"), 9)
+					_, err := ioutil.ReadAll(reader)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`app-1: Uploaded 100%`))
+				})
+
+				It("starts the apps that were staged and reports the failures at the end", func() {
+					Expect(executeErr).To(MatchError(translatableerror.PushFailedError{
+						AppErrors: []string{"app-2: app-2-error"},
+					}))
+
+					Expect(fakeVersionActor.RestartApplicationCallCount()).To(Equal(1))
+					Expect(fakeVersionActor.RestartApplicationArgsForCall(0)).To(Equal("app-1-guid"))
+					Expect(fakeVersionActor.PollStartCallCount()).To(Equal(1))
+
+					Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(1))
+					name, spaceGUID, _ := fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+					Expect(name).To(Equal("app-1"))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+				})
+
+				When("an app does not start in time", func() {
+					BeforeEach(func() {
+						fakeVersionActor.PollStartReturns(actionerror.StartupTimeoutError{})
+					})
+
+					It("reports it together with the other failures", func() {
+						Expect(executeErr).To(MatchError(translatableerror.PushFailedError{
+							AppErrors: []string{
+								"app-1: " + translatableerror.StartupTimeoutError{AppName: "app-1", BinaryName: binaryName}.Error(),
+								"app-2: app-2-error",
+							},
+						}))
+						Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			When("the --dry-run flag is provided", func() {
+				BeforeEach(func() {
+					cmd.DryRun = true
+
+					fakeActor.ConceptualizeReturns(
+						[]v7pushaction.PushState{
+							{
+								Application: v7action.Application{Name: appName, GUID: "some-app-guid"},
+							},
+						},
+						v7pushaction.Warnings{"some-warning-1"}, nil)
+				})
+
+				When("planning succeeds", func() {
+					BeforeEach(func() {
+						fakeActor.PlanReturns(
+							v7pushaction.PushPlan{
+								CurrentApplication: v7action.Application{Name: appName, GUID: "some-app-guid"},
+								DesiredApplication: v7action.Application{Name: appName, GUID: "some-app-guid"},
+								BitsPath:           pwd,
+								CurrentRoutes:      []string{"other.example.com"},
+								DesiredRoutes:      []string{"other.example.com", "some-app.example.com"},
+							},
+							v7pushaction.Warnings{"plan-warning"},
+							nil)
+					})
+
+					It("displays the plan without applying it", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Planning push of app %s to org some-org / space some-space as some-user", appName))
+						Expect(testUI.Out).To(Say(`Push would update app with these attributes\.\.\.`))
+						Expect(testUI.Out).To(Say(`name:\s+%s`, appName))
+						Expect(testUI.Out).To(Say(`path:\s+%s`, pwd))
+						Expect(testUI.Out).To(Say(`routes:`))
+						Expect(testUI.Out).To(Say(`other\.example\.com`))
+						Expect(testUI.Out).To(Say(`\+\s+some-app\.example\.com`))
+						Expect(testUI.Out).To(Say(`Dry run complete\. No changes were made\.`))
+						Expect(testUI.Err).To(Say("some-warning-1"))
+						Expect(testUI.Err).To(Say("plan-warning"))
+
+						Expect(fakeActor.PlanCallCount()).To(Equal(1))
+						Expect(fakeActor.PlanArgsForCall(0).Application.GUID).To(Equal("some-app-guid"))
+
+						Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+						Expect(fakeVersionActor.RestartApplicationCallCount()).To(Equal(0))
+					})
+				})
+
+				When("the app would be created", func() {
+					BeforeEach(func() {
+						fakeActor.PlanReturns(
+							v7pushaction.PushPlan{
+								DesiredApplication: v7action.Application{Name: appName},
+								BitsPath:           pwd,
+								DesiredRoutes:      []string{"some-app.example.com"},
+							},
+							nil,
+							nil)
+					})
+
+					It("says that the app would be created", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say(`Push would create app with these attributes\.\.\.`))
+						Expect(testUI.Out).To(Say(`\+\s+name:\s+%s`, appName))
+					})
+				})
+
+				When("planning fails", func() {
+					BeforeEach(func() {
+						fakeActor.PlanReturns(v7pushaction.PushPlan{}, v7pushaction.Warnings{"plan-warning"}, errors.New("plan-error"))
+					})
+
+					It("returns the error and displays all warnings", func() {
+						Expect(executeErr).To(MatchError("plan-error"))
+						Expect(testUI.Err).To(Say("plan-warning"))
+						Expect(fakeActor.ActualizeCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			When("there is a manifest", func() {
+				var (
+					manifestDir  string
+					manifestApps []manifest.Application
+				)
+
+				BeforeEach(func() {
+					var err error
+					manifestDir, err = ioutil.TempDir("", "push-command-test")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(ioutil.WriteFile(filepath.Join(manifestDir, "manifest.yml"), nil, 0666)).To(Succeed())
+					cmd.PWD = manifestDir
+					cmd.OptionalArgs.AppName = ""
+
+					manifestApps = []manifest.Application{{Name: "app-1"}, {Name: "app-2"}}
+					fakeActor.ReadManifestReturns(manifestApps, nil)
+					fakeActor.ConceptualizeReturns(
+						[]v7pushaction.PushState{
+							{Application: v7action.Application{Name: "app-1"}},
+							{Application: v7action.Application{Name: "app-2"}},
+						},
+						nil, nil)
+					fakeActor.ActualizeStub = FillInValues([]Step{{}}, v7pushaction.PushState{})
+				})
+
+				AfterEach(func() {
+					Expect(os.RemoveAll(manifestDir)).To(Succeed())
+				})
+
+				When("it is in the current directory", func() {
+					It("pushes every app of the manifest", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(testUI.Out).To(Say("Pushing from manifest to org some-org / space some-space as some-user"))
+						Expect(testUI.Out).To(Say("Using manifest file %s", regexp.QuoteMeta(filepath.Join(manifestDir, "manifest.yml"))))
+
+						Expect(fakeActor.ReadManifestCallCount()).To(Equal(1))
+						Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(filepath.Join(manifestDir, "manifest.yml")))
+
+						Expect(fakeActor.ConceptualizeCallCount()).To(Equal(1))
+						name, passedManifestApps, _, _, _, _ := fakeActor.ConceptualizeArgsForCall(0)
+						Expect(name).To(BeEmpty())
+						Expect(passedManifestApps).To(Equal(manifestApps))
+
+						Expect(fakeActor.ActualizeCallCount()).To(Equal(2))
+						Expect(fakeVersionActor.GetApplicationSummaryByNameAndSpaceCallCount()).To(Equal(2))
+						summaryName, _, _ := fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(0)
+						Expect(summaryName).To(Equal("app-1"))
+						summaryName, _, _ = fakeVersionActor.GetApplicationSummaryByNameAndSpaceArgsForCall(1)
+						Expect(summaryName).To(Equal("app-2"))
+					})
+				})
+
+				When("it is given with -f as a directory", func() {
+					BeforeEach(func() {
+						cmd.PWD = pwd
+						cmd.PathToManifest = flag.PathWithExistenceCheck(manifestDir)
+					})
+
+					It("reads the manifest in that directory", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+						Expect(fakeActor.ReadManifestArgsForCall(0)).To(Equal(filepath.Join(manifestDir, "manifest.yml")))
+					})
+
+					When("the directory has no manifest", func() {
+						BeforeEach(func() {
+							Expect(os.Remove(filepath.Join(manifestDir, "manifest.yml"))).To(Succeed())
+						})
+
+						It("returns a ManifestFileNotFoundInDirectoryError", func() {
+							Expect(executeErr).To(MatchError(translatableerror.ManifestFileNotFoundInDirectoryError{
+								PathToManifest: manifestDir,
+							}))
+						})
+					})
+				})
+
+				When("--no-manifest is provided", func() {
+					BeforeEach(func() {
+						cmd.NoManifest = true
+						cmd.OptionalArgs.AppName = appName
+					})
+
+					It("does not read the manifest", func() {
+						Expect(fakeActor.ReadManifestCallCount()).To(Equal(0))
+						name, passedManifestApps, _, _, _, _ := fakeActor.ConceptualizeArgsForCall(0)
+						Expect(name).To(Equal(appName))
+						Expect(passedManifestApps).To(BeEmpty())
+					})
+				})
+
+				When("reading the manifest fails", func() {
+					BeforeEach(func() {
+						fakeActor.ReadManifestReturns(nil, errors.New("some-manifest-error"))
+					})
+
+					It("returns the error", func() {
+						Expect(executeErr).To(MatchError("some-manifest-error"))
+						Expect(fakeActor.ConceptualizeCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			When("there is neither an app name nor a manifest", func() {
+				BeforeEach(func() {
+					cmd.OptionalArgs.AppName = ""
+				})
+
+				It("returns a RequiredNameForPushError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredNameForPushError{}))
+					Expect(fakeActor.ConceptualizeCallCount()).To(Equal(0))
+				})
+			})
+
 			When("getting app settings returns an error", func() {
 				var expectedErr error
 
@@ -516,7 +808,7 @@ var _ = Describe("push Command", func() {
 
 				It("generates a push state with the specified app path", func() {
 					Expect(fakeActor.ConceptualizeCallCount()).To(Equal(1))
-					name, spaceGUID, orgGUID, currentDirectory, overrides := fakeActor.ConceptualizeArgsForCall(0)
+					name, _, spaceGUID, orgGUID, currentDirectory, overrides := fakeActor.ConceptualizeArgsForCall(0)
 					Expect(name).To(Equal(appName))
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(orgGUID).To(Equal("some-org-guid"))
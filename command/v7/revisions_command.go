@@ -0,0 +1,127 @@
+package v7
+
+import (
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . RevisionsActor
+
+type RevisionsActor interface {
+	GetRevisionsByApplicationNameAndSpace(appName string, spaceGUID string) (v7action.Revisions, v7action.Warnings, error)
+}
+
+type revisionsRecord struct {
+	Revisions []revisionRecord `json:"revisions" yaml:"revisions"`
+}
+
+type revisionRecord struct {
+	Version     int    `json:"version" yaml:"version"`
+	GUID        string `json:"guid" yaml:"guid"`
+	Description string `json:"description" yaml:"description"`
+	Deployable  bool   `json:"deployable" yaml:"deployable"`
+	CreatedAt   string `json:"created_at" yaml:"created_at"`
+}
+
+type RevisionsCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME revisions APP_NAME"`
+	relatedCommands interface{}  `related_commands:"rollback"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RevisionsActor
+}
+
+func (cmd *RevisionsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (RevisionsCommand) SupportsStructuredOutput() {}
+
+func (cmd RevisionsCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting revisions for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	revisions, warnings, err := cmd.Actor.GetRevisionsByApplicationNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := revisionsRecord{Revisions: []revisionRecord{}}
+	if len(revisions) == 0 {
+		cmd.UI.DisplayText("No revisions found")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("revision"),
+			cmd.UI.TranslateText("description"),
+			cmd.UI.TranslateText("deployable"),
+			cmd.UI.TranslateText("created"),
+		},
+	}
+
+	for _, revision := range revisions {
+		table = append(table, []string{
+			strconv.Itoa(revision.Version),
+			revision.Description,
+			strconv.FormatBool(revision.Deployable),
+			cmd.revisionCreatedTime(revision),
+		})
+		record.Revisions = append(record.Revisions, revisionRecord{
+			Version:     revision.Version,
+			GUID:        revision.GUID,
+			Description: revision.Description,
+			Deployable:  revision.Deployable,
+			CreatedAt:   revision.CreatedAt,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
+
+func (cmd RevisionsCommand) revisionCreatedTime(revision v7action.Revision) string {
+	timestamp, err := time.Parse(time.RFC3339, revision.CreatedAt)
+	if err != nil {
+		return revision.CreatedAt
+	}
+	return cmd.UI.UserFriendlyDate(timestamp)
+}
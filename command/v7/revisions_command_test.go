@@ -0,0 +1,132 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("revisions Command", func() {
+	var (
+		cmd             RevisionsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRevisionsActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRevisionsActor)
+
+		cmd = RevisionsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("getting the revisions returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionsByApplicationNameAndSpaceReturns(nil, v7action.Warnings{"warning-1"}, actionerror.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no revisions", func() {
+		It("displays that no revisions were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting revisions for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No revisions found"))
+		})
+	})
+
+	When("there are revisions", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionsByApplicationNameAndSpaceReturns(
+				v7action.Revisions{
+					{GUID: "revision-1-guid", Version: 1, Description: "Initial revision.", CreatedAt: "2019-03-28T17:34:02Z"},
+					{GUID: "revision-2-guid", Version: 2, Description: "New droplet deployed.", Deployable: true, CreatedAt: "2019-03-29T17:34:02Z"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("displays the revisions", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetRevisionsByApplicationNameAndSpaceCallCount()).To(Equal(1))
+			appName, spaceGUID := fakeActor.GetRevisionsByApplicationNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(testUI.Out).To(Say(`revision\s+description\s+deployable\s+created`))
+			Expect(testUI.Out).To(Say(`1\s+Initial revision\.\s+false\s+Thu 28 Mar 17:34:02 UTC 2019`))
+			Expect(testUI.Out).To(Say(`2\s+New droplet deployed\.\s+true\s+Fri 29 Mar 17:34:02 UTC 2019`))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the revisions as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"version": 1`))
+				Expect(testUI.Out).To(Say(`"guid": "revision-1-guid"`))
+			})
+		})
+	})
+})
@@ -0,0 +1,137 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+)
+
+//go:generate counterfeiter . RollbackActor
+
+type RollbackActor interface {
+	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v7action.Application, v7action.Warnings, error)
+	GetRevisionByApplicationAndVersion(appGUID string, version int) (v7action.Revision, v7action.Warnings, error)
+	CreateDeploymentByApplicationAndRevision(appGUID string, revisionGUID string) (string, v7action.Warnings, error)
+	PollDeployment(deploymentGUID string, warningsChannel chan<- v7action.Warnings) error
+}
+
+type RollbackCommand struct {
+	RequiredArgs    flag.AppName         `positional-args:"yes"`
+	Force           bool                 `short:"f" description:"Force rollback without confirmation"`
+	Version         flag.PositiveInteger `long:"version" required:"true" description:"Roll back to the given app revision"`
+	usage           interface{}          `usage:"CF_NAME rollback APP_NAME --version VERSION [-f]\n\nEXAMPLES:\n   CF_NAME rollback my-app --version 3"`
+	relatedCommands interface{}          `related_commands:"revisions"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RollbackActor
+}
+
+func (cmd *RollbackCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd RollbackCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	revision, warnings, err := cmd.Actor.GetRevisionByApplicationAndVersion(app.GUID, cmd.Version.Value)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		response, promptErr := cmd.UI.DisplayBoolPrompt(false, "Rolling '{{.AppName}}' back to revision '{{.Version}}' will create a new revision. The new revision will use the settings from revision '{{.Version}}'.\nAre you sure you want to continue?", map[string]interface{}{
+			"AppName": cmd.RequiredArgs.AppName,
+			"Version": revision.Version,
+		})
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !response {
+			cmd.UI.DisplayText("App '{{.AppName}}' has not been rolled back to revision '{{.Version}}'.", map[string]interface{}{
+				"AppName": cmd.RequiredArgs.AppName,
+				"Version": revision.Version,
+			})
+			return nil
+		}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Rolling back to revision {{.Version}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Version":   revision.Version,
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	deploymentGUID, warnings, err := cmd.Actor.CreateDeploymentByApplicationAndRevision(app.GUID, revision.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for app to deploy...")
+
+	pollWarnings := make(chan v7action.Warnings)
+	done := make(chan bool)
+	go func() {
+		defer close(done)
+		for {
+			select {
+			case message := <-pollWarnings:
+				cmd.UI.DisplayWarnings(message)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err = cmd.Actor.PollDeployment(deploymentGUID, pollWarnings)
+	done <- true
+
+	if err != nil {
+		if _, ok := err.(actionerror.StartupTimeoutError); ok {
+			return translatableerror.StartupTimeoutError{
+				AppName:    cmd.RequiredArgs.AppName,
+				BinaryName: cmd.Config.BinaryName(),
+			}
+		}
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("rollback Command", func() {
+	var (
+		cmd             RollbackCommand
+		input           *Buffer
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRollbackActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRollbackActor)
+
+		cmd = RollbackCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			Version:     flag.PositiveInteger{Value: 3},
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(v7action.Application{Name: "some-app", GUID: "some-app-guid"}, v7action.Warnings{"get-app-warning"}, nil)
+		fakeActor.GetRevisionByApplicationAndVersionReturns(v7action.Revision{GUID: "some-revision-guid", Version: 3}, v7action.Warnings{"get-revision-warning"}, nil)
+		fakeActor.CreateDeploymentByApplicationAndRevisionReturns("some-deployment-guid", v7action.Warnings{"create-deployment-warning"}, nil)
+		fakeActor.PollDeploymentStub = func(_ string, warnings chan<- v7action.Warnings) error {
+			warnings <- v7action.Warnings{"poll-warning"}
+			return nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the revision does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRevisionByApplicationAndVersionReturns(v7action.Revision{}, v7action.Warnings{"get-revision-warning"}, actionerror.RevisionNotFoundError{Version: 3})
+		})
+
+		It("returns the error without creating a deployment", func() {
+			Expect(executeErr).To(MatchError(actionerror.RevisionNotFoundError{Version: 3}))
+			Expect(testUI.Err).To(Say("get-revision-warning"))
+			Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the user confirms the rollback", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("y\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a deployment from the revision and waits for it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			appGUID, version := fakeActor.GetRevisionByApplicationAndVersionArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(version).To(Equal(3))
+
+			Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1))
+			appGUID, revisionGUID := fakeActor.CreateDeploymentByApplicationAndRevisionArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(revisionGUID).To(Equal("some-revision-guid"))
+
+			Expect(fakeActor.PollDeploymentCallCount()).To(Equal(1))
+			deploymentGUID, _ := fakeActor.PollDeploymentArgsForCall(0)
+			Expect(deploymentGUID).To(Equal("some-deployment-guid"))
+
+			Expect(testUI.Out).To(Say(`Rolling 'some-app' back to revision '3' will create a new revision\.`))
+			Expect(testUI.Out).To(Say(`Rolling back to revision 3 for app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say(`Waiting for app to deploy\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-revision-warning"))
+			Expect(testUI.Err).To(Say("create-deployment-warning"))
+			Expect(testUI.Err).To(Say("poll-warning"))
+		})
+	})
+
+	When("the user declines the rollback", func() {
+		BeforeEach(func() {
+			_, err := input.Write([]byte("n\n"))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not create a deployment", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`App 'some-app' has not been rolled back to revision '3'\.`))
+			Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the -f flag is provided", func() {
+		BeforeEach(func() {
+			cmd.Force = true
+		})
+
+		It("does not prompt for confirmation", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).ToNot(Say("Are you sure you want to continue?"))
+			Expect(fakeActor.CreateDeploymentByApplicationAndRevisionCallCount()).To(Equal(1))
+		})
+
+		When("creating the deployment fails", func() {
+			BeforeEach(func() {
+				fakeActor.CreateDeploymentByApplicationAndRevisionReturns("", v7action.Warnings{"create-deployment-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("create-deployment-warning"))
+				Expect(fakeActor.PollDeploymentCallCount()).To(Equal(0))
+			})
+		})
+
+		When("the deployment times out", func() {
+			BeforeEach(func() {
+				fakeActor.PollDeploymentStub = nil
+				fakeActor.PollDeploymentReturns(actionerror.StartupTimeoutError{})
+			})
+
+			It("returns a StartupTimeoutError", func() {
+				Expect(executeErr).To(MatchError(translatableerror.StartupTimeoutError{
+					AppName:    "some-app",
+					BinaryName: "faceman",
+				}))
+			})
+		})
+	})
+})
@@ -0,0 +1,140 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . RoutesActor
+
+type RoutesActor interface {
+	GetRoutesBySpace(spaceGUID string) (v7action.Routes, v7action.Warnings, error)
+	GetRoutesByOrg(orgGUID string) (v7action.Routes, v7action.Warnings, error)
+	GetRouteSummaries(routes v7action.Routes) ([]v7action.RouteSummary, v7action.Warnings, error)
+}
+
+type routesRecord struct {
+	Routes []routeRecord `json:"routes" yaml:"routes"`
+}
+
+type routeRecord struct {
+	GUID   string   `json:"guid" yaml:"guid"`
+	Space  string   `json:"space" yaml:"space"`
+	Host   string   `json:"host" yaml:"host"`
+	Domain string   `json:"domain" yaml:"domain"`
+	Path   string   `json:"path" yaml:"path"`
+	Apps   []string `json:"apps" yaml:"apps"`
+}
+
+type RoutesCommand struct {
+	OrgLevel        bool        `long:"orglevel" description:"List all the routes for all spaces of current organization"`
+	usage           interface{} `usage:"CF_NAME routes [--orglevel]"`
+	relatedCommands interface{} `related_commands:"create-route, domains, map-route, unmap-route"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       RoutesActor
+}
+
+func (cmd *RoutesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (RoutesCommand) SupportsStructuredOutput() {}
+
+func (cmd RoutesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, !cmd.OrgLevel)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	var (
+		routes   v7action.Routes
+		warnings v7action.Warnings
+	)
+
+	if cmd.OrgLevel {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  cmd.Config.TargetedOrganization().Name,
+			"Username": user.Name,
+		})
+		routes, warnings, err = cmd.Actor.GetRoutesByOrg(cmd.Config.TargetedOrganization().GUID)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Getting routes for org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   cmd.Config.TargetedOrganization().Name,
+			"SpaceName": cmd.Config.TargetedSpace().Name,
+			"Username":  user.Name,
+		})
+		routes, warnings, err = cmd.Actor.GetRoutesBySpace(cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+	cmd.UI.DisplayNewline()
+
+	record := routesRecord{Routes: []routeRecord{}}
+	if len(routes) == 0 {
+		cmd.UI.DisplayText("No routes found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	summaries, warnings, err := cmd.Actor.GetRouteSummaries(routes)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("space"),
+			cmd.UI.TranslateText("host"),
+			cmd.UI.TranslateText("domain"),
+			cmd.UI.TranslateText("path"),
+			cmd.UI.TranslateText("apps"),
+		},
+	}
+
+	for _, summary := range summaries {
+		table = append(table, []string{
+			summary.SpaceName,
+			summary.Host,
+			summary.DomainName,
+			summary.Path,
+			strings.Join(summary.AppNames, ", "),
+		})
+		record.Routes = append(record.Routes, routeRecord{
+			GUID:   summary.GUID,
+			Space:  summary.SpaceName,
+			Host:   summary.Host,
+			Domain: summary.DomainName,
+			Path:   summary.Path,
+			Apps:   append([]string{}, summary.AppNames...),
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
@@ -0,0 +1,175 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("routes Command", func() {
+	var (
+		cmd             RoutesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeRoutesActor
+		binaryName      string
+		executeErr      error
+		routes          v7action.Routes
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeRoutesActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = RoutesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		routes = v7action.Routes{
+			{GUID: "route-guid-1", Host: "host-1", DomainName: "example.com", SpaceName: "some-space"},
+			{GUID: "route-guid-2", Host: "host-2", DomainName: "example.com", Path: "/foo", SpaceName: "some-space"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the user is not logged in", func() {
+		BeforeEach(func() {
+			fakeConfig.CurrentUserReturns(configv3.User{}, errors.New("some current user error"))
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("some current user error"))
+		})
+	})
+
+	When("getting the routes returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoutesBySpaceReturns(nil, v7action.Warnings{"get-routes-warning"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("get-routes-warning"))
+			Expect(fakeActor.GetRouteSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are no routes", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoutesBySpaceReturns(nil, v7action.Warnings{"get-routes-warning"}, nil)
+		})
+
+		It("displays that no routes were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting routes for org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No routes found."))
+			Expect(fakeActor.GetRouteSummariesCallCount()).To(Equal(0))
+		})
+	})
+
+	When("there are routes in the space", func() {
+		BeforeEach(func() {
+			fakeActor.GetRoutesBySpaceReturns(routes, v7action.Warnings{"get-routes-warning"}, nil)
+			fakeActor.GetRouteSummariesReturns(
+				[]v7action.RouteSummary{
+					{Route: routes[0], AppNames: []string{"app-1", "app-2"}},
+					{Route: routes[1]},
+				},
+				v7action.Warnings{"get-summaries-warning"},
+				nil,
+			)
+		})
+
+		It("displays the routes and the apps mapped to them", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetRoutesBySpaceCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRoutesBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+			Expect(fakeActor.GetRouteSummariesCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRouteSummariesArgsForCall(0)).To(Equal(routes))
+
+			Expect(testUI.Out).To(Say(`space\s+host\s+domain\s+path\s+apps`))
+			Expect(testUI.Out).To(Say(`some-space\s+host-1\s+example\.com\s+app-1, app-2`))
+			Expect(testUI.Out).To(Say(`some-space\s+host-2\s+example\.com\s+/foo`))
+
+			Expect(testUI.Err).To(Say("get-routes-warning"))
+			Expect(testUI.Err).To(Say("get-summaries-warning"))
+		})
+
+		When("getting the route summaries returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.GetRouteSummariesReturns(nil, v7action.Warnings{"get-summaries-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("get-summaries-warning"))
+			})
+		})
+	})
+
+	When("the --orglevel flag is provided", func() {
+		BeforeEach(func() {
+			cmd.OrgLevel = true
+			fakeActor.GetRoutesByOrgReturns(routes, v7action.Warnings{"get-routes-warning"}, nil)
+		})
+
+		It("only requires an org to be targeted and lists the routes in the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedSpace).To(BeFalse())
+
+			Expect(testUI.Out).To(Say(`Getting routes for org some-org as steve\.\.\.`))
+			Expect(fakeActor.GetRoutesByOrgCallCount()).To(Equal(1))
+			Expect(fakeActor.GetRoutesByOrgArgsForCall(0)).To(Equal("some-org-guid"))
+			Expect(fakeActor.GetRoutesBySpaceCallCount()).To(Equal(0))
+		})
+	})
+})
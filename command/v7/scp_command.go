@@ -0,0 +1,141 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/clissh"
+	"code.cloudfoundry.org/cli/util/progressbar"
+)
+
+//go:generate counterfeiter . SharedSCPActor
+
+type SharedSCPActor interface {
+	DownloadFromSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, remotePath string, localPath string, progressBar sharedaction.ProgressBar) error
+	UploadToSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions, localPath string, remotePath string, progressBar sharedaction.ProgressBar) error
+}
+
+//go:generate counterfeiter . SCPProgressBar
+
+type SCPProgressBar interface {
+	sharedaction.ProgressBar
+	Complete()
+}
+
+type SCPCommand struct {
+	RequiredArgs       flag.SecureCopyArgs `positional-args:"yes"`
+	ProcessIndex       uint                `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	ProcessType        string              `long:"process" default:"web" description:"App process name"`
+	SkipHostValidation bool                `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+
+	usage           interface{} `usage:"CF_NAME scp [--process PROCESS] [-i INDEX] [--skip-host-validation] APP_NAME:REMOTE_PATH LOCAL_PATH\n   CF_NAME scp [--process PROCESS] [-i INDEX] [--skip-host-validation] LOCAL_PATH APP_NAME:REMOTE_PATH\n\nEXAMPLES:\n   CF_NAME scp my-app:/tmp/heap.hprof .\n   CF_NAME scp -i 2 ./config my-app:/home/vcap/app/config\n\nTIP:\n   If DESTINATION is an existing directory, SOURCE is copied into it."`
+	relatedCommands interface{} `related_commands:"enable-ssh, ssh"`
+	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SSHActor
+	SSHActor    SharedSCPActor
+	SSHClient   *clissh.SecureShell
+	ProgressBar SCPProgressBar
+}
+
+func (cmd *SCPCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	sharedActor := sharedaction.NewActor(config)
+	cmd.SharedActor = sharedActor
+	cmd.SSHActor = sharedActor
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+
+	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
+
+	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.ProgressBar = progressbar.NewTransferProgressBar(ui.Writer())
+
+	return nil
+}
+
+func (cmd SCPCommand) Execute(args []string) error {
+	sourceApp, sourcePath, sourceIsRemote := parseSecureCopyLocation(cmd.RequiredArgs.Source)
+	destinationApp, destinationPath, destinationIsRemote := parseSecureCopyLocation(cmd.RequiredArgs.Destination)
+	if sourceIsRemote == destinationIsRemote {
+		return translatableerror.SecureCopyLocationError{}
+	}
+
+	err := cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	appName := sourceApp
+	if destinationIsRemote {
+		appName = destinationApp
+	}
+
+	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
+		appName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.ProcessIndex,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	sshOptions := sharedaction.SSHOptions{
+		Endpoint:           sshAuth.Endpoint,
+		HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+		Passcode:           sshAuth.Passcode,
+		SkipHostValidation: cmd.SkipHostValidation,
+		Username:           sshAuth.Username,
+	}
+
+	if sourceIsRemote {
+		cmd.UI.DisplayTextWithFlavor("Downloading {{.RemotePath}} from instance {{.Index}} of app {{.AppName}} to {{.LocalPath}}...", map[string]interface{}{
+			"RemotePath": sourcePath,
+			"Index":      cmd.ProcessIndex,
+			"AppName":    appName,
+			"LocalPath":  destinationPath,
+		})
+		err = cmd.SSHActor.DownloadFromSecureShell(cmd.SSHClient, sshOptions, sourcePath, destinationPath, cmd.ProgressBar)
+	} else {
+		cmd.UI.DisplayTextWithFlavor("Uploading {{.LocalPath}} to {{.RemotePath}} on instance {{.Index}} of app {{.AppName}}...", map[string]interface{}{
+			"LocalPath":  sourcePath,
+			"RemotePath": destinationPath,
+			"Index":      cmd.ProcessIndex,
+			"AppName":    appName,
+		})
+		err = cmd.SSHActor.UploadToSecureShell(cmd.SSHClient, sshOptions, sourcePath, destinationPath, cmd.ProgressBar)
+	}
+	cmd.ProgressBar.Complete()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// parseSecureCopyLocation splits an scp argument of the form APP_NAME:PATH.
+// Arguments without a colon, and those where the colon follows a single
+// letter such as a Windows drive, are local paths.
+func parseSecureCopyLocation(arg string) (string, string, bool) {
+	index := strings.Index(arg, ":")
+	if index < 2 || strings.ContainsAny(arg[:index], `/\`) {
+		return "", arg, false
+	}
+	return arg[:index], arg[index+1:], true
+}
@@ -0,0 +1,192 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("scp Command", func() {
+	var (
+		cmd             SCPCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeSSHActor
+		fakeSSHActor    *v7fakes.FakeSharedSCPActor
+		fakeProgressBar *v7fakes.FakeSCPProgressBar
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeSSHActor)
+		fakeSSHActor = new(v7fakes.FakeSharedSCPActor)
+		fakeProgressBar = new(v7fakes.FakeSCPProgressBar)
+
+		cmd = SCPCommand{
+			RequiredArgs: flag.SecureCopyArgs{
+				Source:      "some-app:/tmp/heap.hprof",
+				Destination: "some-dir",
+			},
+
+			ProcessType:        "some-process-type",
+			ProcessIndex:       1,
+			SkipHostValidation: true,
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+			SSHActor:    fakeSSHActor,
+			ProgressBar: fakeProgressBar,
+		}
+
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+		fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+			v7action.SSHAuthentication{
+				Endpoint:           "some-endpoint",
+				HostKeyFingerprint: "some-fingerprint",
+				Passcode:           "some-passcode",
+				Username:           "some-username",
+			},
+			v7action.Warnings{"some-warnings"},
+			nil,
+		)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	expectedSSHOptions := sharedaction.SSHOptions{
+		Endpoint:           "some-endpoint",
+		HostKeyFingerprint: "some-fingerprint",
+		Passcode:           "some-passcode",
+		SkipHostValidation: true,
+		Username:           "some-username",
+	}
+
+	When("the source is on the app instance", func() {
+		It("downloads it with the app instance's SSH configuration", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(1))
+			appName, spaceGUID, processType, processIndex := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(processType).To(Equal("some-process-type"))
+			Expect(processIndex).To(Equal(uint(1)))
+
+			Expect(fakeSSHActor.DownloadFromSecureShellCallCount()).To(Equal(1))
+			_, sshOptions, remotePath, localPath, progressBar := fakeSSHActor.DownloadFromSecureShellArgsForCall(0)
+			Expect(sshOptions).To(Equal(expectedSSHOptions))
+			Expect(remotePath).To(Equal("/tmp/heap.hprof"))
+			Expect(localPath).To(Equal("some-dir"))
+			Expect(progressBar).To(Equal(fakeProgressBar))
+			Expect(fakeSSHActor.UploadToSecureShellCallCount()).To(Equal(0))
+
+			Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(testUI.Out).To(Say(`Downloading /tmp/heap\.hprof from instance 1 of app some-app to some-dir\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		When("downloading fails", func() {
+			BeforeEach(func() {
+				fakeSSHActor.DownloadFromSecureShellReturns(errors.New("some-download-error"))
+			})
+
+			It("completes the progress bar and returns the error", func() {
+				Expect(executeErr).To(MatchError("some-download-error"))
+				Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+
+	When("the destination is on the app instance", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SecureCopyArgs{
+				Source:      "./config",
+				Destination: "some-app:/home/vcap/app/config",
+			}
+		})
+
+		It("uploads the source with the app instance's SSH configuration", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			appName, _, _, _ := fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+
+			Expect(fakeSSHActor.UploadToSecureShellCallCount()).To(Equal(1))
+			_, sshOptions, localPath, remotePath, _ := fakeSSHActor.UploadToSecureShellArgsForCall(0)
+			Expect(sshOptions).To(Equal(expectedSSHOptions))
+			Expect(localPath).To(Equal("./config"))
+			Expect(remotePath).To(Equal("/home/vcap/app/config"))
+			Expect(fakeSSHActor.DownloadFromSecureShellCallCount()).To(Equal(0))
+
+			Expect(testUI.Out).To(Say(`Uploading \./config to /home/vcap/app/config on instance 1 of app some-app\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	DescribeTable("when the arguments do not name exactly one path on an app instance",
+		func(source string, destination string) {
+			cmd.RequiredArgs = flag.SecureCopyArgs{Source: source, Destination: destination}
+			Expect(cmd.Execute(nil)).To(MatchError(translatableerror.SecureCopyLocationError{}))
+		},
+		Entry("both are local", "./config", "/tmp/config"),
+		Entry("both are remote", "some-app:/tmp/a", "other-app:/tmp/b"),
+		Entry("the colon follows a Windows drive letter", `C:\config`, `D:\config`),
+		Entry("the colon follows a directory", "./some:dir", "some/dir:b"),
+	)
+
+	When("checking the target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+			Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+		})
+	})
+
+	When("getting the SSH configuration fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexReturns(
+				v7action.SSHAuthentication{},
+				v7action.Warnings{"some-warnings"},
+				actionerror.ApplicationNotStartedError{Name: "some-app"},
+			)
+		})
+
+		It("displays the warnings and returns the error", func() {
+			Expect(executeErr).To(MatchError(actionerror.ApplicationNotStartedError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("some-warnings"))
+			Expect(fakeSSHActor.DownloadFromSecureShellCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package v7
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+)
+
+//go:generate counterfeiter . SetLabelActor
+
+type SetLabelActor interface {
+	UpdateApplicationLabelsByApplicationName(appName string, spaceGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateOrganizationLabelsByOrganizationName(orgName string, labels map[string]types.NullString) (v7action.Warnings, error)
+	UpdateSpaceLabelsBySpaceName(spaceName string, orgGUID string, labels map[string]types.NullString) (v7action.Warnings, error)
+}
+
+type SetLabelCommand struct {
+	RequiredArgs    flag.SetLabelArgs `positional-args:"yes"`
+	usage           interface{}       `usage:"CF_NAME set-label RESOURCE RESOURCE_NAME KEY=VALUE...\n\nEXAMPLES:\n   CF_NAME set-label app dora env=production\n   CF_NAME set-label org business pci=true public-facing=false\n   CF_NAME set-label space business_space public-facing=false owner=jane_doe\n\nRESOURCES:\n   app\n   org\n   space"`
+	relatedCommands interface{}       `related_commands:"delete-label, labels"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetLabelActor
+}
+
+func (cmd *SetLabelCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (cmd SetLabelCommand) Execute(args []string) error {
+	labels := map[string]types.NullString{}
+	for _, label := range cmd.RequiredArgs.Labels {
+		parts := strings.SplitN(label, "=", 2)
+		if len(parts) < 2 {
+			return translatableerror.ParseArgumentError{
+				ArgumentName: label,
+				ExpectedType: "in the form KEY=VALUE",
+			}
+		}
+		labels[parts[0]] = types.NewNullString(parts[1])
+	}
+
+	resourceType := cmd.RequiredArgs.ResourceType.Type
+	err := checkLabelResourceTarget(cmd.SharedActor, resourceType)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	flavorText := labelResourceFlavorText(cmd.Config, resourceType, cmd.RequiredArgs.ResourceName, user.Name)
+	var warnings v7action.Warnings
+	switch resourceType {
+	case "app":
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateApplicationLabelsByApplicationName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedSpace().GUID, labels)
+	case "space":
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for {{.ResourceType}} {{.ResourceName}} in org {{.OrgName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateSpaceLabelsBySpaceName(cmd.RequiredArgs.ResourceName, cmd.Config.TargetedOrganization().GUID, labels)
+	default:
+		cmd.UI.DisplayTextWithFlavor("Setting label(s) for {{.ResourceType}} {{.ResourceName}} as {{.Username}}...", flavorText)
+		warnings, err = cmd.Actor.UpdateOrganizationLabelsByOrganizationName(cmd.RequiredArgs.ResourceName, labels)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-label Command", func() {
+	var (
+		cmd             SetLabelCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeSetLabelActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeSetLabelActor)
+
+		cmd = SetLabelCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "some-space", GUID: "some-space-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Describe("setting app labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: flag.LabelResource{Type: "app"},
+				ResourceName: "dora",
+				Labels:       []string{"FOO=BAR", "ENV=FAKE=VALUE"},
+			}
+			fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v7action.Warnings{"warning-1"}, nil)
+		})
+
+		It("sets the provided labels on the app", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+
+			Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(1))
+			appName, spaceGUID, labels := fakeActor.UpdateApplicationLabelsByApplicationNameArgsForCall(0)
+			Expect(appName).To(Equal("dora"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{
+				"FOO": types.NewNullString("BAR"),
+				"ENV": types.NewNullString("FAKE=VALUE"),
+			}))
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for app dora in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("a label is not in the form KEY=VALUE", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Labels = []string{"FOO"}
+			})
+
+			It("returns a ParseArgumentError without calling the actor", func() {
+				Expect(executeErr).To(MatchError(translatableerror.ParseArgumentError{
+					ArgumentName: "FOO",
+					ExpectedType: "in the form KEY=VALUE",
+				}))
+				Expect(fakeActor.UpdateApplicationLabelsByApplicationNameCallCount()).To(Equal(0))
+			})
+		})
+
+		When("checking target fails", func() {
+			BeforeEach(func() {
+				fakeSharedActor.CheckTargetReturns(actionerror.NoSpaceTargetedError{BinaryName: "faceman"})
+			})
+
+			It("returns an error", func() {
+				Expect(executeErr).To(MatchError(actionerror.NoSpaceTargetedError{BinaryName: "faceman"}))
+			})
+		})
+
+		When("the update fails", func() {
+			BeforeEach(func() {
+				fakeActor.UpdateApplicationLabelsByApplicationNameReturns(v7action.Warnings{"warning-1"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("warning-1"))
+			})
+		})
+	})
+
+	Describe("setting org labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: flag.LabelResource{Type: "org"},
+				ResourceName: "business",
+				Labels:       []string{"pci=true"},
+			}
+		})
+
+		It("sets the provided labels on the org", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateOrganizationLabelsByOrganizationNameCallCount()).To(Equal(1))
+			orgName, labels := fakeActor.UpdateOrganizationLabelsByOrganizationNameArgsForCall(0)
+			Expect(orgName).To(Equal("business"))
+			Expect(labels).To(Equal(map[string]types.NullString{"pci": types.NewNullString("true")}))
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for org business as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+	})
+
+	Describe("setting space labels", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs = flag.SetLabelArgs{
+				ResourceType: flag.LabelResource{Type: "space"},
+				ResourceName: "business-space",
+				Labels:       []string{"owner=jane"},
+			}
+		})
+
+		It("sets the provided labels on the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.UpdateSpaceLabelsBySpaceNameCallCount()).To(Equal(1))
+			spaceName, orgGUID, labels := fakeActor.UpdateSpaceLabelsBySpaceNameArgsForCall(0)
+			Expect(spaceName).To(Equal("business-space"))
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labels).To(Equal(map[string]types.NullString{"owner": types.NewNullString("jane")}))
+
+			Expect(testUI.Out).To(Say(`Setting label\(s\) for space business-space in org some-org as steve\.\.\.`))
+		})
+	})
+})
@@ -92,8 +92,14 @@ func (display AppSummaryDisplayer) displayProcessTable(summary v7action.Applicat
 			startCommandRow = append(startCommandRow, display.UI.TranslateText("start command:"), process.Command)
 		}
 
+		var sidecarsRow []string
+		if sidecars := summary.Sidecars.ForProcessType(process.Type); len(sidecars) > 0 {
+			sidecarsRow = append(sidecarsRow, display.UI.TranslateText("sidecars:"), display.sidecarNames(sidecars))
+		}
+
 		keyValueTable := [][]string{
 			{display.UI.TranslateText("type:"), process.Type},
+			sidecarsRow,
 			{display.UI.TranslateText("instances:"), fmt.Sprintf("%d/%d", process.HealthyInstanceCount(), process.TotalInstanceCount())},
 			{display.UI.TranslateText("memory usage:"), fmt.Sprintf("%dM", process.MemoryInMB.Value)},
 			startCommandRow,
@@ -143,6 +149,15 @@ func (AppSummaryDisplayer) buildpackNames(buildpacks []v7action.Buildpack) strin
 	return strings.Join(names, ", ")
 }
 
+func (AppSummaryDisplayer) sidecarNames(sidecars v7action.Sidecars) string {
+	var names []string
+	for _, sidecar := range sidecars {
+		names = append(names, sidecar.Name)
+	}
+
+	return strings.Join(names, ", ")
+}
+
 func (AppSummaryDisplayer) appInstanceDate(input time.Time) string {
 	return input.UTC().Format(time.RFC3339)
 }
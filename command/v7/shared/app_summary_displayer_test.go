@@ -3,7 +3,6 @@ package shared_test
 import (
 	"time"
 
-	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
 	. "code.cloudfoundry.org/cli/command/v7/shared"
@@ -417,11 +416,42 @@ var _ = Describe("app summary displayer", func() {
 			})
 		})
 
+		When("the application has sidecars", func() {
+			BeforeEach(func() {
+				summary = v7action.ApplicationSummary{
+					Application: v7action.Application{
+						GUID:  "some-app-guid",
+						State: constant.ApplicationStarted,
+					},
+					ProcessSummaries: v7action.ProcessSummaries{
+						{Process: v7action.Process{Type: constant.ProcessTypeWeb}},
+						{Process: v7action.Process{Type: "worker"}},
+						{Process: v7action.Process{Type: "clock"}},
+					},
+					Sidecars: v7action.Sidecars{
+						{Name: "auth-sidecar", ProcessTypes: []string{"web"}},
+						{Name: "log-shipper", ProcessTypes: []string{"web", "worker"}},
+					},
+				}
+			})
+
+			It("displays the sidecars of each process", func() {
+				Expect(testUI.Out).To(Say(`type:\s+web`))
+				Expect(testUI.Out).To(Say(`sidecars:\s+auth-sidecar, log-shipper`))
+
+				Expect(testUI.Out).To(Say(`type:\s+worker`))
+				Expect(testUI.Out).To(Say(`sidecars:\s+log-shipper`))
+
+				Expect(testUI.Out).To(Say(`type:\s+clock`))
+				Expect(testUI.Out).ToNot(Say("sidecars:"))
+			})
+		})
+
 		When("the application has routes", func() {
 			BeforeEach(func() {
-				summary.Routes = []v2action.Route{
-					{Host: "route1", Domain: v2action.Domain{Name: "example.com"}},
-					{Host: "route2", Domain: v2action.Domain{Name: "example.com"}},
+				summary.Routes = v7action.Routes{
+					{Host: "route1", DomainName: "example.com"},
+					{Host: "route2", DomainName: "example.com"},
 				}
 			})
 
@@ -0,0 +1,122 @@
+package shared
+
+import (
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+)
+
+// AppSummaryRecord is the structured representation of an application summary
+// displayed by UI.DisplayRecord.
+type AppSummaryRecord struct {
+	Name             string                 `json:"name" yaml:"name"`
+	GUID             string                 `json:"guid" yaml:"guid"`
+	RequestedState   string                 `json:"requested_state" yaml:"requested_state"`
+	IsolationSegment string                 `json:"isolation_segment,omitempty" yaml:"isolation_segment,omitempty"`
+	Routes           []string               `json:"routes" yaml:"routes"`
+	LastUploaded     string                 `json:"last_uploaded,omitempty" yaml:"last_uploaded,omitempty"`
+	Stack            string                 `json:"stack,omitempty" yaml:"stack,omitempty"`
+	Buildpacks       []string               `json:"buildpacks,omitempty" yaml:"buildpacks,omitempty"`
+	DockerImage      string                 `json:"docker_image,omitempty" yaml:"docker_image,omitempty"`
+	Processes        []ProcessSummaryRecord `json:"processes" yaml:"processes"`
+}
+
+// ProcessSummaryRecord is the structured representation of a process and its
+// instances.
+type ProcessSummaryRecord struct {
+	Type             string                  `json:"type" yaml:"type"`
+	Command          string                  `json:"command,omitempty" yaml:"command,omitempty"`
+	Sidecars         []string                `json:"sidecars,omitempty" yaml:"sidecars,omitempty"`
+	HealthyInstances int                     `json:"healthy_instances" yaml:"healthy_instances"`
+	Instances        int                     `json:"instances" yaml:"instances"`
+	MemoryInMB       uint64                  `json:"memory_in_mb" yaml:"memory_in_mb"`
+	DiskInMB         uint64                  `json:"disk_in_mb" yaml:"disk_in_mb"`
+	InstanceDetails  []ProcessInstanceRecord `json:"instance_details" yaml:"instance_details"`
+}
+
+// ProcessInstanceRecord is the structured representation of a single process
+// instance.
+type ProcessInstanceRecord struct {
+	Index       int     `json:"index" yaml:"index"`
+	State       string  `json:"state" yaml:"state"`
+	Uptime      int     `json:"uptime" yaml:"uptime"`
+	CPU         float64 `json:"cpu" yaml:"cpu"`
+	MemoryUsage uint64  `json:"memory_usage" yaml:"memory_usage"`
+	MemoryQuota uint64  `json:"memory_quota" yaml:"memory_quota"`
+	DiskUsage   uint64  `json:"disk_usage" yaml:"disk_usage"`
+	DiskQuota   uint64  `json:"disk_quota" yaml:"disk_quota"`
+	Details     string  `json:"details,omitempty" yaml:"details,omitempty"`
+}
+
+// NewAppSummaryRecord converts the summary into an AppSummaryRecord. The
+// start command of each process is only included when displayStartCommand is
+// true.
+func NewAppSummaryRecord(summary v7action.ApplicationSummary, displayStartCommand bool) AppSummaryRecord {
+	record := AppSummaryRecord{
+		Name:           summary.Name,
+		GUID:           summary.GUID,
+		RequestedState: strings.ToLower(string(summary.State)),
+		Routes:         []string{},
+		LastUploaded:   summary.CurrentDroplet.CreatedAt,
+		Stack:          summary.CurrentDroplet.Stack,
+		Processes:      []ProcessSummaryRecord{},
+	}
+
+	if name, exists := summary.GetIsolationSegmentName(); exists {
+		record.IsolationSegment = name
+	}
+
+	for _, route := range summary.Routes {
+		record.Routes = append(record.Routes, route.String())
+	}
+
+	if summary.LifecycleType == constant.AppLifecycleTypeDocker {
+		record.DockerImage = summary.CurrentDroplet.Image
+	} else {
+		for _, buildpack := range summary.CurrentDroplet.Buildpacks {
+			if buildpack.DetectOutput != "" {
+				record.Buildpacks = append(record.Buildpacks, buildpack.DetectOutput)
+			} else {
+				record.Buildpacks = append(record.Buildpacks, buildpack.Name)
+			}
+		}
+	}
+
+	for _, process := range summary.ProcessSummaries {
+		processRecord := ProcessSummaryRecord{
+			Type:             process.Type,
+			HealthyInstances: process.HealthyInstanceCount(),
+			Instances:        process.TotalInstanceCount(),
+			MemoryInMB:       process.MemoryInMB.Value,
+			DiskInMB:         process.DiskInMB.Value,
+			InstanceDetails:  []ProcessInstanceRecord{},
+		}
+
+		if displayStartCommand {
+			processRecord.Command = process.Command
+		}
+
+		for _, sidecar := range summary.Sidecars.ForProcessType(process.Type) {
+			processRecord.Sidecars = append(processRecord.Sidecars, sidecar.Name)
+		}
+
+		for _, instance := range process.InstanceDetails {
+			processRecord.InstanceDetails = append(processRecord.InstanceDetails, ProcessInstanceRecord{
+				Index:       instance.Index,
+				State:       strings.ToLower(string(instance.State)),
+				CPU:         instance.CPU,
+				MemoryUsage: instance.MemoryUsage,
+				MemoryQuota: instance.MemoryQuota,
+				DiskUsage:   instance.DiskUsage,
+				DiskQuota:   instance.DiskQuota,
+				Uptime:      instance.Uptime,
+				Details:     instance.Details,
+			})
+		}
+
+		record.Processes = append(record.Processes, processRecord)
+	}
+
+	return record
+}
@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"code.cloudfoundry.org/bytefmt"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// GetPushPlanChanges converts a push plan into the list of changes displayed
+// by push --dry-run.
+func GetPushPlanChanges(plan v7pushaction.PushPlan) []ui.Change {
+	changes := []ui.Change{
+		{
+			Header:       "name:",
+			CurrentValue: plan.CurrentApplication.Name,
+			NewValue:     plan.DesiredApplication.Name,
+		},
+		{
+			Header:       "path:",
+			CurrentValue: plan.BitsPath,
+			NewValue:     plan.BitsPath,
+		},
+	}
+
+	oldBuildpacks := plan.CurrentApplication.LifecycleBuildpacks
+	newBuildpacks := plan.DesiredApplication.LifecycleBuildpacks
+	if len(oldBuildpacks) > 0 || len(newBuildpacks) > 0 {
+		changes = append(changes,
+			ui.Change{
+				Header:       "buildpacks:",
+				CurrentValue: oldBuildpacks,
+				NewValue:     newBuildpacks,
+			})
+	}
+
+	if plan.CurrentWebProcess.HealthCheckType != "" || plan.DesiredWebProcess.HealthCheckType != "" {
+		changes = append(changes,
+			ui.Change{
+				Header:       "health check type:",
+				CurrentValue: plan.CurrentWebProcess.HealthCheckType,
+				NewValue:     plan.DesiredWebProcess.HealthCheckType,
+			})
+	}
+
+	if plan.CurrentWebProcess.MemoryInMB.IsSet || plan.DesiredWebProcess.MemoryInMB.IsSet {
+		var currentMemory, desiredMemory string
+		if plan.CurrentWebProcess.MemoryInMB.IsSet {
+			currentMemory = bytefmt.ByteSize(bytefmt.MEGABYTE * plan.CurrentWebProcess.MemoryInMB.Value)
+		}
+		if plan.DesiredWebProcess.MemoryInMB.IsSet {
+			desiredMemory = bytefmt.ByteSize(bytefmt.MEGABYTE * plan.DesiredWebProcess.MemoryInMB.Value)
+		}
+		changes = append(changes,
+			ui.Change{
+				Header:       "memory:",
+				CurrentValue: currentMemory,
+				NewValue:     desiredMemory,
+			})
+	}
+
+	changes = append(changes,
+		ui.Change{
+			Header:       "routes:",
+			CurrentValue: plan.CurrentRoutes,
+			NewValue:     plan.DesiredRoutes,
+		})
+
+	return changes
+}
@@ -0,0 +1,72 @@
+package shared_test
+
+import (
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/actor/v7pushaction"
+	. "code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("GetPushPlanChanges", func() {
+	var (
+		plan    v7pushaction.PushPlan
+		changes []ui.Change
+	)
+
+	BeforeEach(func() {
+		plan = v7pushaction.PushPlan{
+			CurrentApplication: v7action.Application{Name: "some-app", GUID: "some-app-guid"},
+			DesiredApplication: v7action.Application{Name: "some-app", GUID: "some-app-guid"},
+			BitsPath:           "/some/path",
+			CurrentRoutes:      []string{"other.example.com"},
+			DesiredRoutes:      []string{"other.example.com", "some-app.example.com"},
+		}
+	})
+
+	JustBeforeEach(func() {
+		changes = GetPushPlanChanges(plan)
+	})
+
+	It("always includes the name, path and routes", func() {
+		Expect(changes).To(Equal([]ui.Change{
+			{Header: "name:", CurrentValue: "some-app", NewValue: "some-app"},
+			{Header: "path:", CurrentValue: "/some/path", NewValue: "/some/path"},
+			{Header: "routes:", CurrentValue: []string{"other.example.com"}, NewValue: []string{"other.example.com", "some-app.example.com"}},
+		}))
+	})
+
+	When("the application is being created", func() {
+		BeforeEach(func() {
+			plan.CurrentApplication = v7action.Application{}
+			plan.CurrentRoutes = nil
+		})
+
+		It("has no current values", func() {
+			Expect(changes[0]).To(Equal(ui.Change{Header: "name:", CurrentValue: "", NewValue: "some-app"}))
+			Expect(changes[2].CurrentValue).To(BeEmpty())
+		})
+	})
+
+	When("the buildpacks, health check type and memory are set", func() {
+		BeforeEach(func() {
+			plan.CurrentApplication.LifecycleBuildpacks = []string{"old-buildpack"}
+			plan.DesiredApplication.LifecycleBuildpacks = []string{"new-buildpack"}
+			plan.CurrentWebProcess = v7action.Process{HealthCheckType: "port"}
+			plan.DesiredWebProcess = v7action.Process{
+				HealthCheckType: "http",
+				MemoryInMB:      types.NullUint64{Value: 1024, IsSet: true},
+			}
+		})
+
+		It("includes them between the path and the routes", func() {
+			Expect(changes).To(HaveLen(6))
+			Expect(changes[2]).To(Equal(ui.Change{Header: "buildpacks:", CurrentValue: []string{"old-buildpack"}, NewValue: []string{"new-buildpack"}}))
+			Expect(changes[3]).To(Equal(ui.Change{Header: "health check type:", CurrentValue: "port", NewValue: "http"}))
+			Expect(changes[4]).To(Equal(ui.Change{Header: "memory:", CurrentValue: "", NewValue: "1G"}))
+			Expect(changes[5].Header).To(Equal("routes:"))
+		})
+	})
+})
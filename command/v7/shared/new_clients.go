@@ -25,7 +25,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(config.RequestRetryCount()))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(command.NewRetryPolicy(config)))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:            config.BinaryName(),
@@ -79,7 +79,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool, minVersionV
 
 	uaaAuthWrapper := uaaWrapper.NewUAAAuthentication(uaaClient, config)
 	uaaClient.WrapConnection(uaaAuthWrapper)
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(config.RequestRetryCount()))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(command.NewRetryPolicy(config)))
 
 	err = uaaClient.SetupResources(ccClient.UAA())
 	if err != nil {
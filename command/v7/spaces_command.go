@@ -0,0 +1,90 @@
+package v7
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . SpacesActor
+
+type SpacesActor interface {
+	GetOrganizationSpaces(orgGUID string, labelSelector string) ([]v7action.Space, v7action.Warnings, error)
+}
+
+type spacesRecord struct {
+	Spaces []spaceRecord `json:"spaces" yaml:"spaces"`
+}
+
+type spaceRecord struct {
+	Name string `json:"name" yaml:"name"`
+	GUID string `json:"guid" yaml:"guid"`
+}
+
+type SpacesCommand struct {
+	Labels          string      `long:"labels" description:"Selector to filter spaces by labels"`
+	usage           interface{} `usage:"CF_NAME spaces [--labels SELECTOR]\n\nEXAMPLES:\n   CF_NAME spaces\n   CF_NAME spaces --labels 'environment in (production,staging),tier in (backend)'\n   CF_NAME spaces --labels 'env=dev,!chargeback-code,tier in (backend,worker)'"`
+	relatedCommands interface{} `related_commands:"target"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SpacesActor
+}
+
+func (cmd *SpacesCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor(config)
+
+	ccClient, _, err := shared.NewClients(config, ui, true, "")
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v7action.NewActor(ccClient, config, nil, nil)
+
+	return nil
+}
+
+func (SpacesCommand) SupportsStructuredOutput() {}
+
+func (cmd SpacesCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(true, false)
+	if err != nil {
+		return err
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Getting spaces in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":  cmd.Config.TargetedOrganization().Name,
+		"Username": user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	spaces, warnings, err := cmd.Actor.GetOrganizationSpaces(cmd.Config.TargetedOrganization().GUID, cmd.Labels)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	record := spacesRecord{Spaces: []spaceRecord{}}
+	if len(spaces) == 0 {
+		cmd.UI.DisplayText("No spaces found.")
+		return cmd.UI.DisplayRecord(record)
+	}
+
+	table := [][]string{{cmd.UI.TranslateText("name")}}
+	for _, space := range spaces {
+		table = append(table, []string{space.Name})
+		record.Spaces = append(record.Spaces, spaceRecord{Name: space.Name, GUID: space.GUID})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, ui.DefaultTableSpacePadding)
+
+	return cmd.UI.DisplayRecord(record)
+}
@@ -0,0 +1,121 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("spaces Command", func() {
+	var (
+		cmd             SpacesCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeSpacesActor
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeSpacesActor)
+
+		cmd = SpacesCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "some-org", GUID: "some-org-guid"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: "faceman"}))
+
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeFalse())
+		})
+	})
+
+	When("getting the spaces returns an error", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationSpacesReturns(nil, v7action.Warnings{"warning-1"}, errors.New("some-error"))
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError("some-error"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+	})
+
+	When("there are no spaces", func() {
+		It("displays that no spaces were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say(`Getting spaces in org some-org as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("No spaces found."))
+		})
+	})
+
+	When("there are spaces", func() {
+		BeforeEach(func() {
+			fakeActor.GetOrganizationSpacesReturns(
+				[]v7action.Space{
+					{Name: "space-1", GUID: "space-guid-1"},
+					{Name: "space-2", GUID: "space-guid-2"},
+				},
+				v7action.Warnings{"warning-1"},
+				nil,
+			)
+			cmd.Labels = "env=prod"
+		})
+
+		It("displays the spaces filtered by the label selector", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.GetOrganizationSpacesCallCount()).To(Equal(1))
+			orgGUID, labelSelector := fakeActor.GetOrganizationSpacesArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(labelSelector).To(Equal("env=prod"))
+
+			Expect(testUI.Out).To(Say("name"))
+			Expect(testUI.Out).To(Say("space-1"))
+			Expect(testUI.Out).To(Say("space-2"))
+			Expect(testUI.Err).To(Say("warning-1"))
+		})
+
+		When("the output format is JSON", func() {
+			BeforeEach(func() {
+				testUI.OutputFormat = configv3.OutputFormatJSON
+			})
+
+			It("displays the spaces as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`"name": "space-1"`))
+				Expect(testUI.Out).To(Say(`"guid": "space-guid-1"`))
+			})
+		})
+	})
+})
@@ -1,6 +1,8 @@
 package v7
 
 import (
+	"io"
+
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command"
@@ -14,27 +16,33 @@ import (
 
 type SharedSSHActor interface {
 	ExecuteSecureShell(sshClient sharedaction.SecureShellClient, sshOptions sharedaction.SSHOptions) error
+	ExecuteSecureShellOnInstances(newSSHClient func() sharedaction.SecureShellClient, instances []sharedaction.InstanceSSHOptions, stdout io.Writer, stderr io.Writer) []sharedaction.InstanceSecureShellError
 }
 
 //go:generate counterfeiter . SSHActor
 
 type SSHActor interface {
 	GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(appName string, spaceGUID string, processType string, processIndex uint) (v7action.SSHAuthentication, v7action.Warnings, error)
+	GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(appName string, spaceGUID string, processType string, processIndexes []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
 }
 
 type SSHCommand struct {
-	RequiredArgs          flag.AppName             `positional-args:"yes"`
-	ProcessIndex          uint                     `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
-	Commands              []string                 `long:"command" short:"c" description:"Command to run"`
-	DisablePseudoTTY      bool                     `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
-	ForcePseudoTTY        bool                     `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
-	LocalPortForwardSpecs []flag.SSHPortForwarding `short:"L" description:"Local port forward specification"`
-	ProcessType           string                   `long:"process" default:"web" description:"App process name"`
-	RequestPseudoTTY      bool                     `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
-	SkipHostValidation    bool                     `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
-	SkipRemoteExecution   bool                     `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
-
-	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
+	RequiredArgs            flag.AppName                    `positional-args:"yes"`
+	AllInstances            bool                            `long:"all-instances" description:"Run the command on every running instance of the process"`
+	ProcessIndex            uint                            `long:"app-instance-index" short:"i" default:"0" description:"App process instance index"`
+	Commands                []string                        `long:"command" short:"c" description:"Command to run"`
+	DynamicPortForwardSpecs []flag.SSHDynamicPortForwarding `short:"D" description:"Dynamic (SOCKS5) port forward specification"`
+	DisablePseudoTTY        bool                            `long:"disable-pseudo-tty" short:"T" description:"Disable pseudo-tty allocation"`
+	ForcePseudoTTY          bool                            `long:"force-pseudo-tty" description:"Force pseudo-tty allocation"`
+	Instances               flag.InstanceIndexes            `long:"instances" description:"Run the command on the given comma separated instance indexes"`
+	LocalPortForwardSpecs   []flag.SSHPortForwarding        `short:"L" description:"Local port forward specification"`
+	ProcessType             string                          `long:"process" default:"web" description:"App process name"`
+	RemotePortForwardSpecs  []flag.SSHRemotePortForwarding  `short:"R" description:"Remote port forward specification"`
+	RequestPseudoTTY        bool                            `long:"request-pseudo-tty" short:"t" description:"Request pseudo-tty allocation"`
+	SkipHostValidation      bool                            `long:"skip-host-validation" short:"k" description:"Skip host key validation. Not recommended!"`
+	SkipRemoteExecution     bool                            `long:"skip-remote-execution" short:"N" description:"Do not execute a remote command"`
+
+	usage           interface{} `usage:"CF_NAME ssh APP_NAME [--process PROCESS] [-i INDEX] [-c COMMAND]...\n   [--all-instances | --instances INDEXES]\n   [-L [BIND_ADDRESS:]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT]...\n   [-R [BIND_ADDRESS:]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT]... [-D [BIND_ADDRESS:]LOCAL_PORT]... [--skip-remote-execution]\n   [--disable-pseudo-tty | --force-pseudo-tty | --request-pseudo-tty] [--skip-host-validation]"`
 	relatedCommands interface{} `related_commands:"allow-space-ssh, enable-ssh, space-ssh-allowed, ssh-code, ssh-enabled"`
 	allproxy        interface{} `environmentName:"all_proxy" environmentDescription:"Specify a proxy server to enable proxying for all requests"`
 
@@ -44,6 +52,8 @@ type SSHCommand struct {
 	Actor       SSHActor
 	SSHActor    SharedSSHActor
 	SSHClient   *clissh.SecureShell
+
+	NewSSHClient func() sharedaction.SecureShellClient
 }
 
 func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
@@ -61,11 +71,17 @@ func (cmd *SSHCommand) Setup(config command.Config, ui command.UI) error {
 	cmd.Actor = v7action.NewActor(ccClient, config, sharedActor, uaaClient)
 
 	cmd.SSHClient = clissh.NewDefaultSecureShell()
+	cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+		return clissh.NewDefaultSecureShell()
+	}
 
 	return nil
 }
 
 func (cmd SSHCommand) Execute(args []string) error {
+	if cmd.AllInstances || len(cmd.Instances.Indexes) > 0 {
+		return cmd.executeOnInstances()
+	}
 
 	err := cmd.SharedActor.CheckTarget(true, true)
 	if err != nil {
@@ -82,6 +98,16 @@ func (cmd SSHCommand) Execute(args []string) error {
 		forwardSpecs = append(forwardSpecs, sharedaction.LocalPortForward(spec))
 	}
 
+	var remoteForwardSpecs []sharedaction.RemotePortForward
+	for _, spec := range cmd.RemotePortForwardSpecs {
+		remoteForwardSpecs = append(remoteForwardSpecs, sharedaction.RemotePortForward(spec))
+	}
+
+	var dynamicForwardAddresses []string
+	for _, spec := range cmd.DynamicPortForwardSpecs {
+		dynamicForwardAddresses = append(dynamicForwardAddresses, spec.LocalAddress)
+	}
+
 	sshAuth, warnings, err := cmd.Actor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndex(
 		cmd.RequiredArgs.AppName,
 		cmd.Config.TargetedSpace().GUID,
@@ -96,15 +122,17 @@ func (cmd SSHCommand) Execute(args []string) error {
 	err = cmd.SSHActor.ExecuteSecureShell(
 		cmd.SSHClient,
 		sharedaction.SSHOptions{
-			Commands:              cmd.Commands,
-			Endpoint:              sshAuth.Endpoint,
-			HostKeyFingerprint:    sshAuth.HostKeyFingerprint,
-			LocalPortForwardSpecs: forwardSpecs,
-			Passcode:              sshAuth.Passcode,
-			SkipHostValidation:    cmd.SkipHostValidation,
-			SkipRemoteExecution:   cmd.SkipRemoteExecution,
-			TTYOption:             ttyOption,
-			Username:              sshAuth.Username,
+			Commands:                    cmd.Commands,
+			DynamicPortForwardAddresses: dynamicForwardAddresses,
+			Endpoint:                    sshAuth.Endpoint,
+			HostKeyFingerprint:          sshAuth.HostKeyFingerprint,
+			LocalPortForwardSpecs:       forwardSpecs,
+			Passcode:                    sshAuth.Passcode,
+			RemotePortForwardSpecs:      remoteForwardSpecs,
+			SkipHostValidation:          cmd.SkipHostValidation,
+			SkipRemoteExecution:         cmd.SkipRemoteExecution,
+			TTYOption:                   ttyOption,
+			Username:                    sshAuth.Username,
 		})
 	if err != nil {
 		return err
@@ -113,6 +141,97 @@ func (cmd SSHCommand) Execute(args []string) error {
 	return nil
 }
 
+// executeOnInstances runs the command on several instances at once, prefixing
+// each line of output with the index of the instance that wrote it.
+func (cmd SSHCommand) executeOnInstances() error {
+	err := cmd.validateInstancesFlags()
+	if err != nil {
+		return err
+	}
+
+	err = cmd.SharedActor.CheckTarget(true, true)
+	if err != nil {
+		return err
+	}
+
+	sshAuths, warnings, err := cmd.Actor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(
+		cmd.RequiredArgs.AppName,
+		cmd.Config.TargetedSpace().GUID,
+		cmd.ProcessType,
+		cmd.Instances.Indexes,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	var instances []sharedaction.InstanceSSHOptions
+	for _, sshAuth := range sshAuths {
+		instances = append(instances, sharedaction.InstanceSSHOptions{
+			Index: sshAuth.Index,
+			SSHOptions: sharedaction.SSHOptions{
+				Commands:           cmd.Commands,
+				Endpoint:           sshAuth.Endpoint,
+				HostKeyFingerprint: sshAuth.HostKeyFingerprint,
+				Passcode:           sshAuth.Passcode,
+				SkipHostValidation: cmd.SkipHostValidation,
+				Username:           sshAuth.Username,
+			},
+		})
+	}
+
+	failures := cmd.SSHActor.ExecuteSecureShellOnInstances(cmd.NewSSHClient, instances, cmd.UI.GetOut(), cmd.UI.GetErr())
+	if len(failures) == 0 {
+		return nil
+	}
+
+	var failedIndexes []uint
+	for _, failure := range failures {
+		cmd.UI.DisplayWarning("Instance {{.Index}} failed: {{.Error}}", map[string]interface{}{
+			"Index": failure.Index,
+			"Error": failure.Err.Error(),
+		})
+		failedIndexes = append(failedIndexes, failure.Index)
+	}
+	return translatableerror.SSHCommandFailedOnInstancesError{Indexes: failedIndexes}
+}
+
+// validateInstancesFlags checks that only flags that make sense for
+// non-interactive commands are used with --all-instances and --instances.
+func (cmd SSHCommand) validateInstancesFlags() error {
+	if cmd.AllInstances && len(cmd.Instances.Indexes) > 0 {
+		return translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "--instances"}}
+	}
+
+	instancesFlag := "--instances"
+	if cmd.AllInstances {
+		instancesFlag = "--all-instances"
+	}
+
+	if len(cmd.Commands) == 0 {
+		return translatableerror.RequiredFlagsError{Arg1: instancesFlag, Arg2: "--command"}
+	}
+
+	conflicts := []struct {
+		used bool
+		flag string
+	}{
+		{len(cmd.LocalPortForwardSpecs) > 0, "-L"},
+		{len(cmd.RemotePortForwardSpecs) > 0, "-R"},
+		{len(cmd.DynamicPortForwardSpecs) > 0, "-D"},
+		{cmd.SkipRemoteExecution, "--skip-remote-execution"},
+		{cmd.ForcePseudoTTY, "--force-pseudo-tty"},
+		{cmd.RequestPseudoTTY, "--request-pseudo-tty"},
+	}
+	for _, conflict := range conflicts {
+		if conflict.used {
+			return translatableerror.ArgumentCombinationError{Args: []string{instancesFlag, conflict.flag}}
+		}
+	}
+
+	return nil
+}
+
 func (cmd SSHCommand) parseForwardSpecs() ([]sharedaction.LocalPortForward, error) {
 	return nil, nil
 }
@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/actionerror"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/sharedaction/sharedactionfakes"
 	"code.cloudfoundry.org/cli/actor/v7action"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
@@ -157,6 +158,29 @@ var _ = Describe("ssh Command", func() {
 					})
 				})
 
+				When("working with remote and dynamic port forwarding", func() {
+					BeforeEach(func() {
+						cmd.DisablePseudoTTY = true
+						cmd.RemotePortForwardSpecs = []flag.SSHRemotePortForwarding{
+							{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9230"},
+						}
+						cmd.DynamicPortForwardSpecs = []flag.SSHDynamicPortForwarding{
+							{LocalAddress: "localhost:1080"},
+						}
+					})
+
+					It("passes along port forwarding information", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(1))
+						_, sshOptionsArg := fakeSSHActor.ExecuteSecureShellArgsForCall(0)
+						Expect(sshOptionsArg.RemotePortForwardSpecs).To(Equal([]sharedaction.RemotePortForward{
+							{RemoteAddress: "localhost:9229", LocalAddress: "localhost:9230"},
+						}))
+						Expect(sshOptionsArg.DynamicPortForwardAddresses).To(Equal([]string{"localhost:1080"}))
+					})
+				})
+
 				When("executing the secure shell fails", func() {
 					BeforeEach(func() {
 						cmd.DisablePseudoTTY = true
@@ -182,6 +206,158 @@ var _ = Describe("ssh Command", func() {
 				})
 			})
 		})
+
+		When("running the command on several instances", func() {
+			BeforeEach(func() {
+				cmd.NewSSHClient = func() sharedaction.SecureShellClient {
+					return new(sharedactionfakes.FakeSecureShellClient)
+				}
+				cmd.SkipRemoteExecution = false
+				cmd.AllInstances = true
+
+				fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid"})
+				fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(
+					[]v7action.InstanceSSHAuthentication{
+						{
+							SSHAuthentication: v7action.SSHAuthentication{
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								Passcode:           "some-passcode-0",
+								Username:           "cf:some-process-guid/0",
+							},
+							Index: 0,
+						},
+						{
+							SSHAuthentication: v7action.SSHAuthentication{
+								Endpoint:           "some-endpoint",
+								HostKeyFingerprint: "some-fingerprint",
+								Passcode:           "some-passcode-2",
+								Username:           "cf:some-process-guid/2",
+							},
+							Index: 2,
+						},
+					},
+					v7action.Warnings{"some-warnings"},
+					nil,
+				)
+			})
+
+			It("runs the command on every instance", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("some-warnings"))
+
+				Expect(fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCallCount()).To(Equal(1))
+				appNameArg, spaceGUID, processType, processIndexes := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(0)
+				Expect(appNameArg).To(Equal(appName))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(processType).To(Equal("some-process-type"))
+				Expect(processIndexes).To(BeEmpty())
+				Expect(fakeActor.GetSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexCallCount()).To(Equal(0))
+
+				Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(1))
+				_, instances, stdout, stderr := fakeSSHActor.ExecuteSecureShellOnInstancesArgsForCall(0)
+				Expect(instances).To(Equal([]sharedaction.InstanceSSHOptions{
+					{
+						Index: 0,
+						SSHOptions: sharedaction.SSHOptions{
+							Commands:           []string{"some", "commands"},
+							Endpoint:           "some-endpoint",
+							HostKeyFingerprint: "some-fingerprint",
+							Passcode:           "some-passcode-0",
+							SkipHostValidation: true,
+							Username:           "cf:some-process-guid/0",
+						},
+					},
+					{
+						Index: 2,
+						SSHOptions: sharedaction.SSHOptions{
+							Commands:           []string{"some", "commands"},
+							Endpoint:           "some-endpoint",
+							HostKeyFingerprint: "some-fingerprint",
+							Passcode:           "some-passcode-2",
+							SkipHostValidation: true,
+							Username:           "cf:some-process-guid/2",
+						},
+					},
+				}))
+				Expect(stdout).To(Equal(testUI.Out))
+				Expect(stderr).To(Equal(testUI.Err))
+				Expect(fakeSSHActor.ExecuteSecureShellCallCount()).To(Equal(0))
+			})
+
+			When("specific instances are requested", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = false
+					cmd.Instances = flag.InstanceIndexes{Indexes: []uint{0, 2}}
+				})
+
+				It("gets the configuration for those instances", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					_, _, _, processIndexes := fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(0)
+					Expect(processIndexes).To(Equal([]uint{0, 2}))
+				})
+			})
+
+			When("the command fails on some instances", func() {
+				BeforeEach(func() {
+					fakeSSHActor.ExecuteSecureShellOnInstancesReturns([]sharedaction.InstanceSecureShellError{
+						{Index: 0, Err: errors.New("some-exit-error")},
+						{Index: 2, Err: errors.New("some-other-exit-error")},
+					})
+				})
+
+				It("displays each failure and returns an error naming the instances", func() {
+					Expect(executeErr).To(MatchError(translatableerror.SSHCommandFailedOnInstancesError{Indexes: []uint{0, 2}}))
+					Expect(testUI.Err).To(Say("Instance 0 failed: some-exit-error"))
+					Expect(testUI.Err).To(Say("Instance 2 failed: some-other-exit-error"))
+				})
+			})
+
+			When("getting the secure shell configurations fails", func() {
+				BeforeEach(func() {
+					fakeActor.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(nil, v7action.Warnings{"some-warnings"}, actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"})
+				})
+
+				It("returns the error and displays all warnings", func() {
+					Expect(executeErr).To(MatchError(actionerror.NoRunningProcessInstancesError{ProcessType: "some-process-type"}))
+					Expect(testUI.Err).To(Say("some-warnings"))
+					Expect(fakeSSHActor.ExecuteSecureShellOnInstancesCallCount()).To(Equal(0))
+				})
+			})
+
+			When("no command is given", func() {
+				BeforeEach(func() {
+					cmd.Commands = nil
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.RequiredFlagsError{Arg1: "--all-instances", Arg2: "--command"}))
+					Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+				})
+			})
+
+			When("both --all-instances and --instances are given", func() {
+				BeforeEach(func() {
+					cmd.Instances = flag.InstanceIndexes{Indexes: []uint{1}}
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--all-instances", "--instances"}}))
+				})
+			})
+
+			When("an interactive or port forwarding flag is given", func() {
+				BeforeEach(func() {
+					cmd.AllInstances = false
+					cmd.Instances = flag.InstanceIndexes{Indexes: []uint{1}}
+					cmd.LocalPortForwardSpecs = []flag.SSHPortForwarding{{LocalAddress: "localhost:8080", RemoteAddress: "localhost:8080"}}
+				})
+
+				It("returns an ArgumentCombinationError", func() {
+					Expect(executeErr).To(MatchError(translatableerror.ArgumentCombinationError{Args: []string{"--instances", "-L"}}))
+				})
+			})
+		})
 	})
 
 	DescribeTable("EvaluateTTYOption",
@@ -0,0 +1,174 @@
+package v7_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/actionerror"
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v7"
+	"code.cloudfoundry.org/cli/command/v7/v7fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("unmap-route Command", func() {
+	var (
+		cmd             UnmapRouteCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v7fakes.FakeUnmapRouteActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v7fakes.FakeUnmapRouteActor)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		cmd = UnmapRouteCommand{
+			RequiredArgs: flag.AppDomain{App: "some-app", Domain: "example.com"},
+			Hostname:     "some-host",
+			Path:         "/some-path",
+
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{
+			Name: "some-org",
+			GUID: "some-org-guid",
+		})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{
+			Name: "some-space",
+			GUID: "some-space-guid",
+		})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "steve"}, nil)
+
+		fakeActor.GetDomainByNameReturns(
+			v7action.Domain{Name: "example.com", GUID: "domain-guid"},
+			v7action.Warnings{"get-domain-warning"},
+			nil,
+		)
+		fakeActor.GetApplicationByNameAndSpaceReturns(
+			v7action.Application{Name: "some-app", GUID: "app-guid"},
+			v7action.Warnings{"get-app-warning"},
+			nil,
+		)
+		fakeActor.GetRouteByAttributesReturns(
+			v7action.Route{GUID: "route-guid", Host: "some-host", Path: "/some-path", DomainName: "example.com"},
+			v7action.Warnings{"get-route-warning"},
+			nil,
+		)
+		fakeActor.GetRouteDestinationsByAppGUIDReturns(
+			[]v7action.RouteDestination{
+				{GUID: "web-destination-guid", AppGUID: "app-guid", ProcessType: "web", Port: 8080},
+				{GUID: "worker-destination-guid", AppGUID: "app-guid", ProcessType: "worker", Port: 9090},
+			},
+			v7action.Warnings{"get-destination-warning"},
+			nil,
+		)
+		fakeActor.UnmapRouteReturns(v7action.Warnings{"unmap-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	When("checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(actionerror.NoOrganizationTargetedError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(actionerror.NoOrganizationTargetedError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	When("the route does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteByAttributesReturns(v7action.Route{}, v7action.Warnings{"get-route-warning"}, actionerror.RouteNotFoundError{})
+		})
+
+		It("returns the error and prints warnings", func() {
+			Expect(executeErr).To(MatchError(actionerror.RouteNotFoundError{}))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+		})
+	})
+
+	When("the route is mapped to the app", func() {
+		It("removes every destination of the app from the route", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			domainName, domainGUID, hostname, path := fakeActor.GetRouteByAttributesArgsForCall(0)
+			Expect(domainName).To(Equal("example.com"))
+			Expect(domainGUID).To(Equal("domain-guid"))
+			Expect(hostname).To(Equal("some-host"))
+			Expect(path).To(Equal("/some-path"))
+
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(2))
+			routeGUID, destinationGUID := fakeActor.UnmapRouteArgsForCall(0)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinationGUID).To(Equal("web-destination-guid"))
+			routeGUID, destinationGUID = fakeActor.UnmapRouteArgsForCall(1)
+			Expect(routeGUID).To(Equal("route-guid"))
+			Expect(destinationGUID).To(Equal("worker-destination-guid"))
+
+			Expect(testUI.Out).To(Say(`Removing route some-host\.example\.com/some-path from app some-app in org some-org / space some-space as steve\.\.\.`))
+			Expect(testUI.Out).To(Say("OK"))
+
+			Expect(testUI.Err).To(Say("get-domain-warning"))
+			Expect(testUI.Err).To(Say("get-app-warning"))
+			Expect(testUI.Err).To(Say("get-route-warning"))
+			Expect(testUI.Err).To(Say("get-destination-warning"))
+			Expect(testUI.Err).To(Say("unmap-warning"))
+		})
+
+		When("unmapping the route returns an error", func() {
+			BeforeEach(func() {
+				fakeActor.UnmapRouteReturns(v7action.Warnings{"unmap-warning"}, errors.New("some-error"))
+			})
+
+			It("returns the error and prints warnings", func() {
+				Expect(executeErr).To(MatchError("some-error"))
+				Expect(testUI.Err).To(Say("unmap-warning"))
+				Expect(fakeActor.UnmapRouteCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	When("the route is not mapped to the app", func() {
+		BeforeEach(func() {
+			fakeActor.GetRouteDestinationsByAppGUIDReturns(
+				nil,
+				v7action.Warnings{"get-destination-warning"},
+				actionerror.RouteDestinationNotFoundError{},
+			)
+		})
+
+		It("displays that the route is not mapped and succeeds", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("Route to be unmapped is not currently mapped to the application."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(fakeActor.UnmapRouteCallCount()).To(Equal(0))
+		})
+	})
+})
@@ -0,0 +1,211 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	sync "sync"
+
+	v7action "code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeAppsActor struct {
+	GetApplicationsWithProcessesBySpaceStub        func(string, string) ([]v7action.ApplicationWithProcessSummary, v7action.Warnings, error)
+	getApplicationsWithProcessesBySpaceMutex       sync.RWMutex
+	getApplicationsWithProcessesBySpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationsWithProcessesBySpaceReturns struct {
+		result1 []v7action.ApplicationWithProcessSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationsWithProcessesBySpaceReturnsOnCall map[int]struct {
+		result1 []v7action.ApplicationWithProcessSummary
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRoutesByApplicationsStub        func([]string) (map[string]v7action.Routes, v7action.Warnings, error)
+	getRoutesByApplicationsMutex       sync.RWMutex
+	getRoutesByApplicationsArgsForCall []struct {
+		arg1 []string
+	}
+	getRoutesByApplicationsReturns struct {
+		result1 map[string]v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRoutesByApplicationsReturnsOnCall map[int]struct {
+		result1 map[string]v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpace(arg1 string, arg2 string) ([]v7action.ApplicationWithProcessSummary, v7action.Warnings, error) {
+	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsWithProcessesBySpaceReturnsOnCall[len(fake.getApplicationsWithProcessesBySpaceArgsForCall)]
+	fake.getApplicationsWithProcessesBySpaceArgsForCall = append(fake.getApplicationsWithProcessesBySpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationsWithProcessesBySpace", []interface{}{arg1, arg2})
+	fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
+	if fake.GetApplicationsWithProcessesBySpaceStub != nil {
+		return fake.GetApplicationsWithProcessesBySpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationsWithProcessesBySpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpaceCallCount() int {
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsWithProcessesBySpaceArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpaceCalls(stub func(string, string) ([]v7action.ApplicationWithProcessSummary, v7action.Warnings, error)) {
+	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
+	fake.GetApplicationsWithProcessesBySpaceStub = stub
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationsWithProcessesBySpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpaceReturns(result1 []v7action.ApplicationWithProcessSummary, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
+	fake.GetApplicationsWithProcessesBySpaceStub = nil
+	fake.getApplicationsWithProcessesBySpaceReturns = struct {
+		result1 []v7action.ApplicationWithProcessSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetApplicationsWithProcessesBySpaceReturnsOnCall(i int, result1 []v7action.ApplicationWithProcessSummary, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationsWithProcessesBySpaceMutex.Lock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.Unlock()
+	fake.GetApplicationsWithProcessesBySpaceStub = nil
+	if fake.getApplicationsWithProcessesBySpaceReturnsOnCall == nil {
+		fake.getApplicationsWithProcessesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v7action.ApplicationWithProcessSummary
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsWithProcessesBySpaceReturnsOnCall[i] = struct {
+		result1 []v7action.ApplicationWithProcessSummary
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplications(arg1 []string) (map[string]v7action.Routes, v7action.Warnings, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.getRoutesByApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRoutesByApplicationsReturnsOnCall[len(fake.getRoutesByApplicationsArgsForCall)]
+	fake.getRoutesByApplicationsArgsForCall = append(fake.getRoutesByApplicationsArgsForCall, struct {
+		arg1 []string
+	}{arg1Copy})
+	fake.recordInvocation("GetRoutesByApplications", []interface{}{arg1Copy})
+	fake.getRoutesByApplicationsMutex.Unlock()
+	if fake.GetRoutesByApplicationsStub != nil {
+		return fake.GetRoutesByApplicationsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRoutesByApplicationsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplicationsCallCount() int {
+	fake.getRoutesByApplicationsMutex.RLock()
+	defer fake.getRoutesByApplicationsMutex.RUnlock()
+	return len(fake.getRoutesByApplicationsArgsForCall)
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplicationsCalls(stub func([]string) (map[string]v7action.Routes, v7action.Warnings, error)) {
+	fake.getRoutesByApplicationsMutex.Lock()
+	defer fake.getRoutesByApplicationsMutex.Unlock()
+	fake.GetRoutesByApplicationsStub = stub
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplicationsArgsForCall(i int) []string {
+	fake.getRoutesByApplicationsMutex.RLock()
+	defer fake.getRoutesByApplicationsMutex.RUnlock()
+	argsForCall := fake.getRoutesByApplicationsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplicationsReturns(result1 map[string]v7action.Routes, result2 v7action.Warnings, result3 error) {
+	fake.getRoutesByApplicationsMutex.Lock()
+	defer fake.getRoutesByApplicationsMutex.Unlock()
+	fake.GetRoutesByApplicationsStub = nil
+	fake.getRoutesByApplicationsReturns = struct {
+		result1 map[string]v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) GetRoutesByApplicationsReturnsOnCall(i int, result1 map[string]v7action.Routes, result2 v7action.Warnings, result3 error) {
+	fake.getRoutesByApplicationsMutex.Lock()
+	defer fake.getRoutesByApplicationsMutex.Unlock()
+	fake.GetRoutesByApplicationsStub = nil
+	if fake.getRoutesByApplicationsReturnsOnCall == nil {
+		fake.getRoutesByApplicationsReturnsOnCall = make(map[int]struct {
+			result1 map[string]v7action.Routes
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRoutesByApplicationsReturnsOnCall[i] = struct {
+		result1 map[string]v7action.Routes
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeAppsActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationsWithProcessesBySpaceMutex.RLock()
+	defer fake.getApplicationsWithProcessesBySpaceMutex.RUnlock()
+	fake.getRoutesByApplicationsMutex.RLock()
+	defer fake.getRoutesByApplicationsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAppsActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.AppsActor = new(FakeAppsActor)
@@ -0,0 +1,553 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package v7fakes
+
+import (
+	sync "sync"
+
+	v7action "code.cloudfoundry.org/cli/actor/v7action"
+	v7 "code.cloudfoundry.org/cli/command/v7"
+)
+
+type FakeMapRouteActor struct {
+	CreateRouteStub        func(string, string, string, string) (v7action.Route, v7action.Warnings, error)
+	createRouteMutex       sync.RWMutex
+	createRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	createRouteReturns struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	createRouteReturnsOnCall map[int]struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetApplicationByNameAndSpaceStub        func(string, string) (v7action.Application, v7action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetDomainByNameStub        func(string) (v7action.Domain, v7action.Warnings, error)
+	getDomainByNameMutex       sync.RWMutex
+	getDomainByNameArgsForCall []struct {
+		arg1 string
+	}
+	getDomainByNameReturns struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	getDomainByNameReturnsOnCall map[int]struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteByAttributesStub        func(string, string, string, string) (v7action.Route, v7action.Warnings, error)
+	getRouteByAttributesMutex       sync.RWMutex
+	getRouteByAttributesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}
+	getRouteByAttributesReturns struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteByAttributesReturnsOnCall map[int]struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}
+	GetRouteDestinationsByAppGUIDStub        func(string, string) ([]v7action.RouteDestination, v7action.Warnings, error)
+	getRouteDestinationsByAppGUIDMutex       sync.RWMutex
+	getRouteDestinationsByAppGUIDArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getRouteDestinationsByAppGUIDReturns struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	getRouteDestinationsByAppGUIDReturnsOnCall map[int]struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}
+	MapRouteStub        func(string, string, string, int) (v7action.Warnings, error)
+	mapRouteMutex       sync.RWMutex
+	mapRouteArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}
+	mapRouteReturns struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	mapRouteReturnsOnCall map[int]struct {
+		result1 v7action.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeMapRouteActor) CreateRoute(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Route, v7action.Warnings, error) {
+	fake.createRouteMutex.Lock()
+	ret, specificReturn := fake.createRouteReturnsOnCall[len(fake.createRouteArgsForCall)]
+	fake.createRouteArgsForCall = append(fake.createRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("CreateRoute", []interface{}{arg1, arg2, arg3, arg4})
+	fake.createRouteMutex.Unlock()
+	if fake.CreateRouteStub != nil {
+		return fake.CreateRouteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.createRouteReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMapRouteActor) CreateRouteCallCount() int {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	return len(fake.createRouteArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) CreateRouteCalls(stub func(string, string, string, string) (v7action.Route, v7action.Warnings, error)) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = stub
+}
+
+func (fake *FakeMapRouteActor) CreateRouteArgsForCall(i int) (string, string, string, string) {
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	argsForCall := fake.createRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeMapRouteActor) CreateRouteReturns(result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	fake.createRouteReturns = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) CreateRouteReturnsOnCall(i int, result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.createRouteMutex.Lock()
+	defer fake.createRouteMutex.Unlock()
+	fake.CreateRouteStub = nil
+	if fake.createRouteReturnsOnCall == nil {
+		fake.createRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.createRouteReturnsOnCall[i] = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpace(arg1 string, arg2 string) (v7action.Application, v7action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{arg1, arg2})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getApplicationByNameAndSpaceReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpaceCalls(stub func(string, string) (v7action.Application, v7action.Warnings, error)) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = stub
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	argsForCall := fake.getApplicationByNameAndSpaceArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpaceReturns(result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v7action.Application, result2 v7action.Warnings, result3 error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	defer fake.getApplicationByNameAndSpaceMutex.Unlock()
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v7action.Application
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v7action.Application
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetDomainByName(arg1 string) (v7action.Domain, v7action.Warnings, error) {
+	fake.getDomainByNameMutex.Lock()
+	ret, specificReturn := fake.getDomainByNameReturnsOnCall[len(fake.getDomainByNameArgsForCall)]
+	fake.getDomainByNameArgsForCall = append(fake.getDomainByNameArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("GetDomainByName", []interface{}{arg1})
+	fake.getDomainByNameMutex.Unlock()
+	if fake.GetDomainByNameStub != nil {
+		return fake.GetDomainByNameStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getDomainByNameReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMapRouteActor) GetDomainByNameCallCount() int {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	return len(fake.getDomainByNameArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) GetDomainByNameCalls(stub func(string) (v7action.Domain, v7action.Warnings, error)) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = stub
+}
+
+func (fake *FakeMapRouteActor) GetDomainByNameArgsForCall(i int) string {
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	argsForCall := fake.getDomainByNameArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeMapRouteActor) GetDomainByNameReturns(result1 v7action.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	fake.getDomainByNameReturns = struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetDomainByNameReturnsOnCall(i int, result1 v7action.Domain, result2 v7action.Warnings, result3 error) {
+	fake.getDomainByNameMutex.Lock()
+	defer fake.getDomainByNameMutex.Unlock()
+	fake.GetDomainByNameStub = nil
+	if fake.getDomainByNameReturnsOnCall == nil {
+		fake.getDomainByNameReturnsOnCall = make(map[int]struct {
+			result1 v7action.Domain
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getDomainByNameReturnsOnCall[i] = struct {
+		result1 v7action.Domain
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributes(arg1 string, arg2 string, arg3 string, arg4 string) (v7action.Route, v7action.Warnings, error) {
+	fake.getRouteByAttributesMutex.Lock()
+	ret, specificReturn := fake.getRouteByAttributesReturnsOnCall[len(fake.getRouteByAttributesArgsForCall)]
+	fake.getRouteByAttributesArgsForCall = append(fake.getRouteByAttributesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("GetRouteByAttributes", []interface{}{arg1, arg2, arg3, arg4})
+	fake.getRouteByAttributesMutex.Unlock()
+	if fake.GetRouteByAttributesStub != nil {
+		return fake.GetRouteByAttributesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteByAttributesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributesCallCount() int {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	return len(fake.getRouteByAttributesArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributesCalls(stub func(string, string, string, string) (v7action.Route, v7action.Warnings, error)) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = stub
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributesArgsForCall(i int) (string, string, string, string) {
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	argsForCall := fake.getRouteByAttributesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributesReturns(result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = nil
+	fake.getRouteByAttributesReturns = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetRouteByAttributesReturnsOnCall(i int, result1 v7action.Route, result2 v7action.Warnings, result3 error) {
+	fake.getRouteByAttributesMutex.Lock()
+	defer fake.getRouteByAttributesMutex.Unlock()
+	fake.GetRouteByAttributesStub = nil
+	if fake.getRouteByAttributesReturnsOnCall == nil {
+		fake.getRouteByAttributesReturnsOnCall = make(map[int]struct {
+			result1 v7action.Route
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteByAttributesReturnsOnCall[i] = struct {
+		result1 v7action.Route
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUID(arg1 string, arg2 string) ([]v7action.RouteDestination, v7action.Warnings, error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	ret, specificReturn := fake.getRouteDestinationsByAppGUIDReturnsOnCall[len(fake.getRouteDestinationsByAppGUIDArgsForCall)]
+	fake.getRouteDestinationsByAppGUIDArgsForCall = append(fake.getRouteDestinationsByAppGUIDArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("GetRouteDestinationsByAppGUID", []interface{}{arg1, arg2})
+	fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	if fake.GetRouteDestinationsByAppGUIDStub != nil {
+		return fake.GetRouteDestinationsByAppGUIDStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getRouteDestinationsByAppGUIDReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUIDCallCount() int {
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	return len(fake.getRouteDestinationsByAppGUIDArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUIDCalls(stub func(string, string) ([]v7action.RouteDestination, v7action.Warnings, error)) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = stub
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUIDArgsForCall(i int) (string, string) {
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	argsForCall := fake.getRouteDestinationsByAppGUIDArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUIDReturns(result1 []v7action.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = nil
+	fake.getRouteDestinationsByAppGUIDReturns = struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) GetRouteDestinationsByAppGUIDReturnsOnCall(i int, result1 []v7action.RouteDestination, result2 v7action.Warnings, result3 error) {
+	fake.getRouteDestinationsByAppGUIDMutex.Lock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.Unlock()
+	fake.GetRouteDestinationsByAppGUIDStub = nil
+	if fake.getRouteDestinationsByAppGUIDReturnsOnCall == nil {
+		fake.getRouteDestinationsByAppGUIDReturnsOnCall = make(map[int]struct {
+			result1 []v7action.RouteDestination
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteDestinationsByAppGUIDReturnsOnCall[i] = struct {
+		result1 []v7action.RouteDestination
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeMapRouteActor) MapRoute(arg1 string, arg2 string, arg3 string, arg4 int) (v7action.Warnings, error) {
+	fake.mapRouteMutex.Lock()
+	ret, specificReturn := fake.mapRouteReturnsOnCall[len(fake.mapRouteArgsForCall)]
+	fake.mapRouteArgsForCall = append(fake.mapRouteArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 int
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("MapRoute", []interface{}{arg1, arg2, arg3, arg4})
+	fake.mapRouteMutex.Unlock()
+	if fake.MapRouteStub != nil {
+		return fake.MapRouteStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.mapRouteReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeMapRouteActor) MapRouteCallCount() int {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	return len(fake.mapRouteArgsForCall)
+}
+
+func (fake *FakeMapRouteActor) MapRouteCalls(stub func(string, string, string, int) (v7action.Warnings, error)) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = stub
+}
+
+func (fake *FakeMapRouteActor) MapRouteArgsForCall(i int) (string, string, string, int) {
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	argsForCall := fake.mapRouteArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeMapRouteActor) MapRouteReturns(result1 v7action.Warnings, result2 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	fake.mapRouteReturns = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMapRouteActor) MapRouteReturnsOnCall(i int, result1 v7action.Warnings, result2 error) {
+	fake.mapRouteMutex.Lock()
+	defer fake.mapRouteMutex.Unlock()
+	fake.MapRouteStub = nil
+	if fake.mapRouteReturnsOnCall == nil {
+		fake.mapRouteReturnsOnCall = make(map[int]struct {
+			result1 v7action.Warnings
+			result2 error
+		})
+	}
+	fake.mapRouteReturnsOnCall[i] = struct {
+		result1 v7action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeMapRouteActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createRouteMutex.RLock()
+	defer fake.createRouteMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getDomainByNameMutex.RLock()
+	defer fake.getDomainByNameMutex.RUnlock()
+	fake.getRouteByAttributesMutex.RLock()
+	defer fake.getRouteByAttributesMutex.RUnlock()
+	fake.getRouteDestinationsByAppGUIDMutex.RLock()
+	defer fake.getRouteDestinationsByAppGUIDMutex.RUnlock()
+	fake.mapRouteMutex.RLock()
+	defer fake.mapRouteMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeMapRouteActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v7.MapRouteActor = new(FakeMapRouteActor)
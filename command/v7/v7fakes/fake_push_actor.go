@@ -6,6 +6,7 @@ import (
 
 	v7pushaction "code.cloudfoundry.org/cli/actor/v7pushaction"
 	v7 "code.cloudfoundry.org/cli/command/v7"
+	manifest "code.cloudfoundry.org/cli/util/manifest"
 )
 
 type FakePushActor struct {
@@ -27,14 +28,28 @@ type FakePushActor struct {
 		result3 <-chan v7pushaction.Warnings
 		result4 <-chan error
 	}
-	ConceptualizeStub        func(string, string, string, string, v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)
+	ActualizeConcurrentlyStub        func([]v7pushaction.PushState, int, func(appName string) v7pushaction.ProgressBar) <-chan v7pushaction.AppEvent
+	actualizeConcurrentlyMutex       sync.RWMutex
+	actualizeConcurrentlyArgsForCall []struct {
+		arg1 []v7pushaction.PushState
+		arg2 int
+		arg3 func(appName string) v7pushaction.ProgressBar
+	}
+	actualizeConcurrentlyReturns struct {
+		result1 <-chan v7pushaction.AppEvent
+	}
+	actualizeConcurrentlyReturnsOnCall map[int]struct {
+		result1 <-chan v7pushaction.AppEvent
+	}
+	ConceptualizeStub        func(string, []manifest.Application, string, string, string, v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)
 	conceptualizeMutex       sync.RWMutex
 	conceptualizeArgsForCall []struct {
 		arg1 string
-		arg2 string
+		arg2 []manifest.Application
 		arg3 string
 		arg4 string
-		arg5 v7pushaction.FlagOverrides
+		arg5 string
+		arg6 v7pushaction.FlagOverrides
 	}
 	conceptualizeReturns struct {
 		result1 []v7pushaction.PushState
@@ -46,6 +61,34 @@ type FakePushActor struct {
 		result2 v7pushaction.Warnings
 		result3 error
 	}
+	PlanStub        func(v7pushaction.PushState) (v7pushaction.PushPlan, v7pushaction.Warnings, error)
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		arg1 v7pushaction.PushState
+	}
+	planReturns struct {
+		result1 v7pushaction.PushPlan
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	planReturnsOnCall map[int]struct {
+		result1 v7pushaction.PushPlan
+		result2 v7pushaction.Warnings
+		result3 error
+	}
+	ReadManifestStub        func(string) ([]manifest.Application, error)
+	readManifestMutex       sync.RWMutex
+	readManifestArgsForCall []struct {
+		arg1 string
+	}
+	readManifestReturns struct {
+		result1 []manifest.Application
+		result2 error
+	}
+	readManifestReturnsOnCall map[int]struct {
+		result1 []manifest.Application
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -120,20 +163,93 @@ func (fake *FakePushActor) ActualizeReturnsOnCall(i int, result1 <-chan v7pushac
 	}{result1, result2, result3, result4}
 }
 
-func (fake *FakePushActor) Conceptualize(arg1 string, arg2 string, arg3 string, arg4 string, arg5 v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error) {
+func (fake *FakePushActor) ActualizeConcurrently(arg1 []v7pushaction.PushState, arg2 int, arg3 func(appName string) v7pushaction.ProgressBar) <-chan v7pushaction.AppEvent {
+	var arg1Copy []v7pushaction.PushState
+	if arg1 != nil {
+		arg1Copy = make([]v7pushaction.PushState, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.actualizeConcurrentlyMutex.Lock()
+	ret, specificReturn := fake.actualizeConcurrentlyReturnsOnCall[len(fake.actualizeConcurrentlyArgsForCall)]
+	fake.actualizeConcurrentlyArgsForCall = append(fake.actualizeConcurrentlyArgsForCall, struct {
+		arg1 []v7pushaction.PushState
+		arg2 int
+		arg3 func(appName string) v7pushaction.ProgressBar
+	}{arg1Copy, arg2, arg3})
+	fake.recordInvocation("ActualizeConcurrently", []interface{}{arg1Copy, arg2, arg3})
+	fake.actualizeConcurrentlyMutex.Unlock()
+	if fake.ActualizeConcurrentlyStub != nil {
+		return fake.ActualizeConcurrentlyStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.actualizeConcurrentlyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyCallCount() int {
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
+	return len(fake.actualizeConcurrentlyArgsForCall)
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyCalls(stub func([]v7pushaction.PushState, int, func(appName string) v7pushaction.ProgressBar) <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = stub
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyArgsForCall(i int) ([]v7pushaction.PushState, int, func(appName string) v7pushaction.ProgressBar) {
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
+	argsForCall := fake.actualizeConcurrentlyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyReturns(result1 <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = nil
+	fake.actualizeConcurrentlyReturns = struct {
+		result1 <-chan v7pushaction.AppEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) ActualizeConcurrentlyReturnsOnCall(i int, result1 <-chan v7pushaction.AppEvent) {
+	fake.actualizeConcurrentlyMutex.Lock()
+	defer fake.actualizeConcurrentlyMutex.Unlock()
+	fake.ActualizeConcurrentlyStub = nil
+	if fake.actualizeConcurrentlyReturnsOnCall == nil {
+		fake.actualizeConcurrentlyReturnsOnCall = make(map[int]struct {
+			result1 <-chan v7pushaction.AppEvent
+		})
+	}
+	fake.actualizeConcurrentlyReturnsOnCall[i] = struct {
+		result1 <-chan v7pushaction.AppEvent
+	}{result1}
+}
+
+func (fake *FakePushActor) Conceptualize(arg1 string, arg2 []manifest.Application, arg3 string, arg4 string, arg5 string, arg6 v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error) {
+	var arg2Copy []manifest.Application
+	if arg2 != nil {
+		arg2Copy = make([]manifest.Application, len(arg2))
+		copy(arg2Copy, arg2)
+	}
 	fake.conceptualizeMutex.Lock()
 	ret, specificReturn := fake.conceptualizeReturnsOnCall[len(fake.conceptualizeArgsForCall)]
 	fake.conceptualizeArgsForCall = append(fake.conceptualizeArgsForCall, struct {
 		arg1 string
-		arg2 string
+		arg2 []manifest.Application
 		arg3 string
 		arg4 string
-		arg5 v7pushaction.FlagOverrides
-	}{arg1, arg2, arg3, arg4, arg5})
-	fake.recordInvocation("Conceptualize", []interface{}{arg1, arg2, arg3, arg4, arg5})
+		arg5 string
+		arg6 v7pushaction.FlagOverrides
+	}{arg1, arg2Copy, arg3, arg4, arg5, arg6})
+	fake.recordInvocation("Conceptualize", []interface{}{arg1, arg2Copy, arg3, arg4, arg5, arg6})
 	fake.conceptualizeMutex.Unlock()
 	if fake.ConceptualizeStub != nil {
-		return fake.ConceptualizeStub(arg1, arg2, arg3, arg4, arg5)
+		return fake.ConceptualizeStub(arg1, arg2, arg3, arg4, arg5, arg6)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -148,17 +264,17 @@ func (fake *FakePushActor) ConceptualizeCallCount() int {
 	return len(fake.conceptualizeArgsForCall)
 }
 
-func (fake *FakePushActor) ConceptualizeCalls(stub func(string, string, string, string, v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)) {
+func (fake *FakePushActor) ConceptualizeCalls(stub func(string, []manifest.Application, string, string, string, v7pushaction.FlagOverrides) ([]v7pushaction.PushState, v7pushaction.Warnings, error)) {
 	fake.conceptualizeMutex.Lock()
 	defer fake.conceptualizeMutex.Unlock()
 	fake.ConceptualizeStub = stub
 }
 
-func (fake *FakePushActor) ConceptualizeArgsForCall(i int) (string, string, string, string, v7pushaction.FlagOverrides) {
+func (fake *FakePushActor) ConceptualizeArgsForCall(i int) (string, []manifest.Application, string, string, string, v7pushaction.FlagOverrides) {
 	fake.conceptualizeMutex.RLock()
 	defer fake.conceptualizeMutex.RUnlock()
 	argsForCall := fake.conceptualizeArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5, argsForCall.arg6
 }
 
 func (fake *FakePushActor) ConceptualizeReturns(result1 []v7pushaction.PushState, result2 v7pushaction.Warnings, result3 error) {
@@ -190,13 +306,148 @@ func (fake *FakePushActor) ConceptualizeReturnsOnCall(i int, result1 []v7pushact
 	}{result1, result2, result3}
 }
 
+func (fake *FakePushActor) Plan(arg1 v7pushaction.PushState) (v7pushaction.PushPlan, v7pushaction.Warnings, error) {
+	fake.planMutex.Lock()
+	ret, specificReturn := fake.planReturnsOnCall[len(fake.planArgsForCall)]
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		arg1 v7pushaction.PushState
+	}{arg1})
+	fake.recordInvocation("Plan", []interface{}{arg1})
+	fake.planMutex.Unlock()
+	if fake.PlanStub != nil {
+		return fake.PlanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.planReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePushActor) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakePushActor) PlanCalls(stub func(v7pushaction.PushState) (v7pushaction.PushPlan, v7pushaction.Warnings, error)) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = stub
+}
+
+func (fake *FakePushActor) PlanArgsForCall(i int) v7pushaction.PushState {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	argsForCall := fake.planArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) PlanReturns(result1 v7pushaction.PushPlan, result2 v7pushaction.Warnings, result3 error) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 v7pushaction.PushPlan
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) PlanReturnsOnCall(i int, result1 v7pushaction.PushPlan, result2 v7pushaction.Warnings, result3 error) {
+	fake.planMutex.Lock()
+	defer fake.planMutex.Unlock()
+	fake.PlanStub = nil
+	if fake.planReturnsOnCall == nil {
+		fake.planReturnsOnCall = make(map[int]struct {
+			result1 v7pushaction.PushPlan
+			result2 v7pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.planReturnsOnCall[i] = struct {
+		result1 v7pushaction.PushPlan
+		result2 v7pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePushActor) ReadManifest(arg1 string) ([]manifest.Application, error) {
+	fake.readManifestMutex.Lock()
+	ret, specificReturn := fake.readManifestReturnsOnCall[len(fake.readManifestArgsForCall)]
+	fake.readManifestArgsForCall = append(fake.readManifestArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("ReadManifest", []interface{}{arg1})
+	fake.readManifestMutex.Unlock()
+	if fake.ReadManifestStub != nil {
+		return fake.ReadManifestStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.readManifestReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePushActor) ReadManifestCallCount() int {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	return len(fake.readManifestArgsForCall)
+}
+
+func (fake *FakePushActor) ReadManifestCalls(stub func(string) ([]manifest.Application, error)) {
+	fake.readManifestMutex.Lock()
+	defer fake.readManifestMutex.Unlock()
+	fake.ReadManifestStub = stub
+}
+
+func (fake *FakePushActor) ReadManifestArgsForCall(i int) string {
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
+	argsForCall := fake.readManifestArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePushActor) ReadManifestReturns(result1 []manifest.Application, result2 error) {
+	fake.readManifestMutex.Lock()
+	defer fake.readManifestMutex.Unlock()
+	fake.ReadManifestStub = nil
+	fake.readManifestReturns = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePushActor) ReadManifestReturnsOnCall(i int, result1 []manifest.Application, result2 error) {
+	fake.readManifestMutex.Lock()
+	defer fake.readManifestMutex.Unlock()
+	fake.ReadManifestStub = nil
+	if fake.readManifestReturnsOnCall == nil {
+		fake.readManifestReturnsOnCall = make(map[int]struct {
+			result1 []manifest.Application
+			result2 error
+		})
+	}
+	fake.readManifestReturnsOnCall[i] = struct {
+		result1 []manifest.Application
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.actualizeMutex.RLock()
 	defer fake.actualizeMutex.RUnlock()
+	fake.actualizeConcurrentlyMutex.RLock()
+	defer fake.actualizeConcurrentlyMutex.RUnlock()
 	fake.conceptualizeMutex.RLock()
 	defer fake.conceptualizeMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	fake.readManifestMutex.RLock()
+	defer fake.readManifestMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -2,6 +2,7 @@
 package v7fakes
 
 import (
+	io "io"
 	sync "sync"
 
 	sharedaction "code.cloudfoundry.org/cli/actor/sharedaction"
@@ -21,6 +22,20 @@ type FakeSharedSSHActor struct {
 	executeSecureShellReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ExecuteSecureShellOnInstancesStub        func(func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, io.Writer, io.Writer) []sharedaction.InstanceSecureShellError
+	executeSecureShellOnInstancesMutex       sync.RWMutex
+	executeSecureShellOnInstancesArgsForCall []struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.InstanceSSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}
+	executeSecureShellOnInstancesReturns struct {
+		result1 []sharedaction.InstanceSecureShellError
+	}
+	executeSecureShellOnInstancesReturnsOnCall map[int]struct {
+		result1 []sharedaction.InstanceSecureShellError
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -86,11 +101,81 @@ func (fake *FakeSharedSSHActor) ExecuteSecureShellReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstances(arg1 func() sharedaction.SecureShellClient, arg2 []sharedaction.InstanceSSHOptions, arg3 io.Writer, arg4 io.Writer) []sharedaction.InstanceSecureShellError {
+	var arg2Copy []sharedaction.InstanceSSHOptions
+	if arg2 != nil {
+		arg2Copy = make([]sharedaction.InstanceSSHOptions, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	ret, specificReturn := fake.executeSecureShellOnInstancesReturnsOnCall[len(fake.executeSecureShellOnInstancesArgsForCall)]
+	fake.executeSecureShellOnInstancesArgsForCall = append(fake.executeSecureShellOnInstancesArgsForCall, struct {
+		arg1 func() sharedaction.SecureShellClient
+		arg2 []sharedaction.InstanceSSHOptions
+		arg3 io.Writer
+		arg4 io.Writer
+	}{arg1, arg2Copy, arg3, arg4})
+	fake.recordInvocation("ExecuteSecureShellOnInstances", []interface{}{arg1, arg2Copy, arg3, arg4})
+	fake.executeSecureShellOnInstancesMutex.Unlock()
+	if fake.ExecuteSecureShellOnInstancesStub != nil {
+		return fake.ExecuteSecureShellOnInstancesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.executeSecureShellOnInstancesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCallCount() int {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	return len(fake.executeSecureShellOnInstancesArgsForCall)
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesCalls(stub func(func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, io.Writer, io.Writer) []sharedaction.InstanceSecureShellError) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = stub
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesArgsForCall(i int) (func() sharedaction.SecureShellClient, []sharedaction.InstanceSSHOptions, io.Writer, io.Writer) {
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
+	argsForCall := fake.executeSecureShellOnInstancesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturns(result1 []sharedaction.InstanceSecureShellError) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	fake.executeSecureShellOnInstancesReturns = struct {
+		result1 []sharedaction.InstanceSecureShellError
+	}{result1}
+}
+
+func (fake *FakeSharedSSHActor) ExecuteSecureShellOnInstancesReturnsOnCall(i int, result1 []sharedaction.InstanceSecureShellError) {
+	fake.executeSecureShellOnInstancesMutex.Lock()
+	defer fake.executeSecureShellOnInstancesMutex.Unlock()
+	fake.ExecuteSecureShellOnInstancesStub = nil
+	if fake.executeSecureShellOnInstancesReturnsOnCall == nil {
+		fake.executeSecureShellOnInstancesReturnsOnCall = make(map[int]struct {
+			result1 []sharedaction.InstanceSecureShellError
+		})
+	}
+	fake.executeSecureShellOnInstancesReturnsOnCall[i] = struct {
+		result1 []sharedaction.InstanceSecureShellError
+	}{result1}
+}
+
 func (fake *FakeSharedSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.executeSecureShellMutex.RLock()
 	defer fake.executeSecureShellMutex.RUnlock()
+	fake.executeSecureShellOnInstancesMutex.RLock()
+	defer fake.executeSecureShellOnInstancesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -27,6 +27,24 @@ type FakeSSHActor struct {
 		result2 v7action.Warnings
 		result3 error
 	}
+	GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub        func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex       sync.RWMutex
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
+	getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall map[int]struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -100,11 +118,87 @@ func (fake *FakeSSHActor) GetSecureShellConfigurationByApplicationNameSpaceProce
 	}{result1, result2, result3}
 }
 
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes(arg1 string, arg2 string, arg3 string, arg4 []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error) {
+	var arg4Copy []uint
+	if arg4 != nil {
+		arg4Copy = make([]uint, len(arg4))
+		copy(arg4Copy, arg4)
+	}
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	ret, specificReturn := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall[len(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall)]
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall = append(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 string
+		arg4 []uint
+	}{arg1, arg2, arg3, arg4Copy})
+	fake.recordInvocation("GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexes", []interface{}{arg1, arg2, arg3, arg4Copy})
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	if fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub != nil {
+		return fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCallCount() int {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
+	return len(fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall)
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesCalls(stub func(string, string, string, []uint) ([]v7action.InstanceSSHAuthentication, v7action.Warnings, error)) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = stub
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall(i int) (string, string, string, []uint) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
+	argsForCall := fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns(result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = nil
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturns = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSSHActor) GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall(i int, result1 []v7action.InstanceSSHAuthentication, result2 v7action.Warnings, result3 error) {
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Lock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.Unlock()
+	fake.GetSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesStub = nil
+	if fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall == nil {
+		fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall = make(map[int]struct {
+			result1 []v7action.InstanceSSHAuthentication
+			result2 v7action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesReturnsOnCall[i] = struct {
+		result1 []v7action.InstanceSSHAuthentication
+		result2 v7action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeSSHActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RLock()
 	defer fake.getSecureShellConfigurationByApplicationNameSpaceProcessTypeAndIndexMutex.RUnlock()
+	fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RLock()
+	defer fake.getSecureShellConfigurationsByApplicationNameSpaceProcessTypeAndIndexesMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
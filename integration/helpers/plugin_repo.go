@@ -2,6 +2,7 @@ package helpers
 
 import (
 	"bytes"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -12,15 +13,17 @@ import (
 
 	"code.cloudfoundry.org/cli/util"
 	"code.cloudfoundry.org/cli/util/generic"
+	"golang.org/x/crypto/ed25519"
 
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
 )
 
 type Binary struct {
-	Checksum string `json:"checksum"`
-	Platform string `json:"platform"`
-	URL      string `json:"url"`
+	Checksum  string `json:"checksum"`
+	Platform  string `json:"platform"`
+	URL       string `json:"url"`
+	Signature string `json:"signature,omitempty"`
 }
 
 type Plugin struct {
@@ -36,6 +39,7 @@ type PluginRepository struct {
 type PluginRepositoryServerWithPlugin struct {
 	server     *Server
 	pluginPath string
+	trustedKey string
 }
 
 func NewPluginRepositoryServer(pluginRepo PluginRepository) *Server {
@@ -72,6 +76,13 @@ func (pluginRepoServer *PluginRepositoryServerWithPlugin) Init(pluginName string
 		Expect(err).NotTo(HaveOccurred())
 	}
 
+	pluginData, err := ioutil.ReadFile(pluginPath)
+	Expect(err).ToNot(HaveOccurred())
+
+	publicKey, privateKey, err := ed25519.GenerateKey(nil)
+	Expect(err).ToNot(HaveOccurred())
+	pluginRepoServer.trustedKey = base64.StdEncoding.EncodeToString(publicKey)
+
 	baseFile := fmt.Sprintf("/%s", generic.ExecutableFilename(filepath.Base(pluginPath)))
 	downloadURL := fmt.Sprintf("%s%s", repoServer.URL(), baseFile)
 	pluginRepo := PluginRepository{
@@ -81,9 +92,10 @@ func (pluginRepoServer *PluginRepositoryServerWithPlugin) Init(pluginName string
 				Version: version,
 				Binaries: []Binary{
 					{
-						Checksum: fmt.Sprintf("%x", checksum),
-						Platform: platform,
-						URL:      downloadURL,
+						Checksum:  fmt.Sprintf("%x", checksum),
+						Platform:  platform,
+						URL:       downloadURL,
+						Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(privateKey, pluginData)),
 					},
 				},
 			},
@@ -95,9 +107,6 @@ func (pluginRepoServer *PluginRepositoryServerWithPlugin) Init(pluginName string
 	jsonBytes, err := json.Marshal(pluginRepo)
 	Expect(err).ToNot(HaveOccurred())
 
-	pluginData, err := ioutil.ReadFile(pluginPath)
-	Expect(err).ToNot(HaveOccurred())
-
 	repoServer.AppendHandlers(
 		CombineHandlers(
 			VerifyRequest(http.MethodGet, "/list"),
@@ -124,6 +133,12 @@ func (pluginRepoServer *PluginRepositoryServerWithPlugin) URL() string {
 	return pluginRepoServer.server.URL()
 }
 
+// TrustedKey returns the base64 encoded public key the plugin binary is
+// signed with.
+func (pluginRepoServer *PluginRepositoryServerWithPlugin) TrustedKey() string {
+	return pluginRepoServer.trustedKey
+}
+
 func (pluginRepoServer *PluginRepositoryServerWithPlugin) Cleanup() {
 	pluginRepoServer.server.Close()
 	Expect(os.RemoveAll(filepath.Dir(pluginRepoServer.pluginPath))).NotTo(HaveOccurred())
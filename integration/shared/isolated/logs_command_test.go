@@ -20,9 +20,23 @@ var _ = Describe("Logs Command", func() {
 			Eventually(session).Should(Say("NAME:"))
 			Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 			Eventually(session).Should(Say("USAGE:"))
-			Eventually(session).Should(Say("cf logs APP_NAME"))
+			Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --all-apps\) \[--recent\] \[--since TIME\] \[--until TIME\] \[--lines NUMBER\]`))
+			Eventually(session).Should(Say("EXAMPLES:"))
+			Eventually(session).Should(Say("cf logs my-app my-worker"))
+			Eventually(session).Should(Say("cf logs --all-apps --source-type APP"))
+			Eventually(session).Should(Say("cf logs my-app --since 2h --until 90m"))
 			Eventually(session).Should(Say("OPTIONS:"))
+			Eventually(session).Should(Say(`--all-apps\s+Show logs of every app in the targeted space`))
+			Eventually(session).Should(Say(`--format\s+Display each log message as a JSON object on its own line`))
+			Eventually(session).Should(Say(`--grep\s+Only show log messages matching the regular expression`))
+			Eventually(session).Should(Say(`--instance\s+Only show logs from this instance index \(can be repeated\)`))
+			Eventually(session).Should(Say(`--lines\s+Dump at most this many of the most recent log lines \(implies --recent\)`))
 			Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
+			Eventually(session).Should(Say(`--since\s+Dump logs newer than a duration such as 30m or an RFC3339 time \(implies --recent\)`))
+			Eventually(session).Should(Say(`--source-type\s+Only show logs from this source type, such as APP, RTR, STG, API or CELL \(can be repeated\)`))
+			Eventually(session).Should(Say(`--stderr\s+Only show logs written to stderr`))
+			Eventually(session).Should(Say(`--stdout\s+Only show logs written to stdout`))
+			Eventually(session).Should(Say(`--until\s+Dump logs older than a duration such as 30m or an RFC3339 time \(implies --recent\)`))
 			Eventually(session).Should(Say("SEE ALSO:"))
 			Eventually(session).Should(Say("app, apps, ssh"))
 			Eventually(session).Should(Exit(0))
@@ -59,7 +73,7 @@ var _ = Describe("Logs Command", func() {
 					Eventually(session).Should(Say("NAME:"))
 					Eventually(session).Should(Say("logs - Tail or show recent logs for an app"))
 					Eventually(session).Should(Say("USAGE:"))
-					Eventually(session).Should(Say("cf logs APP_NAME"))
+					Eventually(session).Should(Say(`cf logs \(APP_NAME\.\.\. \| --all-apps\)`))
 					Eventually(session).Should(Say("OPTIONS:"))
 					Eventually(session).Should(Say(`--recent\s+Dump recent logs instead of tailing`))
 					Eventually(session).Should(Say("SEE ALSO:"))
@@ -24,9 +24,12 @@ var _ = Describe("add-plugin-repo command", func() {
 				Eventually(session).Should(Say("NAME:"))
 				Eventually(session).Should(Say("add-plugin-repo - Add a new plugin repository"))
 				Eventually(session).Should(Say("USAGE:"))
-				Eventually(session).Should(Say("cf add-plugin-repo REPO_NAME URL"))
+				Eventually(session).Should(Say(`cf add-plugin-repo REPO_NAME URL \[--trusted-key KEY\]\.\.\.`))
 				Eventually(session).Should(Say("EXAMPLES"))
 				Eventually(session).Should(Say(`cf add-plugin-repo ExampleRepo https://example\.com/repo`))
+				Eventually(session).Should(Say(`cf add-plugin-repo ExampleRepo https://example\.com/repo --trusted-key 11qYAYKxCrfVS/7TyWQHOg7hcvPapiMlrwIaaPcHURo=`))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--trusted-key\s+Base64 encoded ed25519 public key`))
 				Eventually(session).Should(Say("SEE ALSO:"))
 				Eventually(session).Should(Say("install-plugin, list-plugin-repos"))
 				Eventually(session).Should(Exit(0))
@@ -139,7 +139,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 			When("no compatible binary is found in the repo", func() {
 				BeforeEach(func() {
 					repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", "not-me-platform", true)
-					Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+					Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 				})
 
 				AfterEach(func() {
@@ -160,7 +160,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the plugin checksum is valid", func() {
 						BeforeEach(func() {
 							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 						})
 
 						AfterEach(func() {
@@ -186,7 +186,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the plugin checksum is invalid", func() {
 						BeforeEach(func() {
 							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 
 						})
 
@@ -202,6 +202,73 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							Eventually(session).Should(Exit(1))
 						})
 					})
+
+					When("the repository has no trusted keys", func() {
+						BeforeEach(func() {
+							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+						})
+
+						AfterEach(func() {
+							repoServer.Cleanup()
+						})
+
+						It("fails with an error message", func() {
+							session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+							Eventually(session).Should(Say("FAILED"))
+							Eventually(session.Err).Should(Say(`Repository kaka has no trusted keys to verify the plugin binary's signature with\.`))
+							Eventually(session).Should(Exit(1))
+
+							Expect(session).ToNot(Say(`Installing plugin`))
+						})
+
+						When("trusted keys are added to the repository", func() {
+							BeforeEach(func() {
+								Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
+							})
+
+							It("verifies the signature of the plugin", func() {
+								session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+								Eventually(session).Should(Say(`Installing plugin some-plugin\.\.\.`))
+								Eventually(session).Should(Exit(0))
+
+								Expect(session.Err).ToNot(Say(`has no trusted keys`))
+								Expect(session.Err).ToNot(Say(`Skipping signature validation`))
+							})
+						})
+
+						When("--skip-signature-validation is specified", func() {
+							It("installs the plugin with a warning", func() {
+								session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k", "--skip-signature-validation")
+								Eventually(session.Err).Should(Say(`Skipping signature validation of the plugin binary\.`))
+								Eventually(session).Should(Say(`Installing plugin some-plugin\.\.\.`))
+								Eventually(session).Should(Say(`Plugin some-plugin 1\.0\.0 successfully installed\.`))
+								Eventually(session).Should(Exit(0))
+							})
+						})
+					})
+
+					When("the plugin is signed with a key that is not trusted", func() {
+						var otherRepoServer *helpers.PluginRepositoryServerWithPlugin
+
+						BeforeEach(func() {
+							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+							otherRepoServer = helpers.NewPluginRepositoryServerWithPlugin("other-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", otherRepoServer.TrustedKey())).Should(Exit(0))
+						})
+
+						AfterEach(func() {
+							repoServer.Cleanup()
+							otherRepoServer.Cleanup()
+						})
+
+						It("fails with an error message", func() {
+							session := helpers.CF("install-plugin", "-f", "-r", "kaka", "some-plugin", "-k")
+							Eventually(session).Should(Say("FAILED"))
+							Eventually(session.Err).Should(Say(`Downloaded plugin binary's signature does not match any trusted key of repository kaka\.`))
+							Eventually(session).Should(Exit(1))
+						})
+					})
 				})
 
 				When("the plugin is already installed", func() {
@@ -217,7 +284,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the plugin checksum is valid", func() {
 						BeforeEach(func() {
 							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "2.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 						})
 
 						AfterEach(func() {
@@ -248,7 +315,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the plugin checksum is invalid", func() {
 						BeforeEach(func() {
 							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "2.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 						})
 
 						AfterEach(func() {
@@ -286,7 +353,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 				When("the plugin is not already installed", func() {
 					BeforeEach(func() {
 						repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 					})
 
 					AfterEach(func() {
@@ -353,7 +420,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 						When("the plugin checksum is valid", func() {
 							BeforeEach(func() {
 								repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-								Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+								Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 							})
 
 							AfterEach(func() {
@@ -386,7 +453,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 							BeforeEach(func() {
 								repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
 
-								Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+								Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 							})
 
 							AfterEach(func() {
@@ -415,7 +482,7 @@ var _ = Describe("install-plugin (from repo) command", func() {
 					When("the user chooses no", func() {
 						BeforeEach(func() {
 							repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
 
 							_, _ = buffer.Write([]byte("n\n"))
 						})
@@ -525,10 +592,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 					BeforeEach(func() {
 						repoServer1 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL(), "--trusted-key", repoServer1.TrustedKey())).Should(Exit(0))
 
 						repoServer2 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL(), "--trusted-key", repoServer2.TrustedKey())).Should(Exit(0))
 					})
 
 					AfterEach(func() {
@@ -561,10 +628,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 							BeforeEach(func() {
 								repoServer3 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin-with-bad-checksum", "2.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-								Eventually(helpers.CF("add-plugin-repo", "kaka3", repoServer3.URL())).Should(Exit(0))
+								Eventually(helpers.CF("add-plugin-repo", "kaka3", repoServer3.URL(), "--trusted-key", repoServer3.TrustedKey())).Should(Exit(0))
 
 								repoServer4 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin-with-bad-checksum", "2.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-								Eventually(helpers.CF("add-plugin-repo", "kaka4", repoServer4.URL())).Should(Exit(0))
+								Eventually(helpers.CF("add-plugin-repo", "kaka4", repoServer4.URL(), "--trusted-key", repoServer4.TrustedKey())).Should(Exit(0))
 							})
 
 							AfterEach(func() {
@@ -621,10 +688,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 					BeforeEach(func() {
 						repoServer1 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", "solaris", false)
-						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL(), "--trusted-key", repoServer1.TrustedKey())).Should(Exit(0))
 
 						repoServer2 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL(), "--trusted-key", repoServer2.TrustedKey())).Should(Exit(0))
 					})
 
 					AfterEach(func() {
@@ -652,10 +719,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 						BeforeEach(func() {
 							repoServer1 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-							Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL(), "--trusted-key", repoServer1.TrustedKey())).Should(Exit(0))
 
 							repoServer2 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.4", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-							Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL(), "--trusted-key", repoServer2.TrustedKey())).Should(Exit(0))
 						})
 
 						AfterEach(func() {
@@ -686,10 +753,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 						BeforeEach(func() {
 							repoServer1 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-							Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL(), "--trusted-key", repoServer1.TrustedKey())).Should(Exit(0))
 
 							repoServer2 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.4", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-							Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL())).Should(Exit(0))
+							Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL(), "--trusted-key", repoServer2.TrustedKey())).Should(Exit(0))
 						})
 
 						AfterEach(func() {
@@ -729,10 +796,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 					BeforeEach(func() {
 						repoServer1 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka1", repoServer1.URL(), "--trusted-key", repoServer1.TrustedKey())).Should(Exit(0))
 
 						repoServer2 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
-						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL())).Should(Exit(0))
+						Eventually(helpers.CF("add-plugin-repo", "kaka2", repoServer2.URL(), "--trusted-key", repoServer2.TrustedKey())).Should(Exit(0))
 					})
 
 					AfterEach(func() {
@@ -771,10 +838,10 @@ var _ = Describe("install-plugin (from repo) command", func() {
 
 								BeforeEach(func() {
 									repoServer3 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin-with-bad-checksum", "2.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-									Eventually(helpers.CF("add-plugin-repo", "kaka3", repoServer3.URL())).Should(Exit(0))
+									Eventually(helpers.CF("add-plugin-repo", "kaka3", repoServer3.URL(), "--trusted-key", repoServer3.TrustedKey())).Should(Exit(0))
 
 									repoServer4 = helpers.NewPluginRepositoryServerWithPlugin("some-plugin-with-bad-checksum", "2.2.3", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), false)
-									Eventually(helpers.CF("add-plugin-repo", "kaka4", repoServer4.URL())).Should(Exit(0))
+									Eventually(helpers.CF("add-plugin-repo", "kaka4", repoServer4.URL(), "--trusted-key", repoServer4.TrustedKey())).Should(Exit(0))
 								})
 
 								AfterEach(func() {
@@ -0,0 +1,103 @@
+package plugin
+
+import (
+	"runtime"
+
+	"code.cloudfoundry.org/cli/integration/helpers"
+	"code.cloudfoundry.org/cli/util/generic"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+	. "github.com/onsi/gomega/gexec"
+)
+
+var _ = Describe("update-plugin command", func() {
+	Describe("help", func() {
+		When("the --help flag is given", func() {
+			It("displays command usage to stdout", func() {
+				session := helpers.CF("update-plugin", "--help")
+
+				Eventually(session).Should(Say("NAME:"))
+				Eventually(session).Should(Say("update-plugin - Update installed CLI plugins to their latest versions"))
+				Eventually(session).Should(Say("USAGE:"))
+				Eventually(session).Should(Say(`cf update-plugin PLUGIN_NAME \[-f\] \[--skip-signature-validation\]`))
+				Eventually(session).Should(Say(`cf update-plugin --all \[-f\] \[--skip-signature-validation\]`))
+				Eventually(session).Should(Say("WARNING:"))
+				Eventually(session).Should(Say("Plugins are binaries written by potentially untrusted authors."))
+				Eventually(session).Should(Say("EXAMPLES:"))
+				Eventually(session).Should(Say("cf update-plugin my-plugin"))
+				Eventually(session).Should(Say("cf update-plugin --all -f"))
+				Eventually(session).Should(Say("OPTIONS:"))
+				Eventually(session).Should(Say(`--all\s+Update every installed plugin that has a newer version in the registered repositories`))
+				Eventually(session).Should(Say(`-f\s+Force update of plugins without confirmation`))
+				Eventually(session).Should(Say(`--skip-signature-validation\s+Update plugins without verifying their signatures\. Not recommended!`))
+				Eventually(session).Should(Say("SEE ALSO:"))
+				Eventually(session).Should(Say("install-plugin, plugins, uninstall-plugin"))
+
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+
+	When("the plugin is not installed", func() {
+		It("fails with an error message", func() {
+			session := helpers.CF("update-plugin", "some-plugin", "-f")
+			Eventually(session).Should(Say("FAILED"))
+			Eventually(session.Err).Should(Say(`Plugin some-plugin does not exist\.`))
+			Eventually(session).Should(Exit(1))
+		})
+	})
+
+	When("the plugin is installed", func() {
+		var repoServer *helpers.PluginRepositoryServerWithPlugin
+
+		BeforeEach(func() {
+			pluginPath := helpers.BuildConfigurablePlugin("configurable_plugin", "some-plugin", "1.0.0",
+				[]helpers.PluginCommand{
+					{Name: "some-command", Help: "some-command-help"},
+				},
+			)
+			Eventually(helpers.CF("install-plugin", pluginPath, "-f", "-k")).Should(Exit(0))
+		})
+
+		AfterEach(func() {
+			repoServer.Cleanup()
+		})
+
+		When("a newer version is in a registered repository", func() {
+			BeforeEach(func() {
+				repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "2.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+				Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
+			})
+
+			It("updates the plugin and keeps the previous version", func() {
+				session := helpers.CF("update-plugin", "some-plugin", "-f", "-k")
+				Eventually(session).Should(Say(`Searching kaka for newer versions of installed plugins\.\.\.`))
+				Eventually(session).Should(Say(`some-plugin\s+1\.0\.0\s+2\.0\.0`))
+				Eventually(session).Should(Say(`Updating plugin some-plugin from 1\.0\.0 to 2\.0\.0\.\.\.`))
+				Eventually(session).Should(Say(`Starting download of plugin binary from repository kaka\.\.\.`))
+				Eventually(session).Should(Say("OK"))
+				Eventually(session).Should(Say(`Plugin some-plugin 2\.0\.0 successfully updated\.`))
+				Eventually(session).Should(Say(`The previous version was saved to .*some-plugin-1\.0\.0.*\. To roll back, run 'cf install-plugin .*some-plugin-1\.0\.0.* -f'\.`))
+				Eventually(session).Should(Exit(0))
+
+				session = helpers.CF("plugins")
+				Eventually(session).Should(Say(`some-command\s+some-plugin\s+2\.0\.0`))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+
+		When("the plugin is already up to date", func() {
+			BeforeEach(func() {
+				repoServer = helpers.NewPluginRepositoryServerWithPlugin("some-plugin", "1.0.0", generic.GeneratePlatform(runtime.GOOS, runtime.GOARCH), true)
+				Eventually(helpers.CF("add-plugin-repo", "kaka", repoServer.URL(), "--trusted-key", repoServer.TrustedKey())).Should(Exit(0))
+			})
+
+			It("displays that the plugin is up to date", func() {
+				session := helpers.CF("update-plugin", "some-plugin", "-f", "-k")
+				Eventually(session).Should(Say(`Plugin some-plugin 1\.0\.0 is already up to date\.`))
+				Eventually(session).Should(Exit(0))
+			})
+		})
+	})
+})
@@ -35,15 +35,21 @@ var _ = Describe("ssh command", func() {
 			Eventually(session).Should(Say(`ssh - SSH to an application container instance`))
 			Eventually(session).Should(Say(`USAGE:`))
 			Eventually(session).Should(Say(`cf ssh APP_NAME \[--process PROCESS\] \[-i INDEX\] \[-c COMMAND\]...\n`))
-			Eventually(session).Should(Say(`\[-L \[BIND_ADDRESS:\]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT\]\.\.\. \[--skip-remote-execution\]`))
+			Eventually(session).Should(Say(`\[--all-instances \| --instances INDEXES\]\n`))
+			Eventually(session).Should(Say(`\[-L \[BIND_ADDRESS:\]LOCAL_PORT:REMOTE_HOST:REMOTE_PORT\]\.\.\.\n`))
+			Eventually(session).Should(Say(`\[-R \[BIND_ADDRESS:\]REMOTE_PORT:LOCAL_HOST:LOCAL_PORT\]\.\.\. \[-D \[BIND_ADDRESS:\]LOCAL_PORT\]\.\.\. \[--skip-remote-execution\]`))
 			Eventually(session).Should(Say(`\[--disable-pseudo-tty \| --force-pseudo-tty \| --request-pseudo-tty\] \[--skip-host-validation\]`))
 			Eventually(session).Should(Say(`OPTIONS:`))
+			Eventually(session).Should(Say(`--all-instances\s+Run the command on every running instance of the process`))
 			Eventually(session).Should(Say(`--app-instance-index, -i\s+App process instance index \(Default: 0\)`))
 			Eventually(session).Should(Say(`--command, -c\s+Command to run`))
+			Eventually(session).Should(Say(`-D\s+Dynamic \(SOCKS5\) port forward specification`))
 			Eventually(session).Should(Say(`--disable-pseudo-tty, -T\s+Disable pseudo-tty allocation`))
 			Eventually(session).Should(Say(`--force-pseudo-tty\s+Force pseudo-tty allocation`))
+			Eventually(session).Should(Say(`--instances\s+Run the command on the given comma separated instance indexes`))
 			Eventually(session).Should(Say(`-L\s+Local port forward specification`))
 			Eventually(session).Should(Say(`--process\s+App process name \(Default: web\)`))
+			Eventually(session).Should(Say(`-R\s+Remote port forward specification`))
 			Eventually(session).Should(Say(`--request-pseudo-tty, -t\s+Request pseudo-tty allocation`))
 			Eventually(session).Should(Say(`--skip-host-validation, -k\s+Skip host key validation\. Not recommended!`))
 			Eventually(session).Should(Say(`--skip-remote-execution, -N\s+Do not execute a remote command`))
@@ -21,11 +21,11 @@ var _ = Describe("input errors", func() {
 		appName = helpers.PrefixedRandomName("app")
 	})
 
-	When("the app name is not provided", func() {
+	When("neither the app name nor a manifest is provided", func() {
 		It("tells the user that the app name is required, prints help text, and exits 1", func() {
 			session := helpers.CF(PushCommandName)
 
-			Eventually(session.Err).Should(Say("Incorrect Usage: the required argument `APP_NAME` was not provided"))
+			Eventually(session.Err).Should(Say("Incorrect usage: The push command requires an app name. The app name can be supplied as an argument or with a manifest.yml file."))
 			Eventually(session).Should(Say("NAME:"))
 			Eventually(session).Should(Exit(1))
 		})
@@ -161,7 +161,9 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, configErr := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Context:      common.Commands.Context,
+		OutputFormat: common.Commands.Output,
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if configErr != nil {
 		if _, ok := configErr.(translatableerror.EmptyConfigError); !ok {
@@ -210,6 +212,12 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		}
 	}()
 
+	if outputFormat := cfConfig.OutputFormat(); outputFormat.IsStructured() {
+		if _, ok := cmd.(command.StructuredOutputCommander); !ok {
+			return handleError(translatableerror.StructuredOutputNotSupportedError{Format: string(outputFormat)}, commandUI)
+		}
+	}
+
 	if extendedCmd, ok := cmd.(command.ExtendedCommander); ok {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
@@ -236,6 +244,11 @@ func handleError(passedErr error, commandUI UI) error {
 		log.Info("Received a V3V2SwitchError - switch to the V2 version of the command")
 		return passedErr
 	case TriggerLegacyMain:
+		if common.Commands.Context != "" {
+			commandUI.DisplayError(translatableerror.ContextFlagNotSupportedError{})
+			return ErrFailed
+		}
+
 		if typedErr.Error() != "" {
 			commandUI.DisplayWarning("")
 			commandUI.DisplayWarning(typedErr.Error())
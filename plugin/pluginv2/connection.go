@@ -0,0 +1,137 @@
+// Package pluginv2 is the client for version 2 of the plugin RPC API. It
+// exposes V3 Cloud Controller resources, log streaming and authenticated
+// Cloud Controller requests. Plugins that only use plugin.CliConnection do
+// not need it.
+//
+// A plugin creates a Connection in its Run method with the port the CLI passed
+// to it:
+//
+//	connection := pluginv2.NewConnection(os.Args[1])
+package pluginv2
+
+import (
+	"context"
+	"errors"
+	"net/rpc"
+	"strings"
+)
+
+// ErrUnsupportedCLI is returned when the CLI running the plugin does not
+// provide version 2 of the plugin RPC API.
+var ErrUnsupportedCLI = errors.New("this version of the cf CLI does not support the plugin RPC v2 API")
+
+//go:generate counterfeiter . Connection
+
+// Connection lists the calls available in version 2 of the plugin RPC API.
+type Connection interface {
+	GetProcesses(appGUID string) ([]Process, error)
+	GetDeployments(appGUID string) ([]Deployment, error)
+	GetTasks(appGUID string) ([]Task, error)
+	GetDroplets(appGUID string) ([]Droplet, error)
+	GetPackages(appGUID string) ([]Package, error)
+	// StreamLogs calls callback with each log message of the app until ctx is
+	// done or the stream fails.
+	StreamLogs(ctx context.Context, appGUID string, callback func(LogMessage)) error
+	// CloudControllerRequest sends an authenticated request to the Cloud
+	// Controller, refreshing the access token when it has expired.
+	CloudControllerRequest(request Request) (Response, error)
+}
+
+type connection struct {
+	cliServerPort string
+}
+
+// NewConnection returns a Connection to the CLI listening on cliServerPort.
+func NewConnection(cliServerPort string) *connection {
+	return &connection{
+		cliServerPort: cliServerPort,
+	}
+}
+
+func (c *connection) GetProcesses(appGUID string) ([]Process, error) {
+	var processes []Process
+	err := c.call("GetProcesses", appGUID, &processes)
+	return processes, err
+}
+
+func (c *connection) GetDeployments(appGUID string) ([]Deployment, error) {
+	var deployments []Deployment
+	err := c.call("GetDeployments", appGUID, &deployments)
+	return deployments, err
+}
+
+func (c *connection) GetTasks(appGUID string) ([]Task, error) {
+	var tasks []Task
+	err := c.call("GetTasks", appGUID, &tasks)
+	return tasks, err
+}
+
+func (c *connection) GetDroplets(appGUID string) ([]Droplet, error) {
+	var droplets []Droplet
+	err := c.call("GetDroplets", appGUID, &droplets)
+	return droplets, err
+}
+
+func (c *connection) GetPackages(appGUID string) ([]Package, error) {
+	var packages []Package
+	err := c.call("GetPackages", appGUID, &packages)
+	return packages, err
+}
+
+func (c *connection) CloudControllerRequest(request Request) (Response, error) {
+	var response Response
+	err := c.call("CloudControllerRequest", request, &response)
+	return response, err
+}
+
+func (c *connection) StreamLogs(ctx context.Context, appGUID string, callback func(LogMessage)) error {
+	return c.withClientDo(func(client *rpc.Client) error {
+		var streamID int
+		err := client.Call("CliRpcV2Cmd.StartLogStream", appGUID, &streamID)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			var stopped bool
+			_ = client.Call("CliRpcV2Cmd.StopLogStream", streamID, &stopped)
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return nil
+			default:
+			}
+
+			var batch LogBatch
+			err = client.Call("CliRpcV2Cmd.NextLogMessages", streamID, &batch)
+			if err != nil {
+				return err
+			}
+
+			for _, message := range batch.Messages {
+				callback(message)
+			}
+		}
+	})
+}
+
+func (c *connection) call(method string, args interface{}, reply interface{}) error {
+	return c.withClientDo(func(client *rpc.Client) error {
+		return client.Call("CliRpcV2Cmd."+method, args, reply)
+	})
+}
+
+func (c *connection) withClientDo(f func(client *rpc.Client) error) error {
+	client, err := rpc.Dial("tcp", "127.0.0.1:"+c.cliServerPort)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	err = f(client)
+	if serverErr, ok := err.(rpc.ServerError); ok && strings.HasPrefix(string(serverErr), "rpc: can't find service") {
+		return ErrUnsupportedCLI
+	}
+	return err
+}
@@ -0,0 +1,142 @@
+package pluginv2_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/rpc"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "code.cloudfoundry.org/cli/plugin/pluginv2"
+	cliRpc "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Connection", func() {
+	var (
+		server          *rpc.Server
+		listener        net.Listener
+		fakeCCClient    *rpcfakes.FakeCloudControllerV3Client
+		fakeLogStreamer *rpcfakes.FakeLogStreamer
+		connection      Connection
+	)
+
+	BeforeEach(func() {
+		fakeCCClient = new(rpcfakes.FakeCloudControllerV3Client)
+		fakeLogStreamer = new(rpcfakes.FakeLogStreamer)
+
+		server = rpc.NewServer()
+		Expect(server.Register(cliRpc.NewCliRpcV2Cmd(func() (cliRpc.CloudControllerV3Client, cliRpc.LogStreamer, error) {
+			return fakeCCClient, fakeLogStreamer, nil
+		}))).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		listener, err = net.Listen("tcp", "127.0.0.1:0")
+		Expect(err).ToNot(HaveOccurred())
+		go server.Accept(listener)
+
+		connection = NewConnection(strconv.Itoa(listener.Addr().(*net.TCPAddr).Port))
+	})
+
+	AfterEach(func() {
+		listener.Close()
+	})
+
+	It("gets the app's processes from the CLI", func() {
+		fakeCCClient.GetApplicationProcessesReturns([]ccv3.Process{{GUID: "some-process-guid", Type: "web"}}, nil, nil)
+
+		processes, err := connection.GetProcesses("some-app-guid")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(processes).To(Equal([]Process{{GUID: "some-process-guid", Type: "web"}}))
+		Expect(fakeCCClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+	})
+
+	It("returns errors from the CLI", func() {
+		fakeCCClient.GetApplicationTasksReturns(nil, nil, errors.New("some-task-error"))
+
+		_, err := connection.GetTasks("some-app-guid")
+		Expect(err).To(MatchError("some-task-error"))
+	})
+
+	It("makes Cloud Controller requests through the CLI", func() {
+		fakeCCClient.MakeRawRequestReturns(ccv3.RawResponse{StatusCode: http.StatusOK, Body: []byte("some-body")}, nil, nil)
+
+		response, err := connection.CloudControllerRequest(Request{Method: http.MethodGet, Path: "/v3/apps"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(response.StatusCode).To(Equal(http.StatusOK))
+		Expect(string(response.Body)).To(Equal("some-body"))
+	})
+
+	Describe("StreamLogs", func() {
+		var (
+			messages chan *v7action.LogMessage
+			errs     chan error
+			stopped  chan bool
+		)
+
+		BeforeEach(func() {
+			messages = make(chan *v7action.LogMessage)
+			errs = make(chan error)
+			stopped = make(chan bool, 1)
+			fakeLogStreamer.StreamLogsReturns(messages, errs, func() {
+				stopped <- true
+				close(messages)
+				close(errs)
+			})
+		})
+
+		It("calls the callback with each message until the context is done", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			received := make(chan LogMessage, 2)
+			streamErr := make(chan error, 1)
+			go func() {
+				streamErr <- connection.StreamLogs(ctx, "some-app-guid", func(message LogMessage) {
+					received <- message
+				})
+			}()
+
+			messages <- v7action.NewLogMessage("some-message", 1, time.Unix(1000, 0), "APP/PROC/WEB", "0")
+			Eventually(received).Should(Receive(Equal(LogMessage{
+				Message:        "some-message",
+				Type:           "OUT",
+				Timestamp:      time.Unix(1000, 0),
+				SourceType:     "APP/PROC/WEB",
+				SourceInstance: "0",
+			})))
+			Expect(fakeLogStreamer.StreamLogsArgsForCall(0)).To(Equal("some-app-guid"))
+
+			cancel()
+			Eventually(streamErr, 3*time.Second).Should(Receive(BeNil()))
+			Expect(stopped).To(Receive())
+		})
+
+		It("returns the error when the stream fails", func() {
+			streamErr := make(chan error, 1)
+			go func() {
+				streamErr <- connection.StreamLogs(context.Background(), "some-app-guid", func(LogMessage) {})
+			}()
+
+			errs <- errors.New("some-stream-error")
+			Eventually(streamErr, 3*time.Second).Should(Receive(MatchError("some-stream-error")))
+		})
+	})
+
+	When("the CLI does not provide the v2 API", func() {
+		BeforeEach(func() {
+			server = rpc.NewServer()
+		})
+
+		It("returns ErrUnsupportedCLI", func() {
+			_, err := connection.GetDroplets("some-app-guid")
+			Expect(err).To(Equal(ErrUnsupportedCLI))
+		})
+	})
+})
@@ -0,0 +1,90 @@
+package pluginv2
+
+import (
+	"net/http"
+	"time"
+)
+
+// Process is a process of an application, such as web or worker.
+type Process struct {
+	GUID            string
+	Type            string
+	Command         string
+	Instances       int
+	MemoryInMB      uint64
+	DiskInMB        uint64
+	HealthCheckType string
+}
+
+// Deployment is a rolling deployment of an application.
+type Deployment struct {
+	GUID         string
+	State        string
+	DropletGUID  string
+	RevisionGUID string
+	CreatedAt    string
+	UpdatedAt    string
+}
+
+// Task is a one-off task run by an application.
+type Task struct {
+	GUID       string
+	SequenceID int
+	Name       string
+	Command    string
+	State      string
+	MemoryInMB uint64
+	DiskInMB   uint64
+	CreatedAt  string
+}
+
+// Droplet is the result of staging an application.
+type Droplet struct {
+	GUID       string
+	State      string
+	Stack      string
+	Image      string
+	Buildpacks []string
+	CreatedAt  string
+}
+
+// Package is the source bits or Docker image of an application.
+type Package struct {
+	GUID        string
+	Type        string
+	State       string
+	DockerImage string
+	CreatedAt   string
+}
+
+// LogMessage is a single line logged by an application or the platform on
+// its behalf.
+type LogMessage struct {
+	Message        string
+	Type           string
+	Timestamp      time.Time
+	SourceType     string
+	SourceInstance string
+}
+
+// Request is a Cloud Controller request. Path is relative to the API root,
+// for example "/v3/apps?names=my-app".
+type Request struct {
+	Method string
+	Path   string
+	Body   []byte
+}
+
+// Response is the response to a Request. Responses with an error status code
+// are returned as they are rather than as errors.
+type Response struct {
+	StatusCode int
+	Header     http.Header
+	Body       []byte
+}
+
+// LogBatch is the set of log messages received since the last batch of a log
+// stream.
+type LogBatch struct {
+	Messages []LogMessage
+}
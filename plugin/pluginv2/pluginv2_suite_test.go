@@ -0,0 +1,13 @@
+package pluginv2_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestPluginV2(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Plugin V2 Suite")
+}
@@ -33,6 +33,7 @@ type CliRpcService struct {
 	stopCh   chan struct{}
 	Pinged   bool
 	RpcCmd   *CliRpcCmd
+	RpcV2Cmd *CliRpcV2Cmd
 	Server   *rpc.Server
 }
 
@@ -85,6 +86,7 @@ func NewRpcService(
 			outputBucket:         &bytes.Buffer{},
 			stdout:               w,
 		},
+		RpcV2Cmd: NewCliRpcV2Cmd(newV2Clients),
 	}
 
 	err := rpcService.Server.Register(rpcService.RpcCmd)
@@ -92,6 +94,11 @@ func NewRpcService(
 		return nil, err
 	}
 
+	err = rpcService.Server.Register(rpcService.RpcV2Cmd)
+	if err != nil {
+		return nil, err
+	}
+
 	return rpcService, nil
 }
 
@@ -0,0 +1,427 @@
+package rpc
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/command/v7/shared"
+	"code.cloudfoundry.org/cli/plugin/pluginv2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+// logPollTimeout is how long NextLogMessages waits for new messages before
+// returning an empty batch.
+var logPollTimeout = time.Second
+
+//go:generate counterfeiter . CloudControllerV3Client
+
+// CloudControllerV3Client is the V3 Cloud Controller client used by version 2
+// of the plugin RPC API.
+type CloudControllerV3Client interface {
+	GetApplicationProcesses(appGUID string) ([]ccv3.Process, ccv3.Warnings, error)
+	GetApplicationTasks(appGUID string, query ...ccv3.Query) ([]ccv3.Task, ccv3.Warnings, error)
+	GetDeployments(query ...ccv3.Query) ([]ccv3.Deployment, ccv3.Warnings, error)
+	GetDroplets(query ...ccv3.Query) ([]ccv3.Droplet, ccv3.Warnings, error)
+	GetPackages(query ...ccv3.Query) ([]ccv3.Package, ccv3.Warnings, error)
+	MakeRawRequest(method string, path string, body []byte) (ccv3.RawResponse, ccv3.Warnings, error)
+}
+
+//go:generate counterfeiter . LogStreamer
+
+// LogStreamer streams the logs of an application until stop is called.
+type LogStreamer interface {
+	StreamLogs(appGUID string) (messages <-chan *v7action.LogMessage, errs <-chan error, stop func())
+}
+
+// CliRpcV2Cmd serves version 2 of the plugin RPC API. Its clients are only
+// created once a plugin makes a V2 call, so plugins that do not use it pay
+// nothing for it.
+type CliRpcV2Cmd struct {
+	newClients  func() (CloudControllerV3Client, LogStreamer, error)
+	clientsOnce *sync.Once
+	ccClient    CloudControllerV3Client
+	logStreamer LogStreamer
+	clientsErr  error
+
+	streamsMutex *sync.Mutex
+	streams      map[int]*logStream
+	nextStreamID int
+}
+
+// NewCliRpcV2Cmd returns a CliRpcV2Cmd that gets its clients from newClients.
+func NewCliRpcV2Cmd(newClients func() (CloudControllerV3Client, LogStreamer, error)) *CliRpcV2Cmd {
+	return &CliRpcV2Cmd{
+		newClients:   newClients,
+		clientsOnce:  &sync.Once{},
+		streamsMutex: &sync.Mutex{},
+		streams:      map[int]*logStream{},
+	}
+}
+
+func (cmd *CliRpcV2Cmd) GetProcesses(appGUID string, retVal *[]pluginv2.Process) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	processes, _, err := ccClient.GetApplicationProcesses(appGUID)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []pluginv2.Process{}
+	for _, process := range processes {
+		*retVal = append(*retVal, pluginv2.Process{
+			GUID:            process.GUID,
+			Type:            process.Type,
+			Command:         process.Command,
+			Instances:       process.Instances.Value,
+			MemoryInMB:      process.MemoryInMB.Value,
+			DiskInMB:        process.DiskInMB.Value,
+			HealthCheckType: process.HealthCheckType,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) GetDeployments(appGUID string, retVal *[]pluginv2.Deployment) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	deployments, _, err := ccClient.GetDeployments(ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}})
+	if err != nil {
+		return err
+	}
+
+	*retVal = []pluginv2.Deployment{}
+	for _, deployment := range deployments {
+		*retVal = append(*retVal, pluginv2.Deployment{
+			GUID:         deployment.GUID,
+			State:        string(deployment.State),
+			DropletGUID:  deployment.DropletGUID,
+			RevisionGUID: deployment.RevisionGUID,
+			CreatedAt:    deployment.CreatedAt,
+			UpdatedAt:    deployment.UpdatedAt,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) GetTasks(appGUID string, retVal *[]pluginv2.Task) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	tasks, _, err := ccClient.GetApplicationTasks(appGUID)
+	if err != nil {
+		return err
+	}
+
+	*retVal = []pluginv2.Task{}
+	for _, task := range tasks {
+		*retVal = append(*retVal, pluginv2.Task{
+			GUID:       task.GUID,
+			SequenceID: task.SequenceID,
+			Name:       task.Name,
+			Command:    task.Command,
+			State:      string(task.State),
+			MemoryInMB: task.MemoryInMB,
+			DiskInMB:   task.DiskInMB,
+			CreatedAt:  task.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) GetDroplets(appGUID string, retVal *[]pluginv2.Droplet) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	droplets, _, err := ccClient.GetDroplets(ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}})
+	if err != nil {
+		return err
+	}
+
+	*retVal = []pluginv2.Droplet{}
+	for _, droplet := range droplets {
+		var buildpacks []string
+		for _, buildpack := range droplet.Buildpacks {
+			buildpacks = append(buildpacks, buildpack.Name)
+		}
+
+		*retVal = append(*retVal, pluginv2.Droplet{
+			GUID:       droplet.GUID,
+			State:      string(droplet.State),
+			Stack:      droplet.Stack,
+			Image:      droplet.Image,
+			Buildpacks: buildpacks,
+			CreatedAt:  droplet.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) GetPackages(appGUID string, retVal *[]pluginv2.Package) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	packages, _, err := ccClient.GetPackages(ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{appGUID}})
+	if err != nil {
+		return err
+	}
+
+	*retVal = []pluginv2.Package{}
+	for _, pkg := range packages {
+		*retVal = append(*retVal, pluginv2.Package{
+			GUID:        pkg.GUID,
+			Type:        string(pkg.Type),
+			State:       string(pkg.State),
+			DockerImage: pkg.DockerImage,
+			CreatedAt:   pkg.CreatedAt,
+		})
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) CloudControllerRequest(request pluginv2.Request, retVal *pluginv2.Response) error {
+	ccClient, _, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	response, _, err := ccClient.MakeRawRequest(request.Method, request.Path, request.Body)
+	if err != nil {
+		return err
+	}
+
+	*retVal = pluginv2.Response{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Body:       response.Body,
+	}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) StartLogStream(appGUID string, streamID *int) error {
+	_, logStreamer, err := cmd.clients()
+	if err != nil {
+		return err
+	}
+
+	messages, errs, stop := logStreamer.StreamLogs(appGUID)
+	stream := newLogStream(messages, errs, stop)
+
+	cmd.streamsMutex.Lock()
+	defer cmd.streamsMutex.Unlock()
+	cmd.nextStreamID++
+	cmd.streams[cmd.nextStreamID] = stream
+	*streamID = cmd.nextStreamID
+	return nil
+}
+
+// NextLogMessages returns the messages received on the stream since the last
+// call. It waits up to logPollTimeout for at least one message.
+func (cmd *CliRpcV2Cmd) NextLogMessages(streamID int, retVal *pluginv2.LogBatch) error {
+	stream, err := cmd.stream(streamID)
+	if err != nil {
+		return err
+	}
+
+	messages, err := stream.next(logPollTimeout)
+	if err != nil {
+		cmd.removeStream(streamID)
+		stream.stop()
+		return err
+	}
+
+	*retVal = pluginv2.LogBatch{Messages: messages}
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) StopLogStream(streamID int, retVal *bool) error {
+	stream, err := cmd.stream(streamID)
+	if err != nil {
+		*retVal = false
+		return nil
+	}
+
+	cmd.removeStream(streamID)
+	stream.stop()
+	*retVal = true
+	return nil
+}
+
+func (cmd *CliRpcV2Cmd) clients() (CloudControllerV3Client, LogStreamer, error) {
+	cmd.clientsOnce.Do(func() {
+		cmd.ccClient, cmd.logStreamer, cmd.clientsErr = cmd.newClients()
+	})
+	return cmd.ccClient, cmd.logStreamer, cmd.clientsErr
+}
+
+func (cmd *CliRpcV2Cmd) stream(streamID int) (*logStream, error) {
+	cmd.streamsMutex.Lock()
+	defer cmd.streamsMutex.Unlock()
+
+	stream, ok := cmd.streams[streamID]
+	if !ok {
+		return nil, errors.New("unknown log stream")
+	}
+	return stream, nil
+}
+
+func (cmd *CliRpcV2Cmd) removeStream(streamID int) {
+	cmd.streamsMutex.Lock()
+	defer cmd.streamsMutex.Unlock()
+	delete(cmd.streams, streamID)
+}
+
+// logStream buffers the messages of a log stream between calls to
+// NextLogMessages.
+type logStream struct {
+	mutex    sync.Mutex
+	buffered []pluginv2.LogMessage
+	err      error
+	done     bool
+	notify   chan struct{}
+	stopOnce sync.Once
+	stopFunc func()
+}
+
+func newLogStream(messages <-chan *v7action.LogMessage, errs <-chan error, stop func()) *logStream {
+	stream := &logStream{
+		notify:   make(chan struct{}, 1),
+		stopFunc: stop,
+	}
+	go stream.receive(messages, errs)
+	return stream
+}
+
+// receive reads the stream until both channels are closed, so that the
+// sender is never blocked, even after the stream is stopped.
+func (stream *logStream) receive(messages <-chan *v7action.LogMessage, errs <-chan error) {
+	for messages != nil || errs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+			stream.update(func() {
+				stream.buffered = append(stream.buffered, pluginv2.LogMessage{
+					Message:        message.Message(),
+					Type:           message.Type(),
+					Timestamp:      message.Timestamp(),
+					SourceType:     message.SourceType(),
+					SourceInstance: message.SourceInstance(),
+				})
+			})
+		case err, ok := <-errs:
+			if !ok {
+				errs = nil
+				break
+			}
+			stream.update(func() {
+				if stream.err == nil {
+					stream.err = err
+				}
+			})
+		}
+	}
+
+	stream.update(func() {
+		stream.done = true
+	})
+}
+
+func (stream *logStream) update(f func()) {
+	stream.mutex.Lock()
+	f()
+	stream.mutex.Unlock()
+
+	select {
+	case stream.notify <- struct{}{}:
+	default:
+	}
+}
+
+func (stream *logStream) next(timeout time.Duration) ([]pluginv2.LogMessage, error) {
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		stream.mutex.Lock()
+		messages, err, done := stream.buffered, stream.err, stream.done
+		stream.buffered = nil
+		stream.mutex.Unlock()
+
+		if len(messages) > 0 {
+			return messages, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if done {
+			return nil, errors.New("log stream closed")
+		}
+
+		select {
+		case <-stream.notify:
+		case <-timer.C:
+			return nil, nil
+		}
+	}
+}
+
+func (stream *logStream) stop() {
+	stream.stopOnce.Do(stream.stopFunc)
+}
+
+// noaaLogStreamer streams logs with a new NOAA client for each stream.
+type noaaLogStreamer struct {
+	actor         *v7action.Actor
+	newNOAAClient func() v7action.NOAAClient
+}
+
+func (streamer noaaLogStreamer) StreamLogs(appGUID string) (<-chan *v7action.LogMessage, <-chan error, func()) {
+	client := streamer.newNOAAClient()
+	messages, errs := streamer.actor.GetStreamingLogs(appGUID, client)
+	return messages, errs, func() { _ = client.Close() }
+}
+
+// newV2Clients creates the clients for version 2 of the plugin RPC API from
+// the same configuration the V7 commands use.
+func newV2Clients() (CloudControllerV3Client, LogStreamer, error) {
+	config, err := configv3.LoadConfig()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	commandUI, err := ui.NewUI(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, commandUI, true, "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	logStreamer := noaaLogStreamer{
+		actor: v7action.NewActor(ccClient, config, nil, uaaClient),
+		newNOAAClient: func() v7action.NOAAClient {
+			return shared.NewNOAAClient(ccClient.Logging(), config, uaaClient, commandUI)
+		},
+	}
+	return ccClient, logStreamer, nil
+}
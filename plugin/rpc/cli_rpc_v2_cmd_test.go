@@ -0,0 +1,275 @@
+package rpc_test
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v7action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/constant"
+	"code.cloudfoundry.org/cli/plugin/pluginv2"
+	. "code.cloudfoundry.org/cli/plugin/rpc"
+	"code.cloudfoundry.org/cli/plugin/rpc/rpcfakes"
+	"code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CliRpcV2Cmd", func() {
+	var (
+		cmd             *CliRpcV2Cmd
+		fakeCCClient    *rpcfakes.FakeCloudControllerV3Client
+		fakeLogStreamer *rpcfakes.FakeLogStreamer
+		newClientsCalls int
+		newClientsErr   error
+	)
+
+	BeforeEach(func() {
+		fakeCCClient = new(rpcfakes.FakeCloudControllerV3Client)
+		fakeLogStreamer = new(rpcfakes.FakeLogStreamer)
+		newClientsCalls = 0
+		newClientsErr = nil
+
+		cmd = NewCliRpcV2Cmd(func() (CloudControllerV3Client, LogStreamer, error) {
+			newClientsCalls++
+			return fakeCCClient, fakeLogStreamer, newClientsErr
+		})
+	})
+
+	Describe("GetProcesses", func() {
+		BeforeEach(func() {
+			fakeCCClient.GetApplicationProcessesReturns([]ccv3.Process{
+				{
+					GUID:            "some-process-guid",
+					Type:            "web",
+					Command:         "some-command",
+					HealthCheckType: "port",
+					Instances:       types.NullInt{Value: 2, IsSet: true},
+					MemoryInMB:      types.NullUint64{Value: 256, IsSet: true},
+					DiskInMB:        types.NullUint64{Value: 1024, IsSet: true},
+				},
+			}, ccv3.Warnings{"some-warning"}, nil)
+		})
+
+		It("returns the app's processes and only creates the clients once", func() {
+			var processes []pluginv2.Process
+			Expect(cmd.GetProcesses("some-app-guid", &processes)).To(Succeed())
+			Expect(cmd.GetProcesses("some-app-guid", &processes)).To(Succeed())
+
+			Expect(processes).To(Equal([]pluginv2.Process{
+				{
+					GUID:            "some-process-guid",
+					Type:            "web",
+					Command:         "some-command",
+					Instances:       2,
+					MemoryInMB:      256,
+					DiskInMB:        1024,
+					HealthCheckType: "port",
+				},
+			}))
+			Expect(fakeCCClient.GetApplicationProcessesArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(newClientsCalls).To(Equal(1))
+		})
+
+		When("creating the clients fails", func() {
+			BeforeEach(func() {
+				newClientsErr = errors.New("some-config-error")
+			})
+
+			It("returns the error", func() {
+				var processes []pluginv2.Process
+				Expect(cmd.GetProcesses("some-app-guid", &processes)).To(MatchError("some-config-error"))
+				Expect(fakeCCClient.GetApplicationProcessesCallCount()).To(Equal(0))
+			})
+		})
+
+		When("getting the processes fails", func() {
+			BeforeEach(func() {
+				fakeCCClient.GetApplicationProcessesReturns(nil, nil, errors.New("some-process-error"))
+			})
+
+			It("returns the error", func() {
+				var processes []pluginv2.Process
+				Expect(cmd.GetProcesses("some-app-guid", &processes)).To(MatchError("some-process-error"))
+			})
+		})
+	})
+
+	Describe("GetDeployments", func() {
+		BeforeEach(func() {
+			fakeCCClient.GetDeploymentsReturns([]ccv3.Deployment{
+				{GUID: "some-deployment-guid", State: constant.DeploymentDeploying, DropletGUID: "some-droplet-guid", CreatedAt: "some-time"},
+			}, nil, nil)
+		})
+
+		It("returns the app's deployments", func() {
+			var deployments []pluginv2.Deployment
+			Expect(cmd.GetDeployments("some-app-guid", &deployments)).To(Succeed())
+
+			Expect(deployments).To(Equal([]pluginv2.Deployment{
+				{GUID: "some-deployment-guid", State: "DEPLOYING", DropletGUID: "some-droplet-guid", CreatedAt: "some-time"},
+			}))
+			Expect(fakeCCClient.GetDeploymentsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+			))
+		})
+	})
+
+	Describe("GetTasks", func() {
+		BeforeEach(func() {
+			fakeCCClient.GetApplicationTasksReturns([]ccv3.Task{
+				{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", Command: "some-command", State: constant.TaskRunning},
+			}, nil, nil)
+		})
+
+		It("returns the app's tasks", func() {
+			var tasks []pluginv2.Task
+			Expect(cmd.GetTasks("some-app-guid", &tasks)).To(Succeed())
+
+			Expect(tasks).To(Equal([]pluginv2.Task{
+				{GUID: "some-task-guid", SequenceID: 3, Name: "some-task", Command: "some-command", State: "RUNNING"},
+			}))
+			appGUID, _ := fakeCCClient.GetApplicationTasksArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+		})
+	})
+
+	Describe("GetDroplets", func() {
+		BeforeEach(func() {
+			fakeCCClient.GetDropletsReturns([]ccv3.Droplet{
+				{
+					GUID:       "some-droplet-guid",
+					State:      constant.DropletStaged,
+					Stack:      "some-stack",
+					Buildpacks: []ccv3.DropletBuildpack{{Name: "some-buildpack"}},
+				},
+			}, nil, nil)
+		})
+
+		It("returns the app's droplets", func() {
+			var droplets []pluginv2.Droplet
+			Expect(cmd.GetDroplets("some-app-guid", &droplets)).To(Succeed())
+
+			Expect(droplets).To(Equal([]pluginv2.Droplet{
+				{GUID: "some-droplet-guid", State: "STAGED", Stack: "some-stack", Buildpacks: []string{"some-buildpack"}},
+			}))
+			Expect(fakeCCClient.GetDropletsArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+			))
+		})
+	})
+
+	Describe("GetPackages", func() {
+		BeforeEach(func() {
+			fakeCCClient.GetPackagesReturns([]ccv3.Package{
+				{GUID: "some-package-guid", Type: constant.PackageTypeBits, State: constant.PackageReady},
+			}, nil, nil)
+		})
+
+		It("returns the app's packages", func() {
+			var packages []pluginv2.Package
+			Expect(cmd.GetPackages("some-app-guid", &packages)).To(Succeed())
+
+			Expect(packages).To(Equal([]pluginv2.Package{
+				{GUID: "some-package-guid", Type: "bits", State: "READY"},
+			}))
+			Expect(fakeCCClient.GetPackagesArgsForCall(0)).To(ConsistOf(
+				ccv3.Query{Key: ccv3.AppGUIDFilter, Values: []string{"some-app-guid"}},
+			))
+		})
+	})
+
+	Describe("CloudControllerRequest", func() {
+		BeforeEach(func() {
+			fakeCCClient.MakeRawRequestReturns(ccv3.RawResponse{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"Some-Header": {"some-value"}},
+				Body:       []byte("some-body"),
+			}, nil, nil)
+		})
+
+		It("makes the request and returns the response", func() {
+			var response pluginv2.Response
+			Expect(cmd.CloudControllerRequest(pluginv2.Request{
+				Method: http.MethodPatch,
+				Path:   "/v3/apps/some-app-guid",
+				Body:   []byte("some-request-body"),
+			}, &response)).To(Succeed())
+
+			method, path, body := fakeCCClient.MakeRawRequestArgsForCall(0)
+			Expect(method).To(Equal(http.MethodPatch))
+			Expect(path).To(Equal("/v3/apps/some-app-guid"))
+			Expect(body).To(Equal([]byte("some-request-body")))
+
+			Expect(response).To(Equal(pluginv2.Response{
+				StatusCode: http.StatusNotFound,
+				Header:     http.Header{"Some-Header": {"some-value"}},
+				Body:       []byte("some-body"),
+			}))
+		})
+	})
+
+	Describe("log streams", func() {
+		var (
+			messages  chan *v7action.LogMessage
+			errs      chan error
+			stopCalls int
+			streamID  int
+		)
+
+		BeforeEach(func() {
+			messages = make(chan *v7action.LogMessage)
+			errs = make(chan error)
+			stopCalls = 0
+			fakeLogStreamer.StreamLogsReturns(messages, errs, func() {
+				stopCalls++
+				close(messages)
+				close(errs)
+			})
+
+			Expect(cmd.StartLogStream("some-app-guid", &streamID)).To(Succeed())
+			Expect(fakeLogStreamer.StreamLogsArgsForCall(0)).To(Equal("some-app-guid"))
+		})
+
+		It("returns the messages received since the last call", func() {
+			timestamp := time.Unix(1000, 0)
+			messages <- v7action.NewLogMessage("message-1", 1, timestamp, "APP/PROC/WEB", "0")
+			messages <- v7action.NewLogMessage("message-2", 2, timestamp, "STG", "1")
+
+			var batch pluginv2.LogBatch
+			Eventually(func() []pluginv2.LogMessage {
+				var next pluginv2.LogBatch
+				Expect(cmd.NextLogMessages(streamID, &next)).To(Succeed())
+				batch.Messages = append(batch.Messages, next.Messages...)
+				return batch.Messages
+			}).Should(HaveLen(2))
+
+			Expect(batch.Messages).To(Equal([]pluginv2.LogMessage{
+				{Message: "message-1", Type: "OUT", Timestamp: timestamp, SourceType: "APP/PROC/WEB", SourceInstance: "0"},
+				{Message: "message-2", Type: "ERR", Timestamp: timestamp, SourceType: "STG", SourceInstance: "1"},
+			}))
+
+			var stopped bool
+			Expect(cmd.StopLogStream(streamID, &stopped)).To(Succeed())
+			Expect(stopped).To(BeTrue())
+			Expect(stopCalls).To(Equal(1))
+
+			Expect(cmd.NextLogMessages(streamID, &batch)).To(MatchError("unknown log stream"))
+		})
+
+		When("the stream fails", func() {
+			It("returns the error and stops the stream", func() {
+				errs <- errors.New("some-stream-error")
+
+				var batch pluginv2.LogBatch
+				Expect(cmd.NextLogMessages(streamID, &batch)).To(MatchError("some-stream-error"))
+				Expect(stopCalls).To(Equal(1))
+
+				var stopped bool
+				Expect(cmd.StopLogStream(streamID, &stopped)).To(Succeed())
+				Expect(stopped).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullString", func() {
+	var nullString NullString
+
+	BeforeEach(func() {
+		nullString = NullString{}
+	})
+
+	Describe("NewNullString", func() {
+		It("returns a set NullString", func() {
+			Expect(NewNullString("")).To(Equal(NullString{Value: "", IsSet: true}))
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		When("a string value is provided", func() {
+			It("parses the JSON string correctly", func() {
+				err := nullString.UnmarshalJSON([]byte(`"some-value"`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullString).To(Equal(NullString{Value: "some-value", IsSet: true}))
+			})
+		})
+
+		When("null is provided", func() {
+			It("returns an unset NullString", func() {
+				err := nullString.UnmarshalJSON([]byte("null"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(nullString).To(Equal(NullString{Value: "", IsSet: false}))
+			})
+		})
+	})
+
+	DescribeTable("MarshalJSON",
+		func(nullString NullString, expectedBytes []byte) {
+			bytes, err := nullString.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(expectedBytes))
+		},
+		Entry("a value when set", NullString{IsSet: true, Value: "some-value"}, []byte(`"some-value"`)),
+		Entry("an empty string when set", NullString{IsSet: true}, []byte(`""`)),
+		Entry("no value", NullString{IsSet: false}, []byte("null")),
+	)
+})
@@ -0,0 +1,144 @@
+// Package socks implements the server side of the SOCKS5 protocol (RFC 1928)
+// for dynamic port forwarding over SSH. Only the CONNECT command without
+// authentication is supported, which is all that SOCKS clients such as
+// browsers and curl need.
+package socks
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	version5 = 0x05
+
+	methodNoAuth       = 0x00
+	methodNoAcceptable = 0xff
+
+	commandConnect = 0x01
+
+	addressTypeIPv4   = 0x01
+	addressTypeDomain = 0x03
+	addressTypeIPv6   = 0x04
+
+	replySucceeded          = 0x00
+	replyGeneralFailure     = 0x01
+	replyCommandUnsupported = 0x07
+	replyAddressUnsupported = 0x08
+)
+
+// DialFunc opens a connection to address on behalf of a SOCKS client.
+type DialFunc func(network string, address string) (net.Conn, error)
+
+// handshake reads a SOCKS5 CONNECT request from conn and returns the
+// requested target address. It does not reply to the request; call reply
+// once the outcome of connecting to the target is known.
+func handshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return "", err
+	}
+	if header[0] != version5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", header[0])
+	}
+
+	methods := make([]byte, header[1])
+	if _, err := io.ReadFull(conn, methods); err != nil {
+		return "", err
+	}
+
+	selected := byte(methodNoAcceptable)
+	for _, method := range methods {
+		if method == methodNoAuth {
+			selected = methodNoAuth
+			break
+		}
+	}
+	if _, err := conn.Write([]byte{version5, selected}); err != nil {
+		return "", err
+	}
+	if selected == methodNoAcceptable {
+		return "", errors.New("SOCKS client does not support unauthenticated connections")
+	}
+
+	request := make([]byte, 4)
+	if _, err := io.ReadFull(conn, request); err != nil {
+		return "", err
+	}
+	if request[0] != version5 {
+		return "", fmt.Errorf("unsupported SOCKS version %d", request[0])
+	}
+
+	var host string
+	switch request[3] {
+	case addressTypeIPv4, addressTypeIPv6:
+		size := net.IPv4len
+		if request[3] == addressTypeIPv6 {
+			size = net.IPv6len
+		}
+		ip := make([]byte, size)
+		if _, err := io.ReadFull(conn, ip); err != nil {
+			return "", err
+		}
+		host = net.IP(ip).String()
+	case addressTypeDomain:
+		length := make([]byte, 1)
+		if _, err := io.ReadFull(conn, length); err != nil {
+			return "", err
+		}
+		domain := make([]byte, length[0])
+		if _, err := io.ReadFull(conn, domain); err != nil {
+			return "", err
+		}
+		host = string(domain)
+	default:
+		_ = reply(conn, replyAddressUnsupported)
+		return "", fmt.Errorf("unsupported SOCKS address type %d", request[3])
+	}
+
+	port := make([]byte, 2)
+	if _, err := io.ReadFull(conn, port); err != nil {
+		return "", err
+	}
+
+	if request[1] != commandConnect {
+		_ = reply(conn, replyCommandUnsupported)
+		return "", fmt.Errorf("unsupported SOCKS command %d", request[1])
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+// reply sends a SOCKS5 reply with the given status to the client. The bound
+// address is always reported as 0.0.0.0:0, since the real address is on the
+// far side of the SSH connection.
+func reply(conn io.Writer, status byte) error {
+	_, err := conn.Write([]byte{version5, status, 0x00, addressTypeIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+// Connect performs the SOCKS5 handshake on conn and connects to the requested
+// target using dial. On success the returned connection is ready to have
+// data copied to and from conn.
+func Connect(conn io.ReadWriter, dial DialFunc) (net.Conn, error) {
+	address, err := handshake(conn)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := dial("tcp", address)
+	if err != nil {
+		_ = reply(conn, replyGeneralFailure)
+		return nil, fmt.Errorf("connect to %s failed: %s", address, err)
+	}
+
+	if err := reply(conn, replySucceeded); err != nil {
+		target.Close()
+		return nil, err
+	}
+	return target, nil
+}
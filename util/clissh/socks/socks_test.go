@@ -0,0 +1,146 @@
+package socks_test
+
+import (
+	"bytes"
+	"errors"
+	"net"
+
+	. "code.cloudfoundry.org/cli/util/clissh/socks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeClientConn replays a SOCKS client's request and records the server's
+// replies.
+type fakeClientConn struct {
+	request *bytes.Buffer
+	replies *bytes.Buffer
+}
+
+func (conn fakeClientConn) Read(p []byte) (int, error)  { return conn.request.Read(p) }
+func (conn fakeClientConn) Write(p []byte) (int, error) { return conn.replies.Write(p) }
+
+var _ = Describe("Connect", func() {
+	var (
+		conn       fakeClientConn
+		target     net.Conn
+		dialedAddr string
+		dialErr    error
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		conn = fakeClientConn{request: new(bytes.Buffer), replies: new(bytes.Buffer)}
+		dialedAddr = ""
+		dialErr = nil
+	})
+
+	JustBeforeEach(func() {
+		target, executeErr = Connect(conn, func(network string, address string) (net.Conn, error) {
+			Expect(network).To(Equal("tcp"))
+			dialedAddr = address
+			if dialErr != nil {
+				return nil, dialErr
+			}
+			client, _ := net.Pipe()
+			return client, nil
+		})
+	})
+
+	When("the client requests a domain name", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x05, 0x01, 0x00})
+			conn.request.Write([]byte{0x05, 0x01, 0x00, 0x03, 0x0b})
+			conn.request.WriteString("example.com")
+			conn.request.Write([]byte{0x1f, 0x90})
+		})
+
+		It("dials the target and replies with success", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(target).ToNot(BeNil())
+			Expect(dialedAddr).To(Equal("example.com:8080"))
+			Expect(conn.replies.Bytes()).To(Equal([]byte{
+				0x05, 0x00,
+				0x05, 0x00, 0x00, 0x01, 0, 0, 0, 0, 0, 0,
+			}))
+		})
+
+		When("dialing the target fails", func() {
+			BeforeEach(func() {
+				dialErr = errors.New("no route to host")
+			})
+
+			It("replies with a failure and returns the error", func() {
+				Expect(executeErr).To(MatchError("connect to example.com:8080 failed: no route to host"))
+				Expect(conn.replies.Bytes()).To(Equal([]byte{
+					0x05, 0x00,
+					0x05, 0x01, 0x00, 0x01, 0, 0, 0, 0, 0, 0,
+				}))
+			})
+		})
+	})
+
+	When("the client requests an IPv4 address", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x05, 0x01, 0x00})
+			conn.request.Write([]byte{0x05, 0x01, 0x00, 0x01, 10, 0, 0, 1, 0x00, 0x50})
+		})
+
+		It("dials the address", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(dialedAddr).To(Equal("10.0.0.1:80"))
+		})
+	})
+
+	When("the client requests an IPv6 address", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x05, 0x01, 0x00})
+			conn.request.Write([]byte{0x05, 0x01, 0x00, 0x04})
+			conn.request.Write(net.ParseIP("fd00::1"))
+			conn.request.Write([]byte{0x00, 0x50})
+		})
+
+		It("dials the address", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(dialedAddr).To(Equal("[fd00::1]:80"))
+		})
+	})
+
+	When("the client only supports authenticated connections", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x05, 0x01, 0x02})
+		})
+
+		It("rejects the client", func() {
+			Expect(executeErr).To(MatchError("SOCKS client does not support unauthenticated connections"))
+			Expect(conn.replies.Bytes()).To(Equal([]byte{0x05, 0xff}))
+			Expect(dialedAddr).To(BeEmpty())
+		})
+	})
+
+	When("the client requests a command other than CONNECT", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x05, 0x01, 0x00})
+			conn.request.Write([]byte{0x05, 0x02, 0x00, 0x01, 10, 0, 0, 1, 0x00, 0x50})
+		})
+
+		It("replies that the command is not supported", func() {
+			Expect(executeErr).To(MatchError("unsupported SOCKS command 2"))
+			Expect(conn.replies.Bytes()).To(Equal([]byte{
+				0x05, 0x00,
+				0x05, 0x07, 0x00, 0x01, 0, 0, 0, 0, 0, 0,
+			}))
+			Expect(dialedAddr).To(BeEmpty())
+		})
+	})
+
+	When("the client speaks a different SOCKS version", func() {
+		BeforeEach(func() {
+			conn.request.Write([]byte{0x04, 0x01, 0x00, 0x50})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError("unsupported SOCKS version 4"))
+		})
+	})
+})
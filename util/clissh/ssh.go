@@ -1,6 +1,7 @@
 package clissh
 
 import (
+	"bytes"
 	"crypto/md5"
 	"crypto/sha1"
 	"crypto/sha256"
@@ -18,6 +19,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/cli/util/clissh/sigwinch"
+	"code.cloudfoundry.org/cli/util/clissh/socks"
 	"code.cloudfoundry.org/cli/util/clissh/ssherror"
 	"github.com/moby/moby/pkg/term"
 	"golang.org/x/crypto/ssh"
@@ -45,6 +47,13 @@ type LocalPortForward struct {
 	RemoteAddress string
 }
 
+// RemotePortForward forwards connections made to RemoteAddress, on the app
+// instance, to LocalAddress on this machine.
+type RemotePortForward struct {
+	RemoteAddress string
+	LocalAddress  string
+}
+
 //go:generate counterfeiter . SecureDialer
 
 type SecureDialer interface {
@@ -57,6 +66,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -98,6 +108,7 @@ type SecureShell struct {
 	listenerFactory ListenerFactory
 
 	localListeners    []net.Listener
+	remoteListeners   []net.Listener
 	keepAliveInterval time.Duration
 }
 
@@ -154,6 +165,9 @@ func (c *SecureShell) Close() error {
 	for _, listener := range c.localListeners {
 		listener.Close()
 	}
+	for _, listener := range c.remoteListeners {
+		listener.Close()
+	}
 	return c.secureClient.Close()
 }
 
@@ -171,7 +185,50 @@ func (c *SecureShell) LocalPortForward(localPortForwardSpecs []LocalPortForward)
 	return nil
 }
 
+// RemotePortForward asks the SSH server to listen on each spec's
+// RemoteAddress and forwards the connections it accepts to the spec's
+// LocalAddress.
+func (c *SecureShell) RemotePortForward(remotePortForwardSpecs []RemotePortForward) error {
+	for _, spec := range remotePortForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", spec.RemoteAddress)
+		if err != nil {
+			return fmt.Errorf("remote port forwarding of %s failed: %s", spec.RemoteAddress, err.Error())
+		}
+		c.remoteListeners = append(c.remoteListeners, listener)
+
+		localAddress := spec.LocalAddress
+		go acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, localAddress)
+		})
+	}
+
+	return nil
+}
+
+// DynamicPortForward listens on each of the local addresses for SOCKS5
+// clients and connects them to the targets they request through the SSH
+// server.
+func (c *SecureShell) DynamicPortForward(localAddresses []string) error {
+	for _, address := range localAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.localListeners = append(c.localListeners, listener)
+
+		go acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
 func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+	acceptLoop(listener, func(conn net.Conn) {
+		c.handleForwardConnection(conn, addr)
+	})
+}
+
+func acceptLoop(listener net.Listener, handle func(conn net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -184,7 +241,7 @@ func (c *SecureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handle(conn)
 	}
 }
 
@@ -198,6 +255,36 @@ func (c *SecureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
+	copyBothWays(conn, target)
+}
+
+func (c *SecureShell) handleRemoteForwardConnection(conn net.Conn, localAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", localAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", localAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
+func (c *SecureShell) handleDynamicForwardConnection(conn net.Conn) {
+	defer conn.Close()
+
+	target, err := socks.Connect(conn, c.secureClient.Dial)
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	defer target.Close()
+
+	copyBothWays(conn, target)
+}
+
+func copyBothWays(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -318,6 +405,84 @@ func (c *SecureShell) InteractiveSession(commands []string, terminalRequest TTYR
 	return result
 }
 
+// RunCommand runs command on the app instance without a terminal. stdin, if
+// not nil, is streamed to the command and its output is written to stdout and
+// stderr. If stderr is nil and the command fails, anything it wrote to stderr
+// is returned as the error instead.
+func (c *SecureShell) RunCommand(command string, stdin io.Reader, stdout io.Writer, stderr io.Writer) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	if stdin != nil {
+		go copyAndClose(nil, inPipe, stdin)
+	} else {
+		_ = inPipe.Close()
+	}
+
+	var (
+		stdoutErr      error
+		capturedStderr bytes.Buffer
+	)
+	if stderr == nil {
+		stderr = &capturedStderr
+	}
+
+	wg := &sync.WaitGroup{}
+	wg.Add(2)
+
+	go func() {
+		defer wg.Done()
+		_, stdoutErr = io.Copy(stdout, outPipe)
+		if stdoutErr != nil {
+			// Nothing is reading the output anymore, so stop the command
+			// rather than letting it block on a full channel.
+			_ = session.Close()
+		}
+	}()
+	go copyAndDone(wg, stderr, errPipe)
+
+	keepaliveStopCh := make(chan struct{})
+	defer close(keepaliveStopCh)
+
+	go keepalive(c.secureClient.Conn(), time.NewTicker(c.keepAliveInterval), keepaliveStopCh)
+
+	result := session.Wait()
+	wg.Wait()
+
+	if stdoutErr != nil {
+		return stdoutErr
+	}
+	if result != nil {
+		if message := strings.TrimSpace(capturedStderr.String()); message != "" {
+			return errors.New(message)
+		}
+	}
+	return result
+}
+
 func (c *SecureShell) Wait() error {
 	keepaliveStopCh := make(chan struct{})
 	defer close(keepaliveStopCh)
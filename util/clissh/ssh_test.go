@@ -7,11 +7,13 @@
 package clissh_test
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
 	"io"
 	"net"
 	"os"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -30,7 +32,9 @@ import (
 	"github.com/moby/moby/pkg/term"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 	"golang.org/x/crypto/ssh"
+	"golang.org/x/net/proxy"
 )
 
 func BlockAcceptOnClose(fake *fake_net.FakeListener) {
@@ -185,6 +189,9 @@ var _ = Describe("CLI SSH", func() {
 
 		BeforeEach(func() {
 			stdin = new(fake_io.FakeReadCloser)
+			stdin.ReadStub = func(p []byte) (int, error) {
+				return 0, io.EOF
+			}
 			stdout = new(fake_io.FakeWriter)
 			stderr = new(fake_io.FakeWriter)
 
@@ -1147,6 +1154,317 @@ var _ = Describe("CLI SSH", func() {
 		})
 	})
 
+	Describe("RunCommand", func() {
+		var (
+			stdin  io.Reader
+			stdout io.Writer
+			stderr io.Writer
+			runErr error
+
+			inBuffer  *gbytes.Buffer
+			outBuffer *bytes.Buffer
+		)
+
+		BeforeEach(func() {
+			outBuffer = new(bytes.Buffer)
+			stdin = nil
+			stdout = outBuffer
+			stderr = nil
+
+			inBuffer = gbytes.NewBuffer()
+			fakeSecureSession.StdinPipeReturns(inBuffer, nil)
+			fakeSecureSession.StdoutPipeReturns(strings.NewReader("some-output"), nil)
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			runErr = secureShell.RunCommand("some-command", stdin, stdout, stderr)
+		})
+
+		It("runs the command without a terminal and writes its output to stdout", func() {
+			Expect(runErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureSession.StartCallCount()).To(Equal(1))
+			Expect(fakeSecureSession.StartArgsForCall(0)).To(Equal("some-command"))
+			Expect(fakeSecureSession.RequestPtyCallCount()).To(Equal(0))
+			Expect(fakeSecureSession.WaitCallCount()).To(Equal(1))
+
+			Expect(outBuffer.String()).To(Equal("some-output"))
+			Expect(inBuffer.Closed()).To(BeTrue())
+		})
+
+		When("stdin is provided", func() {
+			BeforeEach(func() {
+				stdin = strings.NewReader("some-input")
+			})
+
+			It("streams it to the command", func() {
+				Expect(runErr).NotTo(HaveOccurred())
+				Eventually(inBuffer).Should(gbytes.Say("some-input"))
+				Eventually(inBuffer.Closed).Should(BeTrue())
+			})
+		})
+
+		When("the command fails", func() {
+			BeforeEach(func() {
+				fakeSecureSession.WaitReturns(errors.New("Process exited with status 2"))
+			})
+
+			When("it writes to stderr", func() {
+				BeforeEach(func() {
+					fakeSecureSession.StderrPipeReturns(strings.NewReader("tar: some-file: Cannot open\n"), nil)
+				})
+
+				It("returns what it wrote as the error", func() {
+					Expect(runErr).To(MatchError("tar: some-file: Cannot open"))
+				})
+			})
+
+			When("stderr is provided", func() {
+				var errBuffer *bytes.Buffer
+
+				BeforeEach(func() {
+					errBuffer = new(bytes.Buffer)
+					stderr = errBuffer
+					fakeSecureSession.StderrPipeReturns(strings.NewReader("tar: some-file: Cannot open\n"), nil)
+				})
+
+				It("writes to it and returns the session error", func() {
+					Expect(runErr).To(MatchError("Process exited with status 2"))
+					Expect(errBuffer.String()).To(Equal("tar: some-file: Cannot open\n"))
+				})
+			})
+
+			When("it does not write to stderr", func() {
+				It("returns the session error", func() {
+					Expect(runErr).To(MatchError("Process exited with status 2"))
+				})
+			})
+		})
+
+		When("writing the output fails", func() {
+			BeforeEach(func() {
+				failingWriter := new(fake_io.FakeWriteCloser)
+				failingWriter.WriteReturns(0, errors.New("some-write-error"))
+				stdout = failingWriter
+
+				fakeSecureSession.WaitReturns(errors.New("wait: remote command exited without exit status"))
+			})
+
+			It("closes the session and returns the write error", func() {
+				Expect(runErr).To(MatchError("some-write-error"))
+				Expect(fakeSecureSession.CloseCallCount()).To(Equal(2))
+			})
+		})
+
+		When("allocating the session fails", func() {
+			BeforeEach(func() {
+				fakeSecureClient.NewSessionReturns(nil, errors.New("some-session-error"))
+			})
+
+			It("returns the error", func() {
+				Expect(runErr).To(MatchError("SSH session allocation failed: some-session-error"))
+			})
+		})
+	})
+
+	Describe("RemotePortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+
+			forwardSpecs []RemotePortForward
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func() {
+				for {
+					conn, acceptErr := echoListener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			forwardSpecs = []RemotePortForward{{
+				RemoteAddress: "localhost:9000",
+				LocalAddress:  echoListener.Addr().String(),
+			}}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.RemotePortForward(forwardSpecs)
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("asks the server to listen on the remote address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9000"))
+		})
+
+		It("copies data between remote connections and the local address", func() {
+			conn, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			msg := "Hello from the app instance\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		When("the server refuses to listen", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("remote port forwarding of localhost:9000 failed: tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			forwardErr error
+
+			echoListener  net.Listener
+			localListener net.Listener
+
+			connsMutex sync.Mutex
+			conns      []net.Conn
+		)
+
+		trackConn := func(conn net.Conn) {
+			connsMutex.Lock()
+			defer connsMutex.Unlock()
+			conns = append(conns, conn)
+		}
+
+		BeforeEach(func() {
+			conns = nil
+
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			go func(listener net.Listener) {
+				for {
+					conn, acceptErr := listener.Accept()
+					if acceptErr != nil {
+						return
+					}
+					trackConn(conn)
+					go io.Copy(conn, conn)
+				}
+			}(echoListener)
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = func(network string, address string) (net.Conn, error) {
+				conn, dialErr := net.Dial(network, address)
+				if dialErr == nil {
+					trackConn(conn)
+				}
+				return conn, dialErr
+			}
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(username, passcode, sshEndpoint, sshEndpointFingerprint, skipHostValidation)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			forwardErr = secureShell.DynamicPortForward([]string{"localhost:1080"})
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			localListener.Close()
+			echoListener.Close()
+
+			connsMutex.Lock()
+			defer connsMutex.Unlock()
+			for _, conn := range conns {
+				conn.Close()
+			}
+		})
+
+		It("listens on the local address", func() {
+			Expect(forwardErr).NotTo(HaveOccurred())
+
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("connects SOCKS clients to the requested target through the server", func() {
+			dialer, err := proxy.SOCKS5("tcp", localListener.Addr().String(), nil, proxy.Direct)
+			Expect(err).NotTo(HaveOccurred())
+
+			conn, err := dialer.Dial("tcp", echoListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			trackConn(conn)
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			msg := "Hello through the proxy\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		When("listen fails", func() {
+			BeforeEach(func() {
+				localListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("failure is an option"))
+			})
+
+			It("returns the error", func() {
+				Expect(forwardErr).To(MatchError("failure is an option"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var waitErr error
 
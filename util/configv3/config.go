@@ -24,6 +24,15 @@ type Config struct {
 	detectedSettings detectedSettings
 
 	pluginsConfig PluginsConfig
+
+	// contextOverride is set when the --context flag selects a context other
+	// than the current one.
+	contextOverride *contextOverride
+
+	// storedCredentials are the credentials known to be in the credential
+	// store, by credential key. They are used to skip writing credentials that
+	// did not change.
+	storedCredentials map[string]Credentials
 }
 
 // BinaryVersion is the current version of the CF binary.
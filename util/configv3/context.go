@@ -0,0 +1,215 @@
+package configv3
+
+import "sort"
+
+// DefaultContextName is the name given to the settings that were in use
+// before any context was named.
+const DefaultContextName = "default"
+
+// TargetContext is a named set of target, authentication and org/space
+// settings. The current context is stored in the top level of the config
+// file; the others are stored in JSONConfig.Contexts.
+type TargetContext struct {
+	Target                   string       `json:"Target"`
+	APIVersion               string       `json:"APIVersion"`
+	AuthorizationEndpoint    string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string       `json:"DopplerEndPoint"`
+	UAAEndpoint              string       `json:"UaaEndpoint"`
+	RoutingEndpoint          string       `json:"RoutingAPIEndpoint"`
+	AccessToken              string       `json:"AccessToken,omitempty"`
+	SSHOAuthClient           string       `json:"SSHOAuthClient"`
+	UAAOAuthClient           string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string       `json:"UAAOAuthClientSecret,omitempty"`
+	UAAGrantType             string       `json:"UAAGrantType"`
+	RefreshToken             string       `json:"RefreshToken,omitempty"`
+	TargetedOrganization     Organization `json:"OrganizationFields"`
+	TargetedSpace            Space        `json:"SpaceFields"`
+	SkipSSLValidation        bool         `json:"SSLDisabled"`
+	MinCLIVersion            string       `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string       `json:"MinRecommendedCLIVersion"`
+}
+
+// contextOverride records the context that is current in the config file
+// while a different one is selected with the --context flag.
+type contextOverride struct {
+	previous string
+}
+
+// CredentialKey returns the key under which a credential store keeps the
+// credentials of the named context. Credentials outside of a named context
+// are keyed by their target.
+func CredentialKey(contextName string, target string) string {
+	if contextName == "" {
+		return target
+	}
+	return "context:" + contextName
+}
+
+// Contexts returns all contexts by name, including the current one.
+func (config *Config) Contexts() map[string]TargetContext {
+	contexts := map[string]TargetContext{}
+	for name, context := range config.ConfigFile.Contexts {
+		contexts[name] = context
+	}
+	if config.ConfigFile.CurrentContext != "" {
+		contexts[config.ConfigFile.CurrentContext] = config.ConfigFile.currentContext()
+	}
+	return contexts
+}
+
+// ContextNames returns the names of all contexts in alphabetical order.
+func (config *Config) ContextNames() []string {
+	var names []string
+	for name := range config.Contexts() {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// CurrentContextName returns the name of the current context, or the empty
+// string when no context has been named.
+func (config *Config) CurrentContextName() string {
+	return config.ConfigFile.CurrentContext
+}
+
+// UseContext makes the named context current, creating an empty one if it
+// does not exist. The settings that were current are kept in their own
+// context, or in the "default" context if they were not named yet. It
+// returns true if the context was created.
+func (config *Config) UseContext(name string) (bool, error) {
+	config.contextOverride = nil
+	if name == config.ConfigFile.CurrentContext {
+		return false, nil
+	}
+
+	_, exists := config.ConfigFile.Contexts[name]
+	config.ConfigFile.switchContext(name)
+
+	return !exists, config.loadCredentials()
+}
+
+// RenameContext renames a context. Credentials kept in a credential store
+// are moved to the new name.
+func (config *Config) RenameContext(oldName string, newName string) error {
+	isCurrent := oldName == config.ConfigFile.CurrentContext
+	if isCurrent {
+		config.ConfigFile.CurrentContext = newName
+	} else {
+		config.ConfigFile.Contexts[newName] = config.ConfigFile.Contexts[oldName]
+		delete(config.ConfigFile.Contexts, oldName)
+	}
+
+	if config.contextOverride != nil && config.contextOverride.previous == oldName {
+		config.contextOverride.previous = newName
+	}
+
+	store := config.CredentialStore()
+	if store == nil {
+		return nil
+	}
+
+	// The credentials of the current context are in memory and are stored
+	// under the new name when the config is written.
+	if !isCurrent {
+		credentials, err := store.Get(CredentialKey(oldName, ""))
+		if err != nil {
+			return err
+		}
+		if !credentials.IsEmpty() {
+			err = store.Store(CredentialKey(newName, ""), credentials)
+			if err != nil {
+				return err
+			}
+			config.rememberStoredCredentials(CredentialKey(newName, ""), credentials)
+		}
+	}
+
+	err := store.Erase(CredentialKey(oldName, ""))
+	if err != nil {
+		return err
+	}
+	config.rememberStoredCredentials(CredentialKey(oldName, ""), Credentials{})
+	return nil
+}
+
+// currentContext returns the settings stored in the top level of the config
+// file.
+func (configFile *JSONConfig) currentContext() TargetContext {
+	return TargetContext{
+		Target:                   configFile.Target,
+		APIVersion:               configFile.APIVersion,
+		AuthorizationEndpoint:    configFile.AuthorizationEndpoint,
+		DopplerEndpoint:          configFile.DopplerEndpoint,
+		UAAEndpoint:              configFile.UAAEndpoint,
+		RoutingEndpoint:          configFile.RoutingEndpoint,
+		AccessToken:              configFile.AccessToken,
+		SSHOAuthClient:           configFile.SSHOAuthClient,
+		UAAOAuthClient:           configFile.UAAOAuthClient,
+		UAAOAuthClientSecret:     configFile.UAAOAuthClientSecret,
+		UAAGrantType:             configFile.UAAGrantType,
+		RefreshToken:             configFile.RefreshToken,
+		TargetedOrganization:     configFile.TargetedOrganization,
+		TargetedSpace:            configFile.TargetedSpace,
+		SkipSSLValidation:        configFile.SkipSSLValidation,
+		MinCLIVersion:            configFile.MinCLIVersion,
+		MinRecommendedCLIVersion: configFile.MinRecommendedCLIVersion,
+	}
+}
+
+// setCurrentContext stores context in the top level of the config file.
+func (configFile *JSONConfig) setCurrentContext(context TargetContext) {
+	configFile.Target = context.Target
+	configFile.APIVersion = context.APIVersion
+	configFile.AuthorizationEndpoint = context.AuthorizationEndpoint
+	configFile.DopplerEndpoint = context.DopplerEndpoint
+	configFile.UAAEndpoint = context.UAAEndpoint
+	configFile.RoutingEndpoint = context.RoutingEndpoint
+	configFile.AccessToken = context.AccessToken
+	configFile.SSHOAuthClient = context.SSHOAuthClient
+	configFile.UAAOAuthClient = context.UAAOAuthClient
+	configFile.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	configFile.UAAGrantType = context.UAAGrantType
+	configFile.RefreshToken = context.RefreshToken
+	configFile.TargetedOrganization = context.TargetedOrganization
+	configFile.TargetedSpace = context.TargetedSpace
+	configFile.SkipSSLValidation = context.SkipSSLValidation
+	configFile.MinCLIVersion = context.MinCLIVersion
+	configFile.MinRecommendedCLIVersion = context.MinRecommendedCLIVersion
+}
+
+// switchContext saves the current settings in Contexts and makes the named
+// context current, creating it if needed. It returns the name the previous
+// settings were saved under, which is empty if they were neither named nor
+// targeted.
+func (configFile *JSONConfig) switchContext(name string) string {
+	contexts := map[string]TargetContext{}
+	for contextName, context := range configFile.Contexts {
+		contexts[contextName] = context
+	}
+
+	previous := configFile.CurrentContext
+	if previous == "" && configFile.Target != "" {
+		if _, exists := contexts[DefaultContextName]; !exists {
+			previous = DefaultContextName
+		}
+	}
+	if previous != "" {
+		contexts[previous] = configFile.currentContext()
+	}
+
+	next, exists := contexts[name]
+	if !exists {
+		next = TargetContext{
+			SSHOAuthClient:       DefaultSSHOAuthClient,
+			UAAOAuthClient:       DefaultUAAOAuthClient,
+			UAAOAuthClientSecret: DefaultUAAOAuthClientSecret,
+		}
+	}
+	delete(contexts, name)
+
+	configFile.setCurrentContext(next)
+	configFile.CurrentContext = name
+	configFile.Contexts = contexts
+	return previous
+}
@@ -0,0 +1,219 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/command/translatableerror"
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	readConfigFile := func() JSONConfig {
+		rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+		Expect(err).ToNot(HaveOccurred())
+		var configFile JSONConfig
+		Expect(json.Unmarshal(rawConfig, &configFile)).To(Succeed())
+		return configFile
+	}
+
+	When("no context has been named", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.one.com",
+				"AccessToken": "one-access-token",
+				"OrganizationFields": {"Name": "one-org"}
+			}`)
+		})
+
+		It("has no contexts", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CurrentContextName()).To(BeEmpty())
+			Expect(config.ContextNames()).To(BeEmpty())
+		})
+
+		Describe("UseContext", func() {
+			It("keeps the current settings in the default context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				created, err := config.UseContext("two")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeTrue())
+
+				Expect(config.CurrentContextName()).To(Equal("two"))
+				Expect(config.Target()).To(BeEmpty())
+				Expect(config.AccessToken()).To(BeEmpty())
+				Expect(config.SSHOAuthClient()).To(Equal(DefaultSSHOAuthClient))
+				Expect(config.UAAOAuthClient()).To(Equal(DefaultUAAOAuthClient))
+				Expect(config.ContextNames()).To(Equal([]string{DefaultContextName, "two"}))
+
+				defaultContext := config.Contexts()[DefaultContextName]
+				Expect(defaultContext.Target).To(Equal("https://api.one.com"))
+				Expect(defaultContext.AccessToken).To(Equal("one-access-token"))
+				Expect(defaultContext.TargetedOrganization.Name).To(Equal("one-org"))
+			})
+		})
+	})
+
+	When("there are named contexts", func() {
+		BeforeEach(func() {
+			setConfig(homeDir, `{
+				"ConfigVersion": 3,
+				"Target": "https://api.one.com",
+				"AccessToken": "one-access-token",
+				"OrganizationFields": {"Name": "one-org"},
+				"CurrentContext": "one",
+				"Contexts": {
+					"two": {
+						"Target": "https://api.two.com",
+						"AccessToken": "two-access-token",
+						"OrganizationFields": {"Name": "two-org"},
+						"SpaceFields": {"Name": "two-space"}
+					}
+				}
+			}`)
+		})
+
+		It("lists all contexts including the current one", func() {
+			config, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.CurrentContextName()).To(Equal("one"))
+			Expect(config.ContextNames()).To(Equal([]string{"one", "two"}))
+			Expect(config.Contexts()["one"].Target).To(Equal("https://api.one.com"))
+			Expect(config.Contexts()["two"].TargetedSpace.Name).To(Equal("two-space"))
+		})
+
+		Describe("UseContext", func() {
+			It("switches to the context and keeps the previous one", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				created, err := config.UseContext("two")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(created).To(BeFalse())
+				Expect(config.Target()).To(Equal("https://api.two.com"))
+				Expect(config.AccessToken()).To(Equal("two-access-token"))
+
+				Expect(WriteConfig(config)).To(Succeed())
+
+				configFile := readConfigFile()
+				Expect(configFile.CurrentContext).To(Equal("two"))
+				Expect(configFile.Target).To(Equal("https://api.two.com"))
+				Expect(configFile.Contexts).To(HaveLen(1))
+				Expect(configFile.Contexts["one"].AccessToken).To(Equal("one-access-token"))
+			})
+		})
+
+		Describe("RenameContext", func() {
+			It("renames the current context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RenameContext("one", "first")).To(Succeed())
+				Expect(config.CurrentContextName()).To(Equal("first"))
+				Expect(config.ContextNames()).To(Equal([]string{"first", "two"}))
+			})
+
+			It("renames another context", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.RenameContext("two", "second")).To(Succeed())
+				Expect(config.CurrentContextName()).To(Equal("one"))
+				Expect(config.ContextNames()).To(Equal([]string{"one", "second"}))
+				Expect(config.Contexts()["second"].Target).To(Equal("https://api.two.com"))
+			})
+		})
+
+		When("the --context flag selects another context", func() {
+			It("uses that context without switching to it", func() {
+				config, err := LoadConfig(FlagOverride{Context: "two"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.CurrentContextName()).To(Equal("two"))
+				Expect(config.Target()).To(Equal("https://api.two.com"))
+
+				config.SetAccessToken("refreshed-two-access-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				configFile := readConfigFile()
+				Expect(configFile.CurrentContext).To(Equal("one"))
+				Expect(configFile.Target).To(Equal("https://api.one.com"))
+				Expect(configFile.AccessToken).To(Equal("one-access-token"))
+				Expect(configFile.Contexts["two"].AccessToken).To(Equal("refreshed-two-access-token"))
+			})
+
+			It("switches to the context when use-context is run", func() {
+				config, err := LoadConfig(FlagOverride{Context: "two"})
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = config.UseContext("two")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				Expect(readConfigFile().CurrentContext).To(Equal("two"))
+			})
+
+			It("returns a ContextNotFoundError when the context does not exist", func() {
+				_, err := LoadConfig(FlagOverride{Context: "three"})
+				Expect(err).To(MatchError(translatableerror.ContextNotFoundError{Name: "three"}))
+			})
+		})
+
+		When("the encrypted-file credential store is configured", func() {
+			BeforeEach(func() {
+				Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				config.SetCredentialStore(CredentialStoreEncryptedFile)
+				Expect(WriteConfig(config)).To(Succeed())
+			})
+
+			AfterEach(func() {
+				Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+			})
+
+			It("stores the credentials of each context separately", func() {
+				configFile := readConfigFile()
+				Expect(configFile.AccessToken).To(BeEmpty())
+				Expect(configFile.Contexts["two"].AccessToken).To(BeEmpty())
+
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("one-access-token"))
+
+				config, err = LoadConfig(FlagOverride{Context: "two"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("two-access-token"))
+			})
+
+			It("moves the stored credentials when a context is renamed", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.RenameContext("two", "second")).To(Succeed())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config, err = LoadConfig(FlagOverride{Context: "second"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("two-access-token"))
+			})
+		})
+	})
+})
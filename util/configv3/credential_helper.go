@@ -0,0 +1,109 @@
+package configv3
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// CredentialHelperPrefix is prepended to the credential store name to find
+// the credential helper executable.
+const CredentialHelperPrefix = "cf-credential-"
+
+// CredentialHelperError is returned when a credential helper exits with an
+// error.
+type CredentialHelperError struct {
+	Helper  string
+	Action  string
+	Message string
+}
+
+func (e CredentialHelperError) Error() string {
+	return fmt.Sprintf("Credential helper '%s' failed to %s credentials: %s", e.Helper, e.Action, e.Message)
+}
+
+// CredentialHelper is a CredentialStore backed by an external executable,
+// similar to git and docker credential helpers.
+//
+// The executable is invoked with a single argument, one of 'get', 'store' or
+// 'erase', and receives a JSON object on stdin containing the "ServerURL" of
+// the API target. For 'store' the object also contains the "AccessToken",
+// "RefreshToken" and "UAAOAuthClientSecret" to save. For 'get' the helper
+// writes a JSON object with the same three keys to stdout; empty output means
+// no credentials are stored. A non-zero exit status is treated as a failure
+// and stderr is used as the error message.
+type CredentialHelper struct {
+	Name string
+}
+
+type credentialHelperRequest struct {
+	ServerURL string `json:"ServerURL"`
+	Credentials
+}
+
+// NewCredentialHelper returns a CredentialHelper that runs
+// cf-credential-<name>.
+func NewCredentialHelper(name string) CredentialHelper {
+	return CredentialHelper{Name: name}
+}
+
+// Get returns the credentials stored for target.
+func (helper CredentialHelper) Get(target string) (Credentials, error) {
+	output, err := helper.run("get", credentialHelperRequest{ServerURL: target})
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	var credentials Credentials
+	if len(bytes.TrimSpace(output)) == 0 {
+		return credentials, nil
+	}
+
+	err = json.Unmarshal(output, &credentials)
+	if err != nil {
+		return Credentials{}, CredentialHelperError{Helper: helper.executable(), Action: "get", Message: err.Error()}
+	}
+	return credentials, nil
+}
+
+// Store saves credentials for target.
+func (helper CredentialHelper) Store(target string, credentials Credentials) error {
+	_, err := helper.run("store", credentialHelperRequest{ServerURL: target, Credentials: credentials})
+	return err
+}
+
+// Erase removes the credentials stored for target.
+func (helper CredentialHelper) Erase(target string) error {
+	_, err := helper.run("erase", credentialHelperRequest{ServerURL: target})
+	return err
+}
+
+func (helper CredentialHelper) executable() string {
+	return CredentialHelperPrefix + helper.Name
+}
+
+func (helper CredentialHelper) run(action string, request credentialHelperRequest) ([]byte, error) {
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(helper.executable(), action)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err = cmd.Run()
+	if err != nil {
+		message := strings.TrimSpace(stderr.String())
+		if message == "" {
+			message = err.Error()
+		}
+		return nil, CredentialHelperError{Helper: helper.executable(), Action: action, Message: message}
+	}
+
+	return stdout.Bytes(), nil
+}
@@ -0,0 +1,86 @@
+// +build !windows
+
+package configv3_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CredentialHelper", func() {
+	var (
+		helperDir string
+		oldPath   string
+		helper    CredentialHelper
+	)
+
+	BeforeEach(func() {
+		var err error
+		helperDir, err = ioutil.TempDir("", "cli-credential-helper")
+		Expect(err).ToNot(HaveOccurred())
+
+		script := `#!/bin/sh
+case "$1" in
+  get) cat "` + helperDir + `/stored" 2>/dev/null || true ;;
+  store) cat > "` + helperDir + `/stored" ;;
+  erase) cat > "` + helperDir + `/erased"; rm -f "` + helperDir + `/stored" ;;
+esac
+`
+		Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-test"), []byte(script), 0700)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(helperDir, "cf-credential-broken"), []byte("#!/bin/sh\necho 'keychain is locked' >&2\nexit 1\n"), 0700)).To(Succeed())
+
+		oldPath = os.Getenv("PATH")
+		Expect(os.Setenv("PATH", helperDir+string(os.PathListSeparator)+oldPath)).To(Succeed())
+
+		helper = NewCredentialHelper("test")
+	})
+
+	AfterEach(func() {
+		Expect(os.Setenv("PATH", oldPath)).To(Succeed())
+		Expect(os.RemoveAll(helperDir)).To(Succeed())
+	})
+
+	It("stores, gets and erases credentials through the helper", func() {
+		Expect(helper.Get("https://api.example.com")).To(Equal(Credentials{}))
+
+		credentials := Credentials{AccessToken: "some-access-token", RefreshToken: "some-refresh-token"}
+		Expect(helper.Store("https://api.example.com", credentials)).To(Succeed())
+
+		stored, err := ioutil.ReadFile(filepath.Join(helperDir, "stored"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stored).To(MatchJSON(`{
+			"ServerURL": "https://api.example.com",
+			"AccessToken": "some-access-token",
+			"RefreshToken": "some-refresh-token",
+			"UAAOAuthClientSecret": ""
+		}`))
+
+		Expect(helper.Get("https://api.example.com")).To(Equal(credentials))
+
+		Expect(helper.Erase("https://api.example.com")).To(Succeed())
+		erased, err := ioutil.ReadFile(filepath.Join(helperDir, "erased"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(erased).To(MatchJSON(`{
+			"ServerURL": "https://api.example.com",
+			"AccessToken": "",
+			"RefreshToken": "",
+			"UAAOAuthClientSecret": ""
+		}`))
+	})
+
+	When("the helper fails", func() {
+		It("returns a CredentialHelperError with the helper's stderr", func() {
+			_, err := NewCredentialHelper("broken").Get("https://api.example.com")
+			Expect(err).To(MatchError(CredentialHelperError{
+				Helper:  "cf-credential-broken",
+				Action:  "get",
+				Message: "keychain is locked",
+			}))
+		})
+	})
+})
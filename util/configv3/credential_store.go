@@ -0,0 +1,167 @@
+package configv3
+
+import "path/filepath"
+
+const (
+	// CredentialStoreFile keeps credentials in config.json in plain text. This
+	// is the default.
+	CredentialStoreFile = "file"
+
+	// CredentialStoreEncryptedFile keeps credentials in a file in the .cf
+	// directory, encrypted with the $CF_CREDENTIAL_PASSPHRASE.
+	CredentialStoreEncryptedFile = "encrypted-file"
+)
+
+// Credentials are the secrets that a CredentialStore keeps out of
+// config.json.
+type Credentials struct {
+	AccessToken          string `json:"AccessToken"`
+	RefreshToken         string `json:"RefreshToken"`
+	UAAOAuthClientSecret string `json:"UAAOAuthClientSecret"`
+}
+
+// IsEmpty returns true when there are no credentials to store.
+func (credentials Credentials) IsEmpty() bool {
+	return credentials == Credentials{}
+}
+
+// CredentialStore persists the credentials for an API target outside of
+// config.json.
+type CredentialStore interface {
+	Get(target string) (Credentials, error)
+	Store(target string, credentials Credentials) error
+	Erase(target string) error
+}
+
+// NewCredentialStore returns the CredentialStore with the given name, or nil
+// when credentials are kept in config.json. Names other than "file" and
+// "encrypted-file" refer to a credential helper executable named
+// cf-credential-<name> in the PATH.
+func NewCredentialStore(name string, passphrase string) CredentialStore {
+	switch name {
+	case "", CredentialStoreFile:
+		return nil
+	case CredentialStoreEncryptedFile:
+		return NewEncryptedFileCredentialStore(filepath.Join(configDirectory(), "credentials.enc"), passphrase)
+	default:
+		return NewCredentialHelper(name)
+	}
+}
+
+// CredentialStore returns the store used to persist the credentials in this
+// config, or nil when they are kept in config.json.
+func (config *Config) CredentialStore() CredentialStore {
+	return NewCredentialStore(config.ConfigFile.CredentialStore, config.ENV.CFCredentialPassphrase)
+}
+
+// SetCredentialStore sets the name of the credential store. The credentials
+// are moved to the new store the next time the config is written.
+func (config *Config) SetCredentialStore(name string) {
+	if name != config.ConfigFile.CredentialStore {
+		config.storedCredentials = nil
+	}
+	config.ConfigFile.CredentialStore = name
+}
+
+// loadCredentials reads the credentials from the configured credential store
+// into the config file.
+func (config *Config) loadCredentials() error {
+	store := config.CredentialStore()
+	if store == nil {
+		return nil
+	}
+
+	key := CredentialKey(config.ConfigFile.CurrentContext, config.ConfigFile.Target)
+	credentials, err := store.Get(key)
+	if err != nil {
+		return err
+	}
+	config.rememberStoredCredentials(key, credentials)
+
+	// Credentials still in config.json from before the store was configured
+	// are kept, and moved to the store when the config is written.
+	if credentials.IsEmpty() {
+		return nil
+	}
+
+	config.ConfigFile.AccessToken = credentials.AccessToken
+	config.ConfigFile.RefreshToken = credentials.RefreshToken
+	config.ConfigFile.UAAOAuthClientSecret = credentials.UAAOAuthClientSecret
+	return nil
+}
+
+// storeCredentials saves the credentials in configFile, including those of
+// the contexts that are not current, to the configured credential store and
+// removes them from configFile.
+func (config *Config) storeCredentials(configFile *JSONConfig) error {
+	store := config.CredentialStore()
+	if store == nil {
+		return nil
+	}
+
+	current := configFile.currentContext()
+	err := config.storeContextCredentials(store, CredentialKey(configFile.CurrentContext, configFile.Target), &current, true)
+	if err != nil {
+		return err
+	}
+	configFile.setCurrentContext(current)
+
+	contexts := map[string]TargetContext{}
+	for name, context := range configFile.Contexts {
+		// Credentials of contexts that are not current are only in memory after
+		// switching away from them; otherwise they are already in the store.
+		err = config.storeContextCredentials(store, CredentialKey(name, context.Target), &context, false)
+		if err != nil {
+			return err
+		}
+		contexts[name] = context
+	}
+	if configFile.Contexts != nil {
+		configFile.Contexts = contexts
+	}
+
+	return nil
+}
+
+// storeContextCredentials saves the credentials of context under key and
+// removes them from context. Empty credentials erase the stored ones when
+// eraseEmpty is true. The store is left alone when it already holds the
+// credentials.
+func (config *Config) storeContextCredentials(store CredentialStore, key string, context *TargetContext, eraseEmpty bool) error {
+	credentials := Credentials{
+		AccessToken:          context.AccessToken,
+		RefreshToken:         context.RefreshToken,
+		UAAOAuthClientSecret: context.UAAOAuthClientSecret,
+	}
+
+	stored, known := config.storedCredentials[key]
+	switch {
+	case known && stored == credentials:
+	case !credentials.IsEmpty():
+		err := store.Store(key, credentials)
+		if err != nil {
+			return err
+		}
+		config.rememberStoredCredentials(key, credentials)
+	case eraseEmpty:
+		err := store.Erase(key)
+		if err != nil {
+			return err
+		}
+		config.rememberStoredCredentials(key, credentials)
+	}
+
+	context.AccessToken = ""
+	context.RefreshToken = ""
+	context.UAAOAuthClientSecret = ""
+	return nil
+}
+
+// rememberStoredCredentials records that the credential store holds
+// credentials under key.
+func (config *Config) rememberStoredCredentials(key string, credentials Credentials) {
+	if config.storedCredentials == nil {
+		config.storedCredentials = map[string]Credentials{}
+	}
+	config.storedCredentials[key] = credentials
+}
@@ -0,0 +1,214 @@
+package configv3_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CredentialStore", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("NewCredentialStore", func() {
+		It("keeps credentials in config.json by default", func() {
+			Expect(NewCredentialStore("", "")).To(BeNil())
+			Expect(NewCredentialStore(CredentialStoreFile, "")).To(BeNil())
+		})
+
+		It("returns an encrypted file store in the .cf directory", func() {
+			store := NewCredentialStore(CredentialStoreEncryptedFile, "some-passphrase")
+			Expect(store).To(BeAssignableToTypeOf(EncryptedFileCredentialStore{}))
+			Expect(store.(EncryptedFileCredentialStore).Path).To(Equal(filepath.Join(homeDir, ".cf", "credentials.enc")))
+		})
+
+		It("returns a credential helper for any other name", func() {
+			Expect(NewCredentialStore("osxkeychain", "")).To(Equal(CredentialHelper{Name: "osxkeychain"}))
+		})
+	})
+
+	Describe("EncryptedFileCredentialStore", func() {
+		var (
+			store       EncryptedFileCredentialStore
+			path        string
+			credentials Credentials
+		)
+
+		BeforeEach(func() {
+			path = filepath.Join(homeDir, "credentials.enc")
+			store = NewEncryptedFileCredentialStore(path, "some-passphrase")
+			credentials = Credentials{
+				AccessToken:  "some-access-token",
+				RefreshToken: "some-refresh-token",
+			}
+		})
+
+		It("stores the credentials encrypted per target", func() {
+			Expect(store.Store("https://api.one.com", credentials)).To(Succeed())
+			Expect(store.Store("https://api.two.com", Credentials{AccessToken: "other-token"})).To(Succeed())
+
+			rawFile, err := ioutil.ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(rawFile)).ToNot(ContainSubstring("some-access-token"))
+
+			info, err := os.Stat(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+
+			Expect(store.Get("https://api.one.com")).To(Equal(credentials))
+			Expect(store.Get("https://api.two.com")).To(Equal(Credentials{AccessToken: "other-token"}))
+			Expect(store.Get("https://api.three.com")).To(Equal(Credentials{}))
+		})
+
+		It("removes the file once all credentials are erased", func() {
+			Expect(store.Store("https://api.one.com", credentials)).To(Succeed())
+			Expect(store.Erase("https://api.one.com")).To(Succeed())
+
+			_, err := os.Stat(path)
+			Expect(os.IsNotExist(err)).To(BeTrue())
+
+			Expect(store.Erase("https://api.one.com")).To(Succeed())
+		})
+
+		When("the passphrase is incorrect", func() {
+			It("returns an IncorrectCredentialPassphraseError", func() {
+				Expect(store.Store("https://api.one.com", credentials)).To(Succeed())
+
+				otherStore := NewEncryptedFileCredentialStore(path, "wrong-passphrase")
+				_, err := otherStore.Get("https://api.one.com")
+				Expect(err).To(MatchError(IncorrectCredentialPassphraseError{Path: path}))
+			})
+		})
+
+		When("the passphrase is not set", func() {
+			It("returns a MissingCredentialPassphraseError", func() {
+				_, err := NewEncryptedFileCredentialStore(path, "").Get("https://api.one.com")
+				Expect(err).To(MatchError(MissingCredentialPassphraseError{}))
+			})
+		})
+	})
+
+	Describe("LoadConfig and WriteConfig", func() {
+		BeforeEach(func() {
+			Expect(os.Setenv("CF_CREDENTIAL_PASSPHRASE", "some-passphrase")).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+		})
+
+		When("the encrypted-file credential store is configured", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"ConfigVersion": 3,
+					"Target": "https://api.example.com",
+					"UAAOAuthClient": "some-client",
+					"AccessToken": "plaintext-access-token",
+					"RefreshToken": "plaintext-refresh-token",
+					"UAAOAuthClientSecret": "plaintext-secret",
+					"CredentialStore": "encrypted-file"
+				}`)
+			})
+
+			It("moves existing plaintext credentials into the store", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("plaintext-access-token"))
+
+				Expect(WriteConfig(config)).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				var writtenConfig JSONConfig
+				Expect(json.Unmarshal(rawConfig, &writtenConfig)).To(Succeed())
+				Expect(writtenConfig.AccessToken).To(BeEmpty())
+				Expect(writtenConfig.RefreshToken).To(BeEmpty())
+				Expect(writtenConfig.UAAOAuthClientSecret).To(BeEmpty())
+				Expect(writtenConfig.CredentialStore).To(Equal(CredentialStoreEncryptedFile))
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("plaintext-access-token"))
+				Expect(config.RefreshToken()).To(Equal("plaintext-refresh-token"))
+				Expect(config.UAAOAuthClientSecret()).To(Equal("plaintext-secret"))
+			})
+
+			It("only writes to the store when the credentials change", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				credentialsPath := filepath.Join(homeDir, ".cf", "credentials.enc")
+				storedFile, err := ioutil.ReadFile(credentialsPath)
+				Expect(err).ToNot(HaveOccurred())
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(ioutil.ReadFile(credentialsPath)).To(Equal(storedFile))
+
+				config.SetAccessToken("new-access-token")
+				Expect(WriteConfig(config)).To(Succeed())
+				Expect(ioutil.ReadFile(credentialsPath)).ToNot(Equal(storedFile))
+
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.AccessToken()).To(Equal("new-access-token"))
+			})
+
+			It("erases the stored credentials on logout", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(WriteConfig(config)).To(Succeed())
+
+				config.SetAccessToken("")
+				config.SetRefreshToken("")
+				config.SetUAAClientCredentials("some-client", "")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				_, err = os.Stat(filepath.Join(homeDir, ".cf", "credentials.enc"))
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+
+			When("the passphrase is not set", func() {
+				BeforeEach(func() {
+					Expect(os.Unsetenv("CF_CREDENTIAL_PASSPHRASE")).To(Succeed())
+				})
+
+				It("returns a MissingCredentialPassphraseError", func() {
+					_, err := LoadConfig()
+					Expect(err).To(MatchError(MissingCredentialPassphraseError{}))
+				})
+			})
+		})
+
+		When("no credential store is configured", func() {
+			It("keeps the credentials in config.json", func() {
+				config := &Config{
+					ConfigFile: JSONConfig{
+						ConfigVersion: 3,
+						AccessToken:   "some-access-token",
+					},
+				}
+				Expect(WriteConfig(config)).To(Succeed())
+
+				rawConfig, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(rawConfig)).To(ContainSubstring("some-access-token"))
+				Expect(string(rawConfig)).ToNot(ContainSubstring("CredentialStore"))
+			})
+		})
+	})
+})
@@ -47,6 +47,14 @@ const (
 
 	// DefaultRetryCount is the default number of request retries.
 	DefaultRetryCount = 2
+
+	// DefaultRetryBaseDelay is the default delay before the first request
+	// retry.
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay is the default maximum delay between request
+	// retries.
+	DefaultRetryMaxDelay = 30 * time.Second
 )
 
 // NOAARequestRetryCount returns the number of request retries.
@@ -59,11 +67,6 @@ func (config *Config) PollingInterval() time.Duration {
 	return DefaultPollingInterval
 }
 
-// RequestRetryCount returns the number of request retries.
-func (*Config) RequestRetryCount() int {
-	return DefaultRetryCount
-}
-
 // UAADisableKeepAlives returns true when TCP connections should not be reused
 // for UAA.
 func (*Config) UAADisableKeepAlives() bool {
@@ -0,0 +1,180 @@
+package configv3
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	encryptionKeyIterations = 100000
+	encryptionKeyLength     = 32
+	encryptionSaltLength    = 16
+)
+
+// MissingCredentialPassphraseError is returned when the encrypted-file
+// credential store is used without setting $CF_CREDENTIAL_PASSPHRASE.
+type MissingCredentialPassphraseError struct{}
+
+func (MissingCredentialPassphraseError) Error() string {
+	return "CF_CREDENTIAL_PASSPHRASE must be set to use the encrypted-file credential store"
+}
+
+// IncorrectCredentialPassphraseError is returned when the credentials file
+// cannot be decrypted with the provided passphrase.
+type IncorrectCredentialPassphraseError struct {
+	Path string
+}
+
+func (e IncorrectCredentialPassphraseError) Error() string {
+	return "Unable to decrypt " + e.Path + ": the CF_CREDENTIAL_PASSPHRASE is incorrect"
+}
+
+// EncryptedFileCredentialStore is a CredentialStore that keeps credentials in
+// a file encrypted with AES-GCM. The key is derived from a passphrase with
+// PBKDF2-HMAC-SHA256.
+type EncryptedFileCredentialStore struct {
+	Path       string
+	passphrase string
+}
+
+type encryptedCredentialsFile struct {
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// NewEncryptedFileCredentialStore returns an EncryptedFileCredentialStore
+// that reads and writes path.
+func NewEncryptedFileCredentialStore(path string, passphrase string) EncryptedFileCredentialStore {
+	return EncryptedFileCredentialStore{
+		Path:       path,
+		passphrase: passphrase,
+	}
+}
+
+// Get returns the credentials stored for target.
+func (store EncryptedFileCredentialStore) Get(target string) (Credentials, error) {
+	allCredentials, err := store.read()
+	if err != nil {
+		return Credentials{}, err
+	}
+	return allCredentials[target], nil
+}
+
+// Store saves credentials for target.
+func (store EncryptedFileCredentialStore) Store(target string, credentials Credentials) error {
+	allCredentials, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	allCredentials[target] = credentials
+	return store.write(allCredentials)
+}
+
+// Erase removes the credentials stored for target. The file is removed once
+// it no longer contains any credentials.
+func (store EncryptedFileCredentialStore) Erase(target string) error {
+	if _, err := os.Stat(store.Path); os.IsNotExist(err) {
+		return nil
+	}
+
+	allCredentials, err := store.read()
+	if err != nil {
+		return err
+	}
+
+	delete(allCredentials, target)
+	if len(allCredentials) == 0 {
+		return os.Remove(store.Path)
+	}
+	return store.write(allCredentials)
+}
+
+func (store EncryptedFileCredentialStore) read() (map[string]Credentials, error) {
+	if store.passphrase == "" {
+		return nil, MissingCredentialPassphraseError{}
+	}
+
+	allCredentials := map[string]Credentials{}
+
+	rawFile, err := ioutil.ReadFile(store.Path)
+	if os.IsNotExist(err) {
+		return allCredentials, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var file encryptedCredentialsFile
+	err = json.Unmarshal(rawFile, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(store.passphrase, file.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, file.Nonce, file.Ciphertext, nil)
+	if err != nil {
+		return nil, IncorrectCredentialPassphraseError{Path: store.Path}
+	}
+
+	err = json.Unmarshal(plaintext, &allCredentials)
+	return allCredentials, err
+}
+
+func (store EncryptedFileCredentialStore) write(allCredentials map[string]Credentials) error {
+	plaintext, err := json.Marshal(allCredentials)
+	if err != nil {
+		return err
+	}
+
+	file := encryptedCredentialsFile{
+		Salt: make([]byte, encryptionSaltLength),
+	}
+	if _, err = rand.Read(file.Salt); err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(store.passphrase, file.Salt)
+	if err != nil {
+		return err
+	}
+
+	file.Nonce = make([]byte, gcm.NonceSize())
+	if _, err = rand.Read(file.Nonce); err != nil {
+		return err
+	}
+	file.Ciphertext = gcm.Seal(nil, file.Nonce, plaintext, nil)
+
+	rawFile, err := json.Marshal(file)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(store.Path), 0700)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(store.Path, rawFile, 0600)
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key := pbkdf2.Key([]byte(passphrase), salt, encryptionKeyIterations, encryptionKeyLength, sha256.New)
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -8,23 +8,28 @@ import (
 
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
-	BinaryName       string
-	CFColor          string
-	CFDialTimeout    string
-	CFHome           string
-	CFLogLevel       string
-	CFPassword       string
-	CFPluginHome     string
-	CFStagingTimeout string
-	CFStartupTimeout string
-	CFTrace          string
-	CFUsername       string
-	DockerPassword   string
-	Experimental     string
-	ForceTTY         string
-	HTTPSProxy       string
-	Lang             string
-	LCAll            string
+	BinaryName             string
+	CFColor                string
+	CFCredentialPassphrase string
+	CFDialTimeout          string
+	CFHome                 string
+	CFHonorGitIgnore       string
+	CFLogLevel             string
+	CFPassword             string
+	CFPluginHome           string
+	CFRetryBaseDelay       string
+	CFRetryCount           string
+	CFRetryMaxDelay        string
+	CFStagingTimeout       string
+	CFStartupTimeout       string
+	CFTrace                string
+	CFUsername             string
+	DockerPassword         string
+	Experimental           string
+	ForceTTY               string
+	HTTPSProxy             string
+	Lang                   string
+	LCAll                  string
 }
 
 // BinaryName returns the running name of the CF CLI
@@ -88,6 +93,22 @@ func (config *Config) HTTPSProxy() string {
 	return ""
 }
 
+// HonorGitIgnore returns whether files matched by .gitignore files should be
+// excluded from app bits alongside those matched by .cfignore files. This is
+// based off of:
+//   1. The $CF_HONOR_GITIGNORE environment variable if set
+//   2. Defaults to false
+func (config *Config) HonorGitIgnore() bool {
+	if config.ENV.CFHonorGitIgnore != "" {
+		envVal, err := strconv.ParseBool(config.ENV.CFHonorGitIgnore)
+		if err == nil {
+			return envVal
+		}
+	}
+
+	return false
+}
+
 // LogLevel returns the global log level. The levels follow Logrus's log level
 // scheme. This value is based off of:
 //   - The $CF_LOG_LEVEL and an int/warn/info/etc...
@@ -2,5 +2,7 @@ package configv3
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Context      string
+	OutputFormat string
+	Verbose      bool
 }
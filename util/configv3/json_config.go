@@ -31,6 +31,15 @@ type JSONConfig struct {
 	PluginRepositories       []PluginRepository `json:"PluginRepos"`
 	MinCLIVersion            string             `json:"MinCLIVersion"`
 	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	CredentialStore          string             `json:"CredentialStore,omitempty"`
+	CurrentContext           string             `json:"CurrentContext,omitempty"`
+
+	// Contexts holds every named context except the current one, which is
+	// stored in the fields above.
+	Contexts map[string]TargetContext `json:"Contexts,omitempty"`
+
+	// RequestRetry configures how failed API requests are retried.
+	RequestRetry *RequestRetrySettings `json:"RequestRetry,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization.
@@ -78,22 +78,50 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 	}
 
 	config.ENV = EnvOverride{
-		BinaryName:       filepath.Base(os.Args[0]),
-		CFColor:          os.Getenv("CF_COLOR"),
-		CFDialTimeout:    os.Getenv("CF_DIAL_TIMEOUT"),
-		CFLogLevel:       os.Getenv("CF_LOG_LEVEL"),
-		CFPassword:       os.Getenv("CF_PASSWORD"),
-		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
-		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
-		CFStartupTimeout: os.Getenv("CF_STARTUP_TIMEOUT"),
-		CFTrace:          os.Getenv("CF_TRACE"),
-		CFUsername:       os.Getenv("CF_USERNAME"),
-		DockerPassword:   os.Getenv("CF_DOCKER_PASSWORD"),
-		Experimental:     os.Getenv("CF_CLI_EXPERIMENTAL"),
-		ForceTTY:         os.Getenv("FORCE_TTY"),
-		HTTPSProxy:       os.Getenv("https_proxy"),
-		Lang:             os.Getenv("LANG"),
-		LCAll:            os.Getenv("LC_ALL"),
+		BinaryName:             filepath.Base(os.Args[0]),
+		CFColor:                os.Getenv("CF_COLOR"),
+		CFCredentialPassphrase: os.Getenv("CF_CREDENTIAL_PASSPHRASE"),
+		CFDialTimeout:          os.Getenv("CF_DIAL_TIMEOUT"),
+		CFHonorGitIgnore:       os.Getenv("CF_HONOR_GITIGNORE"),
+		CFLogLevel:             os.Getenv("CF_LOG_LEVEL"),
+		CFPassword:             os.Getenv("CF_PASSWORD"),
+		CFPluginHome:           os.Getenv("CF_PLUGIN_HOME"),
+		CFRetryBaseDelay:       os.Getenv("CF_RETRY_BASE_DELAY"),
+		CFRetryCount:           os.Getenv("CF_RETRY_COUNT"),
+		CFRetryMaxDelay:        os.Getenv("CF_RETRY_MAX_DELAY"),
+		CFStagingTimeout:       os.Getenv("CF_STAGING_TIMEOUT"),
+		CFStartupTimeout:       os.Getenv("CF_STARTUP_TIMEOUT"),
+		CFTrace:                os.Getenv("CF_TRACE"),
+		CFUsername:             os.Getenv("CF_USERNAME"),
+		DockerPassword:         os.Getenv("CF_DOCKER_PASSWORD"),
+		Experimental:           os.Getenv("CF_CLI_EXPERIMENTAL"),
+		ForceTTY:               os.Getenv("FORCE_TTY"),
+		HTTPSProxy:             os.Getenv("https_proxy"),
+		Lang:                   os.Getenv("LANG"),
+		LCAll:                  os.Getenv("LC_ALL"),
+	}
+
+	if len(flags) > 0 {
+		config.Flags = flags[0]
+	}
+
+	err = config.loadCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	if name := config.Flags.Context; name != "" && name != config.ConfigFile.CurrentContext {
+		if _, exists := config.ConfigFile.Contexts[name]; !exists {
+			return nil, translatableerror.ContextNotFoundError{Name: name}
+		}
+		config.contextOverride = &contextOverride{
+			previous: config.ConfigFile.switchContext(name),
+		}
+
+		err = config.loadCredentials()
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	pluginFilePath := filepath.Join(config.PluginHome(), "config.json")
@@ -119,10 +147,6 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 		}
 	}
 
-	if len(flags) > 0 {
-		config.Flags = flags[0]
-	}
-
 	pwd, err := os.Getwd()
 	if err != nil {
 		return nil, err
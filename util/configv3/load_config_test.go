@@ -59,6 +59,7 @@ var _ = Describe("Config", func() {
 				Expect(config.SkipSSLValidation()).To(BeFalse())
 				Expect(config.ColorEnabled()).To(Equal(ColorAuto))
 				Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
+				Expect(config.ResourceCacheFilePath()).To(Equal(filepath.Join(homeDir, ".cf", "resource-cache.json")))
 				Expect(config.StagingTimeout()).To(Equal(DefaultStagingTimeout))
 				Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
 				Expect(config.Locale()).To(BeEmpty())
@@ -0,0 +1,36 @@
+package configv3
+
+import "strings"
+
+const (
+	// OutputFormatTable displays command results as human readable, translated
+	// text and tables.
+	OutputFormatTable OutputFormat = "table"
+
+	// OutputFormatJSON displays command results as a JSON document.
+	OutputFormatJSON OutputFormat = "json"
+
+	// OutputFormatYAML displays command results as a YAML document.
+	OutputFormatYAML OutputFormat = "yaml"
+)
+
+// OutputFormat is the format in which commands display their results.
+type OutputFormat string
+
+// IsStructured returns true if the format is a machine readable document
+// format.
+func (format OutputFormat) IsStructured() bool {
+	return format == OutputFormatJSON || format == OutputFormatYAML
+}
+
+// OutputFormat returns the output format based off:
+//   1. The '--output' global flag if set (table/json/yaml)
+//   2. Defaults to OutputFormatTable if nothing is set
+func (config *Config) OutputFormat() OutputFormat {
+	switch format := OutputFormat(strings.ToLower(config.Flags.OutputFormat)); format {
+	case OutputFormatJSON, OutputFormatYAML:
+		return format
+	}
+
+	return OutputFormatTable
+}
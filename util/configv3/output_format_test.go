@@ -0,0 +1,46 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	var homeDir string
+
+	BeforeEach(func() {
+		homeDir = setup()
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	DescribeTable("OutputFormat",
+		func(flagVal string, expected OutputFormat) {
+			config, err := LoadConfig(FlagOverride{OutputFormat: flagVal})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config).ToNot(BeNil())
+
+			Expect(config.OutputFormat()).To(Equal(expected))
+		},
+		Entry("flag=json", "json", OutputFormatJSON),
+		Entry("flag=JSON", "JSON", OutputFormatJSON),
+		Entry("flag=yaml", "yaml", OutputFormatYAML),
+		Entry("flag=table", "table", OutputFormatTable),
+		Entry("flag=unset falls back to default", "", OutputFormatTable),
+		Entry("flag=unknown falls back to default", "xml", OutputFormatTable),
+	)
+
+	DescribeTable("IsStructured",
+		func(format OutputFormat, expected bool) {
+			Expect(format.IsStructured()).To(Equal(expected))
+		},
+		Entry("json", OutputFormatJSON, true),
+		Entry("yaml", OutputFormatYAML, true),
+		Entry("table", OutputFormatTable, false),
+		Entry("empty", OutputFormat(""), false),
+	)
+})
@@ -17,13 +17,40 @@ const (
 type PluginRepository struct {
 	Name string `json:"Name"`
 	URL  string `json:"URL"`
+	// TrustedKeys are the base64 encoded ed25519 public keys that plugin
+	// binaries from this repository must be signed with.
+	TrustedKeys []string `json:"TrustedKeys,omitempty"`
 }
 
 // AddPluginRepository adds an new repository to the plugin config. It does not
 // add duplicates to the config.
-func (config *Config) AddPluginRepository(name string, url string) {
+func (config *Config) AddPluginRepository(name string, url string, trustedKeys []string) {
 	config.ConfigFile.PluginRepositories = append(config.ConfigFile.PluginRepositories,
-		PluginRepository{Name: name, URL: url})
+		PluginRepository{Name: name, URL: url, TrustedKeys: trustedKeys})
+}
+
+// AddPluginRepositoryTrustedKeys adds the keys that are not trusted yet to
+// the trusted keys of the repository with the given name. The name is
+// matched case insensitively.
+func (config *Config) AddPluginRepositoryTrustedKeys(name string, trustedKeys []string) {
+	for i, repo := range config.ConfigFile.PluginRepositories {
+		if !strings.EqualFold(repo.Name, name) {
+			continue
+		}
+
+		trusted := map[string]bool{}
+		for _, trustedKey := range repo.TrustedKeys {
+			trusted[trustedKey] = true
+		}
+
+		for _, trustedKey := range trustedKeys {
+			if !trusted[trustedKey] {
+				trusted[trustedKey] = true
+				repo.TrustedKeys = append(repo.TrustedKeys, trustedKey)
+			}
+		}
+		config.ConfigFile.PluginRepositories[i] = repo
+	}
 }
 
 // PluginRepositories returns the currently configured plugin repositories from the
@@ -40,8 +40,27 @@ var _ = Describe("PluginRepository", func() {
 				},
 			}
 
-			config.AddPluginRepository("some-repo", "some-URL")
-			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL"}))
+			config.AddPluginRepository("some-repo", "some-URL", []string{"some-key"})
+			Expect(config.PluginRepositories()).To(ContainElement(PluginRepository{Name: "some-repo", URL: "some-URL", TrustedKeys: []string{"some-key"}}))
+		})
+	})
+
+	Describe("AddPluginRepositoryTrustedKeys", func() {
+		It("adds the keys that are not trusted yet to the repository", func() {
+			config := Config{
+				ConfigFile: JSONConfig{
+					PluginRepositories: []PluginRepository{
+						{Name: "some-repo", URL: "some-URL", TrustedKeys: []string{"some-key"}},
+						{Name: "other-repo", URL: "other-URL"},
+					},
+				},
+			}
+
+			config.AddPluginRepositoryTrustedKeys("SOME-REPO", []string{"some-key", "another-key"})
+			Expect(config.PluginRepositories()).To(ConsistOf(
+				PluginRepository{Name: "other-repo", URL: "other-URL"},
+				PluginRepository{Name: "some-repo", URL: "some-URL", TrustedKeys: []string{"some-key", "another-key"}},
+			))
 		})
 	})
 })
@@ -0,0 +1,76 @@
+package configv3
+
+import (
+	"strconv"
+	"time"
+)
+
+// RequestRetrySettings configures how failed API requests are retried. Unset
+// values fall back to the defaults.
+type RequestRetrySettings struct {
+	Count     *int   `json:"Count,omitempty"`
+	BaseDelay string `json:"BaseDelay,omitempty"`
+	MaxDelay  string `json:"MaxDelay,omitempty"`
+}
+
+// RequestRetryCount returns the number of times a failed request is retried.
+// This is based off of:
+//   1. The $CF_RETRY_COUNT environment variable if set
+//   2. The RequestRetry.Count in the config file if set
+//   3. Defaults to DefaultRetryCount
+func (config *Config) RequestRetryCount() int {
+	if config.ENV.CFRetryCount != "" {
+		envVal, err := strconv.Atoi(config.ENV.CFRetryCount)
+		if err == nil && envVal >= 0 {
+			return envVal
+		}
+	}
+
+	if settings := config.ConfigFile.RequestRetry; settings != nil && settings.Count != nil && *settings.Count >= 0 {
+		return *settings.Count
+	}
+
+	return DefaultRetryCount
+}
+
+// RequestRetryBaseDelay returns the delay before the first retry of a failed
+// request; later retries back off exponentially. This is based off of:
+//   1. The $CF_RETRY_BASE_DELAY environment variable if set (e.g. "250ms")
+//   2. The RequestRetry.BaseDelay in the config file if set
+//   3. Defaults to DefaultRetryBaseDelay
+func (config *Config) RequestRetryBaseDelay() time.Duration {
+	var configVal string
+	if config.ConfigFile.RequestRetry != nil {
+		configVal = config.ConfigFile.RequestRetry.BaseDelay
+	}
+	return retryDelay(config.ENV.CFRetryBaseDelay, configVal, DefaultRetryBaseDelay)
+}
+
+// RequestRetryMaxDelay returns the maximum delay between retries of a failed
+// request. This is based off of:
+//   1. The $CF_RETRY_MAX_DELAY environment variable if set (e.g. "1m")
+//   2. The RequestRetry.MaxDelay in the config file if set
+//   3. Defaults to DefaultRetryMaxDelay
+func (config *Config) RequestRetryMaxDelay() time.Duration {
+	var configVal string
+	if config.ConfigFile.RequestRetry != nil {
+		configVal = config.ConfigFile.RequestRetry.MaxDelay
+	}
+	return retryDelay(config.ENV.CFRetryMaxDelay, configVal, DefaultRetryMaxDelay)
+}
+
+// retryDelay returns the first of envVal and configVal that parses as a
+// non-negative duration, or defaultVal.
+func retryDelay(envVal string, configVal string, defaultVal time.Duration) time.Duration {
+	for _, val := range []string{envVal, configVal} {
+		if val == "" {
+			continue
+		}
+		delay, err := time.ParseDuration(val)
+		if err == nil && delay >= 0 {
+			return delay
+		}
+	}
+
+	return defaultVal
+}
@@ -0,0 +1,69 @@
+package configv3_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RequestRetry", func() {
+	var config *Config
+
+	BeforeEach(func() {
+		config = &Config{}
+	})
+
+	When("nothing is configured", func() {
+		It("returns the defaults", func() {
+			Expect(config.RequestRetryCount()).To(Equal(DefaultRetryCount))
+			Expect(config.RequestRetryBaseDelay()).To(Equal(DefaultRetryBaseDelay))
+			Expect(config.RequestRetryMaxDelay()).To(Equal(DefaultRetryMaxDelay))
+		})
+	})
+
+	When("the config file sets the retry settings", func() {
+		BeforeEach(func() {
+			count := 0
+			config.ConfigFile.RequestRetry = &RequestRetrySettings{
+				Count:     &count,
+				BaseDelay: "100ms",
+				MaxDelay:  "5s",
+			}
+		})
+
+		It("returns the config file values", func() {
+			Expect(config.RequestRetryCount()).To(Equal(0))
+			Expect(config.RequestRetryBaseDelay()).To(Equal(100 * time.Millisecond))
+			Expect(config.RequestRetryMaxDelay()).To(Equal(5 * time.Second))
+		})
+
+		When("the environment sets the retry settings", func() {
+			BeforeEach(func() {
+				config.ENV.CFRetryCount = "7"
+				config.ENV.CFRetryBaseDelay = "2s"
+				config.ENV.CFRetryMaxDelay = "1m"
+			})
+
+			It("prefers the environment", func() {
+				Expect(config.RequestRetryCount()).To(Equal(7))
+				Expect(config.RequestRetryBaseDelay()).To(Equal(2 * time.Second))
+				Expect(config.RequestRetryMaxDelay()).To(Equal(time.Minute))
+			})
+		})
+
+		When("the environment values are invalid", func() {
+			BeforeEach(func() {
+				config.ENV.CFRetryCount = "-1"
+				config.ENV.CFRetryBaseDelay = "soon"
+			})
+
+			It("falls back to the config file", func() {
+				Expect(config.RequestRetryCount()).To(Equal(0))
+				Expect(config.RequestRetryBaseDelay()).To(Equal(100 * time.Millisecond))
+			})
+		})
+	})
+})
@@ -0,0 +1,10 @@
+package configv3
+
+import "path/filepath"
+
+// ResourceCacheFilePath returns the location of the file used to cache the
+// SHA1s of app files between pushes. It lives alongside the config file in
+// the CF home directory.
+func (config *Config) ResourceCacheFilePath() string {
+	return filepath.Join(configDirectory(), "resource-cache.json")
+}
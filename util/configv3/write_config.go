@@ -11,8 +11,22 @@ import (
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// When a credential store is configured, the credentials are saved to it
+// instead of config.json. A context selected with the --context flag does not
+// become the current context.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	configFile := c.ConfigFile
+	if c.contextOverride != nil {
+		configFile.switchContext(c.contextOverride.previous)
+	}
+
+	err := c.storeCredentials(&configFile)
+	if err != nil {
+		return err
+	}
+
+	rawConfig, err := json.MarshalIndent(configFile, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -32,6 +32,7 @@ type Application struct {
 	NoHostname      bool
 	NoRoute         bool
 	Path            string
+	Processes       []Process
 	RandomRoute     bool
 	Routes          []string
 	RoutePath       string
@@ -74,6 +75,10 @@ func (app Application) MarshalYAML() (interface{}, error) {
 		m.Routes = append(m.Routes, rawManifestRoute{Route: route})
 	}
 
+	for _, process := range app.Processes {
+		m.Processes = append(m.Processes, process.rawManifestProcess())
+	}
+
 	return m, nil
 }
 
@@ -147,6 +152,14 @@ func (app *Application) UnmarshalYAML(unmarshaller func(interface{}) error) erro
 		app.Routes = append(app.Routes, route.Route)
 	}
 
+	for _, rawProcess := range m.Processes {
+		process, fmtErr := newProcess(rawProcess)
+		if fmtErr != nil {
+			return fmtErr
+		}
+		app.Processes = append(app.Processes, process)
+	}
+
 	// "null" values are identical to non-existant values in YAML. In order to
 	// detect if an explicit null is given, a manual existance check is required.
 	exists := map[string]interface{}{}
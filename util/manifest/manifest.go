@@ -89,7 +89,13 @@ func ReadAndInterpolateManifest(pathToManifest string, pathsToVarsFiles []string
 // WriteApplicationManifest writes the provided application to the given
 // filepath. If the filepath does not exist, it will create it.
 func WriteApplicationManifest(application Application, filePath string) error {
-	manifest := Manifest{Applications: []Application{application}}
+	return WriteApplicationsManifest([]Application{application}, filePath)
+}
+
+// WriteApplicationsManifest writes a single manifest containing all of the
+// provided applications to filePath.
+func WriteApplicationsManifest(applications []Application, filePath string) error {
+	manifest := Manifest{Applications: applications}
 	manifestBytes, err := yaml.Marshal(manifest)
 	if err != nil {
 		return ManifestCreationError{Err: err}
@@ -724,4 +724,78 @@ applications:
 			})
 		})
 	})
+
+	Describe("WriteApplicationsManifest", func() {
+		var (
+			applications []Application
+			tmpDir       string
+			filePath     string
+
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			var err error
+			tmpDir, err = ioutil.TempDir("", "manifest-test-")
+			Expect(err).NotTo(HaveOccurred())
+			filePath = filepath.Join(tmpDir, "manifest.yml")
+
+			applications = []Application{
+				{
+					Name: "app-1",
+					Processes: []Process{
+						{
+							Type:    "worker",
+							Command: "some-worker-command",
+							Instances: types.NullInt{
+								Value: 2,
+								IsSet: true,
+							},
+							Memory: types.NullByteSizeInMb{
+								Value: 256,
+								IsSet: true,
+							},
+							HealthCheckType: "process",
+						},
+					},
+				},
+				{
+					Name: "app-2",
+				},
+			}
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpDir)
+		})
+
+		JustBeforeEach(func() {
+			executeErr = WriteApplicationsManifest(applications, filePath)
+		})
+
+		It("writes all of the applications, including their processes, to the manifest", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			manifestBytes, err := ioutil.ReadFile(filePath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(manifestBytes)).To(Equal(`applications:
+- name: app-1
+  processes:
+  - type: worker
+    command: some-worker-command
+    health-check-type: process
+    instances: 2
+    memory: 256M
+- name: app-2
+`))
+		})
+
+		It("can be read back in", func() {
+			Expect(executeErr).NotTo(HaveOccurred())
+			readApplications, err := ReadAndInterpolateManifest(filePath, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(readApplications).To(HaveLen(2))
+			Expect(readApplications[0].Processes).To(Equal(applications[0].Processes))
+			Expect(readApplications[1].Name).To(Equal("app-2"))
+		})
+	})
 })
@@ -0,0 +1,57 @@
+package manifest
+
+import "code.cloudfoundry.org/cli/types"
+
+// Process is a non-web process of an application. The top-level attributes
+// of an Application describe its web process.
+type Process struct {
+	Type                         string
+	Command                      string
+	DiskQuota                    types.NullByteSizeInMb
+	HealthCheckHTTPEndpoint      string
+	HealthCheckInvocationTimeout int
+	HealthCheckType              string
+	Instances                    types.NullInt
+	Memory                       types.NullByteSizeInMb
+}
+
+func newProcess(raw rawManifestProcess) (Process, error) {
+	process := Process{
+		Type:                         raw.Type,
+		Command:                      raw.Command,
+		HealthCheckHTTPEndpoint:      raw.HealthCheckHTTPEndpoint,
+		HealthCheckInvocationTimeout: raw.HealthCheckInvocationTimeout,
+		HealthCheckType:              raw.HealthCheckType,
+	}
+
+	process.Instances.ParseIntValue(raw.Instances)
+
+	if err := process.DiskQuota.ParseStringValue(raw.DiskQuota); err != nil {
+		return Process{}, err
+	}
+
+	if err := process.Memory.ParseStringValue(raw.Memory); err != nil {
+		return Process{}, err
+	}
+
+	return process, nil
+}
+
+func (process Process) rawManifestProcess() rawManifestProcess {
+	raw := rawManifestProcess{
+		Type:                         process.Type,
+		Command:                      process.Command,
+		DiskQuota:                    process.DiskQuota.String(),
+		HealthCheckHTTPEndpoint:      process.HealthCheckHTTPEndpoint,
+		HealthCheckInvocationTimeout: process.HealthCheckInvocationTimeout,
+		HealthCheckType:              process.HealthCheckType,
+		Memory:                       process.Memory.String(),
+	}
+
+	if process.Instances.IsSet {
+		instances := process.Instances.Value
+		raw.Instances = &instances
+	}
+
+	return raw
+}
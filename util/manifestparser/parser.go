@@ -2,13 +2,24 @@ package manifestparser
 
 import (
 	"errors"
+	"fmt"
 	"io/ioutil"
 
 	yaml "gopkg.in/yaml.v2"
 )
 
 type Application struct {
-	Name string `yaml:"name"`
+	Name     string    `yaml:"name"`
+	Sidecars []Sidecar `yaml:"sidecars,omitempty"`
+}
+
+// Sidecar is an additional command run in the same container as the given
+// process types of an application.
+type Sidecar struct {
+	Name         string   `yaml:"name"`
+	Command      string   `yaml:"command"`
+	ProcessTypes []string `yaml:"process_types"`
+	Memory       string   `yaml:"memory,omitempty"`
 }
 
 type Parser struct {
@@ -49,6 +60,17 @@ func (parser *Parser) Parse(manifestPath string) error {
 		if application.Name == "" {
 			return errors.New("Found an application with no name specified")
 		}
+
+		for _, sidecar := range application.Sidecars {
+			switch {
+			case sidecar.Name == "":
+				return fmt.Errorf("Found a sidecar with no name specified for application '%s'", application.Name)
+			case sidecar.Command == "":
+				return fmt.Errorf("Sidecar '%s' for application '%s' must specify a command", sidecar.Name, application.Name)
+			case len(sidecar.ProcessTypes) == 0:
+				return fmt.Errorf("Sidecar '%s' for application '%s' must specify at least one process type", sidecar.Name, application.Name)
+			}
+		}
 	}
 
 	return nil
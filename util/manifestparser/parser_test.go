@@ -65,6 +65,71 @@ var _ = Describe("Parser", func() {
 			})
 		})
 
+		When("the manifest contains sidecars", func() {
+			BeforeEach(func() {
+				manifest = map[string]interface{}{
+					"applications": []map[string]interface{}{
+						{
+							"name": "app-1",
+							"sidecars": []map[string]interface{}{
+								{
+									"name":          "log-shipper",
+									"command":       "./ship-logs",
+									"process_types": []string{"web", "worker"},
+									"memory":        "300M",
+								},
+							},
+						},
+					},
+				}
+			})
+
+			It("parses the sidecars", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(parser.Applications).To(ConsistOf(Application{
+					Name: "app-1",
+					Sidecars: []Sidecar{
+						{
+							Name:         "log-shipper",
+							Command:      "./ship-logs",
+							ProcessTypes: []string{"web", "worker"},
+							Memory:       "300M",
+						},
+					},
+				}))
+			})
+
+			When("a sidecar has no name", func() {
+				BeforeEach(func() {
+					manifest["applications"].([]map[string]interface{})[0]["sidecars"].([]map[string]interface{})[0]["name"] = ""
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Found a sidecar with no name specified for application 'app-1'"))
+				})
+			})
+
+			When("a sidecar has no command", func() {
+				BeforeEach(func() {
+					delete(manifest["applications"].([]map[string]interface{})[0]["sidecars"].([]map[string]interface{})[0], "command")
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Sidecar 'log-shipper' for application 'app-1' must specify a command"))
+				})
+			})
+
+			When("a sidecar has no process types", func() {
+				BeforeEach(func() {
+					delete(manifest["applications"].([]map[string]interface{})[0]["sidecars"].([]map[string]interface{})[0], "process_types")
+				})
+
+				It("returns an error", func() {
+					Expect(executeErr).To(MatchError("Sidecar 'log-shipper' for application 'app-1' must specify at least one process type"))
+				})
+			})
+		})
+
 		When("given an invalid manifest file", func() {
 			BeforeEach(func() {
 				manifest = map[string]interface{}{}
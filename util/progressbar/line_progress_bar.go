@@ -0,0 +1,58 @@
+package progressbar
+
+import (
+	"io"
+	"sync"
+)
+
+// LineProgressBar reports the progress of an upload in steps of 25 percent
+// instead of drawing a bar, so that the progress of several uploads can be
+// displayed at the same time.
+type LineProgressBar struct {
+	report func(percent int)
+}
+
+// NewLineProgressBar returns a LineProgressBar that calls report every time
+// another 25 percent of a file has been read.
+func NewLineProgressBar(report func(percent int)) *LineProgressBar {
+	return &LineProgressBar{
+		report: report,
+	}
+}
+
+func (p *LineProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	return &lineProgressReader{
+		reader: reader,
+		size:   sizeOfFile,
+		report: p.report,
+	}
+}
+
+type lineProgressReader struct {
+	reader io.Reader
+	size   int64
+	report func(percent int)
+
+	lock     sync.Mutex
+	read     int64
+	reported int
+}
+
+func (r *lineProgressReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.read += int64(n)
+	percent := 100
+	if r.size > 0 && r.read < r.size {
+		percent = int(r.read * 100 / r.size)
+	}
+	if step := percent / 25 * 25; step > r.reported {
+		r.reported = step
+		r.report(step)
+	}
+
+	return n, err
+}
@@ -0,0 +1,49 @@
+package progressbar_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/progressbar"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LineProgressBar", func() {
+	var (
+		reported    []int
+		progressBar *LineProgressBar
+	)
+
+	BeforeEach(func() {
+		reported = nil
+		progressBar = NewLineProgressBar(func(percent int) {
+			reported = append(reported, percent)
+		})
+	})
+
+	It("reports the progress in steps of 25 percent", func() {
+		content := strings.Repeat("a", 100)
+		reader := progressBar.NewProgressBarWrapper(strings.NewReader(content), int64(len(content)))
+
+		buffer := make([]byte, 10)
+		var read []byte
+		for {
+			n, err := reader.Read(buffer)
+			read = append(read, buffer[:n]...)
+			if err != nil {
+				break
+			}
+		}
+
+		Expect(string(read)).To(Equal(content))
+		Expect(reported).To(Equal([]int{25, 50, 75, 100}))
+	})
+
+	It("reports completion once for an empty file", func() {
+		reader := progressBar.NewProgressBarWrapper(strings.NewReader(""), 0)
+		_, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(reported).To(Equal([]int{100}))
+	})
+})
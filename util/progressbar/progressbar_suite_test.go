@@ -0,0 +1,13 @@
+package progressbar_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestProgressBar(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Progress Bar Suite")
+}
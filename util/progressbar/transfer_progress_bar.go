@@ -0,0 +1,38 @@
+package progressbar
+
+import (
+	"io"
+
+	pb "gopkg.in/cheggaaa/pb.v1"
+)
+
+// TransferProgressBar draws a progress bar as soon as a reader is wrapped,
+// unlike ProgressBar which waits for the command to be Ready. It is meant for
+// transfers that are started directly by a command rather than from an event
+// stream.
+type TransferProgressBar struct {
+	output io.Writer
+	bar    *pb.ProgressBar
+}
+
+// NewTransferProgressBar returns a TransferProgressBar that draws to output.
+func NewTransferProgressBar(output io.Writer) *TransferProgressBar {
+	return &TransferProgressBar{
+		output: output,
+	}
+}
+
+func (p *TransferProgressBar) NewProgressBarWrapper(reader io.Reader, sizeOfFile int64) io.Reader {
+	p.bar = pb.New64(sizeOfFile).SetUnits(pb.U_BYTES)
+	p.bar.Output = p.output
+	p.bar.ShowTimeLeft = false
+	p.bar.Start()
+	return p.bar.NewProxyReader(reader)
+}
+
+// Complete stops drawing the progress bar, if one was started.
+func (p *TransferProgressBar) Complete() {
+	if p.bar != nil {
+		p.bar.Finish()
+	}
+}
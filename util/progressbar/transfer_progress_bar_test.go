@@ -0,0 +1,42 @@
+package progressbar_test
+
+import (
+	"io/ioutil"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/progressbar"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("TransferProgressBar", func() {
+	var (
+		output      *Buffer
+		progressBar *TransferProgressBar
+	)
+
+	BeforeEach(func() {
+		output = NewBuffer()
+		progressBar = NewTransferProgressBar(output)
+	})
+
+	It("passes the content through and draws the progress to the output", func() {
+		content := strings.Repeat("a", 100)
+		reader := progressBar.NewProgressBarWrapper(strings.NewReader(content), int64(len(content)))
+
+		read, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(read)).To(Equal(content))
+
+		progressBar.Complete()
+		Expect(output).To(Say("100.00%"))
+	})
+
+	When("no reader was wrapped", func() {
+		It("completes without drawing anything", func() {
+			progressBar.Complete()
+			Expect(output.Contents()).To(BeEmpty())
+		})
+	})
+})
@@ -10,6 +10,8 @@ type Config interface {
 	ColorEnabled() configv3.ColorSetting
 	// Locale is the language to translate the output to
 	Locale() string
+	// OutputFormat is the format in which command results are displayed
+	OutputFormat() configv3.OutputFormat
 	// IsTTY returns true when the ui has a TTY
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
@@ -1,7 +1,9 @@
 package ui
 
 import (
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"strings"
 	"time"
 
@@ -11,6 +13,16 @@ import (
 // LogTimestampFormat is the timestamp formatting for log lines.
 const LogTimestampFormat = "2006-01-02T15:04:05.00-0700"
 
+// appNameColors are the colors used to tell the log lines of different
+// applications apart.
+var appNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgGreen,
+	color.FgBlue,
+}
+
 //go:generate counterfeiter . LogMessage
 
 // LogMessage is a log response representing one to many joined lines of a log
@@ -25,9 +37,22 @@ type LogMessage interface {
 
 // DisplayLogMessage formats and outputs a given log message.
 func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
+	ui.DisplayLogMessageWithAppName("", message, displayHeader)
+}
+
+// DisplayLogMessageWithAppName formats and outputs a given log message,
+// prefixing every line with the application name. Each application name is
+// always displayed in the same color. If appName is empty, no prefix is
+// displayed.
+func (ui *UI) DisplayLogMessageWithAppName(appName string, message LogMessage, displayHeader bool) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
+	var prefix string
+	if appName != "" {
+		prefix = fmt.Sprintf("%s ", ui.modifyColor(appName, color.New(appNameColor(appName), color.Bold)))
+	}
+
 	var header string
 	if displayHeader {
 		time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
@@ -45,6 +70,44 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "   %s\n", logLine)
+		fmt.Fprintf(ui.Out, "   %s%s\n", prefix, logLine)
 	}
 }
+
+// DisplayLogMessageJSON outputs a given log message as a JSON object on a
+// single line, so that a stream of log messages can be processed line by line.
+// If appName is empty, the "app" field is omitted.
+func (ui *UI) DisplayLogMessageJSON(appName string, message LogMessage) error {
+	document, err := json.Marshal(struct {
+		App            string    `json:"app,omitempty"`
+		Timestamp      time.Time `json:"timestamp"`
+		SourceType     string    `json:"source_type"`
+		SourceInstance string    `json:"source_instance"`
+		Type           string    `json:"type"`
+		Message        string    `json:"message"`
+	}{
+		App:            appName,
+		Timestamp:      message.Timestamp().In(ui.TimezoneLocation),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprintf(ui.Out, "%s\n", document)
+	return err
+}
+
+// appNameColor picks a color for the given application name, so that an
+// application keeps its color across lines and invocations.
+func appNameColor(appName string) color.Attribute {
+	hash := fnv.New32a()
+	_, _ = hash.Write([]byte(appName))
+	return appNameColors[hash.Sum32()%uint32(len(appNameColors))]
+}
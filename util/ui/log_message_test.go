@@ -1,6 +1,7 @@
 package ui_test
 
 import (
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -115,4 +116,67 @@ var _ = Describe("Log Message", func() {
 			})
 		})
 	})
+
+	Describe("DisplayLogMessageWithAppName", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			ui.TimezoneLocation = time.UTC
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prefixes every line with the colored application name", func() {
+			ui.DisplayLogMessageWithAppName("some-app", message, true)
+			Expect(out).To(Say(`\x1b\[\d+;1msome-app\x1b\[0m 2016-07-19T23:08:12.00\+0000 \[APP/PROC/WEB/12\] OUT This is a log message\n`))
+			Expect(out).To(Say(`\x1b\[\d+;1msome-app\x1b\[0m 2016-07-19T23:08:12.00\+0000 \[APP/PROC/WEB/12\] OUT This is also a log message\n`))
+		})
+
+		It("always displays an application name in the same color", func() {
+			ui.DisplayLogMessageWithAppName("some-app", message, false)
+			ui.DisplayLogMessageWithAppName("some-app", message, false)
+			lines := strings.Split(string(out.Contents()), "\n")
+			Expect(lines[0]).To(HavePrefix("   \x1b["))
+			Expect(lines[2][:10]).To(Equal(lines[0][:10]))
+		})
+
+		When("the application name is empty", func() {
+			It("displays the log message without a prefix", func() {
+				ui.DisplayLogMessageWithAppName("", message, false)
+				Expect(out).To(Say("   This is a log message\n"))
+			})
+		})
+	})
+
+	Describe("DisplayLogMessageJSON", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			ui.TimezoneLocation = time.UTC
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a \"log\" message\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prints the message as a JSON object on a single line", func() {
+			Expect(ui.DisplayLogMessageJSON("", message)).To(Succeed())
+			Expect(string(out.Contents())).To(Equal(`{"timestamp":"2016-07-19T23:08:12Z","source_type":"APP/PROC/WEB","source_instance":"12","type":"ERR","message":"This is a \"log\" message"}` + "\n"))
+		})
+
+		When("an application name is given", func() {
+			It("includes the application name", func() {
+				Expect(ui.DisplayLogMessageJSON("some-app", message)).To(Succeed())
+				Expect(string(out.Contents())).To(HavePrefix(`{"app":"some-app","timestamp":"2016-07-19T23:08:12Z",`))
+			})
+		})
+	})
 })
@@ -0,0 +1,38 @@
+package ui
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	yaml "gopkg.in/yaml.v2"
+)
+
+// DisplayRecord outputs the record as a JSON or YAML document to ui.Out when
+// one of those output formats is configured. The record is not translated so
+// that the document remains stable across locales. When displaying text and
+// tables, DisplayRecord does nothing.
+func (ui *UI) DisplayRecord(record interface{}) error {
+	var (
+		document []byte
+		err      error
+	)
+
+	switch ui.OutputFormat {
+	case configv3.OutputFormatJSON:
+		document, err = json.MarshalIndent(record, "", "  ")
+		document = append(document, '\n')
+	case configv3.OutputFormatYAML:
+		document, err = yaml.Marshal(record)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = ui.Out.Write(document)
+	return err
+}
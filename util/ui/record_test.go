@@ -0,0 +1,138 @@
+package ui_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Record", func() {
+	type nestedRecord struct {
+		Instances int `json:"instances" yaml:"instances"`
+	}
+
+	type someRecord struct {
+		Name      string         `json:"name" yaml:"name"`
+		Processes []nestedRecord `json:"processes" yaml:"processes"`
+	}
+
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		out        *Buffer
+		errBuff    *Buffer
+		record     someRecord
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		record = someRecord{
+			Name:      "some-app",
+			Processes: []nestedRecord{{Instances: 2}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		out = NewBuffer()
+		ui.Out = out
+		ui.OutForInteration = out
+		errBuff = NewBuffer()
+		ui.Err = errBuff
+	})
+
+	Describe("DisplayRecord", func() {
+		When("the output format is json", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+			})
+
+			It("displays the record as an indented JSON document", func() {
+				err := ui.DisplayRecord(record)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal(`{
+  "name": "some-app",
+  "processes": [
+    {
+      "instances": 2
+    }
+  ]
+}
+`))
+			})
+
+			When("the record cannot be marshalled", func() {
+				It("returns the error", func() {
+					err := ui.DisplayRecord(map[string]interface{}{"some-key": func() {}})
+					Expect(err).To(HaveOccurred())
+					Expect(out.Contents()).To(BeEmpty())
+				})
+			})
+		})
+
+		When("the output format is yaml", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatYAML)
+			})
+
+			It("displays the record as a YAML document", func() {
+				err := ui.DisplayRecord(record)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(out.Contents())).To(Equal(`name: some-app
+processes:
+- instances: 2
+`))
+			})
+		})
+
+		When("the output format is table", func() {
+			BeforeEach(func() {
+				fakeConfig.OutputFormatReturns(configv3.OutputFormatTable)
+			})
+
+			It("does not display anything", func() {
+				err := ui.DisplayRecord(record)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(out.Contents()).To(BeEmpty())
+			})
+		})
+	})
+
+	When("a structured output format is configured", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns(configv3.OutputFormatJSON)
+		})
+
+		It("does not display text and tables to ui.Out", func() {
+			ui.DisplayHeader("some-header")
+			ui.DisplayText("some-text")
+			ui.DisplayTextWithBold("some-bold-text")
+			ui.DisplayTextWithFlavor("some-flavored-text")
+			ui.DisplayNewline()
+			ui.DisplayOK()
+			ui.DisplayKeyValueTable("", [][]string{{"key:", "value"}}, 3)
+			ui.DisplayTableWithHeader("", [][]string{{"header"}, {"row"}}, 3)
+
+			Expect(out.Contents()).To(BeEmpty())
+		})
+
+		It("displays errors and warnings to ui.Err without FAILED", func() {
+			ui.DisplayWarning("some-warning")
+			ui.DisplayError(errors.New("some-error"))
+
+			Expect(errBuff).To(Say("some-warning"))
+			Expect(errBuff).To(Say("some-error"))
+			Expect(out.Contents()).To(BeEmpty())
+		})
+	})
+})
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"path/filepath"
 	"regexp"
@@ -15,19 +16,31 @@ type RequestLoggerFileWriter struct {
 	filePaths     []string
 	logFiles      []*os.File
 	dumpSanitizer *regexp.Regexp
+	har           *harRecorder
 }
 
 func newRequestLoggerFileWriter(ui *UI, lock *sync.Mutex, filePaths []string) *RequestLoggerFileWriter {
+	var logFilePaths, harFilePaths []string
+	for _, filePath := range filePaths {
+		if isHARFile(filePath) {
+			harFilePaths = append(harFilePaths, filePath)
+		} else {
+			logFilePaths = append(logFilePaths, filePath)
+		}
+	}
+
 	return &RequestLoggerFileWriter{
 		ui:            ui,
 		lock:          lock,
-		filePaths:     filePaths,
+		filePaths:     logFilePaths,
 		logFiles:      []*os.File{},
 		dumpSanitizer: regexp.MustCompile(tokenRegexp),
+		har:           &harRecorder{filePaths: harFilePaths},
 	}
 }
 
 func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
+	display.har.bodyText(RedactedValue)
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(RedactedValue)
 		if err != nil {
@@ -39,6 +52,7 @@ func (display *RequestLoggerFileWriter) DisplayBody([]byte) error {
 
 func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
 	sanitized := display.dumpSanitizer.ReplaceAllString(dump, RedactedValue)
+	display.har.dump(sanitized)
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(sanitized)
 		if err != nil {
@@ -49,11 +63,13 @@ func (display *RequestLoggerFileWriter) DisplayDump(dump string) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayHeader(name string, value string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s: %s", name, value))
+	display.har.header(name, value)
+	return display.writeLine(fmt.Sprintf("%s: %s", name, value))
 }
 
 func (display *RequestLoggerFileWriter) DisplayHost(name string) error {
-	return display.DisplayMessage(fmt.Sprintf("Host: %s", name))
+	display.har.host(name)
+	return display.writeLine(fmt.Sprintf("Host: %s", name))
 }
 
 func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
@@ -66,6 +82,7 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 		return display.DisplayMessage(string(body))
 	}
 
+	display.har.bodyText(string(sanitized))
 	for _, logFile := range display.logFiles {
 		_, err = logFile.Write(sanitized)
 		if err != nil {
@@ -76,6 +93,11 @@ func (display *RequestLoggerFileWriter) DisplayJSONBody(body []byte) error {
 }
 
 func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
+	display.har.bodyText(msg)
+	return display.writeLine(msg)
+}
+
+func (display *RequestLoggerFileWriter) writeLine(msg string) error {
 	for _, logFile := range display.logFiles {
 		_, err := logFile.WriteString(fmt.Sprintf("%s\n", msg))
 		if err != nil {
@@ -85,24 +107,48 @@ func (display *RequestLoggerFileWriter) DisplayMessage(msg string) error {
 	return nil
 }
 
+// DisplayRequestError writes the error of a request that failed without a
+// response.
+func (display *RequestLoggerFileWriter) DisplayRequestError(err error) error {
+	sanitized := display.dumpSanitizer.ReplaceAllString(err.Error(), RedactedValue)
+	display.har.requestError(sanitized)
+	return display.writeLine(sanitized)
+}
+
 func (display *RequestLoggerFileWriter) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s %s %s", method, uri, httpProtocol))
+	display.har.requestLine(method, uri, httpProtocol)
+	return display.writeLine(fmt.Sprintf("%s %s %s", method, uri, httpProtocol))
 }
 
 func (display *RequestLoggerFileWriter) DisplayResponseHeader(httpProtocol string, status string) error {
-	return display.DisplayMessage(fmt.Sprintf("%s %s", httpProtocol, status))
+	display.har.responseLine(httpProtocol, status)
+	return display.writeLine(fmt.Sprintf("%s %s", httpProtocol, status))
 }
 
 func (display *RequestLoggerFileWriter) DisplayType(name string, requestDate time.Time) error {
-	return display.DisplayMessage(fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339)))
+	display.har.startSection(name, requestDate)
+	return display.writeLine(fmt.Sprintf("%s: [%s]", name, requestDate.Format(time.RFC3339)))
 }
 
 func (display *RequestLoggerFileWriter) HandleInternalError(err error) {
 	display.ui.DisplayWarning(err.Error())
 }
 
+// SetRequest identifies the request that is displayed until Stop, so that
+// responses are recorded together with their own requests.
+func (display *RequestLoggerFileWriter) SetRequest(request *http.Request) {
+	display.har.setRequest(request)
+}
+
 func (display *RequestLoggerFileWriter) Start() error {
 	display.lock.Lock()
+	for _, filePath := range display.har.filePaths {
+		err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
+		if err != nil {
+			return err
+		}
+	}
+
 	for _, filePath := range display.filePaths {
 		err := os.MkdirAll(filepath.Dir(filePath), os.ModeDir|os.ModePerm)
 		if err != nil {
@@ -133,12 +179,18 @@ func (display *RequestLoggerFileWriter) Stop() error {
 		}
 	}
 	display.logFiles = []*os.File{}
+
+	harErr := display.har.finishSection()
+	if err == nil {
+		err = harErr
+	}
 	display.lock.Unlock()
 	return err
 }
 
 // RequestLoggerFileWriter returns a RequestLoggerFileWriter that cannot
-// overwrite another RequestLoggerFileWriter.
+// overwrite another RequestLoggerFileWriter. File paths with a .har extension
+// are written as an HTTP Archive (HAR) instead of a plain text log.
 func (ui *UI) RequestLoggerFileWriter(filePaths []string) *RequestLoggerFileWriter {
 	return newRequestLoggerFileWriter(ui, ui.fileLock, filePaths)
 }
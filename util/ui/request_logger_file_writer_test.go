@@ -1,9 +1,11 @@
 package ui_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"path/filepath"
 	"strings"
@@ -318,6 +320,234 @@ Origin: wss://doppler.bosh-lite.com:443`
 		})
 	})
 
+	Describe("HAR file paths", func() {
+		var (
+			harFile    string
+			passedTime time.Time
+			request    *http.Request
+		)
+
+		readEntries := func() []interface{} {
+			contents, err := ioutil.ReadFile(harFile)
+			Expect(err).ToNot(HaveOccurred())
+
+			var archive map[string]interface{}
+			Expect(json.Unmarshal(contents, &archive)).To(Succeed())
+			log := archive["log"].(map[string]interface{})
+			Expect(log["version"]).To(Equal("1.2"))
+			Expect(log["creator"]).To(HaveKeyWithValue("name", "cf"))
+			return log["entries"].([]interface{})
+		}
+
+		displayRequest := func(request *http.Request, uri string) {
+			Expect(display.Start()).To(Succeed())
+			display.SetRequest(request)
+			Expect(display.DisplayType("REQUEST", passedTime)).To(Succeed())
+			Expect(display.DisplayRequestHeader("POST", uri, "HTTP/1.1")).To(Succeed())
+			Expect(display.DisplayHost("api.example.com")).To(Succeed())
+			Expect(display.DisplayHeader("Authorization", RedactedValue)).To(Succeed())
+			Expect(display.DisplayHeader("Content-Type", "application/json")).To(Succeed())
+			Expect(display.DisplayJSONBody([]byte(`{"name":"banana","password":"secret"}`))).To(Succeed())
+			Expect(display.Stop()).To(Succeed())
+		}
+
+		displayResponse := func(request *http.Request, status string, date time.Time) {
+			Expect(display.Start()).To(Succeed())
+			display.SetRequest(request)
+			Expect(display.DisplayType("RESPONSE", date)).To(Succeed())
+			Expect(display.DisplayResponseHeader("HTTP/1.1", status)).To(Succeed())
+			Expect(display.DisplayHeader("Content-Type", "application/json;charset=utf-8")).To(Succeed())
+			Expect(display.DisplayJSONBody([]byte(`{"access_token":"some-token"}`))).To(Succeed())
+			Expect(display.Stop()).To(Succeed())
+		}
+
+		BeforeEach(func() {
+			var err error
+			tmpdir, err = ioutil.TempDir("", "request_logger")
+			Expect(err).ToNot(HaveOccurred())
+
+			harFile = filepath.Join(tmpdir, "sub_dir", "trace.har")
+			logFile1 = filepath.Join(tmpdir, "trace.log")
+			display = ui.RequestLoggerFileWriter([]string{harFile, logFile1})
+			passedTime = time.Date(2019, time.May, 14, 10, 30, 0, 0, time.UTC)
+			request = &http.Request{}
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(tmpdir)).NotTo(HaveOccurred())
+		})
+
+		It("records the request and response as a single sanitized entry", func() {
+			displayRequest(request, "/v2/apps?q=name:banana&password=secret")
+			displayResponse(request, "201 Created", passedTime.Add(1500*time.Millisecond))
+
+			entries := readEntries()
+			Expect(entries).To(HaveLen(1))
+
+			entry := entries[0].(map[string]interface{})
+			Expect(entry["startedDateTime"]).To(Equal("2019-05-14T10:30:00.000Z"))
+			Expect(entry["time"]).To(BeNumerically("==", 1500))
+			Expect(entry["timings"]).To(Equal(map[string]interface{}{"send": 0.0, "wait": 1500.0, "receive": 0.0}))
+
+			request := entry["request"].(map[string]interface{})
+			Expect(request["method"]).To(Equal("POST"))
+			Expect(request["url"]).To(Equal("https://api.example.com/v2/apps?q=name:banana&password=" + RedactedValue))
+			Expect(request["httpVersion"]).To(Equal("HTTP/1.1"))
+			Expect(request["headers"]).To(Equal([]interface{}{
+				map[string]interface{}{"name": "Host", "value": "api.example.com"},
+				map[string]interface{}{"name": "Authorization", "value": RedactedValue},
+				map[string]interface{}{"name": "Content-Type", "value": "application/json"},
+			}))
+			Expect(request["queryString"]).To(Equal([]interface{}{
+				map[string]interface{}{"name": "password", "value": RedactedValue},
+				map[string]interface{}{"name": "q", "value": "name:banana"},
+			}))
+			postData := request["postData"].(map[string]interface{})
+			Expect(postData["mimeType"]).To(Equal("application/json"))
+			Expect(postData["text"]).To(ContainSubstring(`"name": "banana"`))
+			Expect(postData["text"]).To(ContainSubstring(`"password": "` + RedactedValue + `"`))
+
+			response := entry["response"].(map[string]interface{})
+			Expect(response["status"]).To(BeNumerically("==", 201))
+			Expect(response["statusText"]).To(Equal("Created"))
+			Expect(response["httpVersion"]).To(Equal("HTTP/1.1"))
+			content := response["content"].(map[string]interface{})
+			Expect(content["mimeType"]).To(Equal("application/json;charset=utf-8"))
+			Expect(content["text"]).To(ContainSubstring(`"access_token": "` + RedactedValue + `"`))
+			Expect(content["text"]).ToNot(ContainSubstring("some-token"))
+		})
+
+		It("keeps writing the plain text log to the other paths", func() {
+			displayRequest(request, "/v2/apps")
+
+			contents, err := ioutil.ReadFile(logFile1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(HavePrefix(fmt.Sprintf("REQUEST: [%s]\nPOST /v2/apps", passedTime.Format(time.RFC3339))))
+			Expect(string(contents)).ToNot(ContainSubstring(`"log"`))
+		})
+
+		When("several requests are waiting for their responses", func() {
+			It("pairs each response with its own request", func() {
+				otherRequest := &http.Request{}
+				displayRequest(request, "/v2/apps")
+				displayRequest(otherRequest, "/v2/spaces")
+
+				displayResponse(otherRequest, "200 OK", passedTime.Add(time.Second))
+				displayResponse(request, "404 Not Found", passedTime.Add(2*time.Second))
+
+				entries := readEntries()
+				Expect(entries).To(HaveLen(2))
+				Expect(entries[0].(map[string]interface{})["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v2/spaces"))
+				Expect(entries[0].(map[string]interface{})["response"]).To(HaveKeyWithValue("status", 200.0))
+				Expect(entries[1].(map[string]interface{})["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v2/apps"))
+				Expect(entries[1].(map[string]interface{})["response"]).To(HaveKeyWithValue("status", 404.0))
+			})
+		})
+
+		When("the request does not receive a response", func() {
+			It("does not record the request", func() {
+				displayRequest(request, "/v2/apps")
+
+				Expect(harFile).ToNot(BeAnExistingFile())
+			})
+		})
+
+		When("the request fails without a response", func() {
+			It("records the error as a response with status 0", func() {
+				displayRequest(request, "/v2/apps")
+
+				Expect(display.Start()).To(Succeed())
+				display.SetRequest(request)
+				Expect(display.DisplayType("RESPONSE", passedTime.Add(time.Second))).To(Succeed())
+				Expect(display.DisplayRequestError(errors.New("dial tcp: connection refused"))).To(Succeed())
+				Expect(display.Stop()).To(Succeed())
+
+				entries := readEntries()
+				Expect(entries).To(HaveLen(1))
+				entry := entries[0].(map[string]interface{})
+				Expect(entry["request"]).To(HaveKeyWithValue("url", "https://api.example.com/v2/apps"))
+				response := entry["response"].(map[string]interface{})
+				Expect(response["status"]).To(BeNumerically("==", 0))
+				Expect(response["statusText"]).To(Equal("dial tcp: connection refused"))
+
+				contents, err := ioutil.ReadFile(logFile1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(ContainSubstring("dial tcp: connection refused"))
+			})
+		})
+
+		When("the HAR file already contains entries", func() {
+			It("appends the new entries without rewriting the existing ones", func() {
+				displayRequest(request, "/v2/apps")
+				displayResponse(request, "201 Created", passedTime)
+
+				contents, err := ioutil.ReadFile(harFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(HaveSuffix("\n    ]\n  }\n}\n"))
+				existingEntries := strings.TrimSuffix(string(contents), "\n    ]\n  }\n}\n")
+
+				display = ui.RequestLoggerFileWriter([]string{harFile})
+				displayRequest(request, "/v2/apps")
+				displayResponse(request, "404 Not Found", passedTime)
+
+				contents, err = ioutil.ReadFile(harFile)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(HavePrefix(existingEntries + ",\n"))
+
+				entries := readEntries()
+				Expect(entries).To(HaveLen(2))
+				Expect(entries[0].(map[string]interface{})["response"]).To(HaveKeyWithValue("status", 201.0))
+				Expect(entries[1].(map[string]interface{})["response"]).To(HaveKeyWithValue("status", 404.0))
+			})
+		})
+
+		When("the HAR file is not a valid archive", func() {
+			It("returns an error", func() {
+				Expect(os.MkdirAll(filepath.Dir(harFile), os.ModeDir|os.ModePerm)).To(Succeed())
+				Expect(ioutil.WriteFile(harFile, []byte("REQUEST: some text"), 0600)).To(Succeed())
+
+				displayRequest(request, "/v2/apps")
+
+				Expect(display.Start()).To(Succeed())
+				display.SetRequest(request)
+				Expect(display.DisplayType("RESPONSE", passedTime)).To(Succeed())
+				Expect(display.Stop()).To(MatchError(ContainSubstring("does not end with the entries of an HTTP Archive")))
+			})
+		})
+
+		Describe("websocket handshake dumps", func() {
+			It("records the handshake as an entry", func() {
+				Expect(display.Start()).To(Succeed())
+				Expect(display.DisplayType("WEBSOCKET REQUEST", passedTime)).To(Succeed())
+				Expect(display.DisplayDump("GET /apps/some-guid/stream HTTP/1.1\nHost: wss://doppler.example.com:443\nUpgrade: websocket\nAuthorization: bearer some-token\n")).To(Succeed())
+				Expect(display.Stop()).To(Succeed())
+
+				Expect(display.Start()).To(Succeed())
+				Expect(display.DisplayType("WEBSOCKET RESPONSE", passedTime.Add(time.Second))).To(Succeed())
+				Expect(display.DisplayDump("HTTP/1.1 101 Switching Protocols\nUpgrade: websocket\n")).To(Succeed())
+				Expect(display.Stop()).To(Succeed())
+
+				entries := readEntries()
+				Expect(entries).To(HaveLen(1))
+				entry := entries[0].(map[string]interface{})
+				Expect(entry["time"]).To(BeNumerically("==", 1000))
+
+				request := entry["request"].(map[string]interface{})
+				Expect(request["method"]).To(Equal("GET"))
+				Expect(request["url"]).To(Equal("wss://doppler.example.com:443/apps/some-guid/stream"))
+				Expect(request["headers"]).To(ConsistOf(
+					map[string]interface{}{"name": "Host", "value": "doppler.example.com:443"},
+					map[string]interface{}{"name": "Upgrade", "value": "websocket"},
+					map[string]interface{}{"name": "Authorization", "value": RedactedValue},
+				))
+
+				response := entry["response"].(map[string]interface{})
+				Expect(response["status"]).To(BeNumerically("==", 101))
+				Expect(response["statusText"]).To(Equal("Switching Protocols"))
+			})
+		})
+	})
+
 	Describe("when the log file path is invalid", func() {
 		var pathName string
 
@@ -0,0 +1,475 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/version"
+)
+
+const (
+	harVersion     = "1.2"
+	harCreatorName = "cf"
+	harTimeFormat  = "2006-01-02T15:04:05.000Z07:00"
+	harEntryIndent = "      "
+	harClosing     = "\n    ]\n  }\n}\n"
+
+	// harTailSize is how much of the end of an existing archive is read to
+	// find its last entry.
+	harTailSize = 64
+)
+
+// harEntriesEndRegexp matches the end of the last entry, or the opening
+// bracket of the entries, followed by the closing brackets of an archive.
+var harEntriesEndRegexp = regexp.MustCompile(`([\[}])\s*\]\s*}\s*}\s*$`)
+
+// isHARFile returns true when the trace file path should be written as an
+// HTTP Archive instead of a plain text log.
+func isHARFile(filePath string) bool {
+	return strings.EqualFold(filepath.Ext(filePath), ".har")
+}
+
+type harFile struct {
+	Log harLog `json:"log"`
+}
+
+type harLog struct {
+	Version string     `json:"version"`
+	Creator harCreator `json:"creator"`
+	Entries []harEntry `json:"entries"`
+}
+
+type harCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type harEntry struct {
+	StartedDateTime string      `json:"startedDateTime"`
+	Time            float64     `json:"time"`
+	Request         harRequest  `json:"request"`
+	Response        harResponse `json:"response"`
+	Cache           struct{}    `json:"cache"`
+	Timings         harTimings  `json:"timings"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *harPostData   `json:"postData,omitempty"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harPostData struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text"`
+}
+
+type harResponse struct {
+	Status      int            `json:"status"`
+	StatusText  string         `json:"statusText"`
+	HTTPVersion string         `json:"httpVersion"`
+	Cookies     []harNameValue `json:"cookies"`
+	Headers     []harNameValue `json:"headers"`
+	Content     harContent     `json:"content"`
+	RedirectURL string         `json:"redirectURL"`
+	HeadersSize int            `json:"headersSize"`
+	BodySize    int            `json:"bodySize"`
+}
+
+type harContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type harTimings struct {
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+}
+
+// harSection collects the information displayed between a Start and Stop of
+// the request logger.
+type harSection struct {
+	entry      *harEntry
+	date       time.Time
+	isResponse bool
+	host       string
+	uri        string
+	body       []string
+}
+
+// harPendingRequest is a request that is waiting for its response before it
+// is written to the HAR files.
+type harPendingRequest struct {
+	entry     *harEntry
+	startTime time.Time
+}
+
+// harRecorder turns the request logger display calls into HAR entries. The
+// wrappers identify the request that each section belongs to, so a response
+// is paired with its own request even when requests are made concurrently.
+// Outputs that do not identify their requests, such as the websocket debug
+// printer, pair a response with the previous request they displayed.
+type harRecorder struct {
+	filePaths []string
+	section   *harSection
+	request   *http.Request
+	pending   map[*http.Request]harPendingRequest
+}
+
+func (recorder *harRecorder) setRequest(request *http.Request) {
+	recorder.request = request
+}
+
+func (recorder *harRecorder) startSection(name string, date time.Time) {
+	recorder.section = nil
+	if len(recorder.filePaths) == 0 {
+		return
+	}
+
+	switch name {
+	case "REQUEST", "WEBSOCKET REQUEST":
+		recorder.section = &harSection{entry: newHAREntry(date), date: date}
+	case "RESPONSE", "WEBSOCKET RESPONSE":
+		recorder.section = &harSection{entry: newHAREntry(date), date: date, isResponse: true}
+	}
+}
+
+func (recorder *harRecorder) requestLine(method string, uri string, httpProtocol string) {
+	if recorder.section == nil || recorder.section.isResponse {
+		return
+	}
+	recorder.section.entry.Request.Method = method
+	recorder.section.entry.Request.HTTPVersion = httpProtocol
+	recorder.section.uri = sanitizeURL(uri)
+}
+
+func (recorder *harRecorder) responseLine(httpProtocol string, status string) {
+	if recorder.section == nil || !recorder.section.isResponse {
+		return
+	}
+	response := &recorder.section.entry.Response
+	response.HTTPVersion = httpProtocol
+
+	statusCode := strings.SplitN(status, " ", 2)
+	response.Status, _ = strconv.Atoi(statusCode[0])
+	if len(statusCode) > 1 {
+		response.StatusText = statusCode[1]
+	}
+}
+
+// requestError records a request that failed without a response. As in the
+// archives of browsers, it gets status 0, with the error as status text.
+func (recorder *harRecorder) requestError(message string) {
+	if recorder.section == nil || !recorder.section.isResponse {
+		return
+	}
+	recorder.section.entry.Response.Status = 0
+	recorder.section.entry.Response.StatusText = message
+}
+
+func (recorder *harRecorder) host(name string) {
+	if recorder.section == nil {
+		return
+	}
+	recorder.section.host = name
+
+	if hostURL, err := url.Parse(name); err == nil && hostURL.Host != "" {
+		name = hostURL.Host
+	}
+	recorder.header("Host", name)
+}
+
+func (recorder *harRecorder) header(name string, value string) {
+	if recorder.section == nil {
+		return
+	}
+	header := harNameValue{Name: name, Value: value}
+	if recorder.section.isResponse {
+		recorder.section.entry.Response.Headers = append(recorder.section.entry.Response.Headers, header)
+	} else {
+		recorder.section.entry.Request.Headers = append(recorder.section.entry.Request.Headers, header)
+	}
+}
+
+func (recorder *harRecorder) bodyText(text string) {
+	if recorder.section == nil {
+		return
+	}
+	recorder.section.body = append(recorder.section.body, text)
+}
+
+// dump records the raw HTTP dumps of the websocket handshake. Dumps outside
+// of a websocket handshake are treated as body content.
+func (recorder *harRecorder) dump(dump string) {
+	if recorder.section == nil {
+		return
+	}
+	if recorder.section.uri != "" || recorder.section.entry.Response.HTTPVersion != "" {
+		recorder.bodyText(dump)
+		return
+	}
+
+	lines := strings.Split(strings.TrimSpace(dump), "\n")
+	startLine := strings.SplitN(strings.TrimSpace(lines[0]), " ", 3)
+	switch {
+	case recorder.section.isResponse && len(startLine) >= 2:
+		recorder.responseLine(startLine[0], strings.Join(startLine[1:], " "))
+	case !recorder.section.isResponse && len(startLine) == 3:
+		recorder.requestLine(startLine[0], startLine[1], startLine[2])
+	default:
+		recorder.bodyText(dump)
+		return
+	}
+
+	for _, line := range lines[1:] {
+		header := strings.SplitN(line, ":", 2)
+		if len(header) != 2 {
+			continue
+		}
+		name, value := strings.TrimSpace(header[0]), strings.TrimSpace(header[1])
+		if name == "Host" {
+			recorder.host(value)
+		} else {
+			recorder.header(name, value)
+		}
+	}
+}
+
+// finishSection keeps a finished request until its response or error arrives,
+// and appends the completed entry to the HAR files once it does.
+func (recorder *harRecorder) finishSection() error {
+	section, request := recorder.section, recorder.request
+	recorder.section, recorder.request = nil, nil
+	if section == nil {
+		return nil
+	}
+
+	body := strings.Join(section.body, "\n")
+	if !section.isResponse {
+		entry := section.entry
+		entry.Request.URL = harURL(section.host, section.uri)
+		entry.Request.QueryString = harQueryString(section.uri)
+		if len(section.body) > 0 {
+			entry.Request.PostData = &harPostData{
+				MimeType: harHeaderValue(entry.Request.Headers, "Content-Type"),
+				Text:     body,
+			}
+		}
+
+		if recorder.pending == nil {
+			recorder.pending = map[*http.Request]harPendingRequest{}
+		}
+		recorder.pending[request] = harPendingRequest{entry: entry, startTime: section.date}
+		return nil
+	}
+
+	pendingRequest, found := recorder.pending[request]
+	if !found {
+		return nil
+	}
+	delete(recorder.pending, request)
+
+	entry := pendingRequest.entry
+	entry.Response = section.entry.Response
+	entry.Response.Content.MimeType = harHeaderValue(entry.Response.Headers, "Content-Type")
+	entry.Response.Content.Text = body
+	entry.Response.Content.Size = len(body)
+	entry.Response.RedirectURL = harHeaderValue(entry.Response.Headers, "Location")
+
+	elapsed := float64(section.date.Sub(pendingRequest.startTime)) / float64(time.Millisecond)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	entry.Time = elapsed
+	entry.Timings.Wait = elapsed
+
+	for _, filePath := range recorder.filePaths {
+		err := appendHAREntry(filePath, entry)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newHAREntry(date time.Time) *harEntry {
+	return &harEntry{
+		StartedDateTime: date.Format(harTimeFormat),
+		Request: harRequest{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			QueryString: []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Response: harResponse{
+			Cookies:     []harNameValue{},
+			Headers:     []harNameValue{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+	}
+}
+
+// appendHAREntry adds the entry to the end of the HAR file without reading
+// the entries already in it. The entry is written over the closing brackets
+// of the archive, which are written again after it, so the file is a valid
+// archive after every entry.
+func appendHAREntry(filePath string, entry *harEntry) error {
+	file, err := os.OpenFile(filePath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	var (
+		offset    int64
+		separator string
+	)
+	if info.Size() == 0 {
+		header, err := harHeader()
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(header)
+		if err != nil {
+			return err
+		}
+		offset = int64(len(header))
+	} else {
+		offset, separator, err = harEntriesEnd(file, info.Size())
+		if err != nil {
+			return err
+		}
+	}
+
+	rawEntry, err := json.MarshalIndent(entry, harEntryIndent, "  ")
+	if err != nil {
+		return err
+	}
+
+	contents := []byte(separator + "\n" + harEntryIndent)
+	contents = append(contents, rawEntry...)
+	contents = append(contents, harClosing...)
+	_, err = file.WriteAt(contents, offset)
+	if err != nil {
+		return err
+	}
+	return file.Truncate(offset + int64(len(contents)))
+}
+
+// harHeader returns the start of an archive, up to and including the opening
+// bracket of its entries.
+func harHeader() ([]byte, error) {
+	archive := harFile{
+		Log: harLog{
+			Version: harVersion,
+			Creator: harCreator{Name: harCreatorName, Version: version.VersionString()},
+			Entries: []harEntry{},
+		},
+	}
+
+	contents, err := json.MarshalIndent(archive, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return contents[:bytes.LastIndex(contents, []byte("[]"))+1], nil
+}
+
+// harEntriesEnd finds where the next entry of an existing archive goes by
+// reading only the end of the file. It returns the offset right after the
+// last entry, or after the opening bracket when there are no entries, and the
+// separator that has to precede the next entry.
+func harEntriesEnd(file *os.File, size int64) (int64, string, error) {
+	tailSize := int64(harTailSize)
+	if size < tailSize {
+		tailSize = size
+	}
+
+	tail := make([]byte, tailSize)
+	_, err := file.ReadAt(tail, size-tailSize)
+	if err != nil {
+		return 0, "", err
+	}
+
+	match := harEntriesEndRegexp.FindSubmatchIndex(tail)
+	if match == nil {
+		return 0, "", fmt.Errorf("%s does not end with the entries of an HTTP Archive", file.Name())
+	}
+
+	separator := ","
+	if tail[match[2]] == '[' {
+		separator = ""
+	}
+	return size - tailSize + int64(match[3]), separator, nil
+}
+
+func harHeaderValue(headers []harNameValue, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Name, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
+
+// harURL builds the full request URL. The websocket dumps contain the full
+// traffic controller URL as host; the request loggers only display the host
+// name for the other requests, so https is assumed for them.
+func harURL(host string, uri string) string {
+	if strings.Contains(host, "://") {
+		return strings.TrimSuffix(host, "/") + uri
+	}
+	return "https://" + host + uri
+}
+
+func harQueryString(uri string) []harNameValue {
+	queryString := []harNameValue{}
+
+	requestURL, err := url.Parse(uri)
+	if err != nil {
+		return queryString
+	}
+
+	query := requestURL.Query()
+	names := make([]string, 0, len(query))
+	for name := range query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		for _, value := range query[name] {
+			queryString = append(queryString, harNameValue{Name: name, Value: value})
+		}
+	}
+	return queryString
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"net/http"
 	"regexp"
 	"sync"
 	"time"
@@ -65,6 +66,14 @@ func (display *RequestLoggerTerminalDisplay) DisplayMessage(msg string) error {
 	return nil
 }
 
+// DisplayRequestError displays the error of a request that failed without a
+// response.
+func (display *RequestLoggerTerminalDisplay) DisplayRequestError(err error) error {
+	sanitized := display.dumpSanitizer.ReplaceAllString(err.Error(), RedactedValue)
+	fmt.Fprintf(display.ui.Out, "%s\n", sanitized)
+	return nil
+}
+
 func (display *RequestLoggerTerminalDisplay) DisplayRequestHeader(method string, uri string, httpProtocol string) error {
 	fmt.Fprintf(display.ui.Out, "%s %s %s\n", method, uri, httpProtocol)
 	return nil
@@ -85,6 +94,10 @@ func (display *RequestLoggerTerminalDisplay) HandleInternalError(err error) {
 	fmt.Fprintf(display.ui.Err, "%s\n", display.ui.TranslateText(err.Error()))
 }
 
+// SetRequest does nothing; the terminal displays requests and responses as
+// they happen.
+func (display *RequestLoggerTerminalDisplay) SetRequest(*http.Request) {}
+
 func (display *RequestLoggerTerminalDisplay) Start() error {
 	display.lock.Lock()
 	return nil
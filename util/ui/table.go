@@ -43,6 +43,10 @@ func (ui *UI) DisplayKeyValueTable(prefix string, table [][]string, padding int)
 // Prefix will be prepended to each row and padding adds the specified number
 // of spaces between columns.
 func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding int) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
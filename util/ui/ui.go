@@ -41,6 +41,10 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format command results are displayed in. Text and
+	// tables are not displayed to Out when the format is structured.
+	OutputFormat configv3.OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -65,6 +69,7 @@ func NewUI(config Config) (*UI, error) {
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     config.OutputFormat(),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -92,7 +97,8 @@ func NewTestUI(in io.Reader, out io.Writer, err io.Writer) *UI {
 
 // DisplayError outputs the translated error message to ui.Err if the error
 // satisfies TranslatableError, otherwise it outputs the original error message
-// to ui.Err. It also outputs "FAILED" in bold red to ui.Out.
+// to ui.Err. It also outputs "FAILED" in bold red to ui.Out, unless a
+// structured output format is being displayed.
 func (ui *UI) DisplayError(err error) {
 	var errMsg string
 	if translatableError, ok := err.(translatableerror.TranslatableError); ok {
@@ -102,6 +108,10 @@ func (ui *UI) DisplayError(err error) {
 	}
 	fmt.Fprintf(ui.Err, "%s\n", errMsg)
 
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -111,6 +121,10 @@ func (ui *UI) DisplayError(err error) {
 // DisplayHeader translates the header, bolds and adds the default color to the
 // header, and outputs the result to ui.Out.
 func (ui *UI) DisplayHeader(text string) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -119,6 +133,10 @@ func (ui *UI) DisplayHeader(text string) {
 
 // DisplayNewline outputs a newline to UI.Out.
 func (ui *UI) DisplayNewline() {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -127,6 +145,10 @@ func (ui *UI) DisplayNewline() {
 
 // DisplayOK outputs a bold green translated "OK" to UI.Out.
 func (ui *UI) DisplayOK() {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -136,6 +158,10 @@ func (ui *UI) DisplayOK() {
 // DisplayText translates the template, substitutes in templateValues, and
 // outputs the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayText(template string, templateValues ...map[string]interface{}) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -146,6 +172,10 @@ func (ui *UI) DisplayText(template string, templateValues ...map[string]interfac
 // substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]interface{}) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -160,6 +190,10 @@ func (ui *UI) DisplayTextWithBold(template string, templateValues ...map[string]
 // templateValues, substitutes templateValues into the template, and outputs
 // the result to ui.Out. Only the first map in templateValues is used.
 func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[string]interface{}) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
@@ -219,6 +253,10 @@ func (ui *UI) Writer() io.Writer {
 }
 
 func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, padding int) {
+	if ui.OutputFormat.IsStructured() {
+		return
+	}
+
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 